@@ -0,0 +1,121 @@
+package dbmeta
+
+import "fmt"
+
+// AuditFields the audit columns of a table, set by the generated dao functions to the time and the actor of an insert
+// or update and read only in request bodies. The fields of the audit columns a table does not have are nil.
+type AuditFields struct {
+	CreatedAt *FieldInfo
+	UpdatedAt *FieldInfo
+	CreatedBy *FieldInfo
+	UpdatedBy *FieldInfo
+}
+
+// Fields return the audit fields of the table
+func (a AuditFields) Fields() []*FieldInfo {
+	return nonNilFields(a.CreatedAt, a.UpdatedAt, a.CreatedBy, a.UpdatedBy)
+}
+
+// Created return the audit fields set on insert only
+func (a AuditFields) Created() []*FieldInfo {
+	return nonNilFields(a.CreatedAt, a.CreatedBy)
+}
+
+// Has return true if field is an audit field of the table
+func (a AuditFields) Has(field *FieldInfo) bool {
+	return containsField(a.Fields(), field)
+}
+
+// IsCreated return true if field is an audit field set on insert only
+func (a AuditFields) IsCreated(field *FieldInfo) bool {
+	return containsField(a.Created(), field)
+}
+
+func nonNilFields(fields ...*FieldInfo) []*FieldInfo {
+	var result []*FieldInfo
+	for _, field := range fields {
+		if field != nil {
+			result = append(result, field)
+		}
+	}
+	return result
+}
+
+func containsField(fields []*FieldInfo, field *FieldInfo) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// auditValues go expressions of the values of the supported audit field types, %s is the time.Time or string value
+var auditValues = map[string]string{
+	"time.Time":      "%s",
+	"*time.Time":     "&%s",
+	"sql.NullTime":   "sql.NullTime{Time: %s, Valid: true}",
+	"null.Time":      "null.TimeFrom(%s)",
+	"string":         "%s",
+	"*string":        "&%s",
+	"sql.NullString": "sql.NullString{String: %s, Valid: true}",
+	"null.String":    "null.StringFrom(%s)",
+}
+
+// auditZeros go expressions of the zero values of the supported audit field types
+var auditZeros = map[string]string{
+	"time.Time":      "time.Time{}",
+	"*time.Time":     "nil",
+	"sql.NullTime":   "sql.NullTime{}",
+	"null.Time":      "null.Time{}",
+	"string":         `""`,
+	"*string":        "nil",
+	"sql.NullString": "sql.NullString{}",
+	"null.String":    "null.String{}",
+}
+
+// AuditValue return the go expression converting value, the name of a time.Time variable for a time column or of a
+// string variable for an actor column, to the type of the field
+func (fi *FieldInfo) AuditValue(value string) string {
+	return fmt.Sprintf(auditValues[fi.GoFieldType], value)
+}
+
+// AuditZero return the go expression of the zero value of the type of the field
+func (fi *FieldInfo) AuditZero() string {
+	return auditZeros[fi.GoFieldType]
+}
+
+// auditFields return the audit fields of a table. Columns that are primary keys, of the wrong kind, time for the at
+// columns and string for the by columns, or of a type that can not be set are reported and ignored.
+func (c *Config) auditFields(tableName string, fields []*FieldInfo) AuditFields {
+	columns := make([]string, len(fields))
+	for i, field := range fields {
+		columns[i] = field.ColumnMeta.Name()
+	}
+
+	find := func(patterns []string, kind string) *FieldInfo {
+		column := matchColumn(patterns, columns)
+		if column == "" {
+			return nil
+		}
+
+		for _, field := range fields {
+			if field.ColumnMeta.Name() != column {
+				continue
+			}
+			if field.ColumnMeta.IsPrimaryKey() || field.FilterKind() != kind || auditValues[field.GoFieldType] == "" {
+				c.Report.Warning(StageModel, tableName, column, "table: %s audit column %s is not a %s column of a supported type, it is not set", tableName, column, kind)
+				return nil
+			}
+			return field
+		}
+		return nil
+	}
+
+	return AuditFields{
+		CreatedAt: find(c.CreatedAtColumns, "time"),
+		UpdatedAt: find(c.UpdatedAtColumns, "time"),
+		CreatedBy: find(c.CreatedByColumns, "string"),
+		UpdatedBy: find(c.UpdatedByColumns, "string"),
+	}
+}
@@ -0,0 +1,55 @@
+package dbmeta
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func Test_AuditFields(t *testing.T) {
+	conf := NewConfig(nil)
+	conf.CreatedAtColumns = []string{"created_at"}
+	conf.UpdatedAtColumns = []string{"updated_at"}
+	conf.CreatedByColumns = []string{"created_by"}
+	conf.UpdatedByColumns = []string{"updated_by"}
+	conf.Report.Output = ioutil.Discard
+	tableInfos := loadTestTables(t, conf,
+		"CREATE TABLE posts (id INTEGER PRIMARY KEY, created_at DATETIME NOT NULL, Updated_At DATETIME, created_by TEXT, updated_by VARCHAR(40) NOT NULL)",
+		"CREATE TABLE notes (id INTEGER PRIMARY KEY, body TEXT, updated_by TEXT)",
+		"CREATE TABLE bad (id INTEGER PRIMARY KEY, created_at TEXT, created_by INTEGER)",
+	)
+
+	audit := tableInfos["posts"].Audit
+	if len(audit.Fields()) != 4 || len(audit.Created()) != 2 {
+		t.Fatalf("expected 4 audit fields, 2 created, got %+v", audit)
+	}
+	if audit.UpdatedAt.GoFieldName != "UpdatedAt" || !audit.Has(audit.UpdatedAt) || audit.IsCreated(audit.UpdatedAt) {
+		t.Errorf("unexpected updated at field %+v", audit.UpdatedAt)
+	}
+	if !audit.IsCreated(audit.CreatedBy) {
+		t.Errorf("expected created by to be set on insert only")
+	}
+	if value := audit.CreatedAt.AuditValue("now"); value != "now" {
+		t.Errorf("unexpected created at value %s", value)
+	}
+	if value := audit.UpdatedBy.AuditValue("actor"); value != "actor" {
+		t.Errorf("unexpected updated by value %s", value)
+	}
+	if zero := audit.UpdatedBy.AuditZero(); zero != `""` {
+		t.Errorf("unexpected updated by zero %s", zero)
+	}
+	if zero := audit.UpdatedAt.AuditZero(); zero != "time.Time{}" {
+		t.Errorf("unexpected updated at zero %s", zero)
+	}
+
+	notes := tableInfos["notes"].Audit
+	if fields := notes.Fields(); len(fields) != 1 || notes.UpdatedBy == nil || len(notes.Created()) != 0 {
+		t.Errorf("expected the notes updated by field only, got %+v", notes)
+	}
+
+	if fields := tableInfos["bad"].Audit.Fields(); len(fields) != 0 {
+		t.Errorf("expected no bad audit fields, got %d", len(fields))
+	}
+	if len(conf.Report.Issues) != 2 {
+		t.Errorf("expected 2 audit warnings, got %+v", conf.Report.Issues)
+	}
+}
@@ -0,0 +1,404 @@
+package dbmeta
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SchemaCatalog column, key and length information of a set of tables, loaded with a few bulk catalog queries so the
+// meta data of each table can be loaded without querying the catalog per table and column. A nil catalog makes the
+// loaders query the catalog for every table.
+type SchemaCatalog struct {
+	infoSchema         map[string]map[string]*InformationSchema
+	postgresInfoSchema map[string]map[string]*PostgresInformationSchema
+	msSQLColumns       map[string]map[string]*msSQLColumnInfo
+	sqliteDDL          map[string]string
+	sqliteColumns      map[string]map[string]*sqliteColumnInfo
+}
+
+// LoadSchemaCatalog load the catalog of tableNames with bulk queries for the sql type, nil is returned for database
+// types that are loaded table by table
+func LoadSchemaCatalog(db *sql.DB, sqlType, sqlDatabase string, tableNames []string) (*SchemaCatalog, error) {
+	if len(tableNames) == 0 {
+		return nil, nil
+	}
+
+	catalog := &SchemaCatalog{}
+	tables := sqlStringList(tableNames)
+
+	var err error
+	switch sqlType {
+	case "sqlite3", "sqlite":
+		catalog.sqliteDDL, err = sqliteLoadCatalogDDL(db)
+		if err != nil {
+			return nil, err
+		}
+		// pragma table valued functions require sqlite 3.16, fall back to PRAGMA table_info per table
+		catalog.sqliteColumns, _ = sqliteLoadCatalogPragma(db)
+
+	case "mysql":
+		catalog.infoSchema, err = loadCatalogInformationSchema(db, fmt.Sprintf("table_schema = '%s' AND table_name IN (%s)", escapeSQLString(sqlDatabase), tables))
+		if err != nil {
+			return nil, err
+		}
+
+	case "postgres":
+		catalog.postgresInfoSchema, err = postgresLoadCatalog(db, tables)
+		if err != nil {
+			return nil, err
+		}
+
+	case "mssql":
+		catalog.infoSchema, err = loadCatalogInformationSchema(db, fmt.Sprintf("table_name IN (%s)", tables))
+		if err != nil {
+			return nil, err
+		}
+		catalog.msSQLColumns, err = msSQLLoadCatalog(db, tableNames, tables)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, nil
+	}
+	return catalog, nil
+}
+
+// informationSchema return the information_schema columns of tableName
+func (c *SchemaCatalog) informationSchema(db *sql.DB, tableName string) (map[string]*InformationSchema, error) {
+	if c == nil || c.infoSchema == nil {
+		return LoadTableInfoFromMSSqlInformationSchema(db, tableName)
+	}
+	return c.infoSchema[tableName], nil
+}
+
+// fieldLen return the character maximum length of a column
+func (c *SchemaCatalog) fieldLen(db *sql.DB, sqlDatabase, tableName, columnName string) (int64, error) {
+	if c == nil || c.infoSchema == nil {
+		return GetFieldLenFromInformationSchema(db, sqlDatabase, tableName, columnName)
+	}
+
+	col, ok := c.infoSchema[tableName][columnName]
+	if !ok {
+		return -1, fmt.Errorf("column %s.%s not found in information_schema", tableName, columnName)
+	}
+	return catalogInt64(col.CharacterMaximumLength)
+}
+
+// postgresInformationSchema return the information_schema columns of tableName, including the primary key flag
+func (c *SchemaCatalog) postgresInformationSchema(db *sql.DB, tableName string) (map[string]*PostgresInformationSchema, error) {
+	if c == nil || c.postgresInfoSchema == nil {
+		colInfo, err := LoadTableInfoFromPostgresInformationSchema(db, tableName)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load identity info schema from postgres table: %s error: %v", tableName, err)
+		}
+
+		err = postgresLoadPrimaryKey(db, tableName, colInfo)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load primary key from postgres: %v", err)
+		}
+		return colInfo, nil
+	}
+
+	colInfo, ok := c.postgresInfoSchema[tableName]
+	if !ok {
+		colInfo = make(map[string]*PostgresInformationSchema)
+	}
+	return colInfo, nil
+}
+
+// msSQLColumnInfo return the sys.columns info of tableName, including the primary key flag
+func (c *SchemaCatalog) msSQLColumnInfo(db *sql.DB, tableName string) (map[string]*msSQLColumnInfo, error) {
+	if c == nil || c.msSQLColumns == nil {
+		colInfo, err := msSQLloadFromSysColumns(db, tableName)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load ddl from ms sql: %v", err)
+		}
+
+		err = msSQLLoadPrimaryKey(db, tableName, colInfo)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load ddl from ms sql: %v", err)
+		}
+		return colInfo, nil
+	}
+
+	colInfo, ok := c.msSQLColumns[tableName]
+	if !ok {
+		colInfo = make(map[string]*msSQLColumnInfo)
+	}
+	return colInfo, nil
+}
+
+// sqliteDDLFor return the create statement of tableName
+func (c *SchemaCatalog) sqliteDDLFor(db *sql.DB, tableName string) (string, error) {
+	if c == nil || c.sqliteDDL == nil {
+		return sqliteLoadDDL(db, tableName)
+	}
+
+	ddl, ok := c.sqliteDDL[tableName]
+	if !ok {
+		return "", fmt.Errorf("table %s not found in sqlite_master", tableName)
+	}
+	return ddl, nil
+}
+
+// sqlitePragma return the PRAGMA table_info of tableName
+func (c *SchemaCatalog) sqlitePragma(db *sql.DB, tableName string) (map[string]*sqliteColumnInfo, error) {
+	if c == nil || c.sqliteColumns == nil {
+		return sqliteLoadPragma(db, tableName)
+	}
+
+	colsInfos, ok := c.sqliteColumns[tableName]
+	if !ok {
+		colsInfos = make(map[string]*sqliteColumnInfo)
+	}
+	return colsInfos, nil
+}
+
+func loadCatalogInformationSchema(db *sql.DB, where string) (map[string]map[string]*InformationSchema, error) {
+	infoSQL := fmt.Sprintf(`
+SELECT TABLE_CATALOG, TABLE_SCHEMA, TABLE_NAME, ORDINAL_POSITION, COLUMN_NAME, DATA_TYPE, character_maximum_length,
+column_default, is_nullable
+FROM information_schema.columns
+WHERE %s
+ORDER BY table_name, ordinal_position;
+`, where)
+
+	res, err := db.Query(infoSQL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load catalog from information_schema: %v", err)
+	}
+	defer res.Close()
+
+	tables := make(map[string]map[string]*InformationSchema)
+	for res.Next() {
+		ci := &InformationSchema{}
+		err = res.Scan(&ci.TableCatalog, &ci.TableSchema, &ci.TableName, &ci.OrdinalPosition, &ci.ColumnName, &ci.DataType, &ci.CharacterMaximumLength,
+			&ci.ColumnDefault, &ci.IsNullable)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load catalog from information_schema Scan: %v", err)
+		}
+
+		if tables[ci.TableName] == nil {
+			tables[ci.TableName] = make(map[string]*InformationSchema)
+		}
+		tables[ci.TableName][ci.ColumnName] = ci
+	}
+	return tables, res.Err()
+}
+
+func postgresLoadCatalog(db *sql.DB, tables string) (map[string]map[string]*PostgresInformationSchema, error) {
+	infoSQL := fmt.Sprintf(`
+SELECT TABLE_CATALOG, table_schema, table_name, ordinal_position, column_name, data_type, character_maximum_length,
+column_default, is_nullable, is_identity
+FROM information_schema.columns
+WHERE table_name IN (%s)
+ORDER BY table_name, ordinal_position;
+`, tables)
+
+	res, err := db.Query(infoSQL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load catalog from postgres: %v", err)
+	}
+	defer res.Close()
+
+	catalog := make(map[string]map[string]*PostgresInformationSchema)
+	for res.Next() {
+		ci := &PostgresInformationSchema{}
+		err = res.Scan(&ci.TableCatalog, &ci.TableSchema, &ci.TableName, &ci.OrdinalPosition, &ci.ColumnName, &ci.DataType, &ci.CharacterMaximumLength,
+			&ci.ColumnDefault, &ci.IsNullable, &ci.IsIdentity)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load catalog from postgres Scan: %v", err)
+		}
+
+		if catalog[ci.TableName] == nil {
+			catalog[ci.TableName] = make(map[string]*PostgresInformationSchema)
+		}
+		catalog[ci.TableName][ci.ColumnName] = ci
+	}
+	if err = res.Err(); err != nil {
+		return nil, err
+	}
+
+	primaryKeySQL := fmt.Sprintf(`
+	SELECT t.table_name, c.column_name
+	FROM information_schema.key_column_usage AS c
+	LEFT JOIN information_schema.table_constraints AS t
+	ON t.constraint_name = c.constraint_name
+	WHERE t.table_name IN (%s) AND t.constraint_type = 'PRIMARY KEY';
+`, tables)
+
+	err = loadCatalogPrimaryKeys(db, primaryKeySQL, func(tableName, columnName string) {
+		if colInfo, ok := catalog[tableName][columnName]; ok {
+			colInfo.PrimaryKey = true
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return catalog, nil
+}
+
+func msSQLLoadCatalog(db *sql.DB, tableNames []string, tables string) (map[string]map[string]*msSQLColumnInfo, error) {
+	objectIDs := make([]string, len(tableNames))
+	for i, tableName := range tableNames {
+		objectIDs[i] = fmt.Sprintf("object_id('dbo.%s')", escapeSQLString(tableName))
+	}
+
+	identitySQL := fmt.Sprintf(`
+SELECT OBJECT_NAME(object_id), name, is_identity, is_nullable, max_length
+FROM sys.columns
+WHERE object_id IN (%s)`, strings.Join(objectIDs, ", "))
+
+	res, err := db.Query(identitySQL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load catalog from ms sql: %v", err)
+	}
+	defer res.Close()
+
+	catalog := make(map[string]map[string]*msSQLColumnInfo)
+	for res.Next() {
+		var tableName, name string
+		var isIdentity, isNullable bool
+		var maxLength int64
+		err = res.Scan(&tableName, &name, &isIdentity, &isNullable, &maxLength)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load catalog from ms sql Scan: %v", err)
+		}
+
+		if catalog[tableName] == nil {
+			catalog[tableName] = make(map[string]*msSQLColumnInfo)
+		}
+		catalog[tableName][name] = &msSQLColumnInfo{
+			name:       name,
+			isIdentity: isIdentity,
+			isNullable: isNullable,
+			maxLength:  maxLength,
+		}
+	}
+	if err = res.Err(); err != nil {
+		return nil, err
+	}
+
+	primaryKeySQL := fmt.Sprintf(`
+SELECT Col.Table_Name, Col.Column_Name from
+    INFORMATION_SCHEMA.TABLE_CONSTRAINTS Tab,
+    INFORMATION_SCHEMA.CONSTRAINT_COLUMN_USAGE Col
+WHERE
+    Col.Constraint_Name = Tab.Constraint_Name
+    AND Col.Table_Name = Tab.Table_Name
+    AND Constraint_Type = 'PRIMARY KEY'
+    AND Col.Table_Name IN (%s)
+`, tables)
+
+	err = loadCatalogPrimaryKeys(db, primaryKeySQL, func(tableName, columnName string) {
+		if colInfo, ok := catalog[tableName][columnName]; ok {
+			colInfo.primaryKey = true
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return catalog, nil
+}
+
+// loadCatalogPrimaryKeys run a query returning table and column names of primary keys, calling set for each row
+func loadCatalogPrimaryKeys(db *sql.DB, primaryKeySQL string, set func(tableName, columnName string)) error {
+	res, err := db.Query(primaryKeySQL)
+	if err != nil {
+		return fmt.Errorf("unable to load primary keys: %v", err)
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var tableName, columnName string
+		err = res.Scan(&tableName, &columnName)
+		if err != nil {
+			return fmt.Errorf("unable to load primary keys Scan: %v", err)
+		}
+		set(tableName, columnName)
+	}
+	return res.Err()
+}
+
+func sqliteLoadCatalogDDL(db *sql.DB) (map[string]string, error) {
+	res, err := db.Query("SELECT name, sql FROM sqlite_master WHERE type='table' AND sql IS NOT NULL;")
+	if err != nil {
+		return nil, fmt.Errorf("unable to load ddl from sqlite_master: %v", err)
+	}
+	defer res.Close()
+
+	ddls := make(map[string]string)
+	for res.Next() {
+		var name, ddl string
+		err = res.Scan(&name, &ddl)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load ddl from sqlite_master Scan: %v", err)
+		}
+		ddls[name] = ddl
+	}
+	return ddls, res.Err()
+}
+
+func sqliteLoadCatalogPragma(db *sql.DB) (map[string]map[string]*sqliteColumnInfo, error) {
+	res, err := db.Query(`SELECT m.name, p.cid, p.name, p.type, p."notnull", p.dflt_value, p.pk
+FROM sqlite_master m JOIN pragma_table_info(m.name) p
+WHERE m.type='table';`)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load pragma_table_info: %v", err)
+	}
+	defer res.Close()
+
+	catalog := make(map[string]map[string]*sqliteColumnInfo)
+	for res.Next() {
+		var tableName string
+		ci := &sqliteColumnInfo{}
+		err = res.Scan(&tableName, &ci.cid, &ci.name, &ci.dataType, &ci.notnull, &ci.dfltValue, &ci.primaryKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load pragma_table_info Scan: %v", err)
+		}
+
+		if catalog[tableName] == nil {
+			catalog[tableName] = make(map[string]*sqliteColumnInfo)
+		}
+		catalog[tableName][ci.name] = ci
+	}
+	return catalog, res.Err()
+}
+
+// catalogInt64 convert a numeric value scanned into an interface{} to int64
+func catalogInt64(val interface{}) (int64, error) {
+	switch v := val.(type) {
+	case int64:
+		return v, nil
+	case int32:
+		return int64(v), nil
+	case int:
+		return int64(v), nil
+	case float64:
+		return int64(v), nil
+	case []uint8:
+		return strconv.ParseInt(BytesToString(v), 10, 64)
+	case string:
+		return strconv.ParseInt(v, 10, 64)
+	case nil:
+		return -1, fmt.Errorf("no value")
+	default:
+		return -1, fmt.Errorf("unexpected type %T", val)
+	}
+}
+
+// sqlStringList return names as a comma separated list of quoted sql strings
+func sqlStringList(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = "'" + escapeSQLString(name) + "'"
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func escapeSQLString(val string) string {
+	return strings.Replace(val, "'", "''", -1)
+}
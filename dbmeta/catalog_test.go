@@ -0,0 +1,68 @@
+package dbmeta
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/jimsmart/schema"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func Test_LoadSchemaCatalog(t *testing.T) {
+	if !Exists(goldenSampleDb) {
+		t.Skipf("%s not available", goldenSampleDb)
+	}
+
+	db, err := sql.Open("sqlite3", goldenSampleDb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	schemaTables, err := schema.TableNames(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tableNames []string
+	for _, st := range schemaTables {
+		if st[1] != "sqlite_sequence" && st[1] != "sqlite_stat1" {
+			tableNames = append(tableNames, st[1])
+		}
+	}
+
+	catalog, err := LoadSchemaCatalog(db, "sqlite3", "main", tableNames)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if catalog == nil || len(catalog.sqliteDDL) == 0 || len(catalog.sqliteColumns) == 0 {
+		t.Fatalf("catalog was not loaded in bulk: %+v", catalog)
+	}
+
+	for _, tableName := range tableNames {
+		expected, err := LoadMeta("sqlite3", db, "main", tableName)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actual, err := LoadMetaFromCatalog("sqlite3", db, "main", tableName, catalog)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("%s: meta data loaded from the catalog differs\nexpected: %+v\nactual:   %+v", tableName, expected, actual)
+		}
+	}
+
+	if _, err = LoadMetaFromCatalog("sqlite3", db, "main", "no_such_table", catalog); err == nil {
+		t.Errorf("expected an error for a table missing from the catalog")
+	}
+}
+
+func Test_SqlStringList(t *testing.T) {
+	if got := sqlStringList([]string{"albums", "o'brien"}); got != "'albums', 'o''brien'" {
+		t.Errorf("unexpected list %s", got)
+	}
+}
@@ -0,0 +1,141 @@
+package dbmeta
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// TableChecksums return a checksum of the schema definition of every table in the database, computed from a few catalog
+// queries so that schema changes can be detected without loading the full table meta data.
+func TableChecksums(db *sql.DB, sqlType, sqlDatabase string) (map[string]string, error) {
+	var queries []string
+
+	switch sqlType {
+	case "sqlite3", "sqlite":
+		queries = []string{
+			"SELECT tbl_name, type, name, sql FROM sqlite_master WHERE sql IS NOT NULL ORDER BY tbl_name, type, name",
+		}
+	case "mysql":
+		queries = []string{
+			fmt.Sprintf(`SELECT table_name, ordinal_position, column_name, column_type, is_nullable, column_default, column_key, extra
+FROM information_schema.columns
+WHERE table_schema = '%s'
+ORDER BY table_name, ordinal_position`, sqlDatabase),
+			fmt.Sprintf(`SELECT table_name, constraint_name, column_name, ordinal_position
+FROM information_schema.key_column_usage
+WHERE table_schema = '%s'
+ORDER BY table_name, constraint_name, ordinal_position`, sqlDatabase),
+		}
+	case "postgres", "mssql":
+		schemaFilter := "table_schema not in ('information_schema', 'pg_catalog')"
+		if sqlType == "mssql" {
+			schemaFilter = fmt.Sprintf("table_catalog = '%s'", sqlDatabase)
+		}
+		queries = []string{
+			fmt.Sprintf(`SELECT table_name, ordinal_position, column_name, data_type, character_maximum_length, is_nullable, column_default
+FROM information_schema.columns
+WHERE %s
+ORDER BY table_name, ordinal_position`, schemaFilter),
+			fmt.Sprintf(`SELECT table_name, constraint_name, column_name, ordinal_position
+FROM information_schema.key_column_usage
+WHERE %s
+ORDER BY table_name, constraint_name, ordinal_position`, schemaFilter),
+		}
+	default:
+		return nil, fmt.Errorf("schema checksums are not supported for %s", sqlType)
+	}
+
+	hashes := make(map[string]hash.Hash)
+	for _, query := range queries {
+		err := hashRows(db, query, hashes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	checksums := make(map[string]string, len(hashes))
+	for tableName, h := range hashes {
+		checksums[tableName] = hex.EncodeToString(h.Sum(nil))
+	}
+	return checksums, nil
+}
+
+// hashRows add the rows of query to the hash of the table named by the first column
+func hashRows(db *sql.DB, query string, hashes map[string]hash.Hash) error {
+	rows, err := db.Query(query)
+	if err != nil {
+		return fmt.Errorf("unable to load schema checksums: %v", err)
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+
+	values := make([]sql.NullString, len(cols))
+	dest := make([]interface{}, len(cols))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+
+	for rows.Next() {
+		err = rows.Scan(dest...)
+		if err != nil {
+			return fmt.Errorf("unable to load schema checksums Scan: %v", err)
+		}
+
+		h, ok := hashes[values[0].String]
+		if !ok {
+			h = sha256.New()
+			hashes[values[0].String] = h
+		}
+
+		for _, value := range values[1:] {
+			if value.Valid {
+				h.Write([]byte(value.String))
+			}
+			h.Write([]byte{0})
+		}
+		h.Write([]byte{'\n'})
+	}
+	return rows.Err()
+}
+
+// DirChecksum return a checksum of the names and contents of all files within dirs, empty dirs are ignored
+func DirChecksum(dirs ...string) (string, error) {
+	h := sha256.New()
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+
+		err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+
+			content, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+
+			h.Write([]byte(strings.TrimPrefix(path, dir)))
+			h.Write([]byte{0})
+			h.Write(content)
+			h.Write([]byte{0})
+			return nil
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
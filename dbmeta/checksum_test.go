@@ -0,0 +1,94 @@
+package dbmeta
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_TableChecksums(t *testing.T) {
+	db, _ := openTestDB(t)
+
+	exec := func(query string) {
+		if _, err := db.Exec(query); err != nil {
+			t.Fatal(err)
+		}
+	}
+	exec("CREATE TABLE albums (id INTEGER PRIMARY KEY, title TEXT)")
+	exec("CREATE TABLE artists (id INTEGER PRIMARY KEY, name TEXT)")
+
+	before, err := TableChecksums(db, "sqlite3", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(before) != 2 || before["albums"] == "" || before["artists"] == "" {
+		t.Fatalf("unexpected checksums %v", before)
+	}
+
+	exec("ALTER TABLE albums ADD COLUMN year INTEGER")
+	exec("CREATE INDEX artists_name ON artists (name)")
+	exec("INSERT INTO albums (title) VALUES ('data does not change the schema')")
+
+	after, err := TableChecksums(db, "sqlite3", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after["albums"] == before["albums"] {
+		t.Errorf("albums checksum did not change after adding a column")
+	}
+	if after["artists"] == before["artists"] {
+		t.Errorf("artists checksum did not change after adding an index")
+	}
+
+	exec("INSERT INTO albums (title) VALUES ('another row')")
+	again, err := TableChecksums(db, "sqlite3", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again["albums"] != after["albums"] {
+		t.Errorf("albums checksum changed after inserting data")
+	}
+
+	if _, err = TableChecksums(db, "oracle", "main"); err == nil {
+		t.Errorf("expected an error for an unsupported sql type")
+	}
+}
+
+func Test_DirChecksum(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "gen-checksum")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	write := func(name, content string) {
+		if err := ioutil.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checksum := func() string {
+		sum, err := DirChecksum(tmpDir, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sum
+	}
+
+	write("model.go.tmpl", "package model")
+	first := checksum()
+	if checksum() != first {
+		t.Fatalf("checksum is not stable")
+	}
+
+	write("model.go.tmpl", "package models")
+	second := checksum()
+	if second == first {
+		t.Errorf("checksum did not change after editing a file")
+	}
+
+	write("api.go.tmpl", "")
+	if checksum() == second {
+		t.Errorf("checksum did not change after adding a file")
+	}
+}
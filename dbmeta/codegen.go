@@ -140,7 +140,12 @@ func (c *Config) GetTemplate(genTemplate *GenTemplate) (*template.Template, erro
 		baseName == "code_dao_gorm.md.tmpl" ||
 		baseName == "code_http.md.tmpl" {
 
-		operations := []string{"add", "delete", "get", "getall", "update"}
+		operations := []string{"add", "delete", "get", "getall", "update", "patch", "upsert", "bulkadd", "restore"}
+		if baseName == "api.go.tmpl" {
+			operations = append(operations, "batch")
+		} else if baseName != "code_http.md.tmpl" {
+			operations = append(operations, "getpage")
+		}
 		for _, op := range operations {
 			var filename string
 			if baseName == "api.go.tmpl" {
@@ -458,8 +463,9 @@ func (c *Config) JSONTagOmitEmpty(name string) string {
 	return fmt.Sprintf("`json:\"%s,omitempty\"`", c.JSONFieldName(name))
 }
 
-// GenerateTableFile generate file from template using specific table used within templates
-func (c *Config) GenerateTableFile(tableName, templateFilename, outputDirectory, outputFileName string) string {
+// GenerateTableFile generate file from template using specific table used within templates, the error of writing the
+// file, including a failing post-file hook set to abort, stops the execution of the calling template
+func (c *Config) GenerateTableFile(tableName, templateFilename, outputDirectory, outputFileName string) (string, error) {
 	buf := bytes.Buffer{}
 
 	buf.WriteString(fmt.Sprintf("GenerateTableFile( %s, %s, %s, %s)\n", tableName, templateFilename, outputDirectory, outputFileName))
@@ -467,33 +473,36 @@ func (c *Config) GenerateTableFile(tableName, templateFilename, outputDirectory,
 	tableInfo, ok := c.TableInfos[tableName]
 	if !ok {
 		buf.WriteString(fmt.Sprintf("Table: %s - No tableInfo found\n", tableName))
-		return buf.String()
+		return buf.String(), nil
 	}
 
 	if len(tableInfo.Fields) == 0 {
 		buf.WriteString(fmt.Sprintf("able: %s - No Fields Available\n", tableName))
-		return buf.String()
+		return buf.String(), nil
 	}
 
 	data := c.CreateContextForTableFile(tableInfo)
 
 	fileOutDir := filepath.Join(c.OutDir, outputDirectory)
-	err := os.MkdirAll(fileOutDir, 0777)
-	if err != nil && !c.Overwrite {
-		buf.WriteString(fmt.Sprintf("unable to create fileOutDir: %s error: %v\n", fileOutDir, err))
-		return buf.String()
+	if !c.DryRun {
+		if err := os.MkdirAll(fileOutDir, 0777); err != nil && !c.Overwrite {
+			buf.WriteString(fmt.Sprintf("unable to create fileOutDir: %s error: %v\n", fileOutDir, err))
+			return buf.String(), nil
+		}
 	}
 
-	var tpl *GenTemplate
-	if tpl, err = c.TemplateLoader(templateFilename); err != nil {
+	tpl, err := c.TemplateLoader(templateFilename)
+	if err != nil {
 		buf.WriteString(fmt.Sprintf("Error loading template %v\n", err))
-		return buf.String()
+		return buf.String(), nil
 	}
 
 	outputFile := filepath.Join(fileOutDir, outputFileName)
 	buf.WriteString(fmt.Sprintf("Writing %s -> %s\n", templateFilename, outputFile))
-	err = c.WriteTemplate(tpl, data, outputFile)
-	return buf.String()
+	if err = c.WriteTemplate(tpl, data, outputFile); err != nil {
+		return buf.String(), err
+	}
+	return buf.String(), nil
 }
 
 // CreateContextForTableFile create map context for a db table
@@ -501,8 +510,30 @@ func (c *Config) CreateContextForTableFile(tableInfo *ModelInfo) map[string]inte
 	var modelInfo = map[string]interface{}{
 		"StructName":      tableInfo.StructName,
 		"TableName":       tableInfo.DBMeta.TableName(),
+		"RouteName":       tableInfo.RouteName,
 		"ShortStructName": strings.ToLower(string(tableInfo.StructName[0])),
 		"TableInfo":       tableInfo,
+		"db":              "Conn(ctx, DB)",
+		"daoRecv":         "",
+		"daoCall":         "",
+		"daoRepo":         c.DaoPackageName + ".",
+		"daoTx":           c.DaoPackageName + ".WithTx",
+		"apiRecv":         "",
+		"apiHandler":      "",
+		"funcSuffix":      tableInfo.StructName,
+	}
+
+	if c.Repository {
+		// dao functions are methods of the repository using its own handle, api handlers are methods of the handler
+		// holding the injected repository
+		modelInfo["db"] = "Conn(ctx, r.DB)"
+		modelInfo["daoRecv"] = fmt.Sprintf("(r *DB%sRepository) ", tableInfo.StructName)
+		modelInfo["daoCall"] = "r."
+		modelInfo["daoRepo"] = "h.Repository."
+		modelInfo["daoTx"] = "h.Tx"
+		modelInfo["apiRecv"] = fmt.Sprintf("(h *%sHandler) ", tableInfo.StructName)
+		modelInfo["apiHandler"] = "handler."
+		modelInfo["funcSuffix"] = ""
 	}
 
 	nonPrimaryKeys := NonPrimaryKeyNames(tableInfo.DBMeta)
@@ -544,8 +575,10 @@ func (c *Config) CreateContextForTableFile(tableInfo *ModelInfo) map[string]inte
 func (c *Config) WriteTemplate(genTemplate *GenTemplate, data map[string]interface{}, outputFile string) error {
 	//fmt.Printf("WriteTemplate %s\n", outputFile)
 
-	if !c.Overwrite && Exists(outputFile) {
+	exists := Exists(outputFile)
+	if !c.Overwrite && exists {
 		fmt.Printf("not overwriting %s\n", outputFile)
+		c.recordOutput(genTemplate, data, outputFile, OutputSkipped, nil)
 		return nil
 	}
 
@@ -573,6 +606,7 @@ func (c *Config) WriteTemplate(genTemplate *GenTemplate, data map[string]interfa
 
 	data["apiFQPN"] = c.APIFQPN
 	data["apiPackageName"] = c.APIPackageName
+	data["datasources"] = c.Datasources
 
 	data["sqlType"] = c.SQLType
 	data["sqlConnStr"] = c.SQLConnStr
@@ -594,11 +628,39 @@ func (c *Config) WriteTemplate(genTemplate *GenTemplate, data map[string]interfa
 		return fmt.Errorf("error in rendering %s: %s", genTemplate.Name, err.Error())
 	}
 
-	fileContents, err := c.format(genTemplate, buf.Bytes(), outputFile)
+	content := buf.Bytes()
+	var existing []byte
+	if exists {
+		existing, err = ioutil.ReadFile(outputFile)
+		if err != nil {
+			return fmt.Errorf("error reading %s - error: %v", outputFile, err)
+		}
+
+		content, err = c.preserveProtectedRegions(genTemplate, content, existing, outputFile)
+		if err != nil {
+			return fmt.Errorf("error preserving protected regions in %s - error: %v", outputFile, err)
+		}
+	}
+
+	fileContents, err := c.format(genTemplate, content, outputFile)
 	if err != nil {
 		return fmt.Errorf("error writing %s - error: %v", outputFile, err)
 	}
 
+	status := OutputCreated
+	if exists {
+		status = OutputChanged
+		if bytes.Equal(existing, fileContents) {
+			status = OutputUnchanged
+		}
+	}
+	output := c.recordOutput(genTemplate, data, outputFile, status, fileContents)
+
+	if c.DryRun {
+		c.printPendingChange(outputFile, status, existing, fileContents)
+		return nil
+	}
+
 	err = ioutil.WriteFile(outputFile, fileContents, 0777)
 	if err != nil {
 		return fmt.Errorf("error writing %s - error: %v", outputFile, err)
@@ -607,7 +669,109 @@ func (c *Config) WriteTemplate(genTemplate *GenTemplate, data map[string]interfa
 	if c.Verbose {
 		fmt.Printf("writing %s\n", outputFile)
 	}
-	return nil
+	return c.RunHooks(HookPostFile, output)
+}
+
+// OutputStatus describes the effect writing an output file had, or would have had during a dry run
+type OutputStatus int
+
+const (
+	// OutputUnchanged the rendered content matches the existing file
+	OutputUnchanged OutputStatus = iota
+	// OutputCreated the file did not exist
+	OutputCreated
+	// OutputChanged the rendered content differs from the existing file
+	OutputChanged
+	// OutputSkipped the file exists and overwriting is disabled
+	OutputSkipped
+)
+
+func (s OutputStatus) String() string {
+	switch s {
+	case OutputCreated:
+		return "created"
+	case OutputChanged:
+		return "changed"
+	case OutputSkipped:
+		return "skipped"
+	default:
+		return "unchanged"
+	}
+}
+
+// OutputFile a file written by WriteTemplate
+type OutputFile struct {
+	Path     string
+	Template string
+	Table    string
+	Status   OutputStatus
+	// Hash sha256 of the generated content, empty if the file was skipped
+	Hash string
+}
+
+func (c *Config) recordOutput(genTemplate *GenTemplate, data map[string]interface{}, outputFile string, status OutputStatus, fileContents []byte) *OutputFile {
+	output := &OutputFile{Path: outputFile, Template: genTemplate.Name, Status: status}
+	if tableName, ok := data["TableName"].(string); ok {
+		output.Table = tableName
+	}
+	if status != OutputSkipped {
+		output.Hash = HashContent(fileContents)
+	}
+	c.Outputs = append(c.Outputs, output)
+	return output
+}
+
+// PendingChanges return the number of output files that were, or during a dry run would be, created or changed
+func (c *Config) PendingChanges() (created, changed, unchanged int) {
+	for _, output := range c.Outputs {
+		switch output.Status {
+		case OutputCreated:
+			created++
+		case OutputChanged:
+			changed++
+		default:
+			unchanged++
+		}
+	}
+	return
+}
+
+func (c *Config) printPendingChange(outputFile string, status OutputStatus, existing, fileContents []byte) {
+	name := c.relativeOutputPath(outputFile)
+	msg := fmt.Sprintf("%-9s %s\n", status, name)
+	switch {
+	case au == nil:
+		fmt.Print(msg)
+	case status == OutputCreated:
+		fmt.Print(au.Green(msg))
+	case status == OutputChanged:
+		fmt.Print(au.Yellow(msg))
+	default:
+		fmt.Print(msg)
+	}
+
+	if status == OutputChanged {
+		fmt.Print(UnifiedDiff("a/"+name, "b/"+name, existing, fileContents))
+	}
+}
+
+// preserveProtectedRegions carry the protected regions of the existing outputFile over into the rendered content
+func (c *Config) preserveProtectedRegions(genTemplate *GenTemplate, content, existing []byte, outputFile string) ([]byte, error) {
+	merged, orphaned, err := MergeProtectedRegions(content, existing)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, region := range orphaned {
+		msg := fmt.Sprintf("Warning - protected region %s in %s no longer exists in template %s, its content will be dropped:\n%s\n",
+			region.Name, outputFile, genTemplate.Name, region.Content)
+		if au != nil {
+			fmt.Print(au.Yellow(msg))
+		} else {
+			fmt.Print(msg)
+		}
+	}
+	return merged, nil
 }
 
 func (c *Config) format(genTemplate *GenTemplate, content []byte, outputFile string) ([]byte, error) {
@@ -659,32 +823,33 @@ func Exists(name string) bool {
 	return true
 }
 
-// GenerateFile generate file from template, non table used within templates
-func (c *Config) GenerateFile(templateFilename, outputDirectory, outputFileName string, overwrite bool) string {
+// GenerateFile generate file from template, non table used within templates, the error of writing the file, including
+// a failing post-file hook set to abort, stops the execution of the calling template
+func (c *Config) GenerateFile(templateFilename, outputDirectory, outputFileName string, overwrite bool) (string, error) {
 	buf := bytes.Buffer{}
 	buf.WriteString(fmt.Sprintf("GenerateFile( %s, %s, %s)\n", templateFilename, outputDirectory, outputFileName))
 	fileOutDir := outputDirectory
-	err := os.MkdirAll(fileOutDir, 0777)
-	if err != nil && !overwrite {
-		buf.WriteString(fmt.Sprintf("unable to create fileOutDir: %s error: %v\n", fileOutDir, err))
-		return buf.String()
+	if !c.DryRun {
+		if err := os.MkdirAll(fileOutDir, 0777); err != nil && !overwrite {
+			buf.WriteString(fmt.Sprintf("unable to create fileOutDir: %s error: %v\n", fileOutDir, err))
+			return buf.String(), nil
+		}
 	}
 
 	data := map[string]interface{}{}
 
-	var tpl *GenTemplate
-	if tpl, err = c.TemplateLoader(templateFilename); err != nil {
+	tpl, err := c.TemplateLoader(templateFilename)
+	if err != nil {
 		buf.WriteString(fmt.Sprintf("Error loading template %v\n", err))
-		return buf.String()
+		return buf.String(), nil
 	}
 
 	outputFile := filepath.Join(fileOutDir, outputFileName)
 	buf.WriteString(fmt.Sprintf("Writing %s -> %s\n", templateFilename, outputFile))
-	err = c.WriteTemplate(tpl, data, outputFile)
-	if err != nil {
-		buf.WriteString(fmt.Sprintf("Error calling WriteTemplate %s -> %v\n", templateFilename, err))
+	if err = c.WriteTemplate(tpl, data, outputFile); err != nil {
+		return buf.String(), err
 	}
-	return buf.String()
+	return buf.String(), nil
 }
 
 // DisplayConfig display config info
@@ -709,8 +874,9 @@ type copyRules struct {
 }
 
 // FileSystemCopy template command to copy files, directories and to pass --include XXX and --exclude YYY regular expressions. Files ending in .tmpl will be processed as a template.
-// Files ending in .table.tmpl will be processed as a template iterating through all the tables
-func (c *Config) FileSystemCopy(src, dst string, options ...string) string {
+// Files ending in .table.tmpl will be processed as a template iterating through all the tables. The error of the copy,
+// including a failing post-file hook set to abort, stops the execution of the calling template
+func (c *Config) FileSystemCopy(src, dst string, options ...string) (string, error) {
 	dstDir := filepath.Join(c.OutDir, dst)
 
 	patterns := make([]*copyRules, 0)
@@ -775,9 +941,9 @@ func (c *Config) FileSystemCopy(src, dst string, options ...string) string {
 
 	result, err := utils.Copy(src, dstDir, opt)
 	if err != nil {
-		return fmt.Sprintf("copy returned an error %v", err)
+		return "", fmt.Errorf("copy %s %s returned an error %v", src, dstDir, err)
 	}
-	return fmt.Sprintf("copy %s %s\n%s\n", src, dstDir, result.String())
+	return fmt.Sprintf("copy %s %s\n%s\n", src, dstDir, result.String()), nil
 }
 
 // Mkdir template command to mkdir under the output directory
@@ -841,13 +1007,16 @@ func (c *Config) tableFileHandlerFunc(src, dest string, info os.FileInfo, opt ut
 	// parent := filepath.Base(dir)
 	results.Info.WriteString(fmt.Sprintf("WriteTableTemplate %s\n", src))
 
-	for tableName, tableInfo := range c.TableInfos {
+	for _, tableName := range SortedTableNames(c.TableInfos) {
+		tableInfo := c.TableInfos[tableName]
 		data := c.CreateContextForTableFile(tableInfo)
 		// fileName := filepath.Join(dir, tableName+name)
 		name := c.ReplaceFileNamingTemplate(tableName) + filepath.Ext(tmplateName)
 		fileName := filepath.Join(dir, name)
 		results.Info.WriteString(fmt.Sprintf("    table: %-25s  %s\n", tableName, fileName))
-		c.WriteTemplate(genTemplate, data, fileName)
+		if err = c.WriteTemplate(genTemplate, data, fileName); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -909,6 +1078,7 @@ type Config struct {
 	Verbose               bool
 	OutDir                string
 	Overwrite             bool
+	DryRun                bool
 	LineEndingCRLF        bool
 	CmdLine               string
 	CmdLineWrapped        string
@@ -916,10 +1086,40 @@ type Config struct {
 	FileNamingTemplate    string
 	ModelNamingTemplate   string
 	FieldNamingTemplate   string
+	Initialisms           []string
+	IgnoredInitialisms    []string
+	TablePrefixes         []string
+	TableSuffixes         []string
+	ColumnPrefixes        []string
+	ColumnSuffixes        []string
+	SingularStructs       bool
+	WordMappings          map[string]string
+	RoutePrefix           string
+	Datasources           []*Datasource
+	Repository            bool
+	Batch                 bool
+	GenerateDao           bool
+	GenerateRest          bool
+	GenerateServer        bool
+	GenerateMod           bool
+	GenerateMakefile      bool
+	GenerateProject       bool
+	CopyTemplates         bool
+	SoftDeleteColumns     []string
+	SoftDeleteTables      map[string]string
+	CreatedAtColumns      []string
+	UpdatedAtColumns      []string
+	CreatedByColumns      []string
+	UpdatedByColumns      []string
 	ContextMap            map[string]interface{}
 	TemplateLoader        TemplateLoader
 	TableInfos            map[string]*ModelInfo
 	FragmentsDir          string
+	Outputs               []*OutputFile
+	Hooks                 *Hooks
+	TemplatesHash         string
+	Report                *Report
+	Workers               int
 	fragments             *bytes.Buffer
 }
 
@@ -937,6 +1137,7 @@ func NewConfig(templateLoader TemplateLoader) *Config {
 			ContactEmail: "",
 		},
 		TemplateLoader: templateLoader,
+		Report:         NewReport(),
 	}
 	conf.CmdLineArgs = os.Args
 	conf.CmdLineWrapped = strings.Join(os.Args, " \\\n    ")
@@ -0,0 +1,131 @@
+package dbmeta
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+)
+
+// Datasources named databases generated in one run, loaded from a json file
+/*
+	{
+	    "datasources": [
+	        { "name": "oltp", "sqltype": "mysql", "connstr": "user:pass@tcp(localhost:3306)/shop", "database": "shop" },
+	        { "name": "reporting", "sqltype": "postgres", "connstr": "host=localhost dbname=reports sslmode=disable",
+	          "database": "reports", "tables": ["daily_sales", "monthly_sales"], "prefix": "rpt" }
+	    ]
+	}
+*/
+type Datasources struct {
+	Datasources []*Datasource `json:"datasources"`
+}
+
+// Datasource a named database whose tables are generated into their own model, dao and api packages
+type Datasource struct {
+	// Name name of the datasource, the api routes of its tables are served under /<name>
+	Name        string `json:"name"`
+	SQLType     string `json:"sqltype"`
+	SQLConnStr  string `json:"connstr"`
+	SQLDatabase string `json:"database"`
+	// Tables tables to generate, all tables of the database if empty
+	Tables []string `json:"tables"`
+	// Exclude tables not to generate
+	Exclude []string `json:"exclude"`
+	// Prefix prefix of the model, dao and api package names, defaults to the name
+	Prefix string `json:"prefix"`
+
+	ModelPackageName string                `json:"-"`
+	ModelFQPN        string                `json:"-"`
+	DaoPackageName   string                `json:"-"`
+	DaoFQPN          string                `json:"-"`
+	APIPackageName   string                `json:"-"`
+	APIFQPN          string                `json:"-"`
+	TableInfos       map[string]*ModelInfo `json:"-"`
+}
+
+var (
+	datasourceNameRegex   = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	datasourcePrefixRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+)
+
+// LoadDatasources load and validate a datasources file
+func LoadDatasources(filename string) ([]*Datasource, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	datasources := &Datasources{}
+	err = json.Unmarshal(b, datasources)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse datasources file %s error: %v", filename, err)
+	}
+
+	if len(datasources.Datasources) == 0 {
+		return nil, fmt.Errorf("datasources file %s does not define any datasource", filename)
+	}
+
+	names := make(map[string]bool)
+	prefixes := make(map[string]string)
+	for i, ds := range datasources.Datasources {
+		if !datasourceNameRegex.MatchString(ds.Name) {
+			return nil, fmt.Errorf("datasources file %s: datasource [%d] name %q must only contain letters, digits, _ and -", filename, i, ds.Name)
+		}
+		if names[strings.ToLower(ds.Name)] {
+			return nil, fmt.Errorf("datasources file %s: datasource %s is defined more than once", filename, ds.Name)
+		}
+		names[strings.ToLower(ds.Name)] = true
+
+		if ds.SQLType == "" || ds.SQLConnStr == "" || ds.SQLDatabase == "" {
+			return nil, fmt.Errorf("datasources file %s: datasource %s requires a sqltype, connstr and database", filename, ds.Name)
+		}
+
+		if ds.Prefix == "" {
+			ds.Prefix = ds.Name
+		}
+		if !datasourcePrefixRegex.MatchString(ds.Prefix) {
+			return nil, fmt.Errorf("datasources file %s: datasource %s package prefix %q must be lower case letters, digits and _, set a valid \"prefix\"", filename, ds.Name, ds.Prefix)
+		}
+		if other, ok := prefixes[ds.Prefix]; ok {
+			return nil, fmt.Errorf("datasources file %s: datasources %s and %s use the same package prefix %s", filename, other, ds.Name, ds.Prefix)
+		}
+		prefixes[ds.Prefix] = ds.Name
+	}
+	return datasources.Datasources, nil
+}
+
+// Apply set the connection, package names and route prefix of the datasource on the config. The package names of the
+// datasource are the given model, dao and api package names with the datasource prefix.
+func (ds *Datasource) Apply(c *Config, modelPackageName, daoPackageName, apiPackageName string) {
+	ds.ModelPackageName = ds.Prefix + modelPackageName
+	ds.ModelFQPN = c.Module + "/" + ds.ModelPackageName
+	ds.DaoPackageName = ds.Prefix + daoPackageName
+	ds.DaoFQPN = c.Module + "/" + ds.DaoPackageName
+	ds.APIPackageName = ds.Prefix + apiPackageName
+	ds.APIFQPN = c.Module + "/" + ds.APIPackageName
+
+	c.SQLType = ds.SQLType
+	c.SQLConnStr = ds.SQLConnStr
+	c.SQLDatabase = ds.SQLDatabase
+	c.ModelPackageName = ds.ModelPackageName
+	c.ModelFQPN = ds.ModelFQPN
+	c.DaoPackageName = ds.DaoPackageName
+	c.DaoFQPN = ds.DaoFQPN
+	c.APIPackageName = ds.APIPackageName
+	c.APIFQPN = ds.APIFQPN
+	c.RoutePrefix = ds.Name
+	c.TableInfos = ds.TableInfos
+}
+
+// MergeTableInfos return the tables of all datasources keyed by <datasource name>.<table name>
+func MergeTableInfos(datasources []*Datasource) map[string]*ModelInfo {
+	tableInfos := make(map[string]*ModelInfo)
+	for _, ds := range datasources {
+		for tableName, tableInfo := range ds.TableInfos {
+			tableInfos[ds.Name+"."+tableName] = tableInfo
+		}
+	}
+	return tableInfos
+}
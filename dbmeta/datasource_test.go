@@ -0,0 +1,76 @@
+package dbmeta
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_LoadDatasources(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "gen-datasources")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	write := func(content string) string {
+		filename := filepath.Join(tmpDir, "datasources.json")
+		if err := ioutil.WriteFile(filename, []byte(content), 0666); err != nil {
+			t.Fatal(err)
+		}
+		return filename
+	}
+
+	datasources, err := LoadDatasources(write(`{"datasources": [
+		{"name": "oltp", "sqltype": "mysql", "connstr": "root@/shop", "database": "shop", "tables": ["orders"]},
+		{"name": "reporting", "sqltype": "postgres", "connstr": "dbname=reports", "database": "reports", "prefix": "rpt"}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(datasources) != 2 || datasources[0].Prefix != "oltp" || datasources[1].Prefix != "rpt" || datasources[0].Tables[0] != "orders" {
+		t.Errorf("unexpected datasources %+v %+v", datasources[0], datasources[1])
+	}
+
+	valid := `{"name": "a", "sqltype": "mysql", "connstr": "c", "database": "d"}`
+	for _, test := range []struct {
+		datasources string
+		expected    string
+	}{
+		{``, "does not define any datasource"},
+		{`{"name": "a b", "sqltype": "mysql", "connstr": "c", "database": "d"}`, "must only contain"},
+		{`{"name": "a", "sqltype": "mysql", "connstr": "c"}`, "requires a sqltype, connstr and database"},
+		{`{"name": "Oltp", "sqltype": "mysql", "connstr": "c", "database": "d"}`, `package prefix "Oltp"`},
+		{valid + `, {"name": "A", "sqltype": "mysql", "connstr": "c", "database": "d"}`, "defined more than once"},
+		{valid + `, {"name": "b", "sqltype": "mysql", "connstr": "c", "database": "d", "prefix": "a"}`, "same package prefix a"},
+	} {
+		_, err = LoadDatasources(write(`{"datasources": [` + test.datasources + `]}`))
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("%s: expected an error containing %q, got %v", test.datasources, test.expected, err)
+		}
+	}
+}
+
+func Test_DatasourceApply(t *testing.T) {
+	conf := NewConfig(nil)
+	conf.Module = "example.com/shop"
+
+	ds := &Datasource{Name: "reporting", SQLType: "postgres", SQLConnStr: "dbname=reports", SQLDatabase: "reports", Prefix: "rpt"}
+	ds.TableInfos = map[string]*ModelInfo{"sales": {TableName: "sales"}}
+	ds.Apply(conf, "model", "dao", "api")
+
+	if conf.DaoPackageName != "rptdao" || conf.DaoFQPN != "example.com/shop/rptdao" || ds.APIFQPN != "example.com/shop/rptapi" ||
+		conf.ModelPackageName != "rptmodel" || conf.SQLType != "postgres" || conf.SQLDatabase != "reports" {
+		t.Errorf("unexpected config %+v", conf)
+	}
+	if got := conf.RouteName("daily_sales", "DailySales"); got != "reporting/dailysales" {
+		t.Errorf("unexpected route name %s", got)
+	}
+
+	merged := MergeTableInfos([]*Datasource{ds, {Name: "oltp", TableInfos: map[string]*ModelInfo{"sales": {TableName: "sales"}}}})
+	if len(merged) != 2 || merged["reporting.sales"] == nil || merged["oltp.sales"] == nil {
+		t.Errorf("unexpected merged tables %v", merged)
+	}
+}
@@ -0,0 +1,182 @@
+package dbmeta
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// diffContextLines number of unchanged lines shown around each change in a unified diff
+const diffContextLines = 3
+
+type diffOp struct {
+	kind byte // ' ' unchanged, '-' removed, '+' added
+	line string
+}
+
+// UnifiedDiff return a unified diff of the lines of oldContent and newContent, an empty string is returned if they are equal
+func UnifiedDiff(oldName, newName string, oldContent, newContent []byte) string {
+	ops := diffLines(splitLines(oldContent), splitLines(newContent))
+
+	changed := false
+	for _, op := range ops {
+		if op.kind != ' ' {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return ""
+	}
+
+	// line numbers of the old and new content at the start of every op
+	oldLine := make([]int, len(ops)+1)
+	newLine := make([]int, len(ops)+1)
+	oldLine[0], newLine[0] = 1, 1
+	for i, op := range ops {
+		oldLine[i+1], newLine[i+1] = oldLine[i], newLine[i]
+		if op.kind != '+' {
+			oldLine[i+1]++
+		}
+		if op.kind != '-' {
+			newLine[i+1]++
+		}
+	}
+
+	buf := bytes.Buffer{}
+	fmt.Fprintf(&buf, "--- %s\n+++ %s\n", oldName, newName)
+
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+
+		start := i - diffContextLines
+		if start < 0 {
+			start = 0
+		}
+
+		// extend the hunk while the next change is close enough for the context lines to overlap
+		end := i + 1
+		for j := end; j < len(ops); j++ {
+			if ops[j].kind == ' ' {
+				continue
+			}
+			if j-end > 2*diffContextLines {
+				break
+			}
+			end = j + 1
+		}
+
+		stop := end + diffContextLines
+		if stop > len(ops) {
+			stop = len(ops)
+		}
+
+		oldCount := oldLine[stop] - oldLine[start]
+		newCount := newLine[stop] - newLine[start]
+		fmt.Fprintf(&buf, "@@ -%s +%s @@\n", hunkRange(oldLine[start], oldCount), hunkRange(newLine[start], newCount))
+		for _, op := range ops[start:stop] {
+			buf.WriteByte(op.kind)
+			buf.WriteString(op.line)
+			buf.WriteByte('\n')
+		}
+		i = stop
+	}
+	return buf.String()
+}
+
+func hunkRange(start, count int) string {
+	if count == 0 {
+		start--
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
+
+func splitLines(content []byte) []string {
+	if len(content) == 0 {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+}
+
+// diffLines compute the shortest edit script between a and b using the Myers algorithm
+func diffLines(a, b []string) []diffOp {
+	n, m := len(a), len(b)
+	max := n + m
+	offset := max + 1
+	v := make([]int, 2*max+3)
+
+	// trace[d] holds the furthest reaching x for every diagonal k in [-d, d] after d edits
+	var trace [][]int
+	for d := 0; d <= max; d++ {
+		done := false
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				done = true
+			}
+		}
+
+		snapshot := make([]int, 2*d+1)
+		copy(snapshot, v[offset-d:offset+d+1])
+		trace = append(trace, snapshot)
+		if done {
+			break
+		}
+	}
+
+	var ops []diffOp
+	x, y := n, m
+	for d := len(trace) - 1; d > 0; d-- {
+		prev := trace[d-1]
+		at := func(k int) int { return prev[k+d-1] }
+
+		k := x - y
+		down := k == -d || (k != d && at(k-1) < at(k+1))
+
+		var startX int
+		if down {
+			startX = at(k + 1)
+		} else {
+			startX = at(k-1) + 1
+		}
+
+		for x > startX {
+			ops = append(ops, diffOp{' ', a[x-1]})
+			x--
+			y--
+		}
+
+		if down {
+			ops = append(ops, diffOp{'+', b[y-1]})
+			y--
+		} else {
+			ops = append(ops, diffOp{'-', a[x-1]})
+			x--
+		}
+	}
+
+	for x > 0 && y > 0 {
+		ops = append(ops, diffOp{' ', a[x-1]})
+		x--
+		y--
+	}
+
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
@@ -0,0 +1,131 @@
+package dbmeta
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jimsmart/schema"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func Test_UnifiedDiff(t *testing.T) {
+	oldContent := []byte("a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nm\n")
+	newContent := []byte("a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nm\nn\n")
+
+	expected := `--- a/file.go
++++ b/file.go
+@@ -1,5 +1,5 @@
+ a
+-b
++B
+ c
+ d
+ e
+@@ -11,3 +11,4 @@
+ k
+ l
+ m
++n
+`
+
+	diff := UnifiedDiff("a/file.go", "b/file.go", oldContent, newContent)
+	if diff != expected {
+		t.Fatalf("unexpected diff:\n%s", diff)
+	}
+
+	if diff := UnifiedDiff("a/file.go", "b/file.go", oldContent, oldContent); diff != "" {
+		t.Fatalf("expected no diff for equal content, got:\n%s", diff)
+	}
+
+	expected = `--- /dev/null
++++ b/file.go
+@@ -0,0 +1,2 @@
++a
++b
+`
+	if diff := UnifiedDiff("/dev/null", "b/file.go", nil, []byte("a\nb\n")); diff != expected {
+		t.Fatalf("unexpected diff for new file:\n%s", diff)
+	}
+}
+
+// openTestDB create the tables of queries in a temporary sqlite db, closed and removed at the end of the test, and
+// process the type mappings of the templates. It returns the db and the names of its tables.
+func openTestDB(t *testing.T, queries ...string) (*sql.DB, []string) {
+	tmpDir, err := ioutil.TempDir("", "gen-tables")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(tmpDir) })
+
+	db, err := sql.Open("sqlite3", filepath.Join(tmpDir, "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	for _, query := range queries {
+		if _, err = db.Exec(query); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	schemaTables, err := schema.TableNames(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tables []string
+	for _, st := range schemaTables {
+		tables = append(tables, st[1])
+	}
+
+	mapping, err := ioutil.ReadFile(filepath.Join(goldenTemplateDir, "mapping.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ProcessMappings("internal", mapping, false); err != nil {
+		t.Fatal(err)
+	}
+	return db, tables
+}
+
+// loadTestTables create the tables of queries in a temporary sqlite db and load their table infos into conf
+func loadTestTables(t *testing.T, conf *Config, queries ...string) map[string]*ModelInfo {
+	db, tables := openTestDB(t, queries...)
+
+	conf.SQLType = "sqlite3"
+	conf.SQLDatabase = "main"
+	conf.JSONNameFormat = "snake"
+	conf.XMLNameFormat = "snake"
+	conf.ProtobufNameFormat = "snake"
+	conf.TableInfos = LoadTableInfo(db, tables, nil, conf)
+	return conf.TableInfos
+}
+
+func Test_GenerateTableFileDryRun(t *testing.T) {
+	outDir, err := ioutil.TempDir("", "gen-dryrun")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(outDir)
+
+	conf := NewConfig(func(filename string) (*GenTemplate, error) {
+		return &GenTemplate{Name: filename, Content: "{{.StructName}}\n"}, nil
+	})
+	conf.OutDir = outDir
+	conf.Overwrite = true
+	conf.DryRun = true
+	loadTestTables(t, conf, "CREATE TABLE albums (id INTEGER PRIMARY KEY, title TEXT)")
+
+	if _, err = conf.GenerateTableFile("albums", "albums.md.tmpl", "docs", "albums.md"); err != nil {
+		t.Fatal(err)
+	}
+	if Exists(filepath.Join(outDir, "docs")) {
+		t.Error("expected a dry run not to create the output directory")
+	}
+	if created, _, _ := conf.PendingChanges(); created != 1 {
+		t.Errorf("expected 1 pending created file, got %d", created)
+	}
+}
@@ -0,0 +1,47 @@
+package dbmeta
+
+import "strings"
+
+// FilterKind kind of the values a column is filtered by in the generated list functions, int, float, time, bool,
+// string or other
+func (fi *FieldInfo) FilterKind() string {
+	if fi.SQLMapping == nil {
+		return "other"
+	}
+
+	goType := fi.SQLMapping.GoType
+	switch {
+	case goType == "string":
+		return "string"
+	case goType == "bool":
+		return "bool"
+	case goType == "time.Time":
+		return "time"
+	case strings.HasPrefix(goType, "float"):
+		return "float"
+	case strings.HasPrefix(goType, "int"), strings.HasPrefix(goType, "uint"):
+		return "int"
+	}
+	return "other"
+}
+
+// FilterOps operations a column can be filtered with, ranges on int, float and time columns and like on string columns
+func (fi *FieldInfo) FilterOps() []string {
+	ops := []string{"eq", "in", "null"}
+	switch fi.FilterKind() {
+	case "int", "float", "time":
+		ops = append(ops, "gt", "gte", "lt", "lte")
+	case "string":
+		ops = append(ops, "like", "ilike")
+	}
+	return ops
+}
+
+// CursorKind kind of the value of a column in a keyset cursor, the filter kind or bytes for binary columns, whose
+// values are base64 strings once encoded as JSON
+func (fi *FieldInfo) CursorKind() string {
+	if fi.SQLMapping != nil && fi.SQLMapping.GoType == "[]byte" {
+		return "bytes"
+	}
+	return fi.FilterKind()
+}
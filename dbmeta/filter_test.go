@@ -0,0 +1,38 @@
+package dbmeta
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_FilterKind(t *testing.T) {
+	for _, test := range []struct {
+		goType string
+		kind   string
+		ops    string
+		cursor string
+	}{
+		{"string", "string", "eq,in,null,like,ilike", "string"},
+		{"int32", "int", "eq,in,null,gt,gte,lt,lte", "int"},
+		{"uint64", "int", "eq,in,null,gt,gte,lt,lte", "int"},
+		{"float64", "float", "eq,in,null,gt,gte,lt,lte", "float"},
+		{"time.Time", "time", "eq,in,null,gt,gte,lt,lte", "time"},
+		{"bool", "bool", "eq,in,null", "bool"},
+		{"[]byte", "other", "eq,in,null", "bytes"},
+	} {
+		fi := &FieldInfo{SQLMapping: &SQLMapping{GoType: test.goType}}
+		if kind := fi.FilterKind(); kind != test.kind {
+			t.Errorf("%s: expected kind %s, got %s", test.goType, test.kind, kind)
+		}
+		if ops := strings.Join(fi.FilterOps(), ","); ops != test.ops {
+			t.Errorf("%s: expected ops %s, got %s", test.goType, test.ops, ops)
+		}
+		if kind := fi.CursorKind(); kind != test.cursor {
+			t.Errorf("%s: expected cursor kind %s, got %s", test.goType, test.cursor, kind)
+		}
+	}
+
+	if kind := (&FieldInfo{}).FilterKind(); kind != "other" {
+		t.Errorf("expected kind other without a sql mapping, got %s", kind)
+	}
+}
@@ -0,0 +1,155 @@
+package dbmeta
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+)
+
+// tableFingerprint the parts of a table that the generated code of the table depends on
+type tableFingerprint struct {
+	TableName  string
+	StructName string
+	DDL        string
+	Columns    []*columnFingerprint
+	Fields     []*FieldInfo
+}
+
+type columnFingerprint struct {
+	Name          string
+	Definition    string
+	Nullable      bool
+	DatabaseType  string
+	Index         int
+	PrimaryKey    bool
+	AutoIncrement bool
+	Array         bool
+	ColumnType    string
+	Notes         string
+	Comment       string
+	Length        int64
+	Default       string
+}
+
+// TableFingerprint return a hash of the schema of the table and of the fields derived from it, it changes whenever the
+// code generated for the table may change with the same templates and config.
+func TableFingerprint(tableInfo *ModelInfo) string {
+	fingerprint := &tableFingerprint{
+		TableName:  tableInfo.TableName,
+		StructName: tableInfo.StructName,
+	}
+
+	if tableInfo.DBMeta != nil {
+		fingerprint.TableName = tableInfo.DBMeta.TableName()
+		fingerprint.DDL = tableInfo.DBMeta.DDL()
+		for _, col := range tableInfo.DBMeta.Columns() {
+			fingerprint.Columns = append(fingerprint.Columns, &columnFingerprint{
+				Name:          col.Name(),
+				Definition:    col.String(),
+				Nullable:      col.Nullable(),
+				DatabaseType:  col.DatabaseTypeName(),
+				Index:         col.Index(),
+				PrimaryKey:    col.IsPrimaryKey(),
+				AutoIncrement: col.IsAutoIncrement(),
+				Array:         col.IsArray(),
+				ColumnType:    col.ColumnType(),
+				Notes:         col.Notes(),
+				Comment:       col.Comment(),
+				Length:        col.ColumnLength(),
+				Default:       col.DefaultValue(),
+			})
+		}
+	}
+
+	for _, field := range tableInfo.CodeFields {
+		// the column meta is already part of the fingerprint
+		fieldCopy := *field
+		fieldCopy.ColumnMeta = nil
+		fingerprint.Fields = append(fingerprint.Fields, &fieldCopy)
+	}
+
+	b, err := json.Marshal(fingerprint)
+	if err != nil {
+		// an empty fingerprint never matches, so the table is always regenerated
+		return ""
+	}
+	return HashContent(b)
+}
+
+// configHashIgnored settings of Config that only affect how gen runs, such as --verbose or --dry-run, or that hold
+// runtime state
+var configHashIgnored = map[string]bool{
+	"Verbose":        true,
+	"DryRun":         true,
+	"Overwrite":      true,
+	"CmdLine":        true,
+	"CmdLineWrapped": true,
+	"CmdLineArgs":    true,
+	"TemplateLoader": true,
+	"TableInfos":     true,
+	"Outputs":        true,
+	"Hooks":          true,
+	"TemplatesHash":  true,
+	"Workers":        true,
+	"Report":         true,
+	"ContextMap":     true,
+}
+
+// ConfigHash return a hash of the settings that affect the generated code, including the context map and fragments
+func (c *Config) ConfigHash() string {
+	settings := make(map[string]interface{})
+
+	rv := reflect.ValueOf(c).Elem()
+	for i := 0; i < rv.NumField(); i++ {
+		field := rv.Type().Field(i)
+		if field.PkgPath != "" || configHashIgnored[field.Name] {
+			continue
+		}
+		settings[field.Name] = rv.Field(i).Interface()
+	}
+
+	contextMap := make(map[string]interface{}, len(c.ContextMap))
+	for key, value := range c.ContextMap {
+		if key != "tableInfos" {
+			contextMap[key] = value
+		}
+	}
+	settings["ContextMap"] = contextMap
+
+	if c.fragments != nil {
+		settings["fragments"] = c.fragments.String()
+	}
+
+	b, err := json.Marshal(settings)
+	if err != nil {
+		return ""
+	}
+	return HashContent(b)
+}
+
+// ChangedTables return the sorted names of the tables in c.TableInfos that need to be regenerated, because their
+// fingerprint differs from the one recorded in previous or because one of their generated files is missing. All tables
+// are returned if there is no previous manifest or if the templates or the config changed.
+func (c *Config) ChangedTables(previous *Manifest) []string {
+	tableNames := SortedTableNames(c.TableInfos)
+	if previous == nil || previous.Tables == nil || c.TemplatesHash == "" ||
+		previous.Templates != c.TemplatesHash || previous.Config != c.ConfigHash() {
+		return tableNames
+	}
+
+	missing := make(map[string]bool)
+	for _, entry := range previous.Files {
+		if entry.Table != "" && !Exists(filepath.Join(c.OutDir, filepath.FromSlash(entry.Path))) {
+			missing[entry.Table] = true
+		}
+	}
+
+	var changed []string
+	for _, tableName := range tableNames {
+		fingerprint, ok := previous.Tables[tableName]
+		if !ok || missing[tableName] || fingerprint == "" || fingerprint != TableFingerprint(c.TableInfos[tableName]) {
+			changed = append(changed, tableName)
+		}
+	}
+	return changed
+}
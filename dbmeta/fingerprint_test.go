@@ -0,0 +1,98 @@
+package dbmeta
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func Test_ChangedTables(t *testing.T) {
+	outDir, err := ioutil.TempDir("", "gen-fingerprint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(outDir)
+
+	conf := NewConfig(nil)
+	conf.OutDir = outDir
+	conf.TemplatesHash = "templates"
+
+	albums := "CREATE TABLE albums (id INTEGER PRIMARY KEY, title TEXT, released DATETIME)"
+	load := func() {
+		loadTestTables(t, conf,
+			albums,
+			"CREATE TABLE artists (id INTEGER PRIMARY KEY, name TEXT)",
+			"CREATE TABLE tracks (id INTEGER PRIMARY KEY, name TEXT)",
+		)
+	}
+	load()
+
+	if got := conf.ChangedTables(nil); !reflect.DeepEqual(got, []string{"albums", "artists", "tracks"}) {
+		t.Fatalf("expected all tables without a previous manifest, got %v", got)
+	}
+
+	// generate a file per table
+	for tableName := range conf.TableInfos {
+		outputFile := filepath.Join(outDir, tableName+".go")
+		if err = ioutil.WriteFile(outputFile, []byte(tableName), 0666); err != nil {
+			t.Fatal(err)
+		}
+		conf.Outputs = append(conf.Outputs, &OutputFile{Path: outputFile, Template: "model.go.tmpl", Table: tableName, Hash: HashContent([]byte(tableName))})
+	}
+	previous, _ := conf.BuildManifest(nil, nil)
+
+	load()
+	if got := conf.ChangedTables(previous); len(got) != 0 {
+		t.Fatalf("expected no changed tables after reloading the same schema, got %v", got)
+	}
+
+	albums = "CREATE TABLE albums (id INTEGER PRIMARY KEY, title TEXT, released DATETIME, note TEXT)"
+	if err = os.Remove(filepath.Join(outDir, "tracks.go")); err != nil {
+		t.Fatal(err)
+	}
+	load()
+	if got := conf.ChangedTables(previous); !reflect.DeepEqual(got, []string{"albums", "tracks"}) {
+		t.Fatalf("expected the altered table and the table with a missing file, got %v", got)
+	}
+
+	conf.JSONNameFormat = "camel"
+	if got := conf.ChangedTables(previous); len(got) != 3 {
+		t.Fatalf("expected all tables after a config change, got %v", got)
+	}
+	conf.JSONNameFormat = "snake"
+
+	// the generated packages, such as --rest or --generate-dao, add files to every table
+	conf.GenerateRest = true
+	if got := conf.ChangedTables(previous); !reflect.DeepEqual(got, []string{"albums", "artists", "tracks"}) {
+		t.Fatalf("expected all tables after enabling the rest api, got %v", got)
+	}
+	conf.GenerateRest = false
+
+	conf.TemplatesHash = "edited templates"
+	if got := conf.ChangedTables(previous); len(got) != 3 {
+		t.Fatalf("expected all tables after a template change, got %v", got)
+	}
+}
+
+func Test_ConfigHash(t *testing.T) {
+	conf := NewConfig(nil)
+	hash := conf.ConfigHash()
+	if hash == "" {
+		t.Fatal("config hash is empty")
+	}
+
+	conf.Verbose = true
+	conf.DryRun = true
+	conf.CmdLine = "gen --verbose --dry-run"
+	conf.ContextMap["tableInfos"] = map[string]*ModelInfo{}
+	if conf.ConfigHash() != hash {
+		t.Errorf("config hash changed with settings that do not affect the generated code")
+	}
+
+	conf.ContextMap["company"] = "acme"
+	if conf.ConfigHash() == hash {
+		t.Errorf("config hash did not change with the context map")
+	}
+}
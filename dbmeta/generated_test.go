@@ -0,0 +1,370 @@
+package dbmeta
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// generatedDaoStub the declarations of the dao base file used by the dao filter, the dao base itself needs sqlx
+const generatedDaoStub = `package dao
+
+import (
+	"context"
+	"fmt"
+)
+
+var (
+	ErrBadParams = fmt.Errorf("bad params error")
+
+	Actor func(ctx context.Context) (actor string, ok bool)
+)
+`
+
+// runGeneratedDaoTest generate the model, the dao filter and the dao dialect of the tables created by queries into a
+// module, and run test, the source of a test file of the dao package, with go test. The tests can use sqlite.
+func runGeneratedDaoTest(t *testing.T, queries []string, configure func(conf *Config), test string) {
+	if testing.Short() {
+		t.Skip("go test of generated code skipped in short mode")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not found")
+	}
+
+	outDir, err := ioutil.TempDir("", "gen-dao")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(outDir)
+
+	conf := NewConfig(loadGoldenTemplate)
+	conf.Module = "example.com/gentest"
+	conf.ModelFQPN = conf.Module + "/" + conf.ModelPackageName
+	conf.DaoFQPN = conf.Module + "/" + conf.DaoPackageName
+	conf.OutDir = outDir
+	conf.Overwrite = true
+	conf.Report.Output = ioutil.Discard
+	if configure != nil {
+		configure(conf)
+	}
+	tableInfos := loadTestTables(t, conf, queries...)
+	conf.ContextMap["tableInfos"] = tableInfos
+
+	var model bytes.Buffer
+	model.WriteString("package model\n\nimport (\n\t\"database/sql\"\n\t\"time\"\n)\n\nvar (\n\t_ = sql.NullString{}\n\t_ = time.Second\n)\n")
+	for _, tableName := range SortedTableNames(tableInfos) {
+		tableInfo := tableInfos[tableName]
+		fmt.Fprintf(&model, "\ntype %s struct {\n", tableInfo.StructName)
+		for _, field := range tableInfo.CodeFields {
+			fmt.Fprintf(&model, "\t%s %s `json:\"%s\"`\n", field.GoFieldName, field.GoFieldType, field.JSONFieldName)
+		}
+		model.WriteString("}\n")
+	}
+
+	repoSum, err := ioutil.ReadFile(filepath.Join("..", "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sum bytes.Buffer
+	for _, line := range strings.Split(string(repoSum), "\n") {
+		if strings.HasPrefix(line, "github.com/mattn/go-sqlite3 v2.0.3+incompatible") {
+			sum.WriteString(line + "\n")
+		}
+	}
+
+	files := map[string][]byte{
+		"go.mod":                []byte("module " + conf.Module + "\n\ngo 1.14\n\nrequire github.com/mattn/go-sqlite3 v2.0.3+incompatible\n"),
+		"go.sum":                sum.Bytes(),
+		"model/model.go":        model.Bytes(),
+		"dao/dao_base.go":       []byte(generatedDaoStub),
+		"dao/generated_test.go": []byte(test),
+	}
+	for name, content := range files {
+		path := filepath.Join(outDir, filepath.FromSlash(name))
+		if err = os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(path, content, 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for templateName, outputFile := range map[string]string{
+		"dao_filter.go.tmpl":  "dao/dao_filter.go",
+		"dao_dialect.go.tmpl": "dao/dao_dialect.go",
+	} {
+		genTemplate, err := loadGoldenTemplate(templateName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = conf.WriteTemplate(genTemplate, map[string]interface{}{}, filepath.Join(outDir, filepath.FromSlash(outputFile))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cmd := exec.Command(goTool, "test", "./dao")
+	cmd.Dir = outDir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off", "GOWORK=off")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go test of the generated dao failed: %v\n%s", err, output)
+	}
+}
+
+func Test_GeneratedKeysetNullableSortColumn(t *testing.T) {
+	runGeneratedDaoTest(t, []string{
+		"CREATE TABLE songs (id INTEGER PRIMARY KEY, title TEXT, rank INTEGER NOT NULL)",
+	}, nil, `package dao
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"example.com/gentest/model"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestKeyset(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	for _, query := range []string{
+		"CREATE TABLE songs (id INTEGER PRIMARY KEY, title TEXT, rank INTEGER NOT NULL)",
+		"INSERT INTO songs VALUES (1, 'b', 2), (2, NULL, 1), (3, 'a', 2), (4, NULL, 3), (5, 'c', 1)",
+	} {
+		if _, err = db.Exec(query); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, order := range []string{"title", "-title", "rank,title"} {
+		if _, err = newSongsKeyset("sqlite3", order, ""); !errors.Is(err, ErrBadParams) {
+			t.Errorf("order %s: expected the nullable sort column to be rejected, got %v", order, err)
+		}
+	}
+
+	// every row, including the rows with a NULL title, is on a page of a non nullable sort column
+	var ids []int64
+	cursor := ""
+	for page := 0; page < 10; page++ {
+		keyset, err := newSongsKeyset("sqlite3", "-rank", cursor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		where, args := keyset.where("", nil)
+		query := Rebind(DialectFor("sqlite3"), SelectSQL(DialectFor("sqlite3"), "songs", where)+" ORDER BY "+keyset.orderBy()+" LIMIT 2")
+		rows, err := db.Query(query, args...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var last *model.Songs
+		for rows.Next() {
+			record := &model.Songs{}
+			if err = rows.Scan(&record.ID, &record.Title, &record.Rank); err != nil {
+				t.Fatal(err)
+			}
+			ids = append(ids, int64(record.ID))
+			last = record
+		}
+		rows.Close()
+		if last == nil {
+			break
+		}
+		if cursor, err = keyset.cursor(func(column string) interface{} { return songsValue(last, column) }); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(ids) != 5 || ids[0] != 4 || ids[1] != 1 || ids[2] != 3 || ids[3] != 2 || ids[4] != 5 {
+		t.Errorf("unexpected pages %v", ids)
+	}
+}
+`)
+}
+
+func Test_GeneratedKeysetTimeCursor(t *testing.T) {
+	runGeneratedDaoTest(t, []string{
+		"CREATE TABLE events (id INTEGER PRIMARY KEY, at DATETIME NOT NULL)",
+	}, nil, `package dao
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"example.com/gentest/model"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestKeyset(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err = db.Exec("CREATE TABLE events (id INTEGER PRIMARY KEY, at DATETIME NOT NULL)"); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	for id, hours := range []int{3, 1, 2, 1, 5} {
+		if _, err = db.Exec("INSERT INTO events VALUES (?, ?)", id+1, start.Add(time.Duration(hours)*time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the time of the last record of a page is a time again once read back from the cursor
+	var ids []int64
+	cursor := ""
+	for page := 0; page < 10; page++ {
+		keyset, err := newEventsKeyset("sqlite3", "at", cursor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		where, args := keyset.where("", nil)
+		query := Rebind(DialectFor("sqlite3"), SelectSQL(DialectFor("sqlite3"), "events", where)+" ORDER BY "+keyset.orderBy()+" LIMIT 2")
+		rows, err := db.Query(query, args...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var last *model.Events
+		for rows.Next() {
+			record := &model.Events{}
+			if err = rows.Scan(&record.ID, &record.At); err != nil {
+				t.Fatal(err)
+			}
+			ids = append(ids, int64(record.ID))
+			last = record
+		}
+		rows.Close()
+		if last == nil {
+			break
+		}
+		if cursor, err = keyset.cursor(func(column string) interface{} { return eventsValue(last, column) }); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(ids) != 5 || ids[0] != 2 || ids[1] != 4 || ids[2] != 3 || ids[3] != 1 || ids[4] != 5 {
+		t.Errorf("unexpected pages %v", ids)
+	}
+}
+`)
+}
+
+func Test_GeneratedSavepoint(t *testing.T) {
+	runGeneratedDaoTest(t, []string{
+		"CREATE TABLE songs (id INTEGER PRIMARY KEY, title TEXT, rank INTEGER NOT NULL)",
+	}, nil, `package dao
+
+import (
+	"database/sql"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestSavepoint(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	if _, err = db.Exec("CREATE TABLE songs (id INTEGER PRIMARY KEY, title TEXT, rank INTEGER NOT NULL)"); err != nil {
+		t.Fatal(err)
+	}
+
+	// both sqlite driver names have savepoints, the rows inserted after a rolled back savepoint are discarded
+	for _, driverName := range []string{"sqlite", "sqlite3"} {
+		create, rollback, release := DialectFor(driverName).Savepoint("sp_1")
+		if create == "" || rollback == "" || release == "" {
+			t.Fatalf("%s: expected savepoint statements", driverName)
+		}
+
+		tx, err := db.Begin()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, query := range []string{
+			"INSERT INTO songs (title, rank) VALUES ('kept', 1)",
+			create,
+			"INSERT INTO songs (title, rank) VALUES ('discarded', 1)",
+			rollback,
+			release,
+		} {
+			if _, err = tx.Exec(query); err != nil {
+				t.Fatalf("%s: %s: %v", driverName, query, err)
+			}
+		}
+		if err = tx.Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var kept, discarded int
+	if err = db.QueryRow("SELECT COUNT(*) FROM songs WHERE title = 'kept'").Scan(&kept); err != nil || kept != 2 {
+		t.Errorf("expected the rows before the savepoints to be committed, got %d %v", kept, err)
+	}
+	if err = db.QueryRow("SELECT COUNT(*) FROM songs WHERE title = 'discarded'").Scan(&discarded); err != nil || discarded != 0 {
+		t.Errorf("expected the rows of the rolled back savepoints to be discarded, got %d %v", discarded, err)
+	}
+}
+`)
+}
+
+func Test_GeneratedPatchNullable(t *testing.T) {
+	runGeneratedDaoTest(t, []string{
+		"CREATE TABLE songs (id INTEGER PRIMARY KEY, title TEXT, rank INTEGER NOT NULL)",
+	}, nil, `package dao
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"example.com/gentest/model"
+)
+
+func TestPatch(t *testing.T) {
+	record := &model.Songs{ID: 1, Rank: 2}
+	columns, values, err := songsPatch(map[string]json.RawMessage{"title": json.RawMessage(`+"`"+`"x"`+"`"+`)}, record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Title != (sql.NullString{String: "x", Valid: true}) {
+		t.Errorf("unexpected title %#v", record.Title)
+	}
+	if len(columns) != 1 || len(values) != 1 || values[0] != record.Title {
+		t.Errorf("unexpected columns %v values %v", columns, values)
+	}
+
+	columns, values, err = songsPatch(map[string]json.RawMessage{"title": json.RawMessage("null")}, record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Title.Valid {
+		t.Errorf("expected a null title, got %#v", record.Title)
+	}
+	if len(columns) != 1 || len(values) != 1 || values[0] != nil {
+		t.Errorf("unexpected columns %v values %v", columns, values)
+	}
+
+	if _, _, err = songsPatch(map[string]json.RawMessage{"title": json.RawMessage("1")}, record); err == nil {
+		t.Error("expected a patch of the wrong type to fail")
+	}
+}
+`)
+}
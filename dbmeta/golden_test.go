@@ -0,0 +1,388 @@
+package dbmeta
+
+import (
+	"bytes"
+	"database/sql"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/jimsmart/schema"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files in testdata/golden")
+
+const (
+	goldenTemplateDir = "../template"
+	goldenSampleDb    = "../example/sample.db"
+)
+
+type goldenVariant struct {
+	name      string
+	configure func(conf *Config)
+	// tableTemplates template rendered for every table, mapped to the output dir or to the output file name when it
+	// contains %s, which is replaced by the table file name
+	tableTemplates map[string]string
+	// templates template rendered once, mapped to the output file
+	templates map[string]string
+}
+
+var goldenVariants = []*goldenVariant{
+	{
+		name: "gorm",
+		configure: func(conf *Config) {
+			conf.AddGormAnnotation = true
+			conf.UseGureguTypes = true
+			conf.SoftDeleteColumns = []string{"hire*"}
+			conf.CreatedAtColumns = []string{"InvoiceDate"}
+			conf.UpdatedByColumns = []string{"BillingCity"}
+		},
+		tableTemplates: map[string]string{
+			"model.go.tmpl":    "model",
+			"api.go.tmpl":      "api",
+			"dao_gorm.go.tmpl": "dao",
+		},
+		templates: map[string]string{
+			"model_base.go.tmpl":    "model/model_base.go",
+			"dao_gorm_init.go.tmpl": "dao/dao_base.go",
+			"dao_filter.go.tmpl":    "dao/dao_filter.go",
+			"dao_dialect.go.tmpl":   "dao/dao_dialect.go",
+			"router.go.tmpl":        "api/router.go",
+			"http_utils.go.tmpl":    "api/http_utils.go",
+			"main_gorm.go.tmpl":     "app/server/main.go",
+			"gomod.tmpl":            "go.mod",
+			"Makefile.tmpl":         "Makefile",
+			"gitignore.tmpl":        ".gitignore",
+			"README.md.tmpl":        "README.md",
+		},
+	},
+	{
+		name: "sqlx",
+		configure: func(conf *Config) {
+			conf.AddDBAnnotation = true
+			conf.AddProtobufAnnotation = true
+			conf.CreatedAtColumns = []string{"InvoiceDate"}
+			conf.UpdatedByColumns = []string{"BillingCity"}
+		},
+		tableTemplates: map[string]string{
+			"model.go.tmpl":    "model",
+			"dao_sqlx.go.tmpl": "dao",
+		},
+		templates: map[string]string{
+			"model_base.go.tmpl":    "model/model_base.go",
+			"dao_sqlx_init.go.tmpl": "dao/dao_base.go",
+			"dao_filter.go.tmpl":    "dao/dao_filter.go",
+			"dao_dialect.go.tmpl":   "dao/dao_dialect.go",
+			"main_sqlx.go.tmpl":     "app/server/main.go",
+			"protobuf.tmpl":         "main.proto",
+			"protomain.go.tmpl":     "grpc/main.go",
+			"protoserver.go.tmpl":   "grpc/protoserver.go",
+		},
+	},
+	{
+		name: "repository",
+		configure: func(conf *Config) {
+			conf.AddGormAnnotation = false
+			conf.AddDBAnnotation = true
+			conf.Repository = true
+			conf.Batch = true
+			conf.UseGureguTypes = true
+			conf.SoftDeleteTables = map[string]string{"employees": "HireDate"}
+		},
+		tableTemplates: map[string]string{
+			"dao_sqlx.go.tmpl": "dao",
+			"dao_fake.go.tmpl": "dao/%s_fake",
+			"api.go.tmpl":      "api",
+		},
+		templates: map[string]string{
+			"dao_sqlx_init.go.tmpl": "dao/dao_base.go",
+			"dao_filter.go.tmpl":    "dao/dao_filter.go",
+			"dao_dialect.go.tmpl":   "dao/dao_dialect.go",
+			"router.go.tmpl":        "api/router.go",
+			"main_sqlx.go.tmpl":     "app/server/main.go",
+			"protoserver.go.tmpl":   "grpc/protoserver.go",
+		},
+	},
+}
+
+func loadGoldenTemplate(filename string) (*GenTemplate, error) {
+	b, err := ioutil.ReadFile(filepath.Join(goldenTemplateDir, filepath.Base(filename)))
+	if err != nil {
+		return nil, err
+	}
+	return &GenTemplate{Name: filepath.Base(filename), Content: string(b)}, nil
+}
+
+// renderGolden render the templates of variant against the sample db into outDir, loading the tables in the given order
+func renderGolden(t *testing.T, variant *goldenVariant, outDir string, reverse bool) {
+	db, err := sql.Open("sqlite3", goldenSampleDb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mapping, err := ioutil.ReadFile(filepath.Join(goldenTemplateDir, "mapping.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ProcessMappings("internal", mapping, false); err != nil {
+		t.Fatal(err)
+	}
+
+	conf := NewConfig(loadGoldenTemplate)
+	conf.SQLType = "sqlite3"
+	conf.SQLConnStr = "./sample.db"
+	conf.SQLDatabase = "main"
+	conf.Module = "example.com/rest/example"
+	conf.ModelFQPN = conf.Module + "/" + conf.ModelPackageName
+	conf.DaoFQPN = conf.Module + "/" + conf.DaoPackageName
+	conf.APIFQPN = conf.Module + "/" + conf.APIPackageName
+	conf.GrpcPackageName = "grpc"
+	conf.GrpcFQPN = conf.Module + "/" + conf.GrpcPackageName
+	conf.AddJSONAnnotation = true
+	conf.JSONNameFormat = "snake"
+	conf.XMLNameFormat = "snake"
+	conf.ProtobufNameFormat = "snake"
+	conf.ServerPort = 8080
+	conf.ServerHost = "localhost"
+	conf.ServerScheme = "http"
+	conf.ServerListen = ":8080"
+	conf.OutDir = outDir
+	conf.Overwrite = true
+	conf.CmdLine = "gen --sqltype=sqlite3 --connstr ./sample.db --database main"
+	conf.CmdLineWrapped = conf.CmdLine
+	conf.CmdLineArgs = strings.Split(conf.CmdLine, " ")
+	variant.configure(conf)
+
+	schemaTables, err := schema.TableNames(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var dbTables []string
+	for _, st := range schemaTables {
+		dbTables = append(dbTables, st[1])
+	}
+	sort.Strings(dbTables)
+	if reverse {
+		sort.Sort(sort.Reverse(sort.StringSlice(dbTables)))
+	}
+
+	tableInfos := LoadTableInfo(db, dbTables, nil, conf)
+	if len(tableInfos) == 0 {
+		t.Fatal("no tables loaded")
+	}
+	conf.TableInfos = tableInfos
+	conf.ContextMap["tableInfos"] = tableInfos
+
+	write := func(templateName string, data map[string]interface{}, outputFile string) {
+		genTemplate, err := loadGoldenTemplate(templateName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = os.MkdirAll(filepath.Dir(outputFile), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err = conf.WriteTemplate(genTemplate, data, outputFile); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, tableName := range SortedTableNames(tableInfos) {
+		for templateName, dir := range variant.tableTemplates {
+			data := conf.CreateContextForTableFile(tableInfos[tableName])
+			name := filepath.Join(dir, conf.ReplaceFileNamingTemplate(tableName))
+			if strings.Contains(dir, "%s") {
+				name = fmt.Sprintf(dir, conf.ReplaceFileNamingTemplate(tableName))
+			}
+			write(templateName, data, filepath.Join(outDir, filepath.FromSlash(name)+".go"))
+		}
+	}
+
+	for templateName, outputFile := range variant.templates {
+		data := map[string]interface{}{
+			"deps":             "go list -f '{{ join .Deps  \"\\n\"}}' .",
+			"CommandLine":      conf.CmdLine,
+			"RegenCmdLineArgs": conf.CmdLineArgs,
+			"RegenCmdLine":     conf.CmdLine,
+		}
+		write(templateName, data, filepath.Join(outDir, filepath.FromSlash(outputFile)))
+	}
+}
+
+func readTree(t *testing.T, dir string) map[string][]byte {
+	files := make(map[string][]byte)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = content
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+func compareTrees(t *testing.T, expectedName string, expected map[string][]byte, actualName string, actual map[string][]byte) {
+	for name, content := range expected {
+		other, ok := actual[name]
+		if !ok {
+			t.Errorf("%s is missing from %s", name, actualName)
+			continue
+		}
+		if !bytes.Equal(content, other) {
+			t.Errorf("%s differs\n%s", name, UnifiedDiff(expectedName+"/"+name, actualName+"/"+name, content, other))
+		}
+	}
+	for name := range actual {
+		if _, ok := expected[name]; !ok {
+			t.Errorf("%s is not in %s", name, expectedName)
+		}
+	}
+}
+
+func Test_GoldenFiles(t *testing.T) {
+	if !Exists(goldenSampleDb) {
+		t.Skipf("%s not available", goldenSampleDb)
+	}
+
+	for _, variant := range goldenVariants {
+		t.Run(variant.name, func(t *testing.T) {
+			tmpDir, err := ioutil.TempDir("", "gen-golden")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			first := filepath.Join(tmpDir, "first")
+			second := filepath.Join(tmpDir, "second")
+			renderGolden(t, variant, first, false)
+			renderGolden(t, variant, second, true)
+
+			rendered := readTree(t, first)
+			compareTrees(t, "first", rendered, "second", readTree(t, second))
+
+			goldenDir := filepath.Join("testdata", "golden", variant.name)
+			if *updateGolden {
+				if err = os.RemoveAll(goldenDir); err != nil {
+					t.Fatal(err)
+				}
+				for name, content := range rendered {
+					outputFile := filepath.Join(goldenDir, filepath.FromSlash(name))
+					if err = os.MkdirAll(filepath.Dir(outputFile), 0777); err != nil {
+						t.Fatal(err)
+					}
+					if err = ioutil.WriteFile(outputFile, content, 0666); err != nil {
+						t.Fatal(err)
+					}
+				}
+				return
+			}
+
+			compareTrees(t, "golden", readTree(t, goldenDir), "rendered", rendered)
+		})
+	}
+}
+
+// Test_ServersSetActor render every server template and check that the rendered server assigns the dao Actor, which
+// the created by and updated by audit columns are written from
+func Test_ServersSetActor(t *testing.T) {
+	if !Exists(goldenSampleDb) {
+		t.Skipf("%s not available", goldenSampleDb)
+	}
+
+	servers := map[string]bool{"main_gorm.go.tmpl": false, "main_sqlx.go.tmpl": false, "protoserver.go.tmpl": false}
+	for _, variant := range goldenVariants {
+		tmpDir, err := ioutil.TempDir("", "gen-actor")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(tmpDir)
+		renderGolden(t, variant, tmpDir, false)
+
+		for templateName, outputFile := range variant.templates {
+			if _, ok := servers[templateName]; !ok {
+				continue
+			}
+			servers[templateName] = true
+
+			file, err := parser.ParseFile(token.NewFileSet(), filepath.Join(tmpDir, filepath.FromSlash(outputFile)), nil, 0)
+			if err != nil {
+				t.Fatalf("%s: %s: %v", variant.name, outputFile, err)
+			}
+
+			assigned := false
+			ast.Inspect(file, func(node ast.Node) bool {
+				if assign, ok := node.(*ast.AssignStmt); ok {
+					for _, lhs := range assign.Lhs {
+						if selector, ok := lhs.(*ast.SelectorExpr); ok && selector.Sel.Name == "Actor" {
+							if pkg, ok := selector.X.(*ast.Ident); ok && pkg.Name == "dao" {
+								assigned = true
+							}
+						}
+					}
+				}
+				return !assigned
+			})
+			if !assigned {
+				t.Errorf("%s: %s rendered from %s does not assign dao.Actor", variant.name, outputFile, templateName)
+			}
+		}
+	}
+
+	for templateName, covered := range servers {
+		if !covered {
+			t.Errorf("server template %s is not rendered by any golden variant", templateName)
+		}
+	}
+}
+
+// Test_RepositoryTransactions check the api and grpc handlers of the repository golden run their transactions on the
+// database handle of the repositories, not with dao.WithTx on the package DB
+func Test_RepositoryTransactions(t *testing.T) {
+	for _, dir := range []string{"api", "grpc"} {
+		goldenDir := filepath.Join("testdata", "golden", "repository", dir)
+		files, err := filepath.Glob(filepath.Join(goldenDir, "*.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) == 0 {
+			t.Fatalf("no golden files in %s", goldenDir)
+		}
+
+		for _, name := range files {
+			file, err := parser.ParseFile(token.NewFileSet(), name, nil, 0)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+
+			ast.Inspect(file, func(node ast.Node) bool {
+				if selector, ok := node.(*ast.SelectorExpr); ok && selector.Sel.Name == "WithTx" {
+					if pkg, ok := selector.X.(*ast.Ident); ok && pkg.Name == "dao" {
+						t.Errorf("%s calls dao.WithTx instead of the Tx of the repositories", name)
+					}
+				}
+				return true
+			})
+		}
+	}
+}
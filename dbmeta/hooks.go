@@ -0,0 +1,282 @@
+package dbmeta
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+const (
+	// HookPreLoad hooks run before the schema is loaded from the database
+	HookPreLoad = "pre-load"
+	// HookPostFile hooks run after each generated file is written
+	HookPostFile = "post-file"
+	// HookPostGen hooks run after all files are generated
+	HookPostGen = "post-gen"
+)
+
+// Hooks commands and template scripts to run at stages of the generation, loaded from a json file
+/*
+	{
+	    "post-file": [
+	        { "name": "goimports", "command": "goimports -w $GEN_FILE", "match": "*.go" }
+	    ],
+	    "post-gen": [
+	        { "name": "swagger", "command": "swag init --dir ./ --generalInfo app/server/main.go", "abort": true },
+	        { "name": "report", "script": "./hooks/report.tmpl" }
+	    ]
+	}
+*/
+type Hooks struct {
+	PreLoad  []*Hook `json:"pre-load"`
+	PostFile []*Hook `json:"post-file"`
+	PostGen  []*Hook `json:"post-gen"`
+}
+
+// Hook a shell command or a template script run at a generation stage
+type Hook struct {
+	Name string `json:"name"`
+	// Command shell command, the hook context is available as GEN_* environment variables and as json on stdin
+	Command string `json:"command"`
+	// Script template script executed like --exec, the hook context is available as .Hook
+	Script string `json:"script"`
+	// Dir working directory of the command, relative to the current dir, defaults to the output dir
+	Dir string `json:"dir"`
+	// Match only run post-file hooks for files whose path relative to the output dir matches the pattern
+	Match string `json:"match"`
+	// Abort stop the generation if the hook fails
+	Abort bool `json:"abort"`
+}
+
+// HookContext generation context passed to hooks
+type HookContext struct {
+	Stage    string      `json:"stage"`
+	OutDir   string      `json:"out_dir"`
+	Module   string      `json:"module"`
+	SQLType  string      `json:"sql_type"`
+	Database string      `json:"database"`
+	Tables   []string    `json:"tables,omitempty"`
+	File     *HookFile   `json:"file,omitempty"`
+	Files    []*HookFile `json:"files,omitempty"`
+}
+
+// HookFile a generated file passed to hooks
+type HookFile struct {
+	Path     string `json:"path"`
+	Template string `json:"template"`
+	Table    string `json:"table,omitempty"`
+	Status   string `json:"status"`
+}
+
+// LoadHooks load hooks from a json file
+func LoadHooks(filename string) (*Hooks, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks := &Hooks{}
+	err = json.Unmarshal(b, hooks)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse hooks file %s error: %v", filename, err)
+	}
+
+	stages := []struct {
+		name  string
+		hooks []*Hook
+	}{{HookPreLoad, hooks.PreLoad}, {HookPostFile, hooks.PostFile}, {HookPostGen, hooks.PostGen}}
+
+	for _, stage := range stages {
+		for i, hook := range stage.hooks {
+			if (hook.Command == "") == (hook.Script == "") {
+				return nil, fmt.Errorf("hooks file %s: %s hook [%d] requires either a command or a script", filename, stage.name, i)
+			}
+			if hook.Name == "" {
+				hook.Name = hook.Command + hook.Script
+			}
+		}
+	}
+	return hooks, nil
+}
+
+// RunHooks run the hooks registered for stage, file is set for post-file hooks. Failing hooks are reported, an error is
+// returned only if a failing hook is set to abort the generation. Hooks are not run during a dry run.
+func (c *Config) RunHooks(stage string, file *OutputFile) error {
+	if c.Hooks == nil || c.DryRun {
+		return nil
+	}
+
+	var hooks []*Hook
+	switch stage {
+	case HookPreLoad:
+		hooks = c.Hooks.PreLoad
+	case HookPostFile:
+		hooks = c.Hooks.PostFile
+	case HookPostGen:
+		hooks = c.Hooks.PostGen
+	}
+
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	hookCtx := c.createHookContext(stage, file)
+	for _, hook := range hooks {
+		if hook.Match != "" && hookCtx.File != nil {
+			if matched, _ := path.Match(hook.Match, hookCtx.File.Path); !matched {
+				continue
+			}
+		}
+
+		var output string
+		var err error
+		if hook.Command != "" {
+			output, err = c.runHookCommand(hook, hookCtx)
+		} else {
+			output, err = c.runHookScript(hook, hookCtx)
+		}
+
+		if output != "" {
+			fmt.Print(output)
+			if !strings.HasSuffix(output, "\n") {
+				fmt.Print("\n")
+			}
+		}
+
+		if err == nil {
+			if c.Verbose {
+				fmt.Printf("%s hook %s completed\n", stage, hook.Name)
+			}
+			continue
+		}
+
+		msg := fmt.Sprintf("%s hook %s failed: %v\n", stage, hook.Name, err)
+		if au != nil {
+			fmt.Print(au.Red(msg))
+		} else {
+			fmt.Print(msg)
+		}
+
+		if hook.Abort {
+			return fmt.Errorf("%s hook %s failed: %v", stage, hook.Name, err)
+		}
+	}
+	return nil
+}
+
+func (c *Config) createHookContext(stage string, file *OutputFile) *HookContext {
+	outDir, err := filepath.Abs(c.OutDir)
+	if err != nil {
+		outDir = c.OutDir
+	}
+
+	hookCtx := &HookContext{
+		Stage:    stage,
+		OutDir:   outDir,
+		Module:   c.Module,
+		SQLType:  c.SQLType,
+		Database: c.SQLDatabase,
+	}
+
+	if stage != HookPreLoad {
+		hookCtx.Tables = SortedTableNames(c.TableInfos)
+	}
+
+	if file != nil {
+		hookCtx.File = c.createHookFile(file)
+	}
+
+	if stage == HookPostGen {
+		for _, output := range c.Outputs {
+			hookCtx.Files = append(hookCtx.Files, c.createHookFile(output))
+		}
+	}
+	return hookCtx
+}
+
+func (c *Config) createHookFile(output *OutputFile) *HookFile {
+	return &HookFile{
+		Path:     c.relativeOutputPath(output.Path),
+		Template: output.Template,
+		Table:    output.Table,
+		Status:   output.Status.String(),
+	}
+}
+
+// env return the hook context as GEN_* environment variables
+func (h *HookContext) env() []string {
+	env := []string{
+		"GEN_STAGE=" + h.Stage,
+		"GEN_OUT_DIR=" + h.OutDir,
+		"GEN_MODULE=" + h.Module,
+		"GEN_SQL_TYPE=" + h.SQLType,
+		"GEN_DATABASE=" + h.Database,
+		"GEN_TABLES=" + strings.Join(h.Tables, ","),
+	}
+
+	if h.File != nil {
+		env = append(env,
+			"GEN_FILE="+h.File.Path,
+			"GEN_TEMPLATE="+h.File.Template,
+			"GEN_TABLE="+h.File.Table,
+			"GEN_FILE_STATUS="+h.File.Status,
+		)
+	}
+	return env
+}
+
+func (c *Config) runHookCommand(hook *Hook, hookCtx *HookContext) (string, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", hook.Command)
+	} else {
+		cmd = exec.Command("sh", "-c", hook.Command)
+	}
+
+	cmd.Dir = hook.Dir
+	if cmd.Dir == "" && Exists(c.OutDir) {
+		cmd.Dir = c.OutDir
+	}
+	cmd.Env = append(os.Environ(), hookCtx.env()...)
+
+	stdin, err := json.Marshal(hookCtx)
+	if err != nil {
+		return "", err
+	}
+	cmd.Stdin = bytes.NewReader(stdin)
+
+	output, err := cmd.CombinedOutput()
+	return string(output), err
+}
+
+func (c *Config) runHookScript(hook *Hook, hookCtx *HookContext) (string, error) {
+	b, err := ioutil.ReadFile(hook.Script)
+	if err != nil {
+		return "", err
+	}
+
+	data := map[string]interface{}{}
+	for key, value := range c.ContextMap {
+		data[key] = value
+	}
+	data["Hook"] = hookCtx
+	data["Config"] = c
+	data["outDir"] = c.OutDir
+	data["tableInfos"] = c.TableInfos
+
+	rt, err := c.GetTemplate(&GenTemplate{Name: hook.Script, Content: string(b)})
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	err = rt.Execute(&buf, data)
+	return buf.String(), err
+}
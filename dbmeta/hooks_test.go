@@ -0,0 +1,156 @@
+package dbmeta
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func Test_RunHooks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("hook commands in this test use sh")
+	}
+
+	outDir, err := ioutil.TempDir("", "gen-hooks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(outDir)
+
+	conf := NewConfig(nil)
+	conf.OutDir = outDir
+	conf.SQLDatabase = "main"
+	conf.Hooks = &Hooks{
+		PostFile: []*Hook{
+			{Name: "env", Command: `echo "$GEN_STAGE $GEN_FILE $GEN_TABLE" > env.txt`, Match: "model/*"},
+			{Name: "stdin", Command: `cat > stdin.json`},
+		},
+		PostGen: []*Hook{
+			{Name: "ignored", Command: "exit 1"},
+			{Name: "abort", Command: "exit 2", Abort: true},
+		},
+	}
+
+	file := &OutputFile{Path: filepath.Join(outDir, "model", "albums.go"), Template: "model.go.tmpl", Table: "albums", Status: OutputCreated}
+	if err = conf.RunHooks(HookPostFile, file); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(outDir, "env.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.TrimSpace(string(b)) != "post-file model/albums.go albums" {
+		t.Errorf("unexpected hook environment: %s", b)
+	}
+
+	b, err = ioutil.ReadFile(filepath.Join(outDir, "stdin.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"file":{"path":"model/albums.go","template":"model.go.tmpl","table":"albums","status":"created"}`) {
+		t.Errorf("unexpected hook stdin: %s", b)
+	}
+
+	err = conf.RunHooks(HookPostGen, nil)
+	if err == nil || !strings.Contains(err.Error(), "abort") {
+		t.Errorf("expected abort hook to fail the generation, got %v", err)
+	}
+}
+
+func Test_GenerateTableFileHookAbort(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("hook commands in this test use sh")
+	}
+
+	outDir, err := ioutil.TempDir("", "gen-hooks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(outDir)
+
+	conf := NewConfig(func(filename string) (*GenTemplate, error) {
+		return &GenTemplate{Name: filename, Content: "{{.StructName}}\n"}, nil
+	})
+	conf.OutDir = outDir
+	conf.Overwrite = true
+	conf.Hooks = &Hooks{
+		PostFile: []*Hook{{Name: "fail", Command: "exit 3", Abort: true}},
+	}
+	tableInfos := loadTestTables(t, conf,
+		"CREATE TABLE albums (id INTEGER PRIMARY KEY, title TEXT)",
+		"CREATE TABLE artists (id INTEGER PRIMARY KEY, name TEXT)",
+	)
+
+	script := &GenTemplate{
+		Name:    "script.gen",
+		Content: `{{range $name, $table := .tableInfos}}{{GenerateTableFile $name "table.md.tmpl" "docs" (printf "%s.md" $name)}}{{end}}`,
+	}
+	err = conf.WriteTemplate(script, map[string]interface{}{"tableInfos": tableInfos}, filepath.Join(outDir, "script.md"))
+	if err == nil || !strings.Contains(err.Error(), "fail") {
+		t.Fatalf("expected the failing hook to stop the generation, got %v", err)
+	}
+
+	if !Exists(filepath.Join(outDir, "docs", "albums.md")) {
+		t.Error("expected the file of the first table to be written")
+	}
+	for _, name := range []string{filepath.Join("docs", "artists.md"), "script.md"} {
+		if Exists(filepath.Join(outDir, name)) {
+			t.Errorf("expected %s not to be written after the hook failed", name)
+		}
+	}
+}
+
+func Test_GenerateFileHookAbort(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("hook commands in this test use sh")
+	}
+
+	srcDir, err := ioutil.TempDir("", "gen-hooks-src")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(srcDir)
+	// the table template of the copied directory is written by the table file handler
+	if err = ioutil.WriteFile(filepath.Join(srcDir, "table.md.table.tmpl"), []byte("{{.StructName}}\n"), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name   string
+		script string
+	}{
+		{"GenerateFile", `{{GenerateFile "file.md.tmpl" "docs" "file.md" true}}`},
+		{"copy", `{{copy "` + filepath.ToSlash(srcDir) + `" "docs"}}`},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			outDir, err := ioutil.TempDir("", "gen-hooks")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(outDir)
+
+			conf := NewConfig(func(filename string) (*GenTemplate, error) {
+				return &GenTemplate{Name: filename, Content: "file\n"}, nil
+			})
+			conf.OutDir = outDir
+			conf.Overwrite = true
+			conf.Hooks = &Hooks{
+				PostFile: []*Hook{{Name: "fail", Command: "exit 3", Abort: true}},
+			}
+			loadTestTables(t, conf, "CREATE TABLE albums (id INTEGER PRIMARY KEY, title TEXT)")
+
+			script := &GenTemplate{Name: "script.gen", Content: test.script}
+			err = conf.WriteTemplate(script, map[string]interface{}{}, filepath.Join(outDir, "script.md"))
+			if err == nil || !strings.Contains(err.Error(), "fail") {
+				t.Fatalf("expected the failing hook to stop the generation, got %v", err)
+			}
+			if Exists(filepath.Join(outDir, "script.md")) {
+				t.Error("expected script.md not to be written after the hook failed")
+			}
+		})
+	}
+}
@@ -0,0 +1,353 @@
+package dbmeta
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	// InspectTable print the inspection as a table per database table
+	InspectTable = "table"
+	// InspectJSON print the inspection as json
+	InspectJSON = "json"
+	// InspectYAML print the inspection as yaml
+	InspectYAML = "yaml"
+)
+
+// Inspection loaded schema of the tables with the resolved type mappings and generated fields
+type Inspection struct {
+	SQLType       string             `json:"sql_type"`
+	Database      string             `json:"database"`
+	Tables        []*TableInspection `json:"tables"`
+	SkippedTables []string           `json:"skipped_tables,omitempty"`
+}
+
+// TableInspection loaded schema of a table
+type TableInspection struct {
+	Table       string              `json:"table"`
+	Struct      string              `json:"struct"`
+	PrimaryKeys []string            `json:"primary_keys"`
+	Columns     []*ColumnInspection `json:"columns"`
+	Issues      []*Issue            `json:"issues,omitempty"`
+}
+
+// ColumnInspection loaded schema of a column, with the sql mapping and the field generated for it
+type ColumnInspection struct {
+	Name          string      `json:"name"`
+	SQLType       string      `json:"sql_type"`
+	ColumnType    string      `json:"column_type"`
+	Length        int64       `json:"length"`
+	Nullable      bool        `json:"nullable"`
+	PrimaryKey    bool        `json:"primary_key"`
+	AutoIncrement bool        `json:"auto_increment"`
+	Default       string      `json:"default,omitempty"`
+	Comment       string      `json:"comment,omitempty"`
+	Notes         string      `json:"notes,omitempty"`
+	Field         string      `json:"field,omitempty"`
+	GoType        string      `json:"go_type,omitempty"`
+	JSONName      string      `json:"json_name,omitempty"`
+	ProtobufName  string      `json:"protobuf_name,omitempty"`
+	ProtobufType  string      `json:"protobuf_type,omitempty"`
+	Tags          string      `json:"tags,omitempty"`
+	Mapping       *SQLMapping `json:"mapping,omitempty"`
+	// Skipped the column has no mapping and no field is generated for it
+	Skipped bool `json:"skipped,omitempty"`
+}
+
+// CheckInspectFormat return an error if format is not a supported inspect format
+func CheckInspectFormat(format string) error {
+	switch format {
+	case InspectTable, InspectJSON, InspectYAML:
+		return nil
+	}
+	return fmt.Errorf("unknown inspect format: %s, use %s, %s or %s", format, InspectJSON, InspectYAML, InspectTable)
+}
+
+// Inspect build the inspection of the loaded tables
+func (c *Config) Inspect() *Inspection {
+	inspection := &Inspection{
+		SQLType:  c.SQLType,
+		Database: c.SQLDatabase,
+		Tables:   make([]*TableInspection, 0, len(c.TableInfos)),
+	}
+
+	for _, tableName := range SortedTableNames(c.TableInfos) {
+		inspection.Tables = append(inspection.Tables, c.inspectTable(c.TableInfos[tableName]))
+	}
+
+	if c.Report != nil {
+		inspection.SkippedTables = c.Report.SkippedTables()
+	}
+	return inspection
+}
+
+func (c *Config) inspectTable(modelInfo *ModelInfo) *TableInspection {
+	table := &TableInspection{
+		Table:       modelInfo.TableName,
+		Struct:      modelInfo.StructName,
+		PrimaryKeys: PrimaryKeyNames(modelInfo.DBMeta),
+		Columns:     make([]*ColumnInspection, 0),
+	}
+
+	fields := make(map[string]*FieldInfo)
+	for _, fi := range modelInfo.CodeFields {
+		fields[fi.ColumnMeta.Name()] = fi
+	}
+
+	for _, col := range modelInfo.DBMeta.Columns() {
+		column := &ColumnInspection{
+			Name:          col.Name(),
+			SQLType:       col.DatabaseTypeName(),
+			ColumnType:    col.ColumnType(),
+			Length:        col.ColumnLength(),
+			Nullable:      col.Nullable(),
+			PrimaryKey:    col.IsPrimaryKey(),
+			AutoIncrement: col.IsAutoIncrement(),
+			Default:       col.DefaultValue(),
+			Comment:       col.Comment(),
+			Notes:         col.Notes(),
+		}
+
+		fi, ok := fields[col.Name()]
+		if !ok {
+			column.Skipped = true
+			table.Columns = append(table.Columns, column)
+			continue
+		}
+
+		if fi.Notes != "" {
+			column.Notes = strings.TrimSpace(column.Notes + " " + fi.Notes)
+		}
+		column.Field = fi.GoFieldName
+		column.GoType = fi.GoFieldType
+		column.JSONName = fi.JSONFieldName
+		column.ProtobufName = fi.ProtobufFieldName
+		column.ProtobufType = fi.ProtobufType
+		column.Tags = strings.Join(fi.GoAnnotations, " ")
+		column.Mapping = fi.SQLMapping
+		table.Columns = append(table.Columns, column)
+	}
+
+	if c.Report != nil {
+		for _, issue := range c.Report.Issues {
+			if issue.Table == modelInfo.TableName {
+				table.Issues = append(table.Issues, issue)
+			}
+		}
+	}
+	return table
+}
+
+// Write write the inspection in format, one of json, yaml or table
+func (i *Inspection) Write(w io.Writer, format string) error {
+	switch format {
+	case InspectJSON:
+		b, err := json.MarshalIndent(i, "", "    ")
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(append(b, '\n'))
+		return err
+	case InspectYAML:
+		buf := &bytes.Buffer{}
+		writeYAMLFields(buf, reflect.ValueOf(i).Elem(), 0, "")
+		_, err := w.Write(buf.Bytes())
+		return err
+	case InspectTable:
+		return i.writeTable(w)
+	}
+	return CheckInspectFormat(format)
+}
+
+func (i *Inspection) writeTable(w io.Writer) error {
+	buf := &bytes.Buffer{}
+	for _, table := range i.Tables {
+		fmt.Fprintf(buf, "[%s] struct: %s primary keys: %s\n", table.Table, table.Struct, strings.Join(table.PrimaryKeys, ", "))
+
+		tw := tabwriter.NewWriter(buf, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "  COLUMN\tSQL TYPE\tNULL\tPK\tAUTO\tDEFAULT\tFIELD\tGO TYPE\tNULLABLE TYPE\tGUREGU TYPE\tPROTOBUF\tSWAGGER\tJSON")
+		for _, column := range table.Columns {
+			if column.Skipped {
+				fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\t%s\t(skipped)\t\t\t\t\t\t\n",
+					column.Name, column.SQLType, yesNo(column.Nullable), yesNo(column.PrimaryKey), yesNo(column.AutoIncrement), column.Default)
+				continue
+			}
+
+			mapping := column.Mapping
+			if mapping == nil {
+				mapping = &SQLMapping{}
+			}
+			fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				column.Name, column.SQLType, yesNo(column.Nullable), yesNo(column.PrimaryKey), yesNo(column.AutoIncrement), column.Default,
+				column.Field, column.GoType, mapping.GoNullableType, mapping.GureguType, column.ProtobufType, mapping.SwaggerType, column.JSONName)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+
+		for _, column := range table.Columns {
+			if column.Comment != "" {
+				fmt.Fprintf(buf, "  %s comment: %s\n", column.Name, column.Comment)
+			}
+			if column.Notes != "" {
+				fmt.Fprintf(buf, "  %s notes: %s\n", column.Name, column.Notes)
+			}
+		}
+		for _, issue := range table.Issues {
+			buf.WriteString("  " + issue.String())
+		}
+		buf.WriteString("\n")
+	}
+
+	if len(i.SkippedTables) > 0 {
+		fmt.Fprintf(buf, "skipped tables: %s\n", strings.Join(i.SkippedTables, ", "))
+	}
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+var yamlPlain = regexp.MustCompile(`^[A-Za-z_/][A-Za-z0-9_./()-]*$`)
+
+var yamlReserved = map[string]bool{"true": true, "false": true, "yes": true, "no": true, "on": true, "off": true, "y": true, "n": true, "null": true}
+
+// writeYAMLFields write the exported fields of the struct v as a yaml mapping, keyed by their json names. The first
+// line is prefixed with first instead of the indent, to start a mapping inside a sequence item.
+func writeYAMLFields(buf *bytes.Buffer, v reflect.Value, indent int, first string) {
+	prefix := strings.Repeat(" ", indent)
+	t := v.Type()
+	written := 0
+	for i := 0; i < t.NumField(); i++ {
+		name, omitEmpty := yamlFieldName(t.Field(i))
+		if name == "" {
+			continue
+		}
+		fv := v.Field(i)
+		if omitEmpty && yamlEmpty(fv) {
+			continue
+		}
+
+		linePrefix := prefix
+		if written == 0 && first != "" {
+			linePrefix = first
+		}
+		written++
+
+		writeYAMLValue(buf, linePrefix+name+":", fv, indent)
+	}
+
+	if written == 0 {
+		if first == "" {
+			first = prefix
+		}
+		buf.WriteString(first + "{}\n")
+	}
+}
+
+// writeYAMLValue write the value v for the key already formatted in key, nested values are indented below indent
+func writeYAMLValue(buf *bytes.Buffer, key string, v reflect.Value, indent int) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			buf.WriteString(key + " null\n")
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		buf.WriteString(key + "\n")
+		writeYAMLFields(buf, v, indent+2, "")
+	case reflect.Slice:
+		if v.Len() == 0 {
+			buf.WriteString(key + " []\n")
+			return
+		}
+		buf.WriteString(key + "\n")
+		item := strings.Repeat(" ", indent) + "- "
+		for i := 0; i < v.Len(); i++ {
+			ev := v.Index(i)
+			if ev.Kind() == reflect.Ptr && !ev.IsNil() {
+				ev = ev.Elem()
+			}
+			if ev.Kind() == reflect.Struct {
+				writeYAMLFields(buf, ev, indent+2, item)
+			} else {
+				buf.WriteString(item + yamlScalar(ev) + "\n")
+			}
+		}
+	default:
+		buf.WriteString(key + " " + yamlScalar(v) + "\n")
+	}
+}
+
+// yamlEmpty report whether omitempty leaves out v, following encoding/json
+func yamlEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.String, reflect.Array:
+		return v.Len() == 0
+	}
+	return v.IsZero()
+}
+
+func yamlFieldName(f reflect.StructField) (name string, omitEmpty bool) {
+	if f.PkgPath != "" {
+		return "", false
+	}
+
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	if name == "" {
+		name = f.Name
+	}
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return name, omitEmpty
+}
+
+func yamlScalar(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		s := v.String()
+		if yamlPlain.MatchString(s) && !yamlReserved[strings.ToLower(s)] {
+			return s
+		}
+		return strconv.Quote(s)
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return "null"
+		}
+		return yamlScalar(v.Elem())
+	}
+	return strconv.Quote(fmt.Sprintf("%v", v.Interface()))
+}
@@ -0,0 +1,101 @@
+package dbmeta
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_Inspect(t *testing.T) {
+	conf := NewConfig(nil)
+	conf.AddGormAnnotation = false
+	conf.AddJSONAnnotation = true
+	conf.AddXMLAnnotation = false
+	conf.AddDBAnnotation = false
+	conf.AddProtobufAnnotation = false
+	conf.Report.Output = ioutil.Discard
+	loadTestTables(t, conf, "CREATE TABLE albums (id INTEGER PRIMARY KEY AUTOINCREMENT, shape GEOMETRY, title TEXT NOT NULL DEFAULT 'untitled')")
+
+	inspection := conf.Inspect()
+	if len(inspection.Tables) != 1 {
+		t.Fatalf("expected 1 table, got %d", len(inspection.Tables))
+	}
+
+	albums := inspection.Tables[0]
+	if albums.Struct != "Albums" || strings.Join(albums.PrimaryKeys, ",") != "id" || len(albums.Columns) != 3 || len(albums.Issues) != 1 {
+		t.Fatalf("unexpected table %+v", albums)
+	}
+
+	id, shape, title := albums.Columns[0], albums.Columns[1], albums.Columns[2]
+	if !id.PrimaryKey || !id.AutoIncrement || id.Field != "ID" || id.Mapping == nil || id.Mapping.GureguType != "null.Int" {
+		t.Errorf("unexpected id column %+v", id)
+	}
+	if !shape.Skipped || shape.Field != "" || shape.Mapping != nil {
+		t.Errorf("expected the unmapped column to be skipped %+v", shape)
+	}
+	if title.Default != "'untitled'" || title.JSONName != "title" || title.Tags != `json:"title"` {
+		t.Errorf("unexpected title column %+v", title)
+	}
+
+	var buf bytes.Buffer
+	if err := inspection.Write(&buf, InspectJSON); err != nil {
+		t.Fatal(err)
+	}
+	decoded := &Inspection{}
+	if err := json.Unmarshal(buf.Bytes(), decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Tables) != 1 || decoded.Tables[0].Columns[2].Mapping.SwaggerType != "string" {
+		t.Errorf("unexpected json %s", buf.String())
+	}
+
+	buf.Reset()
+	if err := inspection.Write(&buf, InspectYAML); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"tables:\n- table: albums\n  struct: Albums\n  primary_keys:\n  - id\n  columns:\n  - name: id\n",
+		"    tags: \"json:\\\"title\\\"\"\n",
+		"    default: \"'untitled'\"\n",
+		"    mapping:\n      sql_type: integer\n",
+		"    skipped: true\n",
+		"  issues:\n  - table: albums\n    column: shape\n",
+	} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Errorf("yaml does not contain %q\n%s", expected, buf.String())
+		}
+	}
+
+	buf.Reset()
+	if err := inspection.Write(&buf, InspectTable); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "[albums] struct: Albums primary keys: id\n") || !strings.Contains(buf.String(), "(skipped)") {
+		t.Errorf("unexpected table output\n%s", buf.String())
+	}
+
+	if err := inspection.Write(&buf, "xml"); err == nil {
+		t.Errorf("expected an error for an unknown format")
+	}
+}
+
+func Test_YAMLScalar(t *testing.T) {
+	for value, expected := range map[string]string{
+		"":              `""`,
+		"int32":         "int32",
+		"null.Int":      "null.Int",
+		"null":          `"null"`,
+		"Yes":           `"Yes"`,
+		"12":            `"12"`,
+		"a: b":          `"a: b"`,
+		"line\nbreak":   `"line\nbreak"`,
+		"sql.NullInt32": "sql.NullInt32",
+	} {
+		if got := yamlScalar(reflect.ValueOf(value)); got != expected {
+			t.Errorf("%q: expected %s, got %s", value, expected, got)
+		}
+	}
+}
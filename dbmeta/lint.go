@@ -0,0 +1,540 @@
+package dbmeta
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"unicode"
+)
+
+const (
+	// LintNoPrimaryKey a table without a primary key, the generator uses the first column instead
+	LintNoPrimaryKey = "no-primary-key"
+	// LintNullablePrimaryKey a nullable primary key column, the generator treats it as NOT NULL
+	LintNullablePrimaryKey = "nullable-primary-key"
+	// LintFieldNameCollision columns of a table that map to the same go field name
+	LintFieldNameCollision = "field-name-collision"
+	// LintDuplicateJSONName columns of a table that map to the same json name
+	LintDuplicateJSONName = "duplicate-json-name"
+	// LintReservedWord a table or column named after a go or protobuf keyword
+	LintReservedWord = "reserved-word"
+	// LintUnindexedForeignKey a foreign key whose columns are not the leading columns of an index
+	LintUnindexedForeignKey = "unindexed-foreign-key"
+	// LintUnmappedType a column whose sql type has no mapping, it is left out of the generated code
+	LintUnmappedType = "unmapped-type"
+	// LintNamingStyle a table or column named in a different style than most of the schema
+	LintNamingStyle = "naming-style"
+)
+
+const (
+	// LintText print lint findings as text
+	LintText = "text"
+	// LintJSON print lint findings as json
+	LintJSON = "json"
+	// SeverityOff disables a lint rule
+	SeverityOff = "off"
+)
+
+// DefaultLintSeverities severity of each lint rule, rules can be set to SeverityError, SeverityWarning or SeverityOff
+var DefaultLintSeverities = map[string]string{
+	LintNoPrimaryKey:        SeverityError,
+	LintNullablePrimaryKey:  SeverityError,
+	LintFieldNameCollision:  SeverityError,
+	LintDuplicateJSONName:   SeverityError,
+	LintReservedWord:        SeverityWarning,
+	LintUnindexedForeignKey: SeverityWarning,
+	LintUnmappedType:        SeverityError,
+	LintNamingStyle:         SeverityWarning,
+}
+
+var goKeywords = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true, "continue": true, "default": true, "defer": true,
+	"else": true, "fallthrough": true, "for": true, "func": true, "go": true, "goto": true, "if": true,
+	"import": true, "interface": true, "map": true, "package": true, "range": true, "return": true, "select": true,
+	"struct": true, "switch": true, "type": true, "var": true,
+}
+
+var protobufKeywords = map[string]bool{
+	"syntax": true, "import": true, "weak": true, "public": true, "package": true, "option": true, "message": true,
+	"enum": true, "service": true, "rpc": true, "returns": true, "stream": true, "repeated": true, "optional": true,
+	"required": true, "oneof": true, "map": true, "reserved": true, "extend": true, "extensions": true, "to": true,
+	"max": true, "group": true,
+}
+
+// LintFinding a schema design problem found by a lint rule
+type LintFinding struct {
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Table    string `json:"table"`
+	Column   string `json:"column,omitempty"`
+	Message  string `json:"message"`
+}
+
+// LintResult findings of linting a schema
+type LintResult struct {
+	Tables   int            `json:"tables"`
+	Errors   int            `json:"errors"`
+	Warnings int            `json:"warnings"`
+	Findings []*LintFinding `json:"findings"`
+
+	severities map[string]string
+}
+
+// CheckLintFormat return an error if format is not a supported lint format
+func CheckLintFormat(format string) error {
+	switch format {
+	case LintText, LintJSON:
+		return nil
+	}
+	return fmt.Errorf("unknown lint format: %s, use %s or %s", format, LintText, LintJSON)
+}
+
+// ParseLintSeverities parse a comma separated list of rule=severity overrides of DefaultLintSeverities
+func ParseLintSeverities(spec string) (map[string]string, error) {
+	severities := make(map[string]string)
+	for rule, severity := range DefaultLintSeverities {
+		severities[rule] = severity
+	}
+
+	for _, setting := range strings.Split(spec, ",") {
+		setting = strings.TrimSpace(setting)
+		if setting == "" {
+			continue
+		}
+
+		parts := strings.SplitN(setting, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid lint severity %s, use rule=severity", setting)
+		}
+
+		rule, severity := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		if _, ok := DefaultLintSeverities[rule]; !ok {
+			return nil, fmt.Errorf("unknown lint rule %s", rule)
+		}
+		if severity != SeverityError && severity != SeverityWarning && severity != SeverityOff {
+			return nil, fmt.Errorf("invalid severity %s for lint rule %s, use %s, %s or %s", severity, rule, SeverityError, SeverityWarning, SeverityOff)
+		}
+		severities[rule] = severity
+	}
+	return severities, nil
+}
+
+// Lint load the schema of dbTables and check it for design problems, severities sets the severity of each rule
+func (c *Config) Lint(db *sql.DB, dbTables []string, excludeDbTables []string, severities map[string]string) *LintResult {
+	if severities == nil {
+		severities = DefaultLintSeverities
+	}
+	result := &LintResult{Findings: make([]*LintFinding, 0), severities: severities}
+
+	tableNames := filterTableNames(dbTables, excludeDbTables)
+	sort.Strings(tableNames)
+
+	dbMetas, errs := loadMetas(db, tableNames, c)
+	var loaded []DbTableMeta
+	for i, tableName := range tableNames {
+		if errs[i] == errUnsupportedTable {
+			continue
+		}
+		if errs[i] != nil {
+			c.Report.Skip(StageLoad, tableName, "", "LoadMeta skipping table info for %s error: %v", tableName, errs[i])
+			continue
+		}
+		loaded = append(loaded, dbMetas[i])
+	}
+	result.Tables = len(loaded)
+
+	for _, dbMeta := range loaded {
+		c.lintTable(db, dbMeta, result)
+	}
+	lintNamingStyle(loaded, result)
+
+	for _, finding := range result.Findings {
+		if finding.Severity == SeverityError {
+			result.Errors++
+		} else {
+			result.Warnings++
+		}
+	}
+	return result
+}
+
+// add record a finding of rule, unless the rule is turned off
+func (r *LintResult) add(rule, table, column, format string, args ...interface{}) {
+	severity, ok := r.severities[rule]
+	if !ok {
+		severity = DefaultLintSeverities[rule]
+	}
+	if severity == SeverityOff {
+		return
+	}
+	r.Findings = append(r.Findings, &LintFinding{Rule: rule, Severity: severity, Table: table, Column: column, Message: fmt.Sprintf(format, args...)})
+}
+
+func (c *Config) lintTable(db *sql.DB, dbMeta DbTableMeta, result *LintResult) {
+	tableName := dbMeta.TableName()
+
+	if m, ok := dbMeta.(*dbTableMeta); ok {
+		if m.noPrimaryKey {
+			result.add(LintNoPrimaryKey, tableName, "", "table %s has no primary key, the generated code uses the first column %s", tableName, m.columns[0].Name())
+		}
+		for _, name := range m.nullablePrimaryKeys {
+			result.add(LintNullablePrimaryKey, tableName, name, "primary key column %s of table %s is nullable", name, tableName)
+		}
+	}
+	for _, col := range dbMeta.Columns() {
+		if col.IsPrimaryKey() && col.Nullable() {
+			result.add(LintNullablePrimaryKey, tableName, col.Name(), "primary key column %s of table %s is nullable", col.Name(), tableName)
+		}
+	}
+
+	if goKeywords[strings.ToLower(tableName)] || protobufKeywords[strings.ToLower(tableName)] {
+		result.add(LintReservedWord, tableName, "", "table %s is named after a go or protobuf keyword", tableName)
+	}
+
+	fieldNames := make(map[string]string)
+	jsonNames := make(map[string]string)
+	for _, col := range dbMeta.Columns() {
+		name := col.Name()
+
+		if _, err := SQLTypeToMapping(strings.ToLower(col.DatabaseTypeName())); err != nil {
+			result.add(LintUnmappedType, tableName, name, "column %s of table %s has type %s with no mapping, it is left out of the generated code", name, tableName, col.DatabaseTypeName())
+		}
+
+		if goKeywords[strings.ToLower(name)] {
+			result.add(LintReservedWord, tableName, name, "column %s of table %s is a go keyword", name, tableName)
+		}
+		baseName := c.ColumnBaseName(name)
+		if protoName := formatFieldName(c.ProtobufNameFormat, baseName); protobufKeywords[protoName] {
+			result.add(LintReservedWord, tableName, name, "column %s of table %s maps to the protobuf keyword %s", name, tableName, protoName)
+		}
+
+		fieldName := Replace(c.FieldNamingTemplate, baseName)
+		if other, ok := fieldNames[fieldName]; ok {
+			result.add(LintFieldNameCollision, tableName, name, "columns %s and %s of table %s both map to the field name %s", other, name, tableName, fieldName)
+		} else {
+			fieldNames[fieldName] = name
+		}
+
+		jsonName := formatFieldName(c.JSONNameFormat, baseName)
+		if other, ok := jsonNames[jsonName]; ok {
+			result.add(LintDuplicateJSONName, tableName, name, "columns %s and %s of table %s both map to the json name %s", other, name, tableName, jsonName)
+		} else {
+			jsonNames[jsonName] = name
+		}
+	}
+
+	if result.severities[LintUnindexedForeignKey] != SeverityOff {
+		c.lintForeignKeys(db, dbMeta, result)
+	}
+}
+
+// lintForeignKeys report foreign keys whose columns are not the leading columns of an index or the primary key
+func (c *Config) lintForeignKeys(db *sql.DB, dbMeta DbTableMeta, result *LintResult) {
+	tableName := dbMeta.TableName()
+	foreignKeys, indexes, err := loadForeignKeysAndIndexes(db, c.SQLType, c.SQLDatabase, tableName)
+	if err != nil {
+		c.Report.Warning(StageLoad, tableName, "", "unable to load foreign keys and indexes of table %s: %v", tableName, err)
+		return
+	}
+
+	if m, ok := dbMeta.(*dbTableMeta); !ok || !m.noPrimaryKey {
+		indexes = append(indexes, PrimaryKeyNames(dbMeta))
+	}
+
+	for _, fk := range foreignKeys {
+		indexed := false
+		for _, index := range indexes {
+			if hasLeadingColumns(index, fk.columns) {
+				indexed = true
+				break
+			}
+		}
+		if !indexed {
+			result.add(LintUnindexedForeignKey, tableName, strings.Join(fk.columns, ","), "foreign key (%s) of table %s referencing %s has no supporting index", strings.Join(fk.columns, ", "), tableName, fk.refTable)
+		}
+	}
+}
+
+// hasLeadingColumns return true if columns, in any order, are the first columns of index
+func hasLeadingColumns(index, columns []string) bool {
+	if len(columns) == 0 || len(index) < len(columns) {
+		return false
+	}
+	for _, col := range columns {
+		if _, ok := FindInSlice(index[:len(columns)], col); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+type foreignKey struct {
+	columns  []string
+	refTable string
+}
+
+// loadForeignKeysAndIndexes load the foreign keys of a table and the columns of its indexes, in index order. Sql types
+// without catalog queries return no foreign keys.
+func loadForeignKeysAndIndexes(db *sql.DB, sqlType, sqlDatabase, tableName string) ([]*foreignKey, [][]string, error) {
+	var fkSQL, indexSQL string
+	name := escapeSQLString(tableName)
+
+	switch sqlType {
+	case "sqlite3":
+		return loadSqliteForeignKeysAndIndexes(db, tableName)
+	case "mysql":
+		fkSQL = fmt.Sprintf(`SELECT CONSTRAINT_NAME, COLUMN_NAME, REFERENCED_TABLE_NAME FROM information_schema.KEY_COLUMN_USAGE
+WHERE TABLE_SCHEMA = '%s' AND TABLE_NAME = '%s' AND REFERENCED_TABLE_NAME IS NOT NULL ORDER BY CONSTRAINT_NAME, ORDINAL_POSITION`, escapeSQLString(sqlDatabase), name)
+		indexSQL = fmt.Sprintf(`SELECT INDEX_NAME, COLUMN_NAME, '' FROM information_schema.STATISTICS
+WHERE TABLE_SCHEMA = '%s' AND TABLE_NAME = '%s' ORDER BY INDEX_NAME, SEQ_IN_INDEX`, escapeSQLString(sqlDatabase), name)
+	case "postgres":
+		fkSQL = fmt.Sprintf(`SELECT c.conname, a.attname, cf.relname FROM pg_constraint c
+JOIN pg_class t ON t.oid = c.conrelid
+JOIN pg_class cf ON cf.oid = c.confrelid
+JOIN LATERAL unnest(c.conkey) WITH ORDINALITY AS k(attnum, ord) ON true
+JOIN pg_attribute a ON a.attrelid = c.conrelid AND a.attnum = k.attnum
+WHERE c.contype = 'f' AND t.relname = '%s' ORDER BY c.conname, k.ord`, name)
+		indexSQL = fmt.Sprintf(`SELECT i.relname, a.attname, '' FROM pg_index x
+JOIN pg_class t ON t.oid = x.indrelid
+JOIN pg_class i ON i.oid = x.indexrelid
+JOIN LATERAL unnest(x.indkey) WITH ORDINALITY AS k(attnum, ord) ON true
+JOIN pg_attribute a ON a.attrelid = t.oid AND a.attnum = k.attnum
+WHERE t.relname = '%s' ORDER BY i.relname, k.ord`, name)
+	case "mssql", "sqlserver":
+		fkSQL = fmt.Sprintf(`SELECT fk.name, c.name, rt.name FROM sys.foreign_keys fk
+JOIN sys.foreign_key_columns fkc ON fkc.constraint_object_id = fk.object_id
+JOIN sys.columns c ON c.object_id = fkc.parent_object_id AND c.column_id = fkc.parent_column_id
+JOIN sys.tables rt ON rt.object_id = fk.referenced_object_id
+WHERE fk.parent_object_id = OBJECT_ID('%s') ORDER BY fk.name, fkc.constraint_column_id`, name)
+		indexSQL = fmt.Sprintf(`SELECT i.name, c.name, '' FROM sys.indexes i
+JOIN sys.index_columns ic ON ic.object_id = i.object_id AND ic.index_id = i.index_id
+JOIN sys.columns c ON c.object_id = ic.object_id AND c.column_id = ic.column_id
+WHERE i.object_id = OBJECT_ID('%s') AND ic.key_ordinal > 0 ORDER BY i.name, ic.key_ordinal`, name)
+	default:
+		return nil, nil, nil
+	}
+
+	fks, err := queryColumnGroups(db, fkSQL)
+	if err != nil {
+		return nil, nil, err
+	}
+	indexGroups, err := queryColumnGroups(db, indexSQL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var foreignKeys []*foreignKey
+	for _, fk := range fks {
+		foreignKeys = append(foreignKeys, &foreignKey{columns: fk.columns, refTable: fk.refTable})
+	}
+
+	var indexes [][]string
+	for _, index := range indexGroups {
+		indexes = append(indexes, index.columns)
+	}
+	return foreignKeys, indexes, nil
+}
+
+// queryColumnGroups run a query returning rows of name, column and referenced table ordered by name, and group the
+// columns by name
+func queryColumnGroups(db *sql.DB, query string) ([]*foreignKey, error) {
+	rows, err := db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var groups []*foreignKey
+	lastName := ""
+	for rows.Next() {
+		var name, column, refTable string
+		if err = rows.Scan(&name, &column, &refTable); err != nil {
+			return nil, err
+		}
+		if len(groups) == 0 || name != lastName {
+			groups = append(groups, &foreignKey{refTable: refTable})
+			lastName = name
+		}
+		groups[len(groups)-1].columns = append(groups[len(groups)-1].columns, column)
+	}
+	return groups, rows.Err()
+}
+
+func loadSqliteForeignKeysAndIndexes(db *sql.DB, tableName string) ([]*foreignKey, [][]string, error) {
+	rows, err := db.Query(fmt.Sprintf("PRAGMA foreign_key_list('%s');", escapeSQLString(tableName)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var foreignKeys []*foreignKey
+	byID := make(map[int]*foreignKey)
+	for rows.Next() {
+		var id, seq int
+		var refTable, from string
+		var to, onUpdate, onDelete, match sql.NullString
+		if err = rows.Scan(&id, &seq, &refTable, &from, &to, &onUpdate, &onDelete, &match); err != nil {
+			rows.Close()
+			return nil, nil, err
+		}
+		fk, ok := byID[id]
+		if !ok {
+			fk = &foreignKey{refTable: refTable}
+			byID[id] = fk
+			foreignKeys = append(foreignKeys, fk)
+		}
+		fk.columns = append(fk.columns, from)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	rows, err = db.Query(fmt.Sprintf("PRAGMA index_list('%s');", escapeSQLString(tableName)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var indexNames []string
+	for rows.Next() {
+		cols, err := rows.Columns()
+		if err != nil {
+			rows.Close()
+			return nil, nil, err
+		}
+
+		// older sqlite versions return fewer columns, the index name is always the second
+		values := make([]interface{}, len(cols))
+		var name string
+		for i := range values {
+			values[i] = new(interface{})
+		}
+		values[1] = &name
+		if err = rows.Scan(values...); err != nil {
+			rows.Close()
+			return nil, nil, err
+		}
+		indexNames = append(indexNames, name)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	var indexes [][]string
+	for _, indexName := range indexNames {
+		rows, err = db.Query(fmt.Sprintf("PRAGMA index_info('%s');", escapeSQLString(indexName)))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var columns []string
+		for rows.Next() {
+			var seqNo, cid int
+			var name sql.NullString
+			if err = rows.Scan(&seqNo, &cid, &name); err != nil {
+				rows.Close()
+				return nil, nil, err
+			}
+			columns = append(columns, name.String)
+		}
+		rows.Close()
+		indexes = append(indexes, columns)
+	}
+	return foreignKeys, indexes, nil
+}
+
+// namingStyle return the naming style of name, or an empty string for names that fit any style, e.g. a single word
+func namingStyle(name string) string {
+	switch {
+	case strings.Contains(name, "_") && name == strings.ToLower(name):
+		return "snake_case"
+	case strings.Contains(name, "_") && name == strings.ToUpper(name):
+		return "UPPER_SNAKE_CASE"
+	case strings.Contains(name, "_"):
+		return "Mixed_Case"
+	case name == strings.ToLower(name) || name == strings.ToUpper(name):
+		return ""
+	case unicode.IsUpper([]rune(name)[0]):
+		return "PascalCase"
+	default:
+		return "camelCase"
+	}
+}
+
+// dominantNamingStyle return the most common naming style of names
+func dominantNamingStyle(names []string) string {
+	counts := make(map[string]int)
+	for _, name := range names {
+		if style := namingStyle(name); style != "" {
+			counts[style]++
+		}
+	}
+
+	dominant := ""
+	for style, count := range counts {
+		if count > counts[dominant] || (count == counts[dominant] && style < dominant) {
+			dominant = style
+		}
+	}
+	return dominant
+}
+
+// lintNamingStyle report tables and columns not named in the naming style used by most tables and columns
+func lintNamingStyle(dbMetas []DbTableMeta, result *LintResult) {
+	var tableNames, columnNames []string
+	for _, dbMeta := range dbMetas {
+		tableNames = append(tableNames, dbMeta.TableName())
+		for _, col := range dbMeta.Columns() {
+			columnNames = append(columnNames, col.Name())
+		}
+	}
+
+	tableStyle := dominantNamingStyle(tableNames)
+	columnStyle := dominantNamingStyle(columnNames)
+	for _, dbMeta := range dbMetas {
+		tableName := dbMeta.TableName()
+		if style := namingStyle(tableName); style != "" && style != tableStyle {
+			result.add(LintNamingStyle, tableName, "", "table %s is %s, most tables are %s", tableName, style, tableStyle)
+		}
+		for _, col := range dbMeta.Columns() {
+			if style := namingStyle(col.Name()); style != "" && style != columnStyle {
+				result.add(LintNamingStyle, tableName, col.Name(), "column %s of table %s is %s, most columns are %s", col.Name(), tableName, style, columnStyle)
+			}
+		}
+	}
+}
+
+// Write write the findings in format, text or json
+func (r *LintResult) Write(w io.Writer, format string) error {
+	switch format {
+	case LintJSON:
+		b, err := json.MarshalIndent(r, "", "    ")
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(append(b, '\n'))
+		return err
+	case LintText:
+		buf := &bytes.Buffer{}
+		tw := tabwriter.NewWriter(buf, 0, 4, 2, ' ', 0)
+		for _, finding := range r.Findings {
+			location := finding.Table
+			if finding.Column != "" {
+				location = finding.Table + "." + finding.Column
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", finding.Severity, finding.Rule, location, finding.Message)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+		fmt.Fprintf(buf, "%d error(s), %d warning(s) in %d table(s)\n", r.Errors, r.Warnings, r.Tables)
+		_, err := w.Write(buf.Bytes())
+		return err
+	}
+	return CheckLintFormat(format)
+}
@@ -0,0 +1,128 @@
+package dbmeta
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func Test_Lint(t *testing.T) {
+	db, _ := openTestDB(t,
+		"CREATE TABLE artists (artist_id INTEGER PRIMARY KEY, artist_name TEXT)",
+		"CREATE TABLE albums (album_id INTEGER PRIMARY KEY, artist_id INTEGER REFERENCES artists(artist_id), label_id INTEGER REFERENCES artists(artist_id), type TEXT, shape GEOMETRY, userName TEXT, user_name TEXT)",
+		"CREATE INDEX albums_label ON albums (label_id, album_id)",
+		"CREATE TABLE notes (body TEXT, created_at TEXT)",
+	)
+
+	conf := NewConfig(nil)
+	conf.SQLType = "sqlite3"
+	conf.SQLDatabase = "main"
+	conf.JSONNameFormat = "snake"
+	conf.ProtobufNameFormat = "snake"
+
+	result := conf.Lint(db, []string{"artists", "albums", "notes"}, nil, nil)
+	if result.Tables != 3 {
+		t.Fatalf("expected 3 tables, got %d", result.Tables)
+	}
+
+	var found []string
+	for _, finding := range result.Findings {
+		found = append(found, finding.Rule+" "+finding.Table+"."+finding.Column)
+	}
+	sort.Strings(found)
+
+	expected := []string{
+		"duplicate-json-name albums.user_name",
+		"field-name-collision albums.user_name",
+		"naming-style albums.userName",
+		"no-primary-key notes.",
+		"reserved-word albums.type",
+		"unindexed-foreign-key albums.artist_id",
+		"unmapped-type albums.shape",
+	}
+	if strings.Join(found, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected findings\n%s\nexpected\n%s", strings.Join(found, "\n"), strings.Join(expected, "\n"))
+	}
+	if result.Errors != 4 || result.Warnings != 3 {
+		t.Errorf("expected 4 errors and 3 warnings, got %d and %d", result.Errors, result.Warnings)
+	}
+
+	severities, err := ParseLintSeverities("naming-style=off, no-primary-key=warning")
+	if err != nil {
+		t.Fatal(err)
+	}
+	result = conf.Lint(db, []string{"artists", "albums", "notes"}, nil, severities)
+	if result.Errors != 3 || result.Warnings != 3 {
+		t.Errorf("expected 3 errors and 3 warnings with custom severities, got %d and %d", result.Errors, result.Warnings)
+	}
+
+	var buf bytes.Buffer
+	if err = result.Write(&buf, LintJSON); err != nil {
+		t.Fatal(err)
+	}
+	decoded := &LintResult{}
+	if err = json.Unmarshal(buf.Bytes(), decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Tables != 3 || len(decoded.Findings) != 6 {
+		t.Errorf("unexpected json %s", buf.String())
+	}
+
+	buf.Reset()
+	if err = result.Write(&buf, LintText); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(buf.String(), "3 error(s), 3 warning(s) in 3 table(s)\n") {
+		t.Errorf("unexpected text output\n%s", buf.String())
+	}
+}
+
+func Test_LintNullablePrimaryKey(t *testing.T) {
+	m := &dbTableMeta{
+		sqlType:   "postgres",
+		tableName: "tags",
+		columns: []*columnMeta{
+			{index: 0, name: "tag_name", databaseTypeName: "text", isPrimaryKey: true, nullable: true},
+			{index: 1, name: "tag_value", databaseTypeName: "text", isPrimaryKey: true, nullable: true},
+		},
+	}
+	updateDefaultPrimaryKey(m)
+
+	conf := NewConfig(nil)
+	conf.SQLType = "unknown"
+	result := &LintResult{severities: DefaultLintSeverities}
+	conf.lintTable(nil, m, result)
+
+	if len(result.Findings) != 2 || result.Findings[0].Column != "tag_name" || result.Findings[1].Column != "tag_value" {
+		for _, finding := range result.Findings {
+			t.Logf("%+v", finding)
+		}
+		t.Fatalf("expected both nullable primary key columns to be reported")
+	}
+}
+
+func Test_ParseLintSeverities(t *testing.T) {
+	for _, spec := range []string{"no-such-rule=error", "naming-style=fatal", "naming-style"} {
+		if _, err := ParseLintSeverities(spec); err == nil {
+			t.Errorf("%s: expected an error", spec)
+		}
+	}
+}
+
+func Test_NamingStyle(t *testing.T) {
+	for name, expected := range map[string]string{
+		"album_id": "snake_case",
+		"ALBUM_ID": "UPPER_SNAKE_CASE",
+		"Album_Id": "Mixed_Case",
+		"AlbumId":  "PascalCase",
+		"albumId":  "camelCase",
+		"album":    "",
+		"ID":       "",
+	} {
+		if got := namingStyle(name); got != expected {
+			t.Errorf("%s: expected %q, got %q", name, expected, got)
+		}
+	}
+}
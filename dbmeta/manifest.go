@@ -0,0 +1,210 @@
+package dbmeta
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// ManifestFileName name of the manifest written to the output dir, listing every file produced by gen
+const ManifestFileName = ".gen-manifest.json"
+
+// Manifest list of generated files, used to find outputs that are no longer produced. The fingerprints of the tables,
+// templates and config used to generate the files decide which tables are regenerated on the next run.
+type Manifest struct {
+	Templates string            `json:"templates,omitempty"`
+	Config    string            `json:"config,omitempty"`
+	Tables    map[string]string `json:"tables,omitempty"`
+	Files     []*ManifestEntry  `json:"files"`
+}
+
+// ManifestEntry a generated file, the path is relative to the output dir
+type ManifestEntry struct {
+	Path     string `json:"path"`
+	Template string `json:"template"`
+	Table    string `json:"table,omitempty"`
+	Hash     string `json:"sha256"`
+}
+
+// StaleFile a file listed in the previous manifest that was not produced by the current run
+type StaleFile struct {
+	*ManifestEntry
+	// Modified the file was edited since it was generated
+	Modified bool
+	// Missing the file no longer exists
+	Missing bool
+}
+
+// HashContent return the hex encoded sha256 of content
+func HashContent(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// LoadManifest load the manifest from outDir, nil is returned if there is no manifest
+func LoadManifest(outDir string) (*Manifest, error) {
+	manifestFile := filepath.Join(outDir, ManifestFileName)
+	if !Exists(manifestFile) {
+		return nil, nil
+	}
+
+	b, err := ioutil.ReadFile(manifestFile)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+	err = json.Unmarshal(b, manifest)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s error: %v", manifestFile, err)
+	}
+	return manifest, nil
+}
+
+// Save write the manifest to outDir
+func (m *Manifest) Save(outDir string) error {
+	sort.Slice(m.Files, func(i, j int) bool { return m.Files[i].Path < m.Files[j].Path })
+
+	b, err := json.MarshalIndent(m, "", "    ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(outDir, ManifestFileName), append(b, '\n'), 0666)
+}
+
+// Find return the entry for path, nil if the path is not listed
+func (m *Manifest) Find(path string) *ManifestEntry {
+	if m == nil {
+		return nil
+	}
+	for _, entry := range m.Files {
+		if entry.Path == path {
+			return entry
+		}
+	}
+	return nil
+}
+
+// BuildManifest create the manifest for the files written in this run and return the files of previous that are no
+// longer produced. Entries and fingerprints of previous for tables that were not generated in this run are retained if
+// retain returns true.
+func (c *Config) BuildManifest(previous *Manifest, retain func(tableName string) bool) (*Manifest, []*StaleFile) {
+	manifest := &Manifest{
+		Templates: c.TemplatesHash,
+		Config:    c.ConfigHash(),
+		Tables:    make(map[string]string, len(c.TableInfos)),
+	}
+	for tableName, tableInfo := range c.TableInfos {
+		manifest.Tables[tableName] = TableFingerprint(tableInfo)
+	}
+
+	produced := make(map[string]bool)
+
+	for _, output := range c.Outputs {
+		path := c.relativeOutputPath(output.Path)
+		if produced[path] {
+			continue
+		}
+
+		entry := &ManifestEntry{Path: path, Template: filepath.Base(output.Template), Table: output.Table, Hash: output.Hash}
+		if output.Status == OutputSkipped {
+			// not overwritten, only keep tracking the file if it was generated by a previous run
+			prev := previous.Find(path)
+			if prev == nil {
+				continue
+			}
+			entry.Hash = prev.Hash
+		}
+
+		produced[path] = true
+		manifest.Files = append(manifest.Files, entry)
+	}
+
+	if previous == nil {
+		return manifest, nil
+	}
+
+	for tableName, fingerprint := range previous.Tables {
+		if _, ok := manifest.Tables[tableName]; !ok && retain != nil && retain(tableName) {
+			manifest.Tables[tableName] = fingerprint
+		}
+	}
+
+	var stale []*StaleFile
+	for _, entry := range previous.Files {
+		if produced[entry.Path] {
+			continue
+		}
+
+		if retain != nil && entry.Table != "" && retain(entry.Table) {
+			manifest.Files = append(manifest.Files, entry)
+			continue
+		}
+
+		staleFile := &StaleFile{ManifestEntry: entry}
+		content, err := ioutil.ReadFile(filepath.Join(c.OutDir, filepath.FromSlash(entry.Path)))
+		if err != nil {
+			staleFile.Missing = true
+		} else {
+			staleFile.Modified = HashContent(content) != entry.Hash
+		}
+		stale = append(stale, staleFile)
+	}
+	return manifest, stale
+}
+
+// PruneStaleFiles remove stale files from the output dir, files that were edited since generation are only removed if
+// force is set. Files that are kept remain listed in the manifest, so they are reported again on the next run.
+func (c *Config) PruneStaleFiles(manifest *Manifest, stale []*StaleFile, prune, force bool) error {
+	for _, staleFile := range stale {
+		if staleFile.Missing {
+			continue
+		}
+
+		fullPath := filepath.Join(c.OutDir, filepath.FromSlash(staleFile.Path))
+		remove := prune && !c.DryRun && (!staleFile.Modified || force)
+
+		var msg string
+		switch {
+		case c.DryRun:
+			msg = fmt.Sprintf("%-9s %s\n", "stale", staleFile.Path)
+		case remove:
+			msg = fmt.Sprintf("removing stale file %s\n", staleFile.Path)
+		case staleFile.Modified && prune:
+			msg = fmt.Sprintf("stale file %s was edited since generation, not removing it without --force-prune\n", staleFile.Path)
+		default:
+			msg = fmt.Sprintf("stale file %s is no longer generated, remove it with --prune\n", staleFile.Path)
+		}
+
+		if au != nil {
+			fmt.Print(au.Yellow(msg))
+		} else {
+			fmt.Print(msg)
+		}
+
+		if !remove {
+			manifest.Files = append(manifest.Files, staleFile.ManifestEntry)
+			continue
+		}
+
+		err := os.Remove(fullPath)
+		if err != nil {
+			return fmt.Errorf("unable to remove stale file %s error: %v", fullPath, err)
+		}
+	}
+	return nil
+}
+
+// relativeOutputPath return the slash separated path of outputFile relative to the output dir
+func (c *Config) relativeOutputPath(outputFile string) string {
+	path, err := filepath.Rel(c.OutDir, outputFile)
+	if err != nil {
+		path = outputFile
+	}
+	return filepath.ToSlash(path)
+}
@@ -0,0 +1,63 @@
+package dbmeta
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_BuildManifest(t *testing.T) {
+	outDir, err := ioutil.TempDir("", "gen-manifest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(outDir)
+
+	writeFile := func(name, content string) {
+		if err := ioutil.WriteFile(filepath.Join(outDir, name), []byte(content), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("albums.go", "albums")
+	writeFile("tracks.go", "tracks edited")
+
+	previous := &Manifest{Files: []*ManifestEntry{
+		{Path: "artists.go", Template: "model.go.tmpl", Table: "artists", Hash: HashContent([]byte("artists"))},
+		{Path: "albums.go", Template: "model.go.tmpl", Table: "albums", Hash: HashContent([]byte("albums"))},
+		{Path: "tracks.go", Template: "model.go.tmpl", Table: "tracks", Hash: HashContent([]byte("tracks"))},
+		{Path: "genres.go", Template: "model.go.tmpl", Table: "genres", Hash: HashContent([]byte("genres"))},
+	}}
+
+	conf := NewConfig(nil)
+	conf.OutDir = outDir
+	conf.Outputs = []*OutputFile{
+		{Path: filepath.Join(outDir, "artists.go"), Template: "internal://model.go.tmpl", Table: "artists", Hash: HashContent([]byte("artists"))},
+	}
+
+	manifest, stale := conf.BuildManifest(previous, nil)
+	if len(manifest.Files) != 1 || manifest.Files[0].Path != "artists.go" || manifest.Files[0].Template != "model.go.tmpl" {
+		t.Fatalf("unexpected manifest: %+v", manifest.Files)
+	}
+
+	if len(stale) != 3 {
+		t.Fatalf("expected 3 stale files, got %d", len(stale))
+	}
+
+	expected := map[string][2]bool{
+		"albums.go": {false, false},
+		"tracks.go": {true, false},
+		"genres.go": {false, true},
+	}
+	for _, staleFile := range stale {
+		state, ok := expected[staleFile.Path]
+		if !ok || staleFile.Modified != state[0] || staleFile.Missing != state[1] {
+			t.Errorf("unexpected stale file %s modified: %t missing: %t", staleFile.Path, staleFile.Modified, staleFile.Missing)
+		}
+	}
+
+	manifest, stale = conf.BuildManifest(previous, func(tableName string) bool { return tableName != "artists" })
+	if len(manifest.Files) != 4 || len(stale) != 0 {
+		t.Fatalf("partial run should retain other tables, got %d files and %d stale", len(manifest.Files), len(stale))
+	}
+}
@@ -4,13 +4,18 @@ import (
 	"bytes"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"hash/fnv"
 	"io/ioutil"
+	"math/rand"
 	"os"
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/bxcodec/faker/v3"
@@ -18,9 +23,12 @@ import (
 	dynamicstruct "github.com/ompluscator/dynamic-struct"
 )
 
-type metaDataLoader func(db *sql.DB, sqlType, sqlDatabase, tableName string) (DbTableMeta, error)
+type metaDataLoader func(db *sql.DB, sqlType, sqlDatabase, tableName string, catalog *SchemaCatalog) (DbTableMeta, error)
 
 var metaDataFuncs = make(map[string]metaDataLoader)
+
+// errUnsupportedTable returned by loaders for internal tables of the database, which are never generated
+var errUnsupportedTable = errors.New("unsupported table")
 var sqlMappings = make(map[string]*SQLMapping)
 
 func init() {
@@ -205,6 +213,25 @@ type dbTableMeta struct {
 	columns       []*columnMeta
 	ddl           string
 	primaryKeyPos int
+	// issues found while loading the table, reported once the table is added
+	issues []*Issue
+	// noPrimaryKey the table has no primary key, the first column is used instead
+	noPrimaryKey bool
+	// nullablePrimaryKeys primary key columns that are nullable, they are generated as NOT NULL
+	nullablePrimaryKeys []string
+}
+
+func (m *dbTableMeta) warn(stage, column, format string, args ...interface{}) {
+	m.issues = append(m.issues, &Issue{Table: m.tableName, Column: column, Stage: stage, Severity: SeverityWarning, Message: fmt.Sprintf(format, args...)})
+}
+
+// loadIssues return the issues found while loading dbMeta
+func loadIssues(dbMeta DbTableMeta) []*Issue {
+	m, ok := dbMeta.(*dbTableMeta)
+	if !ok {
+		return nil
+	}
+	return m.issues
 }
 
 // PrimaryKeyPos ordinal pos of primary key
@@ -250,10 +277,13 @@ type ModelInfo struct {
 	StructName      string
 	ShortStructName string
 	TableName       string
+	RouteName       string
 	Fields          []string
 	DBMeta          DbTableMeta
 	Instance        interface{}
 	CodeFields      []*FieldInfo
+	SoftDelete      *FieldInfo
+	Audit           AuditFields
 }
 
 // Notes notes on table generation
@@ -300,6 +330,28 @@ type FieldInfo struct {
 	GoGoMoreTags          string
 }
 
+// sqlNullValues the value field and its go type of the database/sql null types, which do not unmarshal from JSON
+var sqlNullValues = map[string][2]string{
+	"sql.NullBool":    {"Bool", "bool"},
+	"sql.NullByte":    {"Byte", "byte"},
+	"sql.NullFloat64": {"Float64", "float64"},
+	"sql.NullInt16":   {"Int16", "int16"},
+	"sql.NullInt32":   {"Int32", "int32"},
+	"sql.NullInt64":   {"Int64", "int64"},
+	"sql.NullString":  {"String", "string"},
+	"sql.NullTime":    {"Time", "time.Time"},
+}
+
+// SQLNullValueField return the name of the value field of a database/sql null type field, empty for other types
+func (fi *FieldInfo) SQLNullValueField() string {
+	return sqlNullValues[fi.GoFieldType][0]
+}
+
+// SQLNullValueType return the go type of the value field of a database/sql null type field, empty for other types
+func (fi *FieldInfo) SQLNullValueType() string {
+	return sqlNullValues[fi.GoFieldType][1]
+}
+
 // GetFunctionName get function name
 func GetFunctionName(i interface{}) string {
 	return runtime.FuncForPC(reflect.ValueOf(i).Pointer()).Name()
@@ -307,12 +359,18 @@ func GetFunctionName(i interface{}) string {
 
 // LoadMeta loads the DbTableMeta data from the db connection for the table
 func LoadMeta(sqlType string, db *sql.DB, sqlDatabase, tableName string) (DbTableMeta, error) {
+	return LoadMetaFromCatalog(sqlType, db, sqlDatabase, tableName, nil)
+}
+
+// LoadMetaFromCatalog loads the DbTableMeta data for the table, using the column and key information of catalog instead
+// of querying the database catalog for the table
+func LoadMetaFromCatalog(sqlType string, db *sql.DB, sqlDatabase, tableName string, catalog *SchemaCatalog) (DbTableMeta, error) {
 	dbMetaFunc, haveMeta := metaDataFuncs[sqlType]
 	if !haveMeta {
 		dbMetaFunc = LoadUnknownMeta
 	}
 
-	dbMeta, err := dbMetaFunc(db, sqlType, sqlDatabase, tableName)
+	dbMeta, err := dbMetaFunc(db, sqlType, sqlDatabase, tableName, catalog)
 	//if err != nil {
 	//	fmt.Printf("Error calling func: %s error: %v\n", GetFunctionName(dbMetaFunc), err)
 	//}
@@ -326,6 +384,7 @@ func (c *Config) GenerateFieldsTypes(dbMeta DbTableMeta) ([]*FieldInfo, error) {
 	field := ""
 	for i, col := range dbMeta.Columns() {
 		fieldName := col.Name()
+		baseName := c.ColumnBaseName(col.Name())
 
 		fi := &FieldInfo{
 			Index: i,
@@ -333,16 +392,16 @@ func (c *Config) GenerateFieldsTypes(dbMeta DbTableMeta) ([]*FieldInfo, error) {
 
 		valueType, err := SQLTypeToGoType(strings.ToLower(col.DatabaseTypeName()), col.Nullable(), c.UseGureguTypes)
 		if err != nil { // unknown type
-			fmt.Printf("table: %s unable to generate struct field: %s type: %s error: %v\n", dbMeta.TableName(), fieldName, col.DatabaseTypeName(), err)
+			c.Report.Skip(StageType, dbMeta.TableName(), fieldName, "table: %s unable to generate struct field: %s type: %s error: %v", dbMeta.TableName(), fieldName, col.DatabaseTypeName(), err)
 			continue
 		}
 
-		fieldName = Replace(c.FieldNamingTemplate, fieldName)
+		fieldName = Replace(c.FieldNamingTemplate, baseName)
 		fieldName = checkDupeFieldName(fields, fieldName)
 
 		fi.GormAnnotation = createGormAnnotation(col)
-		fi.JSONAnnotation = createJSONAnnotation(c.JSONNameFormat, col)
-		fi.XMLAnnotation = createXMLAnnotation(c.XMLNameFormat, col)
+		fi.JSONAnnotation = createJSONAnnotation(c.JSONNameFormat, baseName)
+		fi.XMLAnnotation = createXMLAnnotation(c.XMLNameFormat, baseName)
 		fi.DBAnnotation = createDBAnnotation(col)
 
 		var annotations []string
@@ -366,9 +425,11 @@ func (c *Config) GenerateFieldsTypes(dbMeta DbTableMeta) ([]*FieldInfo, error) {
 		GoGoMoreTags := strings.Join(gogoTags, " ")
 
 		if c.AddProtobufAnnotation {
-			annotation, err := createProtobufAnnotation(c.ProtobufNameFormat, col)
+			annotation, err := createProtobufAnnotation(c.ProtobufNameFormat, baseName, col)
 			if err == nil {
 				annotations = append(annotations, annotation)
+			} else {
+				c.Report.Warning(StageType, dbMeta.TableName(), col.Name(), "table: %s no protobuf type for field: %s type: %s", dbMeta.TableName(), col.Name(), col.DatabaseTypeName())
 			}
 		}
 
@@ -406,6 +467,7 @@ func (c *Config) GenerateFieldsTypes(dbMeta DbTableMeta) ([]*FieldInfo, error) {
 			primaryKeyFieldParser, ok = parsePrimaryKeys[goType]
 			if !ok {
 				primaryKeyFieldParser = "unsupported"
+				c.Report.Warning(StagePrimaryKey, dbMeta.TableName(), col.Name(), "table: %s primary key %s of type %s can not be parsed from a url", dbMeta.TableName(), col.Name(), goType)
 			}
 		}
 
@@ -415,8 +477,8 @@ func (c *Config) GenerateFieldsTypes(dbMeta DbTableMeta) ([]*FieldInfo, error) {
 		fi.GoAnnotations = annotations
 		fi.FakeData = fakeData
 		fi.Comment = col.String()
-		fi.JSONFieldName = formatFieldName(c.JSONNameFormat, col.Name())
-		fi.ProtobufFieldName = formatFieldName(c.ProtobufNameFormat, col.Name())
+		fi.JSONFieldName = formatFieldName(c.JSONNameFormat, baseName)
+		fi.ProtobufFieldName = formatFieldName(c.ProtobufNameFormat, baseName)
 		fi.ProtobufType = protobufType
 		fi.ProtobufPos = i + 1
 		fi.ColumnMeta = col
@@ -450,13 +512,13 @@ func formatFieldName(nameFormat string, name string) string {
 	return jsonName
 }
 
-func createJSONAnnotation(nameFormat string, c ColumnMeta) string {
-	name := formatFieldName(nameFormat, c.Name())
+func createJSONAnnotation(nameFormat string, baseName string) string {
+	name := formatFieldName(nameFormat, baseName)
 	return fmt.Sprintf("json:\"%s\"", name)
 }
 
-func createXMLAnnotation(nameFormat string, c ColumnMeta) string {
-	name := formatFieldName(nameFormat, c.Name())
+func createXMLAnnotation(nameFormat string, baseName string) string {
+	name := formatFieldName(nameFormat, baseName)
 	return fmt.Sprintf("xml:\"%s\"", name)
 }
 
@@ -464,14 +526,14 @@ func createDBAnnotation(c ColumnMeta) string {
 	return fmt.Sprintf("db:\"%s\"", c.Name())
 }
 
-func createProtobufAnnotation(nameFormat string, c ColumnMeta) (string, error) {
+func createProtobufAnnotation(nameFormat string, baseName string, c ColumnMeta) (string, error) {
 	protoBufType, err := SQLTypeToProtobufType(c.DatabaseTypeName())
 	if err != nil {
 		return "", err
 	}
 
 	if protoBufType != "" {
-		name := formatFieldName(nameFormat, c.Name())
+		name := formatFieldName(nameFormat, baseName)
 		return fmt.Sprintf("protobuf:\"%s,%d,opt,name=%s\"", protoBufType, c.Index(), name), nil
 	}
 
@@ -652,7 +714,7 @@ func createFakeData(valueType string, name string) interface{} {
 	case "string":
 		return "hello world"
 	case "time.Time":
-		return time.Now()
+		return fakeTimeBase
 	case "interface{}":
 		return 1
 	default:
@@ -677,10 +739,15 @@ func LoadTableInfo(db *sql.DB, dbTables []string, excludeDbTables []string, conf
 
 	tableInfos := make(map[string]*ModelInfo)
 
-	// generate go files for each table
-	var tableIdx = 0
-	for i, tableName := range dbTables {
+	// load tables sorted by name, so struct naming and indices do not depend on the order the database lists them in
+	UpdateTableInfo(db, tableInfos, filterTableNames(dbTables, excludeDbTables), conf)
+	return tableInfos
+}
 
+// filterTableNames return dbTables without the excluded tables, unwrapping [table] names
+func filterTableNames(dbTables []string, excludeDbTables []string) []string {
+	var tableNames []string
+	for _, tableName := range dbTables {
 		_, ok := FindInSlice(excludeDbTables, tableName)
 		if ok {
 			fmt.Printf("Skipping excluded table %s\n", tableName)
@@ -690,46 +757,101 @@ func LoadTableInfo(db *sql.DB, dbTables []string, excludeDbTables []string, conf
 		if strings.HasPrefix(tableName, "[") && strings.HasSuffix(tableName, "]") {
 			tableName = tableName[1 : len(tableName)-1]
 		}
+		tableNames = append(tableNames, tableName)
+	}
+	return tableNames
+}
 
-		dbMeta, err := LoadMeta(conf.SQLType, db, conf.SQLDatabase, tableName)
-		if err != nil {
-			msg := fmt.Sprintf("Warning - LoadMeta skipping table info for %s error: %v\n", tableName, err)
-			if au != nil {
-				fmt.Print(au.Yellow(msg))
-			} else {
-				fmt.Printf(msg)
+// DefaultWorkers number of tables whose meta data is loaded concurrently if Config.Workers is not set
+const DefaultWorkers = 8
+
+// loadMetas load the DbTableMeta of tableNames with a pool of Config.Workers workers, using a catalog loaded with bulk
+// queries when the sql type supports it. The results are in the order of tableNames.
+func loadMetas(db *sql.DB, tableNames []string, conf *Config) ([]DbTableMeta, []error) {
+	catalog, err := LoadSchemaCatalog(db, conf.SQLType, conf.SQLDatabase, tableNames)
+	if err != nil {
+		conf.Report.Warning(StageLoad, "", "", "unable to load schema catalog, loading tables one by one: %v", err)
+		catalog = nil
+	}
+
+	workers := conf.Workers
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+	if workers > len(tableNames) {
+		workers = len(tableNames)
+	}
+
+	dbMetas := make([]DbTableMeta, len(tableNames))
+	errs := make([]error, len(tableNames))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				dbMetas[i], errs[i] = LoadMetaFromCatalog(conf.SQLType, db, conf.SQLDatabase, tableNames[i], catalog)
 			}
+		}()
+	}
 
+	for i := range tableNames {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return dbMetas, errs
+}
+
+// UpdateTableInfo (re)load the table info of tableNames into tableInfos, tables that can no longer be loaded are removed.
+// The indices of all tables are reassigned in sorted order.
+func UpdateTableInfo(db *sql.DB, tableInfos map[string]*ModelInfo, tableNames []string, conf *Config) {
+	tableNames = append([]string(nil), tableNames...)
+	sort.Strings(tableNames)
+
+	for _, tableName := range tableNames {
+		delete(tableInfos, tableName)
+	}
+
+	dbMetas, errs := loadMetas(db, tableNames, conf)
+
+	// generate go files for each table
+	for i, tableName := range tableNames {
+
+		dbMeta, err := dbMetas[i], errs[i]
+		if err == errUnsupportedTable {
+			conf.Report.Warning(StageLoad, tableName, "", "LoadMeta skipping table info for %s error: %v", tableName, err)
+			continue
+		}
+		if err != nil {
+			conf.Report.Skip(StageLoad, tableName, "", "LoadMeta skipping table info for %s error: %v", tableName, err)
 			continue
 		}
 
+		for _, issue := range loadIssues(dbMeta) {
+			conf.Report.Add(issue)
+		}
+
 		modelInfo, err := GenerateModelInfo(tableInfos, dbMeta, tableName, conf)
 		if err != nil {
-			msg := fmt.Sprintf("Error - %v\n", err)
-			if au != nil {
-				fmt.Print(au.Red(msg))
-			} else {
-				fmt.Printf(msg)
-			}
-
+			conf.Report.Skip(StageModel, tableName, "", "%v", err)
 			continue
 		}
 
 		if len(modelInfo.Fields) == 0 {
-			if conf.Verbose {
-				fmt.Printf("[%d] Table: %s - No Fields Available\n", i, tableName)
-			}
+			conf.Report.Skip(StageModel, tableName, "", "table: %s has no fields that can be generated, skipping table", tableName)
 			continue
 		}
 
-		modelInfo.Index = tableIdx
-		modelInfo.IndexPlus1 = tableIdx + 1
-		tableIdx++
-
 		tableInfos[tableName] = modelInfo
 	}
 
-	return tableInfos
+	for i, tableName := range SortedTableNames(tableInfos) {
+		tableInfos[tableName].Index = i
+		tableInfos[tableName].IndexPlus1 = i + 1
+	}
 }
 
 // GenerateModelInfo generates a struct for the given table.
@@ -737,7 +859,7 @@ func GenerateModelInfo(tables map[string]*ModelInfo, dbMeta DbTableMeta,
 	tableName string,
 	conf *Config) (*ModelInfo, error) {
 
-	structName := Replace(conf.ModelNamingTemplate, tableName)
+	structName := conf.StructName(tableName)
 	structName = CheckForDupeTable(tables, structName)
 
 	fields, err := conf.GenerateFieldsTypes(dbMeta)
@@ -756,9 +878,9 @@ func GenerateModelInfo(tables map[string]*ModelInfo, dbMeta DbTableMeta,
 	generator := dynamicstruct.NewStruct()
 
 	noOfPrimaryKeys := 0
-	for i, c := range fields {
-		meta := dbMeta.Columns()[i]
-		jsonName := formatFieldName(conf.JSONNameFormat, meta.Name())
+	for _, c := range fields {
+		meta := c.ColumnMeta
+		jsonName := formatFieldName(conf.JSONNameFormat, conf.ColumnBaseName(meta.Name()))
 		tag := fmt.Sprintf(`json:"%s"`, jsonName)
 		fakeData := c.FakeData
 		generator = generator.AddField(c.GoFieldName, fakeData, tag)
@@ -771,10 +893,12 @@ func GenerateModelInfo(tables map[string]*ModelInfo, dbMeta DbTableMeta,
 
 	instance := generator.Build().New()
 
+	seedFakeData(tableName)
 	err = faker.FakeData(&instance)
 	if err != nil {
 		fmt.Println(err)
 	}
+	setFakeTimes(reflect.ValueOf(instance))
 	// fmt.Printf("%+v", instance)
 
 	var code []string
@@ -791,16 +915,59 @@ func GenerateModelInfo(tables map[string]*ModelInfo, dbMeta DbTableMeta,
 		PackageName:     conf.ModelPackageName,
 		StructName:      structName,
 		TableName:       tableName,
+		RouteName:       conf.RouteName(tableName, structName),
 		ShortStructName: strings.ToLower(string(structName[0])),
 		Fields:          code,
 		CodeFields:      fields,
 		DBMeta:          dbMeta,
 		Instance:        instance,
+		SoftDelete:      conf.softDeleteField(tableName, fields),
+		Audit:           conf.auditFields(tableName, fields),
 	}
 
 	return modelInfo, nil
 }
 
+// SortedTableNames return the table names of tableInfos in sorted order
+func SortedTableNames(tableInfos map[string]*ModelInfo) []string {
+	tableNames := make([]string, 0, len(tableInfos))
+	for tableName := range tableInfos {
+		tableNames = append(tableNames, tableName)
+	}
+	sort.Strings(tableNames)
+	return tableNames
+}
+
+// seedFakeData seed the random source used by faker from the table name, so sample data is identical between runs
+func seedFakeData(tableName string) {
+	h := fnv.New64a()
+	h.Write([]byte(tableName))
+	rand.Seed(int64(h.Sum64()))
+}
+
+// fakeTimeBase faker derives times from the current time, sample times are set relative to this date instead
+var fakeTimeBase = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// setFakeTimes replace the time values of a faked instance with times derived from the seeded random source
+func setFakeTimes(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			setFakeTimes(v.Elem())
+		}
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(time.Time{}) {
+			if v.CanSet() {
+				v.Set(reflect.ValueOf(fakeTimeBase.Add(time.Duration(rand.Int63n(365*24*60*60)) * time.Second)))
+			}
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			setFakeTimes(v.Field(i))
+		}
+	}
+}
+
 // CheckForDupeTable check for duplicate table name, returns available name
 func CheckForDupeTable(tables map[string]*ModelInfo, name string) string {
 	found := false
@@ -9,7 +9,7 @@ import (
 )
 
 // LoadMsSQLMeta fetch db meta data for MS SQL database
-func LoadMsSQLMeta(db *sql.DB, sqlType, sqlDatabase, tableName string) (DbTableMeta, error) {
+func LoadMsSQLMeta(db *sql.DB, sqlType, sqlDatabase, tableName string, catalog *SchemaCatalog) (DbTableMeta, error) {
 	m := &dbTableMeta{
 		sqlType:     sqlType,
 		sqlDatabase: sqlDatabase,
@@ -22,19 +22,14 @@ func LoadMsSQLMeta(db *sql.DB, sqlType, sqlDatabase, tableName string) (DbTableM
 	}
 
 	m.columns = make([]*columnMeta, len(cols))
-	colInfo, err := msSQLloadFromSysColumns(db, tableName)
+	colInfo, err := catalog.msSQLColumnInfo(db, tableName)
 	if err != nil {
-		return nil, fmt.Errorf("unable to load ddl from ms sql: %v", err)
-	}
-
-	err = msSQLLoadPrimaryKey(db, tableName, colInfo)
-	if err != nil {
-		return nil, fmt.Errorf("unable to load ddl from ms sql: %v", err)
+		return nil, err
 	}
 
-	infoSchema, err := LoadTableInfoFromMSSqlInformationSchema(db, tableName)
+	infoSchema, err := catalog.informationSchema(db, tableName)
 	if err != nil {
-		fmt.Printf("error calling LoadTableInfoFromMSSqlInformationSchema table: %s error: %v\n", tableName, err)
+		m.warn(StageLoad, "", "error calling LoadTableInfoFromMSSqlInformationSchema table: %s error: %v", tableName, err)
 	}
 
 	for i, v := range cols {
@@ -58,7 +53,7 @@ func LoadMsSQLMeta(db *sql.DB, sqlType, sqlDatabase, tableName string) (DbTableM
 				columnLen = colInfo.maxLength
 			}
 		} else {
-			fmt.Printf("name: %s DatabaseTypeName: %s NOT FOUND in colInfo\n", v.Name(), v.DatabaseTypeName())
+			m.warn(StageLoad, v.Name(), "name: %s DatabaseTypeName: %s NOT FOUND in colInfo", v.Name(), v.DatabaseTypeName())
 		}
 
 		defaultVal := ""
@@ -10,7 +10,7 @@ import (
 )
 
 // LoadMysqlMeta fetch db meta data for MySQL database
-func LoadMysqlMeta(db *sql.DB, sqlType, sqlDatabase, tableName string) (DbTableMeta, error) {
+func LoadMysqlMeta(db *sql.DB, sqlType, sqlDatabase, tableName string, catalog *SchemaCatalog) (DbTableMeta, error) {
 	m := &dbTableMeta{
 		sqlType:     sqlType,
 		sqlDatabase: sqlDatabase,
@@ -30,9 +30,9 @@ func LoadMysqlMeta(db *sql.DB, sqlType, sqlDatabase, tableName string) (DbTableM
 	m.ddl = ddl
 	colsDDL, primaryKeys := mysqlParseDDL(ddl)
 
-	infoSchema, err := LoadTableInfoFromMSSqlInformationSchema(db, tableName)
+	infoSchema, err := catalog.informationSchema(db, tableName)
 	if err != nil {
-		fmt.Printf("error calling LoadTableInfoFromMSSqlInformationSchema table: %s error: %v\n", tableName, err)
+		m.warn(StageLoad, "", "error calling LoadTableInfoFromMSSqlInformationSchema table: %s error: %v", tableName, err)
 	}
 
 	m.columns = make([]*columnMeta, len(cols))
@@ -96,7 +96,7 @@ func LoadMysqlMeta(db *sql.DB, sqlType, sqlDatabase, tableName string) (DbTableM
 		// fmt.Printf("dbType: %s\n", dbType)
 
 		if strings.Contains(dbType, "char") || strings.Contains(dbType, "text") {
-			columnLen, err := GetFieldLenFromInformationSchema(db, sqlDatabase, tableName, v.Name())
+			columnLen, err := catalog.fieldLen(db, sqlDatabase, tableName, v.Name())
 			if err == nil {
 				colMeta.columnLen = columnLen
 			}
@@ -9,7 +9,7 @@ import (
 )
 
 // LoadPostgresMeta fetch db meta data for Postgres database
-func LoadPostgresMeta(db *sql.DB, sqlType, sqlDatabase, tableName string) (DbTableMeta, error) {
+func LoadPostgresMeta(db *sql.DB, sqlType, sqlDatabase, tableName string, catalog *SchemaCatalog) (DbTableMeta, error) {
 	m := &dbTableMeta{
 		sqlType:     sqlType,
 		sqlDatabase: sqlDatabase,
@@ -24,14 +24,9 @@ func LoadPostgresMeta(db *sql.DB, sqlType, sqlDatabase, tableName string) (DbTab
 	}
 	m.columns = make([]*columnMeta, len(cols))
 
-	colInfo, err := LoadTableInfoFromPostgresInformationSchema(db, tableName)
+	colInfo, err := catalog.postgresInformationSchema(db, tableName)
 	if err != nil {
-		return nil, fmt.Errorf("unable to load identity info schema from postgres table: %s error: %v", tableName, err)
-	}
-
-	err = postgresLoadPrimaryKey(db, tableName, colInfo)
-	if err != nil {
-		return nil, fmt.Errorf("unable to load primary key from postgres: %v", err)
+		return nil, err
 	}
 
 	for i, v := range cols {
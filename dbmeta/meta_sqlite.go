@@ -9,9 +9,9 @@ import (
 )
 
 // LoadSqliteMeta fetch db meta data for Sqlite3 database
-func LoadSqliteMeta(db *sql.DB, sqlType, sqlDatabase, tableName string) (DbTableMeta, error) {
+func LoadSqliteMeta(db *sql.DB, sqlType, sqlDatabase, tableName string, catalog *SchemaCatalog) (DbTableMeta, error) {
 	if tableName == "sqlite_sequence" || tableName == "sqlite_stat1" {
-		return nil, fmt.Errorf("unsupported table: %s", tableName)
+		return nil, errUnsupportedTable
 	}
 
 	m := &dbTableMeta{
@@ -20,14 +20,14 @@ func LoadSqliteMeta(db *sql.DB, sqlType, sqlDatabase, tableName string) (DbTable
 		tableName:   tableName,
 	}
 
-	ddl, err := sqliteLoadDDL(db, tableName)
+	ddl, err := catalog.sqliteDDLFor(db, tableName)
 	if err != nil {
 		return nil, fmt.Errorf("unable to load ddl from sqlite_master: %v", err)
 	}
 
 	m.ddl = ddl
 
-	colsInfos, err := sqliteLoadPragma(db, tableName)
+	colsInfos, err := catalog.sqlitePragma(db, tableName)
 	if err != nil {
 		return nil, fmt.Errorf("unable to load PRAGMA table_info %s: %v", m.tableName, err)
 	}
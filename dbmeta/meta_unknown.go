@@ -2,7 +2,6 @@ package dbmeta
 
 import (
 	"database/sql"
-	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
@@ -11,7 +10,7 @@ import (
 )
 
 // LoadUnknownMeta fetch db meta data for unknown database type
-func LoadUnknownMeta(db *sql.DB, sqlType, sqlDatabase, tableName string) (DbTableMeta, error) {
+func LoadUnknownMeta(db *sql.DB, sqlType, sqlDatabase, tableName string, catalog *SchemaCatalog) (DbTableMeta, error) {
 	m := &dbTableMeta{
 		sqlType:     sqlType,
 		sqlDatabase: sqlDatabase,
@@ -25,9 +24,9 @@ func LoadUnknownMeta(db *sql.DB, sqlType, sqlDatabase, tableName string) (DbTabl
 
 	m.columns = make([]*columnMeta, len(cols))
 
-	infoSchema, err := LoadTableInfoFromMSSqlInformationSchema(db, tableName)
+	infoSchema, err := catalog.informationSchema(db, tableName)
 	if err != nil {
-		fmt.Printf("NOTICE unable to load InformationSchema table: %s error: %v\n", tableName, err)
+		m.warn(StageLoad, "", "unable to load InformationSchema table: %s error: %v", tableName, err)
 	}
 
 	for i, v := range cols {
@@ -261,25 +261,20 @@ func updateDefaultPrimaryKey(m *dbTableMeta) *dbTableMeta {
 
 	if !hasPrimary && len(m.columns) > 0 {
 		comments := fmt.Sprintf("Warning table: %s does not have a primary key defined, setting col position 1 %s as primary key\n", m.tableName, m.columns[0].Name())
-		if au != nil {
-			fmt.Print(au.Yellow(comments))
-		} else {
-			fmt.Printf(comments)
-		}
+		m.warn(StagePrimaryKey, m.columns[0].Name(), "table: %s does not have a primary key defined, setting col position 1 %s as primary key", m.tableName, m.columns[0].Name())
 
 		primaryKeyPos = 0
+		m.noPrimaryKey = true
 		m.columns[0].isPrimaryKey = true
 		m.columns[0].notes = m.columns[0].notes + comments
 	}
 
 	if m.columns[primaryKeyPos].nullable {
-		comments := fmt.Sprintf("Warning table: %s primary key column %s is nullable column, setting it as NOT NULL\n", m.tableName, m.columns[primaryKeyPos].Name())
-
-		if au != nil {
-			fmt.Print(au.Yellow(comments))
-		} else {
-			fmt.Printf(comments)
+		if hasPrimary {
+			m.nullablePrimaryKeys = append(m.nullablePrimaryKeys, m.columns[primaryKeyPos].Name())
 		}
+		comments := fmt.Sprintf("Warning table: %s primary key column %s is nullable column, setting it as NOT NULL\n", m.tableName, m.columns[primaryKeyPos].Name())
+		m.warn(StagePrimaryKey, m.columns[primaryKeyPos].Name(), "table: %s primary key column %s is nullable column, setting it as NOT NULL", m.tableName, m.columns[primaryKeyPos].Name())
 
 		m.columns[primaryKeyPos].nullable = false
 		m.columns[0].notes = m.columns[0].notes + comments
@@ -0,0 +1,293 @@
+package dbmeta
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/jinzhu/inflection"
+)
+
+// NamingPreview names generated for the loaded tables and columns
+type NamingPreview struct {
+	Tables []*TableNaming `json:"tables"`
+	// Collisions names generated more than once
+	Collisions []string `json:"collisions,omitempty"`
+}
+
+// TableNaming names generated for a table
+type TableNaming struct {
+	Table        string          `json:"table"`
+	Struct       string          `json:"struct"`
+	File         string          `json:"file"`
+	Route        string          `json:"route"`
+	DAOFunctions []string        `json:"dao_functions"`
+	Columns      []*ColumnNaming `json:"columns"`
+	// Renames names changed from the naming template result to avoid a collision
+	Renames []string `json:"renames,omitempty"`
+	// Collisions names of the table generated more than once
+	Collisions []string `json:"collisions,omitempty"`
+}
+
+// ColumnNaming names generated for a column
+type ColumnNaming struct {
+	Column   string `json:"column"`
+	Field    string `json:"field"`
+	JSON     string `json:"json"`
+	XML      string `json:"xml"`
+	Protobuf string `json:"protobuf"`
+}
+
+// GoSrcFileName return the name of the go source file generated for a table, using the file naming template. Names
+// ending with _test are renamed to _tst so go does not treat them as test files.
+func GoSrcFileName(fileNamingTemplate, tableName string) string {
+	name := Replace(fileNamingTemplate, tableName)
+
+	if strings.HasSuffix(name, "_test") {
+		name = name[0 : len(name)-5]
+		name = name + "_tst"
+	}
+	return name + ".go"
+}
+
+// NamingPreview build the names generated for the loaded tables and columns, with the renames and collisions
+func (c *Config) NamingPreview() *NamingPreview {
+	preview := &NamingPreview{Tables: make([]*TableNaming, 0, len(c.TableInfos))}
+
+	files := make(map[string]string)
+	routes := make(map[string]string)
+	functions := make(map[string]string)
+	collide := func(kind, name, tableName string, seen map[string]string, table *TableNaming) {
+		if other, ok := seen[name]; ok {
+			msg := fmt.Sprintf("%s %s is generated for tables %s and %s", kind, name, other, tableName)
+			table.Collisions = append(table.Collisions, msg)
+			preview.Collisions = append(preview.Collisions, msg)
+			return
+		}
+		seen[name] = tableName
+	}
+
+	for _, tableName := range SortedTableNames(c.TableInfos) {
+		modelInfo := c.TableInfos[tableName]
+		table := c.tableNaming(modelInfo)
+		preview.Collisions = append(preview.Collisions, table.Collisions...)
+
+		collide("file", table.File, tableName, files, table)
+		collide("route", table.Route, tableName, routes, table)
+		for _, function := range table.DAOFunctions {
+			collide("dao function", function, tableName, functions, table)
+		}
+		preview.Tables = append(preview.Tables, table)
+	}
+	return preview
+}
+
+func (c *Config) tableNaming(modelInfo *ModelInfo) *TableNaming {
+	structName := modelInfo.StructName
+	table := &TableNaming{
+		Table:  modelInfo.TableName,
+		Struct: structName,
+		File:   GoSrcFileName(c.FileNamingTemplate, c.FileBaseName(modelInfo.TableName, structName)),
+		DAOFunctions: []string{
+			"GetAll" + structName,
+			"GetPage" + structName,
+			"Get" + structName,
+			"Add" + structName,
+			"Upsert" + structName,
+			"BulkAdd" + structName,
+			"Update" + structName,
+			"Patch" + structName,
+			"Delete" + structName,
+		},
+		Columns: make([]*ColumnNaming, 0, len(modelInfo.CodeFields)),
+	}
+	if modelInfo.SoftDelete != nil {
+		table.DAOFunctions = append(table.DAOFunctions, "Restore"+structName)
+	}
+
+	if templated := c.StructName(modelInfo.TableName); templated != structName {
+		table.Renames = append(table.Renames, fmt.Sprintf("struct %s renamed to %s", templated, structName))
+	}
+
+	route := "/" + modelInfo.RouteName
+	xmlNames := make(map[string]string)
+	for _, fi := range modelInfo.CodeFields {
+		name := fi.ColumnMeta.Name()
+		baseName := c.ColumnBaseName(name)
+		column := &ColumnNaming{
+			Column:   name,
+			Field:    fi.GoFieldName,
+			JSON:     fi.JSONFieldName,
+			XML:      formatFieldName(c.XMLNameFormat, baseName),
+			Protobuf: fi.ProtobufFieldName,
+		}
+		table.Columns = append(table.Columns, column)
+
+		if fi.PrimaryKeyArgName != "" {
+			route = route + "/:" + fi.PrimaryKeyArgName
+		}
+
+		if templated := Replace(c.FieldNamingTemplate, baseName); templated != fi.GoFieldName {
+			table.Renames = append(table.Renames, fmt.Sprintf("field %s of column %s renamed to %s", templated, name, fi.GoFieldName))
+		}
+		if formatted := formatFieldName(c.JSONNameFormat, baseName); formatted != fi.JSONFieldName {
+			table.Renames = append(table.Renames, fmt.Sprintf("json name %s of column %s renamed to %s", formatted, name, fi.JSONFieldName))
+		}
+		if formatted := formatFieldName(c.ProtobufNameFormat, baseName); formatted != fi.ProtobufFieldName {
+			table.Renames = append(table.Renames, fmt.Sprintf("protobuf name %s of column %s renamed to %s", formatted, name, fi.ProtobufFieldName))
+		}
+		if other, ok := xmlNames[column.XML]; ok {
+			table.Collisions = append(table.Collisions, fmt.Sprintf("xml name %s is generated for columns %s and %s", column.XML, other, name))
+		} else {
+			xmlNames[column.XML] = name
+		}
+	}
+	table.Route = route
+	return table
+}
+
+// Write write the naming preview as text, renames and collisions are highlighted
+func (p *NamingPreview) Write(w io.Writer) error {
+	buf := &bytes.Buffer{}
+	for _, table := range p.Tables {
+		fmt.Fprintf(buf, "[%s] struct: %s file: %s route: %s\n", table.Table, table.Struct, table.File, table.Route)
+		fmt.Fprintf(buf, "  dao: %s\n", strings.Join(table.DAOFunctions, " "))
+
+		tw := tabwriter.NewWriter(buf, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "  COLUMN\tFIELD\tJSON\tXML\tPROTOBUF")
+		for _, column := range table.Columns {
+			fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\n", column.Column, column.Field, column.JSON, column.XML, column.Protobuf)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+
+		for _, rename := range table.Renames {
+			writeHighlighted(buf, fmt.Sprintf("  renamed: %s\n", rename), false)
+		}
+		for _, collision := range table.Collisions {
+			writeHighlighted(buf, fmt.Sprintf("  collision: %s\n", collision), true)
+		}
+		buf.WriteString("\n")
+	}
+
+	fmt.Fprintf(buf, "%d table(s), %d collision(s)\n", len(p.Tables), len(p.Collisions))
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func writeHighlighted(buf *bytes.Buffer, line string, isError bool) {
+	switch {
+	case au == nil:
+		buf.WriteString(line)
+	case isError:
+		fmt.Fprint(buf, au.Red(line))
+	default:
+		fmt.Fprint(buf, au.Yellow(line))
+	}
+}
+
+// TableBaseName return the table name used to name structs, files and routes: the configured table prefixes and suffixes
+// are stripped and the word mappings applied
+func (c *Config) TableBaseName(tableName string) string {
+	return c.mapWords(stripAffixes(tableName, c.TablePrefixes, c.TableSuffixes))
+}
+
+// ColumnBaseName return the column name used to name fields, json, xml and protobuf names: the configured column
+// prefixes and suffixes are stripped and the word mappings applied
+func (c *Config) ColumnBaseName(columnName string) string {
+	return c.mapWords(stripAffixes(columnName, c.ColumnPrefixes, c.ColumnSuffixes))
+}
+
+// StructName return the struct name for a table, singular if SingularStructs is set
+func (c *Config) StructName(tableName string) string {
+	name := c.TableBaseName(tableName)
+	if c.SingularStructs {
+		name = inflection.Singular(name)
+	}
+	return Replace(c.ModelNamingTemplate, name)
+}
+
+// FileBaseName return the name, before the file naming template, of the go files generated for a table, for a struct
+// named structName. It keeps the suffix CheckForDupeTable added to the struct name, so tables whose names only differ
+// by a stripped prefix or suffix are not written to the same file.
+func (c *Config) FileBaseName(tableName, structName string) string {
+	name := c.TableBaseName(tableName)
+	if templated := c.StructName(tableName); strings.HasPrefix(structName, templated) {
+		name = name + strings.TrimPrefix(structName, templated)
+	}
+	return name
+}
+
+// RouteName return the path of the api routes of a table, for a struct named structName. The route keeps the plural
+// table name when structs are singular, and starts with the RoutePrefix if set.
+func (c *Config) RouteName(tableName, structName string) string {
+	route := strings.ToLower(structName)
+	if c.SingularStructs {
+		// keep the suffix CheckForDupeTable added to the struct name
+		suffix := strings.TrimPrefix(structName, c.StructName(tableName))
+		route = strings.ToLower(Replace(c.ModelNamingTemplate, c.TableBaseName(tableName)) + suffix)
+	}
+
+	if c.RoutePrefix != "" {
+		route = c.RoutePrefix + "/" + route
+	}
+	return route
+}
+
+// stripAffixes strip the first matching prefix and suffix from name, unless nothing would be left of it
+func stripAffixes(name string, prefixes, suffixes []string) string {
+	for _, prefix := range prefixes {
+		if prefix != "" && len(name) > len(prefix) && strings.EqualFold(name[:len(prefix)], prefix) {
+			name = name[len(prefix):]
+			break
+		}
+	}
+	for _, suffix := range suffixes {
+		if suffix != "" && len(name) > len(suffix) && strings.EqualFold(name[len(name)-len(suffix):], suffix) {
+			name = name[:len(name)-len(suffix)]
+			break
+		}
+	}
+	return name
+}
+
+// mapWords replace the words of name, separated by _, that have a word mapping, words are matched ignoring case
+func (c *Config) mapWords(name string) string {
+	if len(c.WordMappings) == 0 {
+		return name
+	}
+
+	mappings := make(map[string]string, len(c.WordMappings))
+	for word, replacement := range c.WordMappings {
+		mappings[strings.ToLower(word)] = replacement
+	}
+
+	words := strings.Split(name, "_")
+	for i, word := range words {
+		if replacement, ok := mappings[strings.ToLower(word)]; ok {
+			words[i] = replacement
+		}
+	}
+	return strings.Join(words, "_")
+}
+
+// ParseWordMappings parse a comma separated list of word=replacement mappings
+func ParseWordMappings(spec string) (map[string]string, error) {
+	mappings := make(map[string]string)
+	for _, setting := range strings.Split(spec, ",") {
+		setting = strings.TrimSpace(setting)
+		if setting == "" {
+			continue
+		}
+
+		parts := strings.SplitN(setting, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+			return nil, fmt.Errorf("invalid word mapping %s, use word=replacement", setting)
+		}
+		mappings[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return mappings, nil
+}
@@ -0,0 +1,148 @@
+package dbmeta
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func Test_NamingPreview(t *testing.T) {
+	conf := NewConfig(nil)
+	loadTestTables(t, conf,
+		"CREATE TABLE albums (id INTEGER PRIMARY KEY, userName TEXT, user_name TEXT)",
+		"CREATE TABLE all_albums (id INTEGER PRIMARY KEY)",
+		"CREATE TABLE order_test (id INTEGER PRIMARY KEY)",
+	)
+
+	preview := conf.NamingPreview()
+	if len(preview.Tables) != 3 {
+		t.Fatalf("expected 3 tables, got %d", len(preview.Tables))
+	}
+
+	albums := preview.Tables[0]
+	if albums.Struct != "Albums" || albums.File != "albums.go" || albums.Route != "/albums/:argID" || albums.DAOFunctions[0] != "GetAllAlbums" {
+		t.Errorf("unexpected table naming %+v", albums)
+	}
+	if len(albums.Columns) != 3 || albums.Columns[2].Field != "UserNamealt1" || albums.Columns[2].JSON != "user_namealt1" {
+		t.Errorf("unexpected column naming %+v", albums.Columns[2])
+	}
+	if len(albums.Renames) != 3 || !strings.HasPrefix(albums.Renames[0], "field UserName of column user_name renamed to UserNamealt1") {
+		t.Errorf("unexpected renames %v", albums.Renames)
+	}
+	if len(albums.Collisions) != 1 || albums.Collisions[0] != "xml name user_name is generated for columns userName and user_name" {
+		t.Errorf("unexpected collisions %v", albums.Collisions)
+	}
+
+	allAlbums := preview.Tables[1]
+	if len(allAlbums.Collisions) != 1 || allAlbums.Collisions[0] != "dao function GetAllAlbums is generated for tables albums and all_albums" {
+		t.Errorf("unexpected collisions %v", allAlbums.Collisions)
+	}
+
+	if preview.Tables[2].File != "order_tst.go" {
+		t.Errorf("unexpected file name %s", preview.Tables[2].File)
+	}
+
+	var buf bytes.Buffer
+	if err := preview.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "[albums] struct: Albums file: albums.go route: /albums/:argID\n") ||
+		!strings.HasSuffix(buf.String(), "3 table(s), 2 collision(s)\n") {
+		t.Errorf("unexpected preview\n%s", buf.String())
+	}
+}
+
+func Test_NamingPreviewDAOFunctions(t *testing.T) {
+	conf := NewConfig(nil)
+	conf.UseGureguTypes = true
+	conf.SoftDeleteColumns = []string{"deleted_at"}
+	conf.Report.Output = ioutil.Discard
+	loadTestTables(t, conf,
+		"CREATE TABLE albums (id INTEGER PRIMARY KEY)",
+		"CREATE TABLE artists (id INTEGER PRIMARY KEY, deleted_at DATETIME)",
+	)
+
+	preview := conf.NamingPreview()
+	for i, expected := range []string{
+		"GetAllAlbums GetPageAlbums GetAlbums AddAlbums UpsertAlbums BulkAddAlbums UpdateAlbums PatchAlbums DeleteAlbums",
+		"GetAllArtists GetPageArtists GetArtists AddArtists UpsertArtists BulkAddArtists UpdateArtists PatchArtists DeleteArtists RestoreArtists",
+	} {
+		if functions := strings.Join(preview.Tables[i].DAOFunctions, " "); functions != expected {
+			t.Errorf("table %s: expected dao functions %s, got %s", preview.Tables[i].Table, expected, functions)
+		}
+	}
+}
+
+func Test_NamingPreviewStrippedPrefix(t *testing.T) {
+	conf := NewConfig(nil)
+	conf.TablePrefixes = []string{"tbl_"}
+	loadTestTables(t, conf,
+		"CREATE TABLE tbl_users (id INTEGER PRIMARY KEY)",
+		"CREATE TABLE users (id INTEGER PRIMARY KEY)",
+	)
+
+	// the struct of the second table loaded is renamed, its files follow the struct
+	preview := conf.NamingPreview()
+	files := map[string]string{}
+	for _, table := range preview.Tables {
+		files[table.Struct] = table.File
+	}
+	if files["Users"] != "users.go" || files["Users_"] != "users_.go" {
+		t.Errorf("unexpected files %v", files)
+	}
+	for _, collision := range preview.Collisions {
+		if strings.HasPrefix(collision, "file ") {
+			t.Errorf("unexpected collision %s", collision)
+		}
+	}
+}
+
+func Test_NamingOptions(t *testing.T) {
+	defer UpdateInitialisms(nil, nil)
+
+	conf := NewConfig(nil)
+	conf.TablePrefixes = []string{"tbl_", "t_"}
+	conf.TableSuffixes = []string{"_tab"}
+	conf.ColumnPrefixes = []string{"ua_"}
+	conf.SingularStructs = true
+	conf.WordMappings = map[string]string{"QTY": "quantity"}
+	UpdateInitialisms([]string{"sku"}, []string{"ACL"})
+
+	if got := conf.TableBaseName("TBL_user_accounts_tab"); got != "user_accounts" {
+		t.Errorf("unexpected table base name %s", got)
+	}
+	if got := conf.TableBaseName("tbl_"); got != "tbl_" {
+		t.Errorf("a table name should not be stripped to nothing, got %s", got)
+	}
+	if got := conf.StructName("tbl_user_accounts"); got != "UserAccount" {
+		t.Errorf("unexpected struct name %s", got)
+	}
+	if got := conf.RouteName("tbl_user_accounts", "UserAccount_"); got != "useraccounts_" {
+		t.Errorf("unexpected route name %s", got)
+	}
+	if got := Replace(conf.FieldNamingTemplate, conf.ColumnBaseName("ua_sku_qty")); got != "SKUQuantity" {
+		t.Errorf("unexpected field name %s", got)
+	}
+	if got := FmtFieldName("acl_id"); got != "AclID" {
+		t.Errorf("expected the removed initialism to be formatted as a word, got %s", got)
+	}
+
+	UpdateInitialisms(nil, nil)
+	if got := FmtFieldName("acl_sku"); got != "ACLSku" {
+		t.Errorf("expected the default initialisms to be restored, got %s", got)
+	}
+
+	conf.SingularStructs = false
+	if got := conf.RouteName("tbl_user_accounts", "UserAccounts"); got != "useraccounts" {
+		t.Errorf("unexpected route name %s", got)
+	}
+
+	mappings, err := ParseWordMappings("qty=quantity, addr=address")
+	if err != nil || len(mappings) != 2 || mappings["addr"] != "address" {
+		t.Errorf("unexpected word mappings %v %v", mappings, err)
+	}
+	if _, err = ParseWordMappings("qty"); err == nil {
+		t.Errorf("expected an error for a word mapping without a replacement")
+	}
+}
@@ -0,0 +1,72 @@
+package dbmeta
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/hex"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"testing"
+)
+
+var (
+	packedFileRegex     = regexp.MustCompile(`"([0-9a-f]{32})": "([0-9a-f]*)"`)
+	packedResolverRegex = regexp.MustCompile(`b\.SetResolver\("([^"]+)", packr\.Pointer\{ForwardBox: gk, ForwardPath: "([0-9a-f]{32})"\}\)`)
+)
+
+// Test_PackedTemplates check that the templates packed into the binary by packrd match the templates on disk, the
+// packed copy of a template wins over the disk copy
+func Test_PackedTemplates(t *testing.T) {
+	packed, err := ioutil.ReadFile(filepath.Join("..", "packrd", "packed-packr.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := make(map[string]string)
+	for _, match := range packedFileRegex.FindAllStringSubmatch(string(packed), -1) {
+		files[match[1]] = match[2]
+	}
+	resolvers := make(map[string]string)
+	for _, match := range packedResolverRegex.FindAllStringSubmatch(string(packed), -1) {
+		resolvers[match[1]] = match[2]
+	}
+
+	infos, err := ioutil.ReadDir(goldenTemplateDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, info := range infos {
+		if !info.Mode().IsRegular() {
+			continue
+		}
+		name := info.Name()
+		if _, ok := resolvers[name]; !ok {
+			t.Errorf("template %s is not packed, run make pack", name)
+		}
+	}
+
+	for name, key := range resolvers {
+		expected, err := ioutil.ReadFile(filepath.Join(goldenTemplateDir, name))
+		if err != nil {
+			t.Errorf("packed template %s is not in %s: %v", name, goldenTemplateDir, err)
+			continue
+		}
+
+		compressed, err := hex.DecodeString(files[key])
+		if err != nil {
+			t.Fatalf("packed template %s: %v", name, err)
+		}
+		reader, err := gzip.NewReader(bytes.NewReader(compressed))
+		if err != nil {
+			t.Fatalf("packed template %s: %v", name, err)
+		}
+		actual, err := ioutil.ReadAll(reader)
+		if err != nil {
+			t.Fatalf("packed template %s: %v", name, err)
+		}
+		if !bytes.Equal(actual, expected) {
+			t.Errorf("packed template %s differs from %s, run make pack", name, goldenTemplateDir)
+		}
+	}
+}
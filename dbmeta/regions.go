@@ -0,0 +1,124 @@
+package dbmeta
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// Protected regions are marked within generated code as shown below. The content between the markers is carried
+// over from the existing file into the freshly rendered output when a file is regenerated.
+/*
+	// gen:begin custom-validate
+	if p.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	// gen:end
+*/
+var (
+	regionBeginExp = regexp.MustCompile(`^[ \t]*//[ \t]*gen:begin[ \t]+([\w.\-]+)[ \t]*$`)
+	regionEndExp   = regexp.MustCompile(`^[ \t]*//[ \t]*gen:end[ \t]*$`)
+)
+
+// ProtectedRegion a named region of a generated file, whose content is preserved across regeneration
+type ProtectedRegion struct {
+	Name string
+	// Content lines between the begin and end markers, markers excluded
+	Content []byte
+	// begin offset of the content, directly after the begin marker line
+	begin int
+	// end offset of the content, directly before the end marker line
+	end int
+}
+
+// ParseProtectedRegions parse the protected regions in file contents, returning them in order of appearance
+func ParseProtectedRegions(content []byte) ([]*ProtectedRegion, error) {
+	var regions []*ProtectedRegion
+	var current *ProtectedRegion
+	names := make(map[string]bool)
+
+	lineNo := 0
+	for pos := 0; pos < len(content); {
+		lineNo++
+		lineEnd := bytes.IndexByte(content[pos:], '\n')
+		next := len(content)
+		if lineEnd > -1 {
+			next = pos + lineEnd + 1
+		}
+		line := bytes.TrimRight(content[pos:next], "\r\n")
+
+		if match := regionBeginExp.FindSubmatch(line); match != nil {
+			name := string(match[1])
+			if current != nil {
+				return nil, fmt.Errorf("line %d: region %s begins inside region %s", lineNo, name, current.Name)
+			}
+			if names[name] {
+				return nil, fmt.Errorf("line %d: duplicate region %s", lineNo, name)
+			}
+			names[name] = true
+			current = &ProtectedRegion{Name: name, begin: next}
+		} else if regionEndExp.Match(line) {
+			if current == nil {
+				return nil, fmt.Errorf("line %d: gen:end without matching gen:begin", lineNo)
+			}
+			current.end = pos
+			current.Content = content[current.begin:current.end]
+			regions = append(regions, current)
+			current = nil
+		}
+		pos = next
+	}
+
+	if current != nil {
+		return nil, fmt.Errorf("region %s is missing gen:end", current.Name)
+	}
+	return regions, nil
+}
+
+// MergeProtectedRegions replace the content of the protected regions in generated with the content of the regions of
+// the same name found in existing. The non empty regions of existing that are no longer present in generated are returned.
+func MergeProtectedRegions(generated, existing []byte) (merged []byte, orphaned []*ProtectedRegion, err error) {
+	existingRegions, err := ParseProtectedRegions(existing)
+	if err != nil {
+		return nil, nil, fmt.Errorf("existing file: %v", err)
+	}
+
+	if len(existingRegions) == 0 {
+		return generated, nil, nil
+	}
+
+	generatedRegions, err := ParseProtectedRegions(generated)
+	if err != nil {
+		return nil, nil, fmt.Errorf("template output: %v", err)
+	}
+
+	saved := make(map[string]*ProtectedRegion, len(existingRegions))
+	for _, region := range existingRegions {
+		saved[region.Name] = region
+	}
+
+	buf := bytes.Buffer{}
+	last := 0
+	for _, region := range generatedRegions {
+		prev, ok := saved[region.Name]
+		if !ok {
+			continue
+		}
+
+		buf.Write(generated[last:region.begin])
+		buf.Write(prev.Content)
+		last = region.end
+		delete(saved, region.Name)
+	}
+	buf.Write(generated[last:])
+
+	for _, region := range saved {
+		if len(bytes.TrimSpace(region.Content)) > 0 {
+			orphaned = append(orphaned, region)
+		}
+	}
+	sort.Slice(orphaned, func(i, j int) bool { return orphaned[i].begin < orphaned[j].begin })
+
+	return buf.Bytes(), orphaned, nil
+}
@@ -0,0 +1,78 @@
+package dbmeta
+
+import (
+	"testing"
+)
+
+func Test_MergeProtectedRegions(t *testing.T) {
+	generated := []byte(`package model
+
+func (a *Albums) Validate() error {
+	// gen:begin custom-validate
+	// gen:end
+	return nil
+}
+
+// gen:begin custom-code
+// gen:end
+`)
+
+	existing := []byte(`package model
+
+func (a *Albums) Validate() error {
+	// gen:begin custom-validate
+	if a.Title == "" {
+		return errEmpty
+	}
+	// gen:end
+	return nil
+}
+
+// gen:begin removed
+func helper() {}
+// gen:end
+`)
+
+	expected := `package model
+
+func (a *Albums) Validate() error {
+	// gen:begin custom-validate
+	if a.Title == "" {
+		return errEmpty
+	}
+	// gen:end
+	return nil
+}
+
+// gen:begin custom-code
+// gen:end
+`
+
+	merged, orphaned, err := MergeProtectedRegions(generated, existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(merged) != expected {
+		t.Fatalf("unexpected merge result:\n%s", merged)
+	}
+
+	if len(orphaned) != 1 || orphaned[0].Name != "removed" || string(orphaned[0].Content) != "func helper() {}\n" {
+		t.Fatalf("unexpected orphaned regions: %v", orphaned)
+	}
+}
+
+func Test_ParseProtectedRegions_Invalid(t *testing.T) {
+	invalid := []string{
+		"// gen:begin a\n// gen:begin b\n// gen:end\n// gen:end\n",
+		"// gen:begin a\n// gen:end\n// gen:begin a\n// gen:end\n",
+		"// gen:end\n",
+		"// gen:begin a\n",
+	}
+
+	for _, content := range invalid {
+		if _, err := ParseProtectedRegions([]byte(content)); err == nil {
+			t.Errorf("expected error parsing %q", content)
+		}
+	}
+}
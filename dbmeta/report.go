@@ -0,0 +1,165 @@
+package dbmeta
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+const (
+	// StageLoad loading the meta data of a table from the database
+	StageLoad = "load"
+	// StagePrimaryKey checking the primary key of a table
+	StagePrimaryKey = "primary-key"
+	// StageType mapping the sql type of a column
+	StageType = "type"
+	// StageModel building the model of a table
+	StageModel = "model"
+	// StageGenerate rendering and writing the generated files
+	StageGenerate = "generate"
+)
+
+const (
+	// SeverityWarning an issue that does not change what is generated
+	SeverityWarning = "warning"
+	// SeverityError an issue that causes a table or column to be skipped, or the generation to fail
+	SeverityError = "error"
+)
+
+// Issue a problem found while loading the schema or generating code
+type Issue struct {
+	Table    string `json:"table,omitempty"`
+	Column   string `json:"column,omitempty"`
+	Stage    string `json:"stage"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+	// Skipped the table, or the column if set, was left out of the generated code
+	Skipped bool `json:"skipped,omitempty"`
+}
+
+// String return the issue formatted for the console
+func (i *Issue) String() string {
+	label := "Warning"
+	if i.Severity == SeverityError {
+		label = "Error"
+	}
+	return fmt.Sprintf("%s - %s\n", label, i.Message)
+}
+
+// Report issues collected while loading the schema and generating code
+type Report struct {
+	Issues []*Issue
+	// Output where issues are printed as they are added, os.Stdout if nil
+	Output io.Writer
+}
+
+// ReportSummary machine readable summary of a report
+type ReportSummary struct {
+	Strict        bool     `json:"strict"`
+	Failed        bool     `json:"failed"`
+	TablesLoaded  int      `json:"tables_loaded"`
+	TablesSkipped []string `json:"tables_skipped"`
+	Errors        int      `json:"errors"`
+	Warnings      int      `json:"warnings"`
+	Issues        []*Issue `json:"issues"`
+}
+
+// NewReport create an empty report
+func NewReport() *Report {
+	return &Report{}
+}
+
+// Add record an issue and print it to the console, a nil report only prints the issue
+func (r *Report) Add(issue *Issue) {
+	var w io.Writer = os.Stdout
+	if r != nil {
+		r.Issues = append(r.Issues, issue)
+		if r.Output != nil {
+			w = r.Output
+		}
+	}
+
+	if au == nil {
+		fmt.Fprint(w, issue.String())
+	} else if issue.Severity == SeverityError {
+		fmt.Fprint(w, au.Red(issue.String()))
+	} else {
+		fmt.Fprint(w, au.Yellow(issue.String()))
+	}
+}
+
+// Warning record a warning
+func (r *Report) Warning(stage, table, column, format string, args ...interface{}) {
+	r.Add(&Issue{Table: table, Column: column, Stage: stage, Severity: SeverityWarning, Message: fmt.Sprintf(format, args...)})
+}
+
+// Error record an error
+func (r *Report) Error(stage, table, column, format string, args ...interface{}) {
+	r.Add(&Issue{Table: table, Column: column, Stage: stage, Severity: SeverityError, Message: fmt.Sprintf(format, args...)})
+}
+
+// Skip record an error for a table, or a column of a table, that is left out of the generated code
+func (r *Report) Skip(stage, table, column, format string, args ...interface{}) {
+	r.Add(&Issue{Table: table, Column: column, Stage: stage, Severity: SeverityError, Message: fmt.Sprintf(format, args...), Skipped: true})
+}
+
+// Counts return the number of errors and warnings
+func (r *Report) Counts() (errors, warnings int) {
+	for _, issue := range r.Issues {
+		if issue.Severity == SeverityError {
+			errors++
+		} else {
+			warnings++
+		}
+	}
+	return errors, warnings
+}
+
+// SkippedTables return the sorted names of the tables left out of the generated code
+func (r *Report) SkippedTables() []string {
+	seen := make(map[string]bool)
+	tables := make([]string, 0)
+	for _, issue := range r.Issues {
+		if issue.Skipped && issue.Column == "" && !seen[issue.Table] {
+			seen[issue.Table] = true
+			tables = append(tables, issue.Table)
+		}
+	}
+	sort.Strings(tables)
+	return tables
+}
+
+// Summary return the summary of the report
+func (r *Report) Summary(tablesLoaded int, strict, failed bool) *ReportSummary {
+	errors, warnings := r.Counts()
+
+	issues := append([]*Issue{}, r.Issues...)
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Table != issues[j].Table {
+			return issues[i].Table < issues[j].Table
+		}
+		return issues[i].Column < issues[j].Column
+	})
+
+	return &ReportSummary{
+		Strict:        strict,
+		Failed:        failed,
+		TablesLoaded:  tablesLoaded,
+		TablesSkipped: r.SkippedTables(),
+		Errors:        errors,
+		Warnings:      warnings,
+		Issues:        issues,
+	}
+}
+
+// WriteJSON write the summary of the report as json
+func (r *Report) WriteJSON(w io.Writer, tablesLoaded int, strict, failed bool) error {
+	b, err := json.MarshalIndent(r.Summary(tablesLoaded, strict, failed), "", "    ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
@@ -0,0 +1,57 @@
+package dbmeta
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func Test_ReportSchemaIssues(t *testing.T) {
+	conf := NewConfig(nil)
+	conf.AddJSONAnnotation = true
+	tableInfos := loadTestTables(t, conf,
+		"CREATE TABLE albums (id INTEGER PRIMARY KEY, shape GEOMETRY, title TEXT)",
+		"CREATE TABLE notes (body TEXT)",
+		"CREATE TABLE shapes (shape GEOMETRY)",
+	)
+
+	if _, ok := tableInfos["shapes"]; ok {
+		t.Errorf("table without mapped columns should be skipped")
+	}
+
+	albums := tableInfos["albums"]
+	if albums == nil || len(albums.CodeFields) != 2 {
+		t.Fatalf("expected albums with 2 fields, got %+v", albums)
+	}
+	if title := albums.CodeFields[1]; title.ColumnMeta.Name() != "title" || title.JSONFieldName != "title" {
+		t.Errorf("fields after a skipped column are misaligned: %s %s", title.ColumnMeta.Name(), title.JSONFieldName)
+	}
+
+	errors, warnings := conf.Report.Counts()
+	if errors != 3 || warnings != 4 {
+		t.Errorf("expected 3 errors and 4 warnings, got %d and %d: %+v", errors, warnings, conf.Report.Issues)
+	}
+
+	if skipped := conf.Report.SkippedTables(); !reflect.DeepEqual(skipped, []string{"shapes"}) {
+		t.Errorf("unexpected skipped tables %v", skipped)
+	}
+
+	var buf bytes.Buffer
+	if err := conf.Report.WriteJSON(&buf, len(tableInfos), true, true); err != nil {
+		t.Fatal(err)
+	}
+
+	summary := &ReportSummary{}
+	if err := json.Unmarshal(buf.Bytes(), summary); err != nil {
+		t.Fatal(err)
+	}
+	if !summary.Strict || !summary.Failed || summary.TablesLoaded != 2 || summary.Errors != 3 || len(summary.Issues) != 7 {
+		t.Errorf("unexpected summary %s", buf.String())
+	}
+
+	first := summary.Issues[0]
+	if first.Table != "albums" || first.Column != "shape" || first.Stage != StageType || first.Severity != SeverityError || !first.Skipped {
+		t.Errorf("unexpected issue %+v", first)
+	}
+}
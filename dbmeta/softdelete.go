@@ -0,0 +1,101 @@
+package dbmeta
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// ParseSoftDeleteTables parse a comma separated list of table=column soft delete column overrides, an empty column
+// disables soft delete for the table
+func ParseSoftDeleteTables(spec string) (map[string]string, error) {
+	tables := make(map[string]string)
+	for _, setting := range strings.Split(spec, ",") {
+		setting = strings.TrimSpace(setting)
+		if setting == "" {
+			continue
+		}
+
+		parts := strings.SplitN(setting, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("invalid soft delete table %s, use table=column", setting)
+		}
+		tables[strings.ToLower(strings.TrimSpace(parts[0]))] = strings.TrimSpace(parts[1])
+	}
+	return tables, nil
+}
+
+// SoftDeleteColumnName return the soft delete column of a table among columns, the override of the table if set or
+// else the first column matching a soft delete pattern, empty if the table has none. Names are matched case
+// insensitively.
+func (c *Config) SoftDeleteColumnName(tableName string, columns []string) string {
+	if column, ok := c.SoftDeleteTables[strings.ToLower(tableName)]; ok {
+		for _, name := range columns {
+			if column != "" && strings.EqualFold(name, column) {
+				return name
+			}
+		}
+		return ""
+	}
+
+	return matchColumn(c.SoftDeleteColumns, columns)
+}
+
+// matchColumn return the first of columns matching the first pattern matching any column, empty if none matches.
+// Patterns are path.Match patterns matched case insensitively.
+func matchColumn(patterns, columns []string) string {
+	for _, pattern := range patterns {
+		for _, name := range columns {
+			if ok, _ := path.Match(strings.ToLower(pattern), strings.ToLower(name)); ok {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// softDeleteTypes the go types of a soft delete field, the nullable time types of auditValues. A time.Time field can not
+// hold NULL, the records it inserts would all look deleted.
+var softDeleteTypes = map[string]bool{
+	"*time.Time":   true,
+	"sql.NullTime": true,
+	"null.Time":    true,
+}
+
+// softDeleteField return the field of the soft delete column of a table, nil if the table has none. A column that is
+// not a nullable time column of a nullable go type is reported and ignored.
+func (c *Config) softDeleteField(tableName string, fields []*FieldInfo) *FieldInfo {
+	if len(c.SoftDeleteColumns) == 0 && len(c.SoftDeleteTables) == 0 {
+		return nil
+	}
+
+	columns := make([]string, len(fields))
+	for i, field := range fields {
+		columns[i] = field.ColumnMeta.Name()
+	}
+	column := c.SoftDeleteColumnName(tableName, columns)
+	if column == "" {
+		if override := c.SoftDeleteTables[strings.ToLower(tableName)]; override != "" {
+			c.Report.Warning(StageModel, tableName, override, "table: %s has no soft delete column %s", tableName, override)
+		}
+		return nil
+	}
+
+	for _, field := range fields {
+		if field.ColumnMeta.Name() != column {
+			continue
+		}
+		if !field.ColumnMeta.Nullable() || field.FilterKind() != "time" || field.ColumnMeta.IsPrimaryKey() || !softDeleteTypes[field.GoFieldType] {
+			c.Report.Warning(StageModel, tableName, column, "table: %s soft delete column %s is not a nullable time column of type sql.NullTime, *time.Time or null.Time, soft delete disabled", tableName, column)
+			return nil
+		}
+		return field
+	}
+	return nil
+}
+
+// IsSoftDelete return true if field is the soft delete field of the table, the field is only written by delete and
+// restore
+func (m *ModelInfo) IsSoftDelete(field *FieldInfo) bool {
+	return m.SoftDelete != nil && m.SoftDelete == field
+}
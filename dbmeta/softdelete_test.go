@@ -0,0 +1,83 @@
+package dbmeta
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func Test_ParseSoftDeleteTables(t *testing.T) {
+	tables, err := ParseSoftDeleteTables(" Users = removed_at, audit_log= ,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 2 || tables["users"] != "removed_at" || tables["audit_log"] != "" {
+		t.Errorf("unexpected soft delete tables %v", tables)
+	}
+
+	if _, err = ParseSoftDeleteTables("users"); err == nil {
+		t.Error("expected an error for a setting without column")
+	}
+	if _, err = ParseSoftDeleteTables("=deleted_at"); err == nil {
+		t.Error("expected an error for a setting without table")
+	}
+}
+
+func Test_SoftDeleteColumnName(t *testing.T) {
+	conf := NewConfig(nil)
+	conf.SoftDeleteColumns = []string{"deleted_at", "*_removed"}
+	conf.SoftDeleteTables = map[string]string{"users": "archived_at", "logs": ""}
+
+	for _, test := range []struct {
+		table   string
+		columns []string
+		column  string
+	}{
+		{"albums", []string{"id", "Deleted_At"}, "Deleted_At"},
+		{"albums", []string{"id", "date_removed"}, "date_removed"},
+		{"albums", []string{"id", "name"}, ""},
+		{"Users", []string{"id", "deleted_at", "ARCHIVED_AT"}, "ARCHIVED_AT"},
+		{"users", []string{"id", "deleted_at"}, ""},
+		{"logs", []string{"id", "deleted_at"}, ""},
+	} {
+		if column := conf.SoftDeleteColumnName(test.table, test.columns); column != test.column {
+			t.Errorf("table %s columns %v: expected soft delete column %q, got %q", test.table, test.columns, test.column, column)
+		}
+	}
+}
+
+func Test_SoftDeleteField(t *testing.T) {
+	for _, useGureguTypes := range []bool{true, false} {
+		conf := NewConfig(nil)
+		conf.UseGureguTypes = useGureguTypes
+		conf.SoftDeleteColumns = []string{"deleted_at"}
+		conf.Report.Output = ioutil.Discard
+		tableInfos := loadTestTables(t, conf,
+			"CREATE TABLE albums (id INTEGER PRIMARY KEY, deleted_at DATETIME)",
+			"CREATE TABLE artists (id INTEGER PRIMARY KEY, deleted_at DATETIME NOT NULL)",
+			"CREATE TABLE tracks (id INTEGER PRIMARY KEY, deleted_at TEXT)",
+		)
+
+		// without guregu types the nullable datetime is a time.Time, which can not hold NULL
+		if !useGureguTypes {
+			if field := tableInfos["albums"].SoftDelete; field != nil {
+				t.Errorf("expected no soft delete field of type time.Time, got %s %s", field.GoFieldName, field.GoFieldType)
+			}
+			if len(conf.Report.Issues) != 3 {
+				t.Errorf("expected 3 soft delete warnings, got %+v", conf.Report.Issues)
+			}
+			continue
+		}
+
+		if field := tableInfos["albums"].SoftDelete; field == nil || field.GoFieldName != "DeletedAt" || field.GoFieldType != "null.Time" {
+			t.Errorf("expected the soft delete field DeletedAt of type null.Time, got %+v", field)
+		}
+		for _, table := range []string{"artists", "tracks"} {
+			if field := tableInfos[table].SoftDelete; field != nil {
+				t.Errorf("table %s: expected no soft delete field, got %s", table, field.GoFieldName)
+			}
+		}
+		if len(conf.Report.Issues) != 2 {
+			t.Errorf("expected 2 soft delete warnings, got %+v", conf.Report.Issues)
+		}
+	}
+}
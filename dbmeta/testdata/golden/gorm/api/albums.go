@@ -0,0 +1,440 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"example.com/rest/example/dao"
+	"example.com/rest/example/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guregu/null"
+	"github.com/julienschmidt/httprouter"
+)
+
+func configAlbumsRouter(router *httprouter.Router) {
+	router.GET("/albums", GetAllAlbums)
+	router.POST("/albums", AddAlbums)
+	router.POST("/albums/upsert", UpsertAlbums)
+	router.POST("/albums/bulk", BulkAddAlbums)
+	router.GET("/albums/:argAlbumID", GetAlbums)
+	router.PUT("/albums/:argAlbumID", UpdateAlbums)
+	router.PATCH("/albums/:argAlbumID", PatchAlbums)
+	router.DELETE("/albums/:argAlbumID", DeleteAlbums)
+}
+
+func configGinAlbumsRouter(router gin.IRoutes) {
+	router.GET("/albums", ConverHttprouterToGin(GetAllAlbums))
+	router.POST("/albums", ConverHttprouterToGin(AddAlbums))
+	router.POST("/albums/upsert", ConverHttprouterToGin(UpsertAlbums))
+	router.POST("/albums/bulk", ConverHttprouterToGin(BulkAddAlbums))
+	router.GET("/albums/:argAlbumID", ConverHttprouterToGin(GetAlbums))
+	router.PUT("/albums/:argAlbumID", ConverHttprouterToGin(UpdateAlbums))
+	router.PATCH("/albums/:argAlbumID", ConverHttprouterToGin(PatchAlbums))
+	router.DELETE("/albums/:argAlbumID", ConverHttprouterToGin(DeleteAlbums))
+}
+
+// GetAllAlbums is a function to get a slice of record(s) from albums table in the main database
+// @Summary Get list of Albums
+// @Tags Albums
+// @Description GetAllAlbums is a handler to get a slice of record(s) from albums table in the main database
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   order    query    string  false        "comma separated json names of the sort columns, descending if prefixed by -, e.g. col1,-col2"
+// @Param   cursor   query    string  false        "next_cursor of the previous page, empty for the first page, selects keyset pagination and ignores page"
+// @Param   count    query    string  false        "with a cursor, exact to count the records matching the filter, estimate to estimate the records of the table"
+// @Param   album_id query int false "filter on AlbumId, album_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   title query string false "filter on Title, title__<op> with op one of eq, in, null, like, ilike"
+// @Param   artist_id query int false "filter on ArtistId, artist_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Success 200 {object} api.PagedResults{data=[]model.Albums}
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /albums [get]
+// http "http://localhost:8080/albums?page=0&pagesize=20" X-Api-User:user123
+func GetAllAlbums(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	order := r.FormValue("order")
+
+	filter := &dao.AlbumsFilter{}
+	if err := readFilter(r, filter); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "albums", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if _, ok := r.URL.Query()["cursor"]; ok {
+		count := dao.CountMode(r.FormValue("count"))
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+
+		records, nextCursor, totalRows, err := dao.GetPageAlbums(ctx, r.FormValue("cursor"), pagesize, order, filter, count)
+		if err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+
+		result := &PagedResults{PageSize: pagesize, Data: records, TotalRecords: totalRows, NextCursor: nextCursor}
+		writeJSON(ctx, w, result)
+		return
+	}
+
+	records, totalRows, err := dao.GetAllAlbums(ctx, page, pagesize, order, filter)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	writeJSON(ctx, w, result)
+}
+
+// GetAlbums is a function to get a single record from the albums table in the main database
+// @Summary Get record from table Albums by  argAlbumID
+// @Tags Albums
+// @ID argAlbumID
+// @Description GetAlbums is a function to get a single record from the albums table in the main database
+// @Accept  json
+// @Produce  json
+// @Param  argAlbumID path int true "AlbumId"
+// @Success 200 {object} model.Albums
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /albums/{argAlbumID} [get]
+// http "http://localhost:8080/albums/1" X-Api-User:user123
+func GetAlbums(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argAlbumID, err := parseInt32(ps, "argAlbumID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "albums", model.RetrieveOne); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, err := dao.GetAlbums(ctx, argAlbumID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, record)
+}
+
+// AddAlbums add to add a single record to albums table in the main database
+// @Summary Add an record to albums table
+// @Description add to add a single record to albums table in the main database
+// @Tags Albums
+// @Accept  json
+// @Produce  json
+// @Param Albums body model.Albums true "Add Albums"
+// @Success 200 {object} model.Albums
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /albums [post]
+// echo '{"album_id": 69,"title": "scAtibAPxXGoaTqIDfpmArZSo","artist_id": 0}' | http POST "http://localhost:8080/albums" X-Api-User:user123
+func AddAlbums(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	albums := &model.Albums{}
+
+	if err := readJSON(r, albums); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := albums.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	albums.Prepare()
+
+	if err := albums.Validate(model.Create); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "albums", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var err error
+	albums, _, err = dao.AddAlbums(ctx, albums)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, albums)
+}
+
+// UpsertAlbums add or update records of albums table in the main database in one transaction
+// @Summary Add or update records of albums table
+// @Description add the records of a json array to albums table in the main database, updating the records with the same conflict columns
+// @Tags Albums
+// @Accept  json
+// @Produce  json
+// @Param   on query string false "comma separated json names of the columns of a unique constraint, the primary key if empty"
+// @Param Albums body []model.Albums true "Upsert Albums"
+// @Success 200 {array} model.Albums
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /albums/upsert [post]
+// echo '[{"album_id": 69,"title": "scAtibAPxXGoaTqIDfpmArZSo","artist_id": 0}]' | http POST "http://localhost:8080/albums/upsert" X-Api-User:user123
+func UpsertAlbums(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Albums
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "albums", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var conflict []string
+	if on := r.FormValue("on"); on != "" {
+		conflict = strings.Split(on, ",")
+	}
+
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, _, err := dao.UpsertAlbums(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+		}
+		return nil
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// BulkAddAlbums add records to albums table in the main database in one transaction
+// @Summary Add records to albums table
+// @Description add the records of a json array to albums table in the main database with multi row inserts
+// @Tags Albums
+// @Accept  json
+// @Produce  json
+// @Param   batch_size query int false "number of records inserted by each statement (defaults to dao.DefaultBatchSize)"
+// @Param Albums body []model.Albums true "Add Albums"
+// @Success 200 {array} model.Albums
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /albums/bulk [post]
+// echo '[{"album_id": 69,"title": "scAtibAPxXGoaTqIDfpmArZSo","artist_id": 0}]' | http POST "http://localhost:8080/albums/bulk" X-Api-User:user123
+func BulkAddAlbums(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Albums
+
+	batchSize, err := readInt(r, "batch_size", 0)
+	if err != nil || batchSize < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "albums", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		records, _, err = dao.BulkAddAlbums(ctx, records, int(batchSize))
+		return err
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// UpdateAlbums Update a single record from albums table in the main database
+// @Summary Update an record in table albums
+// @Description Update a single record from albums table in the main database
+// @Tags Albums
+// @Accept  json
+// @Produce  json
+// @Param  argAlbumID path int true "AlbumId"
+// @Param  Albums body model.Albums true "Update Albums record"
+// @Success 200 {object} model.Albums
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /albums/{argAlbumID} [put]
+// echo '{"album_id": 69,"title": "scAtibAPxXGoaTqIDfpmArZSo","artist_id": 0}' | http PUT "http://localhost:8080/albums/1"  X-Api-User:user123
+func UpdateAlbums(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argAlbumID, err := parseInt32(ps, "argAlbumID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	albums := &model.Albums{}
+	if err := readJSON(r, albums); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := albums.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	albums.Prepare()
+
+	if err := albums.Validate(model.Update); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "albums", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	albums, _, err = dao.UpdateAlbums(ctx,
+		argAlbumID,
+		albums)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, albums)
+}
+
+// PatchAlbums update the fields of a JSON merge patch of a single record from albums table in the main database
+// @Summary Patch an record in table albums
+// @Description Update the fields of a JSON merge patch (RFC 7396) of a single record from albums table in the main database, zero values are written and null sets a field to null
+// @Tags Albums
+// @Accept  json
+// @Produce  json
+// @Param  argAlbumID path int true "AlbumId"
+// @Param  Albums body object true "JSON merge patch of the Albums record"
+// @Success 200 {object} model.Albums
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /albums/{argAlbumID} [patch]
+// echo '{"album_id": 69,"title": "scAtibAPxXGoaTqIDfpmArZSo","artist_id": 0}' | http PATCH "http://localhost:8080/albums/1"  X-Api-User:user123
+func PatchAlbums(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argAlbumID, err := parseInt32(ps, "argAlbumID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := readJSON(r, &patch); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "albums", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	albums, _, err := dao.PatchAlbums(ctx, argAlbumID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, albums)
+}
+
+// DeleteAlbums Delete a single record from albums table in the main database
+// @Summary Delete a record from albums
+// @Description Delete a single record from albums table in the main database
+// @Tags Albums
+// @Accept  json
+// @Produce  json
+// @Param  argAlbumID path int true "AlbumId"
+// @Success 204 {object} model.Albums
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /albums/{argAlbumID} [delete]
+// http DELETE "http://localhost:8080/albums/1" X-Api-User:user123
+func DeleteAlbums(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argAlbumID, err := parseInt32(ps, "argAlbumID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "albums", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	rowsAffected, err := dao.DeleteAlbums(ctx, argAlbumID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeRowsAffected(w, rowsAffected)
+}
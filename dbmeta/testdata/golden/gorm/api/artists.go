@@ -0,0 +1,439 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"example.com/rest/example/dao"
+	"example.com/rest/example/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guregu/null"
+	"github.com/julienschmidt/httprouter"
+)
+
+func configArtistsRouter(router *httprouter.Router) {
+	router.GET("/artists", GetAllArtists)
+	router.POST("/artists", AddArtists)
+	router.POST("/artists/upsert", UpsertArtists)
+	router.POST("/artists/bulk", BulkAddArtists)
+	router.GET("/artists/:argArtistID", GetArtists)
+	router.PUT("/artists/:argArtistID", UpdateArtists)
+	router.PATCH("/artists/:argArtistID", PatchArtists)
+	router.DELETE("/artists/:argArtistID", DeleteArtists)
+}
+
+func configGinArtistsRouter(router gin.IRoutes) {
+	router.GET("/artists", ConverHttprouterToGin(GetAllArtists))
+	router.POST("/artists", ConverHttprouterToGin(AddArtists))
+	router.POST("/artists/upsert", ConverHttprouterToGin(UpsertArtists))
+	router.POST("/artists/bulk", ConverHttprouterToGin(BulkAddArtists))
+	router.GET("/artists/:argArtistID", ConverHttprouterToGin(GetArtists))
+	router.PUT("/artists/:argArtistID", ConverHttprouterToGin(UpdateArtists))
+	router.PATCH("/artists/:argArtistID", ConverHttprouterToGin(PatchArtists))
+	router.DELETE("/artists/:argArtistID", ConverHttprouterToGin(DeleteArtists))
+}
+
+// GetAllArtists is a function to get a slice of record(s) from artists table in the main database
+// @Summary Get list of Artists
+// @Tags Artists
+// @Description GetAllArtists is a handler to get a slice of record(s) from artists table in the main database
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   order    query    string  false        "comma separated json names of the sort columns, descending if prefixed by -, e.g. col1,-col2"
+// @Param   cursor   query    string  false        "next_cursor of the previous page, empty for the first page, selects keyset pagination and ignores page"
+// @Param   count    query    string  false        "with a cursor, exact to count the records matching the filter, estimate to estimate the records of the table"
+// @Param   artist_id query int false "filter on ArtistId, artist_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   name query string false "filter on Name, name__<op> with op one of eq, in, null, like, ilike"
+// @Success 200 {object} api.PagedResults{data=[]model.Artists}
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /artists [get]
+// http "http://localhost:8080/artists?page=0&pagesize=20" X-Api-User:user123
+func GetAllArtists(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	order := r.FormValue("order")
+
+	filter := &dao.ArtistsFilter{}
+	if err := readFilter(r, filter); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "artists", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if _, ok := r.URL.Query()["cursor"]; ok {
+		count := dao.CountMode(r.FormValue("count"))
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+
+		records, nextCursor, totalRows, err := dao.GetPageArtists(ctx, r.FormValue("cursor"), pagesize, order, filter, count)
+		if err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+
+		result := &PagedResults{PageSize: pagesize, Data: records, TotalRecords: totalRows, NextCursor: nextCursor}
+		writeJSON(ctx, w, result)
+		return
+	}
+
+	records, totalRows, err := dao.GetAllArtists(ctx, page, pagesize, order, filter)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	writeJSON(ctx, w, result)
+}
+
+// GetArtists is a function to get a single record from the artists table in the main database
+// @Summary Get record from table Artists by  argArtistID
+// @Tags Artists
+// @ID argArtistID
+// @Description GetArtists is a function to get a single record from the artists table in the main database
+// @Accept  json
+// @Produce  json
+// @Param  argArtistID path int true "ArtistId"
+// @Success 200 {object} model.Artists
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /artists/{argArtistID} [get]
+// http "http://localhost:8080/artists/1" X-Api-User:user123
+func GetArtists(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argArtistID, err := parseInt32(ps, "argArtistID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "artists", model.RetrieveOne); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, err := dao.GetArtists(ctx, argArtistID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, record)
+}
+
+// AddArtists add to add a single record to artists table in the main database
+// @Summary Add an record to artists table
+// @Description add to add a single record to artists table in the main database
+// @Tags Artists
+// @Accept  json
+// @Produce  json
+// @Param Artists body model.Artists true "Add Artists"
+// @Success 200 {object} model.Artists
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /artists [post]
+// echo '{"artist_id": 10,"name": "tWwxuAGKLOeRGYFqWJLOJblPt"}' | http POST "http://localhost:8080/artists" X-Api-User:user123
+func AddArtists(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	artists := &model.Artists{}
+
+	if err := readJSON(r, artists); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := artists.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	artists.Prepare()
+
+	if err := artists.Validate(model.Create); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "artists", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var err error
+	artists, _, err = dao.AddArtists(ctx, artists)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, artists)
+}
+
+// UpsertArtists add or update records of artists table in the main database in one transaction
+// @Summary Add or update records of artists table
+// @Description add the records of a json array to artists table in the main database, updating the records with the same conflict columns
+// @Tags Artists
+// @Accept  json
+// @Produce  json
+// @Param   on query string false "comma separated json names of the columns of a unique constraint, the primary key if empty"
+// @Param Artists body []model.Artists true "Upsert Artists"
+// @Success 200 {array} model.Artists
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /artists/upsert [post]
+// echo '[{"artist_id": 10,"name": "tWwxuAGKLOeRGYFqWJLOJblPt"}]' | http POST "http://localhost:8080/artists/upsert" X-Api-User:user123
+func UpsertArtists(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Artists
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "artists", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var conflict []string
+	if on := r.FormValue("on"); on != "" {
+		conflict = strings.Split(on, ",")
+	}
+
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, _, err := dao.UpsertArtists(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+		}
+		return nil
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// BulkAddArtists add records to artists table in the main database in one transaction
+// @Summary Add records to artists table
+// @Description add the records of a json array to artists table in the main database with multi row inserts
+// @Tags Artists
+// @Accept  json
+// @Produce  json
+// @Param   batch_size query int false "number of records inserted by each statement (defaults to dao.DefaultBatchSize)"
+// @Param Artists body []model.Artists true "Add Artists"
+// @Success 200 {array} model.Artists
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /artists/bulk [post]
+// echo '[{"artist_id": 10,"name": "tWwxuAGKLOeRGYFqWJLOJblPt"}]' | http POST "http://localhost:8080/artists/bulk" X-Api-User:user123
+func BulkAddArtists(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Artists
+
+	batchSize, err := readInt(r, "batch_size", 0)
+	if err != nil || batchSize < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "artists", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		records, _, err = dao.BulkAddArtists(ctx, records, int(batchSize))
+		return err
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// UpdateArtists Update a single record from artists table in the main database
+// @Summary Update an record in table artists
+// @Description Update a single record from artists table in the main database
+// @Tags Artists
+// @Accept  json
+// @Produce  json
+// @Param  argArtistID path int true "ArtistId"
+// @Param  Artists body model.Artists true "Update Artists record"
+// @Success 200 {object} model.Artists
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /artists/{argArtistID} [put]
+// echo '{"artist_id": 10,"name": "tWwxuAGKLOeRGYFqWJLOJblPt"}' | http PUT "http://localhost:8080/artists/1"  X-Api-User:user123
+func UpdateArtists(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argArtistID, err := parseInt32(ps, "argArtistID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	artists := &model.Artists{}
+	if err := readJSON(r, artists); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := artists.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	artists.Prepare()
+
+	if err := artists.Validate(model.Update); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "artists", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	artists, _, err = dao.UpdateArtists(ctx,
+		argArtistID,
+		artists)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, artists)
+}
+
+// PatchArtists update the fields of a JSON merge patch of a single record from artists table in the main database
+// @Summary Patch an record in table artists
+// @Description Update the fields of a JSON merge patch (RFC 7396) of a single record from artists table in the main database, zero values are written and null sets a field to null
+// @Tags Artists
+// @Accept  json
+// @Produce  json
+// @Param  argArtistID path int true "ArtistId"
+// @Param  Artists body object true "JSON merge patch of the Artists record"
+// @Success 200 {object} model.Artists
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /artists/{argArtistID} [patch]
+// echo '{"artist_id": 10,"name": "tWwxuAGKLOeRGYFqWJLOJblPt"}' | http PATCH "http://localhost:8080/artists/1"  X-Api-User:user123
+func PatchArtists(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argArtistID, err := parseInt32(ps, "argArtistID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := readJSON(r, &patch); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "artists", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	artists, _, err := dao.PatchArtists(ctx, argArtistID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, artists)
+}
+
+// DeleteArtists Delete a single record from artists table in the main database
+// @Summary Delete a record from artists
+// @Description Delete a single record from artists table in the main database
+// @Tags Artists
+// @Accept  json
+// @Produce  json
+// @Param  argArtistID path int true "ArtistId"
+// @Success 204 {object} model.Artists
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /artists/{argArtistID} [delete]
+// http DELETE "http://localhost:8080/artists/1" X-Api-User:user123
+func DeleteArtists(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argArtistID, err := parseInt32(ps, "argArtistID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "artists", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	rowsAffected, err := dao.DeleteArtists(ctx, argArtistID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeRowsAffected(w, rowsAffected)
+}
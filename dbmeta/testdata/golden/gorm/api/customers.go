@@ -0,0 +1,450 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"example.com/rest/example/dao"
+	"example.com/rest/example/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guregu/null"
+	"github.com/julienschmidt/httprouter"
+)
+
+func configCustomersRouter(router *httprouter.Router) {
+	router.GET("/customers", GetAllCustomers)
+	router.POST("/customers", AddCustomers)
+	router.POST("/customers/upsert", UpsertCustomers)
+	router.POST("/customers/bulk", BulkAddCustomers)
+	router.GET("/customers/:argCustomerID", GetCustomers)
+	router.PUT("/customers/:argCustomerID", UpdateCustomers)
+	router.PATCH("/customers/:argCustomerID", PatchCustomers)
+	router.DELETE("/customers/:argCustomerID", DeleteCustomers)
+}
+
+func configGinCustomersRouter(router gin.IRoutes) {
+	router.GET("/customers", ConverHttprouterToGin(GetAllCustomers))
+	router.POST("/customers", ConverHttprouterToGin(AddCustomers))
+	router.POST("/customers/upsert", ConverHttprouterToGin(UpsertCustomers))
+	router.POST("/customers/bulk", ConverHttprouterToGin(BulkAddCustomers))
+	router.GET("/customers/:argCustomerID", ConverHttprouterToGin(GetCustomers))
+	router.PUT("/customers/:argCustomerID", ConverHttprouterToGin(UpdateCustomers))
+	router.PATCH("/customers/:argCustomerID", ConverHttprouterToGin(PatchCustomers))
+	router.DELETE("/customers/:argCustomerID", ConverHttprouterToGin(DeleteCustomers))
+}
+
+// GetAllCustomers is a function to get a slice of record(s) from customers table in the main database
+// @Summary Get list of Customers
+// @Tags Customers
+// @Description GetAllCustomers is a handler to get a slice of record(s) from customers table in the main database
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   order    query    string  false        "comma separated json names of the sort columns, descending if prefixed by -, e.g. col1,-col2"
+// @Param   cursor   query    string  false        "next_cursor of the previous page, empty for the first page, selects keyset pagination and ignores page"
+// @Param   count    query    string  false        "with a cursor, exact to count the records matching the filter, estimate to estimate the records of the table"
+// @Param   customer_id query int false "filter on CustomerId, customer_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   first_name query string false "filter on FirstName, first_name__<op> with op one of eq, in, null, like, ilike"
+// @Param   last_name query string false "filter on LastName, last_name__<op> with op one of eq, in, null, like, ilike"
+// @Param   company query string false "filter on Company, company__<op> with op one of eq, in, null, like, ilike"
+// @Param   address query string false "filter on Address, address__<op> with op one of eq, in, null, like, ilike"
+// @Param   city query string false "filter on City, city__<op> with op one of eq, in, null, like, ilike"
+// @Param   state query string false "filter on State, state__<op> with op one of eq, in, null, like, ilike"
+// @Param   country query string false "filter on Country, country__<op> with op one of eq, in, null, like, ilike"
+// @Param   postal_code query string false "filter on PostalCode, postal_code__<op> with op one of eq, in, null, like, ilike"
+// @Param   phone query string false "filter on Phone, phone__<op> with op one of eq, in, null, like, ilike"
+// @Param   fax query string false "filter on Fax, fax__<op> with op one of eq, in, null, like, ilike"
+// @Param   email query string false "filter on Email, email__<op> with op one of eq, in, null, like, ilike"
+// @Param   support_rep_id query int false "filter on SupportRepId, support_rep_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Success 200 {object} api.PagedResults{data=[]model.Customers}
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /customers [get]
+// http "http://localhost:8080/customers?page=0&pagesize=20" X-Api-User:user123
+func GetAllCustomers(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	order := r.FormValue("order")
+
+	filter := &dao.CustomersFilter{}
+	if err := readFilter(r, filter); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "customers", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if _, ok := r.URL.Query()["cursor"]; ok {
+		count := dao.CountMode(r.FormValue("count"))
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+
+		records, nextCursor, totalRows, err := dao.GetPageCustomers(ctx, r.FormValue("cursor"), pagesize, order, filter, count)
+		if err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+
+		result := &PagedResults{PageSize: pagesize, Data: records, TotalRecords: totalRows, NextCursor: nextCursor}
+		writeJSON(ctx, w, result)
+		return
+	}
+
+	records, totalRows, err := dao.GetAllCustomers(ctx, page, pagesize, order, filter)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	writeJSON(ctx, w, result)
+}
+
+// GetCustomers is a function to get a single record from the customers table in the main database
+// @Summary Get record from table Customers by  argCustomerID
+// @Tags Customers
+// @ID argCustomerID
+// @Description GetCustomers is a function to get a single record from the customers table in the main database
+// @Accept  json
+// @Produce  json
+// @Param  argCustomerID path int true "CustomerId"
+// @Success 200 {object} model.Customers
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /customers/{argCustomerID} [get]
+// http "http://localhost:8080/customers/1" X-Api-User:user123
+func GetCustomers(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argCustomerID, err := parseInt32(ps, "argCustomerID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "customers", model.RetrieveOne); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, err := dao.GetCustomers(ctx, argCustomerID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, record)
+}
+
+// AddCustomers add to add a single record to customers table in the main database
+// @Summary Add an record to customers table
+// @Description add to add a single record to customers table in the main database
+// @Tags Customers
+// @Accept  json
+// @Produce  json
+// @Param Customers body model.Customers true "Add Customers"
+// @Success 200 {object} model.Customers
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /customers [post]
+// echo '{"customer_id": 94,"first_name": "KRYsFBnCvxSJViONJmRZXWQJX","last_name": "bEhvSagsXUcNSOLaOExZUAiNQ","company": "mTtICWLKxBYTILKEuLgyJqaUL","address": "EMTuZtYVmbuptfQjrQLGpmfVU","city": "xgmAsnZgKnntSlJcYbUbjhqft","state": "wGPhPIdihSoKvaMxYknRvFEXF","country": "mZSFQJuISrsSmMxGsDTTKqvRk","postal_code": "nKHHZFCsXWMYMZAbBUMlNZWMQ","phone": "ExUpDHycWuoVdNYMGsNDhollH","fax": "VnGJUWFbSLUYYKOcaorhAuAAB","email": "EWNMGHKNIyXeomxKNHcJjXUUK","support_rep_id": 53}' | http POST "http://localhost:8080/customers" X-Api-User:user123
+func AddCustomers(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	customers := &model.Customers{}
+
+	if err := readJSON(r, customers); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := customers.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	customers.Prepare()
+
+	if err := customers.Validate(model.Create); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "customers", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var err error
+	customers, _, err = dao.AddCustomers(ctx, customers)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, customers)
+}
+
+// UpsertCustomers add or update records of customers table in the main database in one transaction
+// @Summary Add or update records of customers table
+// @Description add the records of a json array to customers table in the main database, updating the records with the same conflict columns
+// @Tags Customers
+// @Accept  json
+// @Produce  json
+// @Param   on query string false "comma separated json names of the columns of a unique constraint, the primary key if empty"
+// @Param Customers body []model.Customers true "Upsert Customers"
+// @Success 200 {array} model.Customers
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /customers/upsert [post]
+// echo '[{"customer_id": 94,"first_name": "KRYsFBnCvxSJViONJmRZXWQJX","last_name": "bEhvSagsXUcNSOLaOExZUAiNQ","company": "mTtICWLKxBYTILKEuLgyJqaUL","address": "EMTuZtYVmbuptfQjrQLGpmfVU","city": "xgmAsnZgKnntSlJcYbUbjhqft","state": "wGPhPIdihSoKvaMxYknRvFEXF","country": "mZSFQJuISrsSmMxGsDTTKqvRk","postal_code": "nKHHZFCsXWMYMZAbBUMlNZWMQ","phone": "ExUpDHycWuoVdNYMGsNDhollH","fax": "VnGJUWFbSLUYYKOcaorhAuAAB","email": "EWNMGHKNIyXeomxKNHcJjXUUK","support_rep_id": 53}]' | http POST "http://localhost:8080/customers/upsert" X-Api-User:user123
+func UpsertCustomers(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Customers
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "customers", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var conflict []string
+	if on := r.FormValue("on"); on != "" {
+		conflict = strings.Split(on, ",")
+	}
+
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, _, err := dao.UpsertCustomers(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+		}
+		return nil
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// BulkAddCustomers add records to customers table in the main database in one transaction
+// @Summary Add records to customers table
+// @Description add the records of a json array to customers table in the main database with multi row inserts
+// @Tags Customers
+// @Accept  json
+// @Produce  json
+// @Param   batch_size query int false "number of records inserted by each statement (defaults to dao.DefaultBatchSize)"
+// @Param Customers body []model.Customers true "Add Customers"
+// @Success 200 {array} model.Customers
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /customers/bulk [post]
+// echo '[{"customer_id": 94,"first_name": "KRYsFBnCvxSJViONJmRZXWQJX","last_name": "bEhvSagsXUcNSOLaOExZUAiNQ","company": "mTtICWLKxBYTILKEuLgyJqaUL","address": "EMTuZtYVmbuptfQjrQLGpmfVU","city": "xgmAsnZgKnntSlJcYbUbjhqft","state": "wGPhPIdihSoKvaMxYknRvFEXF","country": "mZSFQJuISrsSmMxGsDTTKqvRk","postal_code": "nKHHZFCsXWMYMZAbBUMlNZWMQ","phone": "ExUpDHycWuoVdNYMGsNDhollH","fax": "VnGJUWFbSLUYYKOcaorhAuAAB","email": "EWNMGHKNIyXeomxKNHcJjXUUK","support_rep_id": 53}]' | http POST "http://localhost:8080/customers/bulk" X-Api-User:user123
+func BulkAddCustomers(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Customers
+
+	batchSize, err := readInt(r, "batch_size", 0)
+	if err != nil || batchSize < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "customers", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		records, _, err = dao.BulkAddCustomers(ctx, records, int(batchSize))
+		return err
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// UpdateCustomers Update a single record from customers table in the main database
+// @Summary Update an record in table customers
+// @Description Update a single record from customers table in the main database
+// @Tags Customers
+// @Accept  json
+// @Produce  json
+// @Param  argCustomerID path int true "CustomerId"
+// @Param  Customers body model.Customers true "Update Customers record"
+// @Success 200 {object} model.Customers
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /customers/{argCustomerID} [put]
+// echo '{"customer_id": 94,"first_name": "KRYsFBnCvxSJViONJmRZXWQJX","last_name": "bEhvSagsXUcNSOLaOExZUAiNQ","company": "mTtICWLKxBYTILKEuLgyJqaUL","address": "EMTuZtYVmbuptfQjrQLGpmfVU","city": "xgmAsnZgKnntSlJcYbUbjhqft","state": "wGPhPIdihSoKvaMxYknRvFEXF","country": "mZSFQJuISrsSmMxGsDTTKqvRk","postal_code": "nKHHZFCsXWMYMZAbBUMlNZWMQ","phone": "ExUpDHycWuoVdNYMGsNDhollH","fax": "VnGJUWFbSLUYYKOcaorhAuAAB","email": "EWNMGHKNIyXeomxKNHcJjXUUK","support_rep_id": 53}' | http PUT "http://localhost:8080/customers/1"  X-Api-User:user123
+func UpdateCustomers(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argCustomerID, err := parseInt32(ps, "argCustomerID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	customers := &model.Customers{}
+	if err := readJSON(r, customers); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := customers.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	customers.Prepare()
+
+	if err := customers.Validate(model.Update); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "customers", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	customers, _, err = dao.UpdateCustomers(ctx,
+		argCustomerID,
+		customers)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, customers)
+}
+
+// PatchCustomers update the fields of a JSON merge patch of a single record from customers table in the main database
+// @Summary Patch an record in table customers
+// @Description Update the fields of a JSON merge patch (RFC 7396) of a single record from customers table in the main database, zero values are written and null sets a field to null
+// @Tags Customers
+// @Accept  json
+// @Produce  json
+// @Param  argCustomerID path int true "CustomerId"
+// @Param  Customers body object true "JSON merge patch of the Customers record"
+// @Success 200 {object} model.Customers
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /customers/{argCustomerID} [patch]
+// echo '{"customer_id": 94,"first_name": "KRYsFBnCvxSJViONJmRZXWQJX","last_name": "bEhvSagsXUcNSOLaOExZUAiNQ","company": "mTtICWLKxBYTILKEuLgyJqaUL","address": "EMTuZtYVmbuptfQjrQLGpmfVU","city": "xgmAsnZgKnntSlJcYbUbjhqft","state": "wGPhPIdihSoKvaMxYknRvFEXF","country": "mZSFQJuISrsSmMxGsDTTKqvRk","postal_code": "nKHHZFCsXWMYMZAbBUMlNZWMQ","phone": "ExUpDHycWuoVdNYMGsNDhollH","fax": "VnGJUWFbSLUYYKOcaorhAuAAB","email": "EWNMGHKNIyXeomxKNHcJjXUUK","support_rep_id": 53}' | http PATCH "http://localhost:8080/customers/1"  X-Api-User:user123
+func PatchCustomers(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argCustomerID, err := parseInt32(ps, "argCustomerID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := readJSON(r, &patch); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "customers", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	customers, _, err := dao.PatchCustomers(ctx, argCustomerID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, customers)
+}
+
+// DeleteCustomers Delete a single record from customers table in the main database
+// @Summary Delete a record from customers
+// @Description Delete a single record from customers table in the main database
+// @Tags Customers
+// @Accept  json
+// @Produce  json
+// @Param  argCustomerID path int true "CustomerId"
+// @Success 204 {object} model.Customers
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /customers/{argCustomerID} [delete]
+// http DELETE "http://localhost:8080/customers/1" X-Api-User:user123
+func DeleteCustomers(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argCustomerID, err := parseInt32(ps, "argCustomerID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "customers", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	rowsAffected, err := dao.DeleteCustomers(ctx, argCustomerID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeRowsAffected(w, rowsAffected)
+}
@@ -0,0 +1,439 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"example.com/rest/example/dao"
+	"example.com/rest/example/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guregu/null"
+	"github.com/julienschmidt/httprouter"
+)
+
+func configGenresRouter(router *httprouter.Router) {
+	router.GET("/genres", GetAllGenres)
+	router.POST("/genres", AddGenres)
+	router.POST("/genres/upsert", UpsertGenres)
+	router.POST("/genres/bulk", BulkAddGenres)
+	router.GET("/genres/:argGenreID", GetGenres)
+	router.PUT("/genres/:argGenreID", UpdateGenres)
+	router.PATCH("/genres/:argGenreID", PatchGenres)
+	router.DELETE("/genres/:argGenreID", DeleteGenres)
+}
+
+func configGinGenresRouter(router gin.IRoutes) {
+	router.GET("/genres", ConverHttprouterToGin(GetAllGenres))
+	router.POST("/genres", ConverHttprouterToGin(AddGenres))
+	router.POST("/genres/upsert", ConverHttprouterToGin(UpsertGenres))
+	router.POST("/genres/bulk", ConverHttprouterToGin(BulkAddGenres))
+	router.GET("/genres/:argGenreID", ConverHttprouterToGin(GetGenres))
+	router.PUT("/genres/:argGenreID", ConverHttprouterToGin(UpdateGenres))
+	router.PATCH("/genres/:argGenreID", ConverHttprouterToGin(PatchGenres))
+	router.DELETE("/genres/:argGenreID", ConverHttprouterToGin(DeleteGenres))
+}
+
+// GetAllGenres is a function to get a slice of record(s) from genres table in the main database
+// @Summary Get list of Genres
+// @Tags Genres
+// @Description GetAllGenres is a handler to get a slice of record(s) from genres table in the main database
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   order    query    string  false        "comma separated json names of the sort columns, descending if prefixed by -, e.g. col1,-col2"
+// @Param   cursor   query    string  false        "next_cursor of the previous page, empty for the first page, selects keyset pagination and ignores page"
+// @Param   count    query    string  false        "with a cursor, exact to count the records matching the filter, estimate to estimate the records of the table"
+// @Param   genre_id query int false "filter on GenreId, genre_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   name query string false "filter on Name, name__<op> with op one of eq, in, null, like, ilike"
+// @Success 200 {object} api.PagedResults{data=[]model.Genres}
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /genres [get]
+// http "http://localhost:8080/genres?page=0&pagesize=20" X-Api-User:user123
+func GetAllGenres(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	order := r.FormValue("order")
+
+	filter := &dao.GenresFilter{}
+	if err := readFilter(r, filter); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "genres", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if _, ok := r.URL.Query()["cursor"]; ok {
+		count := dao.CountMode(r.FormValue("count"))
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+
+		records, nextCursor, totalRows, err := dao.GetPageGenres(ctx, r.FormValue("cursor"), pagesize, order, filter, count)
+		if err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+
+		result := &PagedResults{PageSize: pagesize, Data: records, TotalRecords: totalRows, NextCursor: nextCursor}
+		writeJSON(ctx, w, result)
+		return
+	}
+
+	records, totalRows, err := dao.GetAllGenres(ctx, page, pagesize, order, filter)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	writeJSON(ctx, w, result)
+}
+
+// GetGenres is a function to get a single record from the genres table in the main database
+// @Summary Get record from table Genres by  argGenreID
+// @Tags Genres
+// @ID argGenreID
+// @Description GetGenres is a function to get a single record from the genres table in the main database
+// @Accept  json
+// @Produce  json
+// @Param  argGenreID path int true "GenreId"
+// @Success 200 {object} model.Genres
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /genres/{argGenreID} [get]
+// http "http://localhost:8080/genres/1" X-Api-User:user123
+func GetGenres(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argGenreID, err := parseInt32(ps, "argGenreID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "genres", model.RetrieveOne); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, err := dao.GetGenres(ctx, argGenreID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, record)
+}
+
+// AddGenres add to add a single record to genres table in the main database
+// @Summary Add an record to genres table
+// @Description add to add a single record to genres table in the main database
+// @Tags Genres
+// @Accept  json
+// @Produce  json
+// @Param Genres body model.Genres true "Add Genres"
+// @Success 200 {object} model.Genres
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /genres [post]
+// echo '{"genre_id": 46,"name": "LvVnZWsNjgsEvBcGIIQhdCeDx"}' | http POST "http://localhost:8080/genres" X-Api-User:user123
+func AddGenres(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	genres := &model.Genres{}
+
+	if err := readJSON(r, genres); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := genres.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	genres.Prepare()
+
+	if err := genres.Validate(model.Create); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "genres", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var err error
+	genres, _, err = dao.AddGenres(ctx, genres)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, genres)
+}
+
+// UpsertGenres add or update records of genres table in the main database in one transaction
+// @Summary Add or update records of genres table
+// @Description add the records of a json array to genres table in the main database, updating the records with the same conflict columns
+// @Tags Genres
+// @Accept  json
+// @Produce  json
+// @Param   on query string false "comma separated json names of the columns of a unique constraint, the primary key if empty"
+// @Param Genres body []model.Genres true "Upsert Genres"
+// @Success 200 {array} model.Genres
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /genres/upsert [post]
+// echo '[{"genre_id": 46,"name": "LvVnZWsNjgsEvBcGIIQhdCeDx"}]' | http POST "http://localhost:8080/genres/upsert" X-Api-User:user123
+func UpsertGenres(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Genres
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "genres", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var conflict []string
+	if on := r.FormValue("on"); on != "" {
+		conflict = strings.Split(on, ",")
+	}
+
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, _, err := dao.UpsertGenres(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+		}
+		return nil
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// BulkAddGenres add records to genres table in the main database in one transaction
+// @Summary Add records to genres table
+// @Description add the records of a json array to genres table in the main database with multi row inserts
+// @Tags Genres
+// @Accept  json
+// @Produce  json
+// @Param   batch_size query int false "number of records inserted by each statement (defaults to dao.DefaultBatchSize)"
+// @Param Genres body []model.Genres true "Add Genres"
+// @Success 200 {array} model.Genres
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /genres/bulk [post]
+// echo '[{"genre_id": 46,"name": "LvVnZWsNjgsEvBcGIIQhdCeDx"}]' | http POST "http://localhost:8080/genres/bulk" X-Api-User:user123
+func BulkAddGenres(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Genres
+
+	batchSize, err := readInt(r, "batch_size", 0)
+	if err != nil || batchSize < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "genres", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		records, _, err = dao.BulkAddGenres(ctx, records, int(batchSize))
+		return err
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// UpdateGenres Update a single record from genres table in the main database
+// @Summary Update an record in table genres
+// @Description Update a single record from genres table in the main database
+// @Tags Genres
+// @Accept  json
+// @Produce  json
+// @Param  argGenreID path int true "GenreId"
+// @Param  Genres body model.Genres true "Update Genres record"
+// @Success 200 {object} model.Genres
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /genres/{argGenreID} [put]
+// echo '{"genre_id": 46,"name": "LvVnZWsNjgsEvBcGIIQhdCeDx"}' | http PUT "http://localhost:8080/genres/1"  X-Api-User:user123
+func UpdateGenres(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argGenreID, err := parseInt32(ps, "argGenreID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	genres := &model.Genres{}
+	if err := readJSON(r, genres); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := genres.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	genres.Prepare()
+
+	if err := genres.Validate(model.Update); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "genres", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	genres, _, err = dao.UpdateGenres(ctx,
+		argGenreID,
+		genres)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, genres)
+}
+
+// PatchGenres update the fields of a JSON merge patch of a single record from genres table in the main database
+// @Summary Patch an record in table genres
+// @Description Update the fields of a JSON merge patch (RFC 7396) of a single record from genres table in the main database, zero values are written and null sets a field to null
+// @Tags Genres
+// @Accept  json
+// @Produce  json
+// @Param  argGenreID path int true "GenreId"
+// @Param  Genres body object true "JSON merge patch of the Genres record"
+// @Success 200 {object} model.Genres
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /genres/{argGenreID} [patch]
+// echo '{"genre_id": 46,"name": "LvVnZWsNjgsEvBcGIIQhdCeDx"}' | http PATCH "http://localhost:8080/genres/1"  X-Api-User:user123
+func PatchGenres(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argGenreID, err := parseInt32(ps, "argGenreID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := readJSON(r, &patch); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "genres", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	genres, _, err := dao.PatchGenres(ctx, argGenreID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, genres)
+}
+
+// DeleteGenres Delete a single record from genres table in the main database
+// @Summary Delete a record from genres
+// @Description Delete a single record from genres table in the main database
+// @Tags Genres
+// @Accept  json
+// @Produce  json
+// @Param  argGenreID path int true "GenreId"
+// @Success 204 {object} model.Genres
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /genres/{argGenreID} [delete]
+// http DELETE "http://localhost:8080/genres/1" X-Api-User:user123
+func DeleteGenres(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argGenreID, err := parseInt32(ps, "argGenreID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "genres", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	rowsAffected, err := dao.DeleteGenres(ctx, argGenreID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeRowsAffected(w, rowsAffected)
+}
@@ -0,0 +1,442 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"example.com/rest/example/dao"
+	"example.com/rest/example/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guregu/null"
+	"github.com/julienschmidt/httprouter"
+)
+
+func configInvoiceItemsRouter(router *httprouter.Router) {
+	router.GET("/invoiceitems", GetAllInvoiceItems)
+	router.POST("/invoiceitems", AddInvoiceItems)
+	router.POST("/invoiceitems/upsert", UpsertInvoiceItems)
+	router.POST("/invoiceitems/bulk", BulkAddInvoiceItems)
+	router.GET("/invoiceitems/:argInvoiceLineID", GetInvoiceItems)
+	router.PUT("/invoiceitems/:argInvoiceLineID", UpdateInvoiceItems)
+	router.PATCH("/invoiceitems/:argInvoiceLineID", PatchInvoiceItems)
+	router.DELETE("/invoiceitems/:argInvoiceLineID", DeleteInvoiceItems)
+}
+
+func configGinInvoiceItemsRouter(router gin.IRoutes) {
+	router.GET("/invoiceitems", ConverHttprouterToGin(GetAllInvoiceItems))
+	router.POST("/invoiceitems", ConverHttprouterToGin(AddInvoiceItems))
+	router.POST("/invoiceitems/upsert", ConverHttprouterToGin(UpsertInvoiceItems))
+	router.POST("/invoiceitems/bulk", ConverHttprouterToGin(BulkAddInvoiceItems))
+	router.GET("/invoiceitems/:argInvoiceLineID", ConverHttprouterToGin(GetInvoiceItems))
+	router.PUT("/invoiceitems/:argInvoiceLineID", ConverHttprouterToGin(UpdateInvoiceItems))
+	router.PATCH("/invoiceitems/:argInvoiceLineID", ConverHttprouterToGin(PatchInvoiceItems))
+	router.DELETE("/invoiceitems/:argInvoiceLineID", ConverHttprouterToGin(DeleteInvoiceItems))
+}
+
+// GetAllInvoiceItems is a function to get a slice of record(s) from invoice_items table in the main database
+// @Summary Get list of InvoiceItems
+// @Tags InvoiceItems
+// @Description GetAllInvoiceItems is a handler to get a slice of record(s) from invoice_items table in the main database
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   order    query    string  false        "comma separated json names of the sort columns, descending if prefixed by -, e.g. col1,-col2"
+// @Param   cursor   query    string  false        "next_cursor of the previous page, empty for the first page, selects keyset pagination and ignores page"
+// @Param   count    query    string  false        "with a cursor, exact to count the records matching the filter, estimate to estimate the records of the table"
+// @Param   invoice_line_id query int false "filter on InvoiceLineId, invoice_line_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   invoice_id query int false "filter on InvoiceId, invoice_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   track_id query int false "filter on TrackId, track_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   unit_price query float64 false "filter on UnitPrice, unit_price__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   quantity query int false "filter on Quantity, quantity__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Success 200 {object} api.PagedResults{data=[]model.InvoiceItems}
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoiceitems [get]
+// http "http://localhost:8080/invoiceitems?page=0&pagesize=20" X-Api-User:user123
+func GetAllInvoiceItems(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	order := r.FormValue("order")
+
+	filter := &dao.InvoiceItemsFilter{}
+	if err := readFilter(r, filter); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoice_items", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if _, ok := r.URL.Query()["cursor"]; ok {
+		count := dao.CountMode(r.FormValue("count"))
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+
+		records, nextCursor, totalRows, err := dao.GetPageInvoiceItems(ctx, r.FormValue("cursor"), pagesize, order, filter, count)
+		if err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+
+		result := &PagedResults{PageSize: pagesize, Data: records, TotalRecords: totalRows, NextCursor: nextCursor}
+		writeJSON(ctx, w, result)
+		return
+	}
+
+	records, totalRows, err := dao.GetAllInvoiceItems(ctx, page, pagesize, order, filter)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	writeJSON(ctx, w, result)
+}
+
+// GetInvoiceItems is a function to get a single record from the invoice_items table in the main database
+// @Summary Get record from table InvoiceItems by  argInvoiceLineID
+// @Tags InvoiceItems
+// @ID argInvoiceLineID
+// @Description GetInvoiceItems is a function to get a single record from the invoice_items table in the main database
+// @Accept  json
+// @Produce  json
+// @Param  argInvoiceLineID path int true "InvoiceLineId"
+// @Success 200 {object} model.InvoiceItems
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /invoiceitems/{argInvoiceLineID} [get]
+// http "http://localhost:8080/invoiceitems/1" X-Api-User:user123
+func GetInvoiceItems(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argInvoiceLineID, err := parseInt32(ps, "argInvoiceLineID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoice_items", model.RetrieveOne); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, err := dao.GetInvoiceItems(ctx, argInvoiceLineID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, record)
+}
+
+// AddInvoiceItems add to add a single record to invoice_items table in the main database
+// @Summary Add an record to invoice_items table
+// @Description add to add a single record to invoice_items table in the main database
+// @Tags InvoiceItems
+// @Accept  json
+// @Produce  json
+// @Param InvoiceItems body model.InvoiceItems true "Add InvoiceItems"
+// @Success 200 {object} model.InvoiceItems
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoiceitems [post]
+// echo '{"invoice_line_id": 47,"invoice_id": 63,"track_id": 97,"unit_price": 0.7157533995331656,"quantity": 19}' | http POST "http://localhost:8080/invoiceitems" X-Api-User:user123
+func AddInvoiceItems(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	invoiceitems := &model.InvoiceItems{}
+
+	if err := readJSON(r, invoiceitems); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := invoiceitems.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	invoiceitems.Prepare()
+
+	if err := invoiceitems.Validate(model.Create); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoice_items", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var err error
+	invoiceitems, _, err = dao.AddInvoiceItems(ctx, invoiceitems)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, invoiceitems)
+}
+
+// UpsertInvoiceItems add or update records of invoice_items table in the main database in one transaction
+// @Summary Add or update records of invoice_items table
+// @Description add the records of a json array to invoice_items table in the main database, updating the records with the same conflict columns
+// @Tags InvoiceItems
+// @Accept  json
+// @Produce  json
+// @Param   on query string false "comma separated json names of the columns of a unique constraint, the primary key if empty"
+// @Param InvoiceItems body []model.InvoiceItems true "Upsert InvoiceItems"
+// @Success 200 {array} model.InvoiceItems
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoiceitems/upsert [post]
+// echo '[{"invoice_line_id": 47,"invoice_id": 63,"track_id": 97,"unit_price": 0.7157533995331656,"quantity": 19}]' | http POST "http://localhost:8080/invoiceitems/upsert" X-Api-User:user123
+func UpsertInvoiceItems(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.InvoiceItems
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "invoice_items", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var conflict []string
+	if on := r.FormValue("on"); on != "" {
+		conflict = strings.Split(on, ",")
+	}
+
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, _, err := dao.UpsertInvoiceItems(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+		}
+		return nil
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// BulkAddInvoiceItems add records to invoice_items table in the main database in one transaction
+// @Summary Add records to invoice_items table
+// @Description add the records of a json array to invoice_items table in the main database with multi row inserts
+// @Tags InvoiceItems
+// @Accept  json
+// @Produce  json
+// @Param   batch_size query int false "number of records inserted by each statement (defaults to dao.DefaultBatchSize)"
+// @Param InvoiceItems body []model.InvoiceItems true "Add InvoiceItems"
+// @Success 200 {array} model.InvoiceItems
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoiceitems/bulk [post]
+// echo '[{"invoice_line_id": 47,"invoice_id": 63,"track_id": 97,"unit_price": 0.7157533995331656,"quantity": 19}]' | http POST "http://localhost:8080/invoiceitems/bulk" X-Api-User:user123
+func BulkAddInvoiceItems(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.InvoiceItems
+
+	batchSize, err := readInt(r, "batch_size", 0)
+	if err != nil || batchSize < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "invoice_items", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		records, _, err = dao.BulkAddInvoiceItems(ctx, records, int(batchSize))
+		return err
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// UpdateInvoiceItems Update a single record from invoice_items table in the main database
+// @Summary Update an record in table invoice_items
+// @Description Update a single record from invoice_items table in the main database
+// @Tags InvoiceItems
+// @Accept  json
+// @Produce  json
+// @Param  argInvoiceLineID path int true "InvoiceLineId"
+// @Param  InvoiceItems body model.InvoiceItems true "Update InvoiceItems record"
+// @Success 200 {object} model.InvoiceItems
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoiceitems/{argInvoiceLineID} [put]
+// echo '{"invoice_line_id": 47,"invoice_id": 63,"track_id": 97,"unit_price": 0.7157533995331656,"quantity": 19}' | http PUT "http://localhost:8080/invoiceitems/1"  X-Api-User:user123
+func UpdateInvoiceItems(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argInvoiceLineID, err := parseInt32(ps, "argInvoiceLineID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	invoiceitems := &model.InvoiceItems{}
+	if err := readJSON(r, invoiceitems); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := invoiceitems.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	invoiceitems.Prepare()
+
+	if err := invoiceitems.Validate(model.Update); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoice_items", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	invoiceitems, _, err = dao.UpdateInvoiceItems(ctx,
+		argInvoiceLineID,
+		invoiceitems)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, invoiceitems)
+}
+
+// PatchInvoiceItems update the fields of a JSON merge patch of a single record from invoice_items table in the main database
+// @Summary Patch an record in table invoice_items
+// @Description Update the fields of a JSON merge patch (RFC 7396) of a single record from invoice_items table in the main database, zero values are written and null sets a field to null
+// @Tags InvoiceItems
+// @Accept  json
+// @Produce  json
+// @Param  argInvoiceLineID path int true "InvoiceLineId"
+// @Param  InvoiceItems body object true "JSON merge patch of the InvoiceItems record"
+// @Success 200 {object} model.InvoiceItems
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoiceitems/{argInvoiceLineID} [patch]
+// echo '{"invoice_line_id": 47,"invoice_id": 63,"track_id": 97,"unit_price": 0.7157533995331656,"quantity": 19}' | http PATCH "http://localhost:8080/invoiceitems/1"  X-Api-User:user123
+func PatchInvoiceItems(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argInvoiceLineID, err := parseInt32(ps, "argInvoiceLineID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := readJSON(r, &patch); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoice_items", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	invoiceitems, _, err := dao.PatchInvoiceItems(ctx, argInvoiceLineID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, invoiceitems)
+}
+
+// DeleteInvoiceItems Delete a single record from invoice_items table in the main database
+// @Summary Delete a record from invoice_items
+// @Description Delete a single record from invoice_items table in the main database
+// @Tags InvoiceItems
+// @Accept  json
+// @Produce  json
+// @Param  argInvoiceLineID path int true "InvoiceLineId"
+// @Success 204 {object} model.InvoiceItems
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /invoiceitems/{argInvoiceLineID} [delete]
+// http DELETE "http://localhost:8080/invoiceitems/1" X-Api-User:user123
+func DeleteInvoiceItems(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argInvoiceLineID, err := parseInt32(ps, "argInvoiceLineID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoice_items", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	rowsAffected, err := dao.DeleteInvoiceItems(ctx, argInvoiceLineID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeRowsAffected(w, rowsAffected)
+}
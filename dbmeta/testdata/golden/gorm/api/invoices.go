@@ -0,0 +1,446 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"example.com/rest/example/dao"
+	"example.com/rest/example/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guregu/null"
+	"github.com/julienschmidt/httprouter"
+)
+
+func configInvoicesRouter(router *httprouter.Router) {
+	router.GET("/invoices", GetAllInvoices)
+	router.POST("/invoices", AddInvoices)
+	router.POST("/invoices/upsert", UpsertInvoices)
+	router.POST("/invoices/bulk", BulkAddInvoices)
+	router.GET("/invoices/:argInvoiceID", GetInvoices)
+	router.PUT("/invoices/:argInvoiceID", UpdateInvoices)
+	router.PATCH("/invoices/:argInvoiceID", PatchInvoices)
+	router.DELETE("/invoices/:argInvoiceID", DeleteInvoices)
+}
+
+func configGinInvoicesRouter(router gin.IRoutes) {
+	router.GET("/invoices", ConverHttprouterToGin(GetAllInvoices))
+	router.POST("/invoices", ConverHttprouterToGin(AddInvoices))
+	router.POST("/invoices/upsert", ConverHttprouterToGin(UpsertInvoices))
+	router.POST("/invoices/bulk", ConverHttprouterToGin(BulkAddInvoices))
+	router.GET("/invoices/:argInvoiceID", ConverHttprouterToGin(GetInvoices))
+	router.PUT("/invoices/:argInvoiceID", ConverHttprouterToGin(UpdateInvoices))
+	router.PATCH("/invoices/:argInvoiceID", ConverHttprouterToGin(PatchInvoices))
+	router.DELETE("/invoices/:argInvoiceID", ConverHttprouterToGin(DeleteInvoices))
+}
+
+// GetAllInvoices is a function to get a slice of record(s) from invoices table in the main database
+// @Summary Get list of Invoices
+// @Tags Invoices
+// @Description GetAllInvoices is a handler to get a slice of record(s) from invoices table in the main database
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   order    query    string  false        "comma separated json names of the sort columns, descending if prefixed by -, e.g. col1,-col2"
+// @Param   cursor   query    string  false        "next_cursor of the previous page, empty for the first page, selects keyset pagination and ignores page"
+// @Param   count    query    string  false        "with a cursor, exact to count the records matching the filter, estimate to estimate the records of the table"
+// @Param   invoice_id query int false "filter on InvoiceId, invoice_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   customer_id query int false "filter on CustomerId, customer_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   invoice_date query time.Time false "filter on InvoiceDate, invoice_date__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   billing_address query string false "filter on BillingAddress, billing_address__<op> with op one of eq, in, null, like, ilike"
+// @Param   billing_city query string false "filter on BillingCity, billing_city__<op> with op one of eq, in, null, like, ilike"
+// @Param   billing_state query string false "filter on BillingState, billing_state__<op> with op one of eq, in, null, like, ilike"
+// @Param   billing_country query string false "filter on BillingCountry, billing_country__<op> with op one of eq, in, null, like, ilike"
+// @Param   billing_postal_code query string false "filter on BillingPostalCode, billing_postal_code__<op> with op one of eq, in, null, like, ilike"
+// @Param   total query float64 false "filter on Total, total__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Success 200 {object} api.PagedResults{data=[]model.Invoices}
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoices [get]
+// http "http://localhost:8080/invoices?page=0&pagesize=20" X-Api-User:user123
+func GetAllInvoices(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	order := r.FormValue("order")
+
+	filter := &dao.InvoicesFilter{}
+	if err := readFilter(r, filter); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoices", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if _, ok := r.URL.Query()["cursor"]; ok {
+		count := dao.CountMode(r.FormValue("count"))
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+
+		records, nextCursor, totalRows, err := dao.GetPageInvoices(ctx, r.FormValue("cursor"), pagesize, order, filter, count)
+		if err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+
+		result := &PagedResults{PageSize: pagesize, Data: records, TotalRecords: totalRows, NextCursor: nextCursor}
+		writeJSON(ctx, w, result)
+		return
+	}
+
+	records, totalRows, err := dao.GetAllInvoices(ctx, page, pagesize, order, filter)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	writeJSON(ctx, w, result)
+}
+
+// GetInvoices is a function to get a single record from the invoices table in the main database
+// @Summary Get record from table Invoices by  argInvoiceID
+// @Tags Invoices
+// @ID argInvoiceID
+// @Description GetInvoices is a function to get a single record from the invoices table in the main database
+// @Accept  json
+// @Produce  json
+// @Param  argInvoiceID path int true "InvoiceId"
+// @Success 200 {object} model.Invoices
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /invoices/{argInvoiceID} [get]
+// http "http://localhost:8080/invoices/1" X-Api-User:user123
+func GetInvoices(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argInvoiceID, err := parseInt32(ps, "argInvoiceID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoices", model.RetrieveOne); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, err := dao.GetInvoices(ctx, argInvoiceID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, record)
+}
+
+// AddInvoices add to add a single record to invoices table in the main database
+// @Summary Add an record to invoices table
+// @Description add to add a single record to invoices table in the main database
+// @Tags Invoices
+// @Accept  json
+// @Produce  json
+// @Param Invoices body model.Invoices true "Add Invoices"
+// @Success 200 {object} model.Invoices
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoices [post]
+// echo '{"invoice_id": 33,"customer_id": 66,"invoice_date": "2020-01-23T05:24:50Z","billing_address": "voQxveuavXlKMDAmGUCDmDDUt","billing_city": "uuwIfeIplkkgqRrBSuOHQGCkK","billing_state": "YPDMZBqQDEPQSwvdDgpLbXuEf","billing_country": "NuBsUvxGnZSwSAMmiYbNMGTLS","billing_postal_code": "NvXQvHJkDJjxVWWMlbCTcwiZA","total": 0.4922718791101031}' | http POST "http://localhost:8080/invoices" X-Api-User:user123
+func AddInvoices(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	invoices := &model.Invoices{}
+
+	if err := readJSON(r, invoices); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := invoices.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	invoices.Prepare()
+
+	if err := invoices.Validate(model.Create); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoices", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var err error
+	invoices, _, err = dao.AddInvoices(ctx, invoices)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, invoices)
+}
+
+// UpsertInvoices add or update records of invoices table in the main database in one transaction
+// @Summary Add or update records of invoices table
+// @Description add the records of a json array to invoices table in the main database, updating the records with the same conflict columns
+// @Tags Invoices
+// @Accept  json
+// @Produce  json
+// @Param   on query string false "comma separated json names of the columns of a unique constraint, the primary key if empty"
+// @Param Invoices body []model.Invoices true "Upsert Invoices"
+// @Success 200 {array} model.Invoices
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoices/upsert [post]
+// echo '[{"invoice_id": 33,"customer_id": 66,"invoice_date": "2020-01-23T05:24:50Z","billing_address": "voQxveuavXlKMDAmGUCDmDDUt","billing_city": "uuwIfeIplkkgqRrBSuOHQGCkK","billing_state": "YPDMZBqQDEPQSwvdDgpLbXuEf","billing_country": "NuBsUvxGnZSwSAMmiYbNMGTLS","billing_postal_code": "NvXQvHJkDJjxVWWMlbCTcwiZA","total": 0.4922718791101031}]' | http POST "http://localhost:8080/invoices/upsert" X-Api-User:user123
+func UpsertInvoices(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Invoices
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "invoices", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var conflict []string
+	if on := r.FormValue("on"); on != "" {
+		conflict = strings.Split(on, ",")
+	}
+
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, _, err := dao.UpsertInvoices(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+		}
+		return nil
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// BulkAddInvoices add records to invoices table in the main database in one transaction
+// @Summary Add records to invoices table
+// @Description add the records of a json array to invoices table in the main database with multi row inserts
+// @Tags Invoices
+// @Accept  json
+// @Produce  json
+// @Param   batch_size query int false "number of records inserted by each statement (defaults to dao.DefaultBatchSize)"
+// @Param Invoices body []model.Invoices true "Add Invoices"
+// @Success 200 {array} model.Invoices
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoices/bulk [post]
+// echo '[{"invoice_id": 33,"customer_id": 66,"invoice_date": "2020-01-23T05:24:50Z","billing_address": "voQxveuavXlKMDAmGUCDmDDUt","billing_city": "uuwIfeIplkkgqRrBSuOHQGCkK","billing_state": "YPDMZBqQDEPQSwvdDgpLbXuEf","billing_country": "NuBsUvxGnZSwSAMmiYbNMGTLS","billing_postal_code": "NvXQvHJkDJjxVWWMlbCTcwiZA","total": 0.4922718791101031}]' | http POST "http://localhost:8080/invoices/bulk" X-Api-User:user123
+func BulkAddInvoices(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Invoices
+
+	batchSize, err := readInt(r, "batch_size", 0)
+	if err != nil || batchSize < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "invoices", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		records, _, err = dao.BulkAddInvoices(ctx, records, int(batchSize))
+		return err
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// UpdateInvoices Update a single record from invoices table in the main database
+// @Summary Update an record in table invoices
+// @Description Update a single record from invoices table in the main database
+// @Tags Invoices
+// @Accept  json
+// @Produce  json
+// @Param  argInvoiceID path int true "InvoiceId"
+// @Param  Invoices body model.Invoices true "Update Invoices record"
+// @Success 200 {object} model.Invoices
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoices/{argInvoiceID} [put]
+// echo '{"invoice_id": 33,"customer_id": 66,"invoice_date": "2020-01-23T05:24:50Z","billing_address": "voQxveuavXlKMDAmGUCDmDDUt","billing_city": "uuwIfeIplkkgqRrBSuOHQGCkK","billing_state": "YPDMZBqQDEPQSwvdDgpLbXuEf","billing_country": "NuBsUvxGnZSwSAMmiYbNMGTLS","billing_postal_code": "NvXQvHJkDJjxVWWMlbCTcwiZA","total": 0.4922718791101031}' | http PUT "http://localhost:8080/invoices/1"  X-Api-User:user123
+func UpdateInvoices(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argInvoiceID, err := parseInt32(ps, "argInvoiceID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	invoices := &model.Invoices{}
+	if err := readJSON(r, invoices); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := invoices.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	invoices.Prepare()
+
+	if err := invoices.Validate(model.Update); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoices", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	invoices, _, err = dao.UpdateInvoices(ctx,
+		argInvoiceID,
+		invoices)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, invoices)
+}
+
+// PatchInvoices update the fields of a JSON merge patch of a single record from invoices table in the main database
+// @Summary Patch an record in table invoices
+// @Description Update the fields of a JSON merge patch (RFC 7396) of a single record from invoices table in the main database, zero values are written and null sets a field to null
+// @Tags Invoices
+// @Accept  json
+// @Produce  json
+// @Param  argInvoiceID path int true "InvoiceId"
+// @Param  Invoices body object true "JSON merge patch of the Invoices record"
+// @Success 200 {object} model.Invoices
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoices/{argInvoiceID} [patch]
+// echo '{"invoice_id": 33,"customer_id": 66,"invoice_date": "2020-01-23T05:24:50Z","billing_address": "voQxveuavXlKMDAmGUCDmDDUt","billing_city": "uuwIfeIplkkgqRrBSuOHQGCkK","billing_state": "YPDMZBqQDEPQSwvdDgpLbXuEf","billing_country": "NuBsUvxGnZSwSAMmiYbNMGTLS","billing_postal_code": "NvXQvHJkDJjxVWWMlbCTcwiZA","total": 0.4922718791101031}' | http PATCH "http://localhost:8080/invoices/1"  X-Api-User:user123
+func PatchInvoices(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argInvoiceID, err := parseInt32(ps, "argInvoiceID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := readJSON(r, &patch); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoices", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	invoices, _, err := dao.PatchInvoices(ctx, argInvoiceID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, invoices)
+}
+
+// DeleteInvoices Delete a single record from invoices table in the main database
+// @Summary Delete a record from invoices
+// @Description Delete a single record from invoices table in the main database
+// @Tags Invoices
+// @Accept  json
+// @Produce  json
+// @Param  argInvoiceID path int true "InvoiceId"
+// @Success 204 {object} model.Invoices
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /invoices/{argInvoiceID} [delete]
+// http DELETE "http://localhost:8080/invoices/1" X-Api-User:user123
+func DeleteInvoices(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argInvoiceID, err := parseInt32(ps, "argInvoiceID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoices", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	rowsAffected, err := dao.DeleteInvoices(ctx, argInvoiceID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeRowsAffected(w, rowsAffected)
+}
@@ -0,0 +1,439 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"example.com/rest/example/dao"
+	"example.com/rest/example/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guregu/null"
+	"github.com/julienschmidt/httprouter"
+)
+
+func configPlaylistsRouter(router *httprouter.Router) {
+	router.GET("/playlists", GetAllPlaylists)
+	router.POST("/playlists", AddPlaylists)
+	router.POST("/playlists/upsert", UpsertPlaylists)
+	router.POST("/playlists/bulk", BulkAddPlaylists)
+	router.GET("/playlists/:argPlaylistID", GetPlaylists)
+	router.PUT("/playlists/:argPlaylistID", UpdatePlaylists)
+	router.PATCH("/playlists/:argPlaylistID", PatchPlaylists)
+	router.DELETE("/playlists/:argPlaylistID", DeletePlaylists)
+}
+
+func configGinPlaylistsRouter(router gin.IRoutes) {
+	router.GET("/playlists", ConverHttprouterToGin(GetAllPlaylists))
+	router.POST("/playlists", ConverHttprouterToGin(AddPlaylists))
+	router.POST("/playlists/upsert", ConverHttprouterToGin(UpsertPlaylists))
+	router.POST("/playlists/bulk", ConverHttprouterToGin(BulkAddPlaylists))
+	router.GET("/playlists/:argPlaylistID", ConverHttprouterToGin(GetPlaylists))
+	router.PUT("/playlists/:argPlaylistID", ConverHttprouterToGin(UpdatePlaylists))
+	router.PATCH("/playlists/:argPlaylistID", ConverHttprouterToGin(PatchPlaylists))
+	router.DELETE("/playlists/:argPlaylistID", ConverHttprouterToGin(DeletePlaylists))
+}
+
+// GetAllPlaylists is a function to get a slice of record(s) from playlists table in the main database
+// @Summary Get list of Playlists
+// @Tags Playlists
+// @Description GetAllPlaylists is a handler to get a slice of record(s) from playlists table in the main database
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   order    query    string  false        "comma separated json names of the sort columns, descending if prefixed by -, e.g. col1,-col2"
+// @Param   cursor   query    string  false        "next_cursor of the previous page, empty for the first page, selects keyset pagination and ignores page"
+// @Param   count    query    string  false        "with a cursor, exact to count the records matching the filter, estimate to estimate the records of the table"
+// @Param   playlist_id query int false "filter on PlaylistId, playlist_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   name query string false "filter on Name, name__<op> with op one of eq, in, null, like, ilike"
+// @Success 200 {object} api.PagedResults{data=[]model.Playlists}
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /playlists [get]
+// http "http://localhost:8080/playlists?page=0&pagesize=20" X-Api-User:user123
+func GetAllPlaylists(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	order := r.FormValue("order")
+
+	filter := &dao.PlaylistsFilter{}
+	if err := readFilter(r, filter); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "playlists", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if _, ok := r.URL.Query()["cursor"]; ok {
+		count := dao.CountMode(r.FormValue("count"))
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+
+		records, nextCursor, totalRows, err := dao.GetPagePlaylists(ctx, r.FormValue("cursor"), pagesize, order, filter, count)
+		if err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+
+		result := &PagedResults{PageSize: pagesize, Data: records, TotalRecords: totalRows, NextCursor: nextCursor}
+		writeJSON(ctx, w, result)
+		return
+	}
+
+	records, totalRows, err := dao.GetAllPlaylists(ctx, page, pagesize, order, filter)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	writeJSON(ctx, w, result)
+}
+
+// GetPlaylists is a function to get a single record from the playlists table in the main database
+// @Summary Get record from table Playlists by  argPlaylistID
+// @Tags Playlists
+// @ID argPlaylistID
+// @Description GetPlaylists is a function to get a single record from the playlists table in the main database
+// @Accept  json
+// @Produce  json
+// @Param  argPlaylistID path int true "PlaylistId"
+// @Success 200 {object} model.Playlists
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /playlists/{argPlaylistID} [get]
+// http "http://localhost:8080/playlists/1" X-Api-User:user123
+func GetPlaylists(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argPlaylistID, err := parseInt32(ps, "argPlaylistID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "playlists", model.RetrieveOne); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, err := dao.GetPlaylists(ctx, argPlaylistID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, record)
+}
+
+// AddPlaylists add to add a single record to playlists table in the main database
+// @Summary Add an record to playlists table
+// @Description add to add a single record to playlists table in the main database
+// @Tags Playlists
+// @Accept  json
+// @Produce  json
+// @Param Playlists body model.Playlists true "Add Playlists"
+// @Success 200 {object} model.Playlists
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /playlists [post]
+// echo '{"playlist_id": 81,"name": "jGLcViiwlLYsioxSpdGLsSUJc"}' | http POST "http://localhost:8080/playlists" X-Api-User:user123
+func AddPlaylists(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	playlists := &model.Playlists{}
+
+	if err := readJSON(r, playlists); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := playlists.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	playlists.Prepare()
+
+	if err := playlists.Validate(model.Create); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "playlists", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var err error
+	playlists, _, err = dao.AddPlaylists(ctx, playlists)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, playlists)
+}
+
+// UpsertPlaylists add or update records of playlists table in the main database in one transaction
+// @Summary Add or update records of playlists table
+// @Description add the records of a json array to playlists table in the main database, updating the records with the same conflict columns
+// @Tags Playlists
+// @Accept  json
+// @Produce  json
+// @Param   on query string false "comma separated json names of the columns of a unique constraint, the primary key if empty"
+// @Param Playlists body []model.Playlists true "Upsert Playlists"
+// @Success 200 {array} model.Playlists
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /playlists/upsert [post]
+// echo '[{"playlist_id": 81,"name": "jGLcViiwlLYsioxSpdGLsSUJc"}]' | http POST "http://localhost:8080/playlists/upsert" X-Api-User:user123
+func UpsertPlaylists(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Playlists
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "playlists", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var conflict []string
+	if on := r.FormValue("on"); on != "" {
+		conflict = strings.Split(on, ",")
+	}
+
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, _, err := dao.UpsertPlaylists(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+		}
+		return nil
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// BulkAddPlaylists add records to playlists table in the main database in one transaction
+// @Summary Add records to playlists table
+// @Description add the records of a json array to playlists table in the main database with multi row inserts
+// @Tags Playlists
+// @Accept  json
+// @Produce  json
+// @Param   batch_size query int false "number of records inserted by each statement (defaults to dao.DefaultBatchSize)"
+// @Param Playlists body []model.Playlists true "Add Playlists"
+// @Success 200 {array} model.Playlists
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /playlists/bulk [post]
+// echo '[{"playlist_id": 81,"name": "jGLcViiwlLYsioxSpdGLsSUJc"}]' | http POST "http://localhost:8080/playlists/bulk" X-Api-User:user123
+func BulkAddPlaylists(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Playlists
+
+	batchSize, err := readInt(r, "batch_size", 0)
+	if err != nil || batchSize < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "playlists", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		records, _, err = dao.BulkAddPlaylists(ctx, records, int(batchSize))
+		return err
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// UpdatePlaylists Update a single record from playlists table in the main database
+// @Summary Update an record in table playlists
+// @Description Update a single record from playlists table in the main database
+// @Tags Playlists
+// @Accept  json
+// @Produce  json
+// @Param  argPlaylistID path int true "PlaylistId"
+// @Param  Playlists body model.Playlists true "Update Playlists record"
+// @Success 200 {object} model.Playlists
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /playlists/{argPlaylistID} [put]
+// echo '{"playlist_id": 81,"name": "jGLcViiwlLYsioxSpdGLsSUJc"}' | http PUT "http://localhost:8080/playlists/1"  X-Api-User:user123
+func UpdatePlaylists(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argPlaylistID, err := parseInt32(ps, "argPlaylistID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	playlists := &model.Playlists{}
+	if err := readJSON(r, playlists); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := playlists.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	playlists.Prepare()
+
+	if err := playlists.Validate(model.Update); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "playlists", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	playlists, _, err = dao.UpdatePlaylists(ctx,
+		argPlaylistID,
+		playlists)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, playlists)
+}
+
+// PatchPlaylists update the fields of a JSON merge patch of a single record from playlists table in the main database
+// @Summary Patch an record in table playlists
+// @Description Update the fields of a JSON merge patch (RFC 7396) of a single record from playlists table in the main database, zero values are written and null sets a field to null
+// @Tags Playlists
+// @Accept  json
+// @Produce  json
+// @Param  argPlaylistID path int true "PlaylistId"
+// @Param  Playlists body object true "JSON merge patch of the Playlists record"
+// @Success 200 {object} model.Playlists
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /playlists/{argPlaylistID} [patch]
+// echo '{"playlist_id": 81,"name": "jGLcViiwlLYsioxSpdGLsSUJc"}' | http PATCH "http://localhost:8080/playlists/1"  X-Api-User:user123
+func PatchPlaylists(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argPlaylistID, err := parseInt32(ps, "argPlaylistID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := readJSON(r, &patch); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "playlists", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	playlists, _, err := dao.PatchPlaylists(ctx, argPlaylistID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, playlists)
+}
+
+// DeletePlaylists Delete a single record from playlists table in the main database
+// @Summary Delete a record from playlists
+// @Description Delete a single record from playlists table in the main database
+// @Tags Playlists
+// @Accept  json
+// @Produce  json
+// @Param  argPlaylistID path int true "PlaylistId"
+// @Success 204 {object} model.Playlists
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /playlists/{argPlaylistID} [delete]
+// http DELETE "http://localhost:8080/playlists/1" X-Api-User:user123
+func DeletePlaylists(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argPlaylistID, err := parseInt32(ps, "argPlaylistID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "playlists", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	rowsAffected, err := dao.DeletePlaylists(ctx, argPlaylistID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeRowsAffected(w, rowsAffected)
+}
@@ -0,0 +1,440 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"example.com/rest/example/dao"
+	"example.com/rest/example/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guregu/null"
+	"github.com/julienschmidt/httprouter"
+)
+
+func configPurchaseOrderRouter(router *httprouter.Router) {
+	router.GET("/purchaseorder", GetAllPurchaseOrder)
+	router.POST("/purchaseorder", AddPurchaseOrder)
+	router.POST("/purchaseorder/upsert", UpsertPurchaseOrder)
+	router.POST("/purchaseorder/bulk", BulkAddPurchaseOrder)
+	router.GET("/purchaseorder/:argID", GetPurchaseOrder)
+	router.PUT("/purchaseorder/:argID", UpdatePurchaseOrder)
+	router.PATCH("/purchaseorder/:argID", PatchPurchaseOrder)
+	router.DELETE("/purchaseorder/:argID", DeletePurchaseOrder)
+}
+
+func configGinPurchaseOrderRouter(router gin.IRoutes) {
+	router.GET("/purchaseorder", ConverHttprouterToGin(GetAllPurchaseOrder))
+	router.POST("/purchaseorder", ConverHttprouterToGin(AddPurchaseOrder))
+	router.POST("/purchaseorder/upsert", ConverHttprouterToGin(UpsertPurchaseOrder))
+	router.POST("/purchaseorder/bulk", ConverHttprouterToGin(BulkAddPurchaseOrder))
+	router.GET("/purchaseorder/:argID", ConverHttprouterToGin(GetPurchaseOrder))
+	router.PUT("/purchaseorder/:argID", ConverHttprouterToGin(UpdatePurchaseOrder))
+	router.PATCH("/purchaseorder/:argID", ConverHttprouterToGin(PatchPurchaseOrder))
+	router.DELETE("/purchaseorder/:argID", ConverHttprouterToGin(DeletePurchaseOrder))
+}
+
+// GetAllPurchaseOrder is a function to get a slice of record(s) from purchase_order table in the main database
+// @Summary Get list of PurchaseOrder
+// @Tags PurchaseOrder
+// @Description GetAllPurchaseOrder is a handler to get a slice of record(s) from purchase_order table in the main database
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   order    query    string  false        "comma separated json names of the sort columns, descending if prefixed by -, e.g. col1,-col2"
+// @Param   cursor   query    string  false        "next_cursor of the previous page, empty for the first page, selects keyset pagination and ignores page"
+// @Param   count    query    string  false        "with a cursor, exact to count the records matching the filter, estimate to estimate the records of the table"
+// @Param   id query int false "filter on id, id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   payment_id query int false "filter on payment_id, payment_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   full_name query string false "filter on full_name, full_name__<op> with op one of eq, in, null, like, ilike"
+// @Success 200 {object} api.PagedResults{data=[]model.PurchaseOrder}
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /purchaseorder [get]
+// http "http://localhost:8080/purchaseorder?page=0&pagesize=20" X-Api-User:user123
+func GetAllPurchaseOrder(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	order := r.FormValue("order")
+
+	filter := &dao.PurchaseOrderFilter{}
+	if err := readFilter(r, filter); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "purchase_order", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if _, ok := r.URL.Query()["cursor"]; ok {
+		count := dao.CountMode(r.FormValue("count"))
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+
+		records, nextCursor, totalRows, err := dao.GetPagePurchaseOrder(ctx, r.FormValue("cursor"), pagesize, order, filter, count)
+		if err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+
+		result := &PagedResults{PageSize: pagesize, Data: records, TotalRecords: totalRows, NextCursor: nextCursor}
+		writeJSON(ctx, w, result)
+		return
+	}
+
+	records, totalRows, err := dao.GetAllPurchaseOrder(ctx, page, pagesize, order, filter)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	writeJSON(ctx, w, result)
+}
+
+// GetPurchaseOrder is a function to get a single record from the purchase_order table in the main database
+// @Summary Get record from table PurchaseOrder by  argID
+// @Tags PurchaseOrder
+// @ID argID
+// @Description GetPurchaseOrder is a function to get a single record from the purchase_order table in the main database
+// @Accept  json
+// @Produce  json
+// @Param  argID path int true "id"
+// @Success 200 {object} model.PurchaseOrder
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /purchaseorder/{argID} [get]
+// http "http://localhost:8080/purchaseorder/1" X-Api-User:user123
+func GetPurchaseOrder(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt32(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "purchase_order", model.RetrieveOne); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, err := dao.GetPurchaseOrder(ctx, argID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, record)
+}
+
+// AddPurchaseOrder add to add a single record to purchase_order table in the main database
+// @Summary Add an record to purchase_order table
+// @Description add to add a single record to purchase_order table in the main database
+// @Tags PurchaseOrder
+// @Accept  json
+// @Produce  json
+// @Param PurchaseOrder body model.PurchaseOrder true "Add PurchaseOrder"
+// @Success 200 {object} model.PurchaseOrder
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /purchaseorder [post]
+// echo '{"id": 17,"payment_id": 11,"full_name": "gVGpJwwRFcMPhrmRAXIdIfLQx"}' | http POST "http://localhost:8080/purchaseorder" X-Api-User:user123
+func AddPurchaseOrder(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	purchaseorder := &model.PurchaseOrder{}
+
+	if err := readJSON(r, purchaseorder); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := purchaseorder.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	purchaseorder.Prepare()
+
+	if err := purchaseorder.Validate(model.Create); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "purchase_order", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var err error
+	purchaseorder, _, err = dao.AddPurchaseOrder(ctx, purchaseorder)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, purchaseorder)
+}
+
+// UpsertPurchaseOrder add or update records of purchase_order table in the main database in one transaction
+// @Summary Add or update records of purchase_order table
+// @Description add the records of a json array to purchase_order table in the main database, updating the records with the same conflict columns
+// @Tags PurchaseOrder
+// @Accept  json
+// @Produce  json
+// @Param   on query string false "comma separated json names of the columns of a unique constraint, the primary key if empty"
+// @Param PurchaseOrder body []model.PurchaseOrder true "Upsert PurchaseOrder"
+// @Success 200 {array} model.PurchaseOrder
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /purchaseorder/upsert [post]
+// echo '[{"id": 17,"payment_id": 11,"full_name": "gVGpJwwRFcMPhrmRAXIdIfLQx"}]' | http POST "http://localhost:8080/purchaseorder/upsert" X-Api-User:user123
+func UpsertPurchaseOrder(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.PurchaseOrder
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "purchase_order", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var conflict []string
+	if on := r.FormValue("on"); on != "" {
+		conflict = strings.Split(on, ",")
+	}
+
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, _, err := dao.UpsertPurchaseOrder(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+		}
+		return nil
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// BulkAddPurchaseOrder add records to purchase_order table in the main database in one transaction
+// @Summary Add records to purchase_order table
+// @Description add the records of a json array to purchase_order table in the main database with multi row inserts
+// @Tags PurchaseOrder
+// @Accept  json
+// @Produce  json
+// @Param   batch_size query int false "number of records inserted by each statement (defaults to dao.DefaultBatchSize)"
+// @Param PurchaseOrder body []model.PurchaseOrder true "Add PurchaseOrder"
+// @Success 200 {array} model.PurchaseOrder
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /purchaseorder/bulk [post]
+// echo '[{"id": 17,"payment_id": 11,"full_name": "gVGpJwwRFcMPhrmRAXIdIfLQx"}]' | http POST "http://localhost:8080/purchaseorder/bulk" X-Api-User:user123
+func BulkAddPurchaseOrder(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.PurchaseOrder
+
+	batchSize, err := readInt(r, "batch_size", 0)
+	if err != nil || batchSize < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "purchase_order", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		records, _, err = dao.BulkAddPurchaseOrder(ctx, records, int(batchSize))
+		return err
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// UpdatePurchaseOrder Update a single record from purchase_order table in the main database
+// @Summary Update an record in table purchase_order
+// @Description Update a single record from purchase_order table in the main database
+// @Tags PurchaseOrder
+// @Accept  json
+// @Produce  json
+// @Param  argID path int true "id"
+// @Param  PurchaseOrder body model.PurchaseOrder true "Update PurchaseOrder record"
+// @Success 200 {object} model.PurchaseOrder
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /purchaseorder/{argID} [put]
+// echo '{"id": 17,"payment_id": 11,"full_name": "gVGpJwwRFcMPhrmRAXIdIfLQx"}' | http PUT "http://localhost:8080/purchaseorder/1"  X-Api-User:user123
+func UpdatePurchaseOrder(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt32(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	purchaseorder := &model.PurchaseOrder{}
+	if err := readJSON(r, purchaseorder); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := purchaseorder.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	purchaseorder.Prepare()
+
+	if err := purchaseorder.Validate(model.Update); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "purchase_order", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	purchaseorder, _, err = dao.UpdatePurchaseOrder(ctx,
+		argID,
+		purchaseorder)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, purchaseorder)
+}
+
+// PatchPurchaseOrder update the fields of a JSON merge patch of a single record from purchase_order table in the main database
+// @Summary Patch an record in table purchase_order
+// @Description Update the fields of a JSON merge patch (RFC 7396) of a single record from purchase_order table in the main database, zero values are written and null sets a field to null
+// @Tags PurchaseOrder
+// @Accept  json
+// @Produce  json
+// @Param  argID path int true "id"
+// @Param  PurchaseOrder body object true "JSON merge patch of the PurchaseOrder record"
+// @Success 200 {object} model.PurchaseOrder
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /purchaseorder/{argID} [patch]
+// echo '{"id": 17,"payment_id": 11,"full_name": "gVGpJwwRFcMPhrmRAXIdIfLQx"}' | http PATCH "http://localhost:8080/purchaseorder/1"  X-Api-User:user123
+func PatchPurchaseOrder(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt32(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := readJSON(r, &patch); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "purchase_order", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	purchaseorder, _, err := dao.PatchPurchaseOrder(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, purchaseorder)
+}
+
+// DeletePurchaseOrder Delete a single record from purchase_order table in the main database
+// @Summary Delete a record from purchase_order
+// @Description Delete a single record from purchase_order table in the main database
+// @Tags PurchaseOrder
+// @Accept  json
+// @Produce  json
+// @Param  argID path int true "id"
+// @Success 204 {object} model.PurchaseOrder
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /purchaseorder/{argID} [delete]
+// http DELETE "http://localhost:8080/purchaseorder/1" X-Api-User:user123
+func DeletePurchaseOrder(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt32(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "purchase_order", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	rowsAffected, err := dao.DeletePurchaseOrder(ctx, argID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeRowsAffected(w, rowsAffected)
+}
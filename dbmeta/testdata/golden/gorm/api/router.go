@@ -0,0 +1,533 @@
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	_ "github.com/google/uuid"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unsafe"
+
+	"example.com/rest/example/dao"
+	"example.com/rest/example/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/julienschmidt/httprouter"
+)
+
+var crudEndpoints map[string]*CrudAPI
+
+// CrudAPI describes requests available for tables in the database
+type CrudAPI struct {
+	Name            string           `json:"name"`
+	CreateURL       string           `json:"create_url"`
+	RetrieveOneURL  string           `json:"retrieve_one_url"`
+	RetrieveManyURL string           `json:"retrieve_many_url"`
+	UpdateURL       string           `json:"update_url"`
+	DeleteURL       string           `json:"delete_url"`
+	FetchDDLURL     string           `json:"fetch_ddl_url"`
+	TableInfo       *model.TableInfo `json:"table_info"`
+}
+
+// PagedResults results for pages GetAll results.
+type PagedResults struct {
+	Page         int64       `json:"page"`
+	PageSize     int64       `json:"page_size"`
+	Data         interface{} `json:"data"`
+	TotalRecords int         `json:"total_records"`
+	NextCursor   string      `json:"next_cursor,omitempty"`
+}
+
+// HTTPError example
+type HTTPError struct {
+	Code    int    `json:"code" example:"400"`
+	Message string `json:"message" example:"status bad request"`
+}
+
+// ConfigRouter configure http.Handler router
+func ConfigRouter() http.Handler {
+	router := httprouter.New()
+	configAlbumsRouter(router)
+	configArtistsRouter(router)
+	configCustomersRouter(router)
+	configEmployeesRouter(router)
+	configGenresRouter(router)
+	configInvoiceItemsRouter(router)
+	configInvoicesRouter(router)
+	configMediaTypesRouter(router)
+	configPlaylistTrackRouter(router)
+	configPlaylistsRouter(router)
+	configPurchaseOrderRouter(router)
+	configTracksRouter(router)
+
+	router.GET("/ddl/:argID", GetDdl)
+	router.GET("/ddl", GetDdlEndpoints)
+	return router
+}
+
+// ConfigGinRouter configure gin router
+func ConfigGinRouter(router gin.IRoutes) {
+	configGinAlbumsRouter(router)
+	configGinArtistsRouter(router)
+	configGinCustomersRouter(router)
+	configGinEmployeesRouter(router)
+	configGinGenresRouter(router)
+	configGinInvoiceItemsRouter(router)
+	configGinInvoicesRouter(router)
+	configGinMediaTypesRouter(router)
+	configGinPlaylistTrackRouter(router)
+	configGinPlaylistsRouter(router)
+	configGinPurchaseOrderRouter(router)
+	configGinTracksRouter(router)
+
+	router.GET("/ddl/:argID", ConverHttprouterToGin(GetDdl))
+	router.GET("/ddl", ConverHttprouterToGin(GetDdlEndpoints))
+	return
+}
+
+// ConverHttprouterToGin wrap httprouter.Handle to gin.HandlerFunc
+func ConverHttprouterToGin(f httprouter.Handle) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var params httprouter.Params
+		_len := len(c.Params)
+		if _len == 0 {
+			params = nil
+		} else {
+			params = ((*[1 << 10]httprouter.Param)(unsafe.Pointer(&c.Params[0])))[:_len]
+		}
+
+		f(c.Writer, c.Request, params)
+	}
+}
+
+func initializeContext(r *http.Request) (ctx context.Context) {
+	if ContextInitializer != nil {
+		ctx = ContextInitializer(r)
+	} else {
+		ctx = r.Context()
+	}
+	return ctx
+}
+
+func ValidateRequest(ctx context.Context, r *http.Request, table string, action model.Action) error {
+	if RequestValidator != nil {
+		return RequestValidator(ctx, r, table, action)
+	}
+
+	return nil
+}
+
+type RequestValidatorFunc func(ctx context.Context, r *http.Request, table string, action model.Action) error
+
+var RequestValidator RequestValidatorFunc
+
+type ContextInitializerFunc func(r *http.Request) (ctx context.Context)
+
+var ContextInitializer ContextInitializerFunc
+
+func readInt(r *http.Request, param string, v int64) (int64, error) {
+	p := r.FormValue(param)
+	if p == "" {
+		return v, nil
+	}
+
+	return strconv.ParseInt(p, 10, 64)
+}
+
+func readBool(r *http.Request, param string, v bool) (bool, error) {
+	p := r.FormValue(param)
+	if p == "" {
+		return v, nil
+	}
+
+	return strconv.ParseBool(p)
+}
+
+func writeJSON(ctx context.Context, w http.ResponseWriter, v interface{}) {
+	data, _ := json.Marshal(v)
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Write(data)
+}
+
+func writeRowsAffected(w http.ResponseWriter, rowsAffected int64) {
+	data, _ := json.Marshal(rowsAffected)
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Write(data)
+}
+
+// filterSetter is implemented by the filters of the tables
+type filterSetter interface {
+	Set(column, op string, values ...string) error
+}
+
+// readFilter set the conditions of the query parameters other than page, pagesize, order, cursor, count and
+// include_deleted on filter. A parameter <column> filters on equality, <column>__<op> on the operation op, the values
+// of in are comma separated.
+func readFilter(r *http.Request, filter filterSetter) error {
+	for name, values := range r.URL.Query() {
+		if name == "page" || name == "pagesize" || name == "order" || name == "cursor" || name == "count" || name == "include_deleted" {
+			continue
+		}
+
+		column, op := dao.SplitFilterParam(name)
+		if op == "in" && len(values) == 1 {
+			values = strings.Split(values[0], ",")
+		}
+		if err := filter.Set(column, op, values...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readJSON(r *http.Request, v interface{}) error {
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(buf, v)
+}
+
+func returnError(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) {
+	status := 0
+	switch err {
+	case dao.ErrNotFound:
+		status = http.StatusBadRequest
+	case dao.ErrUnableToMarshalJSON:
+		status = http.StatusBadRequest
+	case dao.ErrUpdateFailed:
+		status = http.StatusBadRequest
+	case dao.ErrInsertFailed:
+		status = http.StatusBadRequest
+	case dao.ErrDeleteFailed:
+		status = http.StatusBadRequest
+	case dao.ErrBadParams:
+		status = http.StatusBadRequest
+	default:
+		status = http.StatusBadRequest
+	}
+	er := HTTPError{
+		Code:    status,
+		Message: err.Error(),
+	}
+
+	SendJSON(w, r, er.Code, er)
+}
+
+// NewError example
+func NewError(ctx *gin.Context, status int, err error) {
+	er := HTTPError{
+		Code:    status,
+		Message: err.Error(),
+	}
+	ctx.JSON(status, er)
+}
+
+func parseUint8(ps httprouter.Params, key string) (uint8, error) {
+	idStr := ps.ByName(key)
+	id, err := strconv.ParseInt(idStr, 10, 8)
+	if err != nil {
+		return uint8(id), err
+	}
+	return uint8(id), err
+}
+func parseUint16(ps httprouter.Params, key string) (uint16, error) {
+	idStr := ps.ByName(key)
+	id, err := strconv.ParseInt(idStr, 10, 16)
+	if err != nil {
+		return uint16(id), err
+	}
+	return uint16(id), err
+}
+func parseUint32(ps httprouter.Params, key string) (uint32, error) {
+	idStr := ps.ByName(key)
+	id, err := strconv.ParseInt(idStr, 10, 32)
+	if err != nil {
+		return uint32(id), err
+	}
+	return uint32(id), err
+}
+func parseUint64(ps httprouter.Params, key string) (uint64, error) {
+	idStr := ps.ByName(key)
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return uint64(id), err
+	}
+	return uint64(id), err
+}
+func parseInt(ps httprouter.Params, key string) (int, error) {
+	idStr := ps.ByName(key)
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return -1, err
+	}
+	return int(id), err
+}
+func parseInt8(ps httprouter.Params, key string) (int8, error) {
+	idStr := ps.ByName(key)
+	id, err := strconv.ParseInt(idStr, 10, 8)
+	if err != nil {
+		return -1, err
+	}
+	return int8(id), err
+}
+func parseInt16(ps httprouter.Params, key string) (int16, error) {
+	idStr := ps.ByName(key)
+	id, err := strconv.ParseInt(idStr, 10, 16)
+	if err != nil {
+		return -1, err
+	}
+	return int16(id), err
+}
+func parseInt32(ps httprouter.Params, key string) (int32, error) {
+	idStr := ps.ByName(key)
+	id, err := strconv.ParseInt(idStr, 10, 32)
+	if err != nil {
+		return -1, err
+	}
+	return int32(id), err
+}
+func parseInt64(ps httprouter.Params, key string) (int64, error) {
+	idStr := ps.ByName(key)
+	id, err := strconv.ParseInt(idStr, 10, 54)
+	if err != nil {
+		return -1, err
+	}
+	return id, err
+}
+func parseString(ps httprouter.Params, key string) (string, error) {
+	idStr := ps.ByName(key)
+	return idStr, nil
+}
+func parseUUID(ps httprouter.Params, key string) (string, error) {
+	idStr := ps.ByName(key)
+	return idStr, nil
+}
+
+func parseBytes(ps httprouter.Params, key string) (string, error) {
+	idStr := ps.ByName(key)
+	return hex.DecodeString(idStr)
+}
+
+// GetDdl is a function to get table info for a table in the main database
+// @Summary Get table info for a table in the main database by argID
+// @Tags TableInfo
+// @ID argID
+// @Description GetDdl is a function to get table info for a table in the main database
+// @Accept  json
+// @Produce  json
+// @Param  argID path int true "id"
+// @Success 200 {object} api.CrudAPI
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /ddl/{argID} [get]
+// http "http://localhost:8080/ddl/xyz" X-Api-User:user123
+func GetDdl(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID := ps.ByName("argID")
+
+	if err := ValidateRequest(ctx, r, "ddl", model.FetchDDL); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, ok := crudEndpoints[argID]
+	if !ok {
+		returnError(ctx, w, r, fmt.Errorf("unable to find table: %s", argID))
+		return
+	}
+
+	writeJSON(ctx, w, record)
+}
+
+// GetDdlEndpoints is a function to get a list of ddl endpoints available for tables in the main database
+// @Summary Gets a list of ddl endpoints available for tables in the main database
+// @Tags TableInfo
+// @Description GetDdlEndpoints is a function to get a list of ddl endpoints available for tables in the main database
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} api.CrudAPI
+// @Router /ddl [get]
+// http "http://localhost:8080/ddl" X-Api-User:user123
+func GetDdlEndpoints(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	if err := ValidateRequest(ctx, r, "ddl", model.FetchDDL); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, crudEndpoints)
+}
+
+func init() {
+	crudEndpoints = make(map[string]*CrudAPI)
+
+	var tmp *CrudAPI
+
+	tmp = &CrudAPI{
+		Name:            "albums",
+		CreateURL:       "/albums",
+		RetrieveOneURL:  "/albums",
+		RetrieveManyURL: "/albums",
+		UpdateURL:       "/albums",
+		DeleteURL:       "/albums",
+		FetchDDLURL:     "/ddl/albums",
+	}
+
+	tmp.TableInfo, _ = model.GetTableInfo("albums")
+	crudEndpoints["albums"] = tmp
+
+	tmp = &CrudAPI{
+		Name:            "artists",
+		CreateURL:       "/artists",
+		RetrieveOneURL:  "/artists",
+		RetrieveManyURL: "/artists",
+		UpdateURL:       "/artists",
+		DeleteURL:       "/artists",
+		FetchDDLURL:     "/ddl/artists",
+	}
+
+	tmp.TableInfo, _ = model.GetTableInfo("artists")
+	crudEndpoints["artists"] = tmp
+
+	tmp = &CrudAPI{
+		Name:            "customers",
+		CreateURL:       "/customers",
+		RetrieveOneURL:  "/customers",
+		RetrieveManyURL: "/customers",
+		UpdateURL:       "/customers",
+		DeleteURL:       "/customers",
+		FetchDDLURL:     "/ddl/customers",
+	}
+
+	tmp.TableInfo, _ = model.GetTableInfo("customers")
+	crudEndpoints["customers"] = tmp
+
+	tmp = &CrudAPI{
+		Name:            "employees",
+		CreateURL:       "/employees",
+		RetrieveOneURL:  "/employees",
+		RetrieveManyURL: "/employees",
+		UpdateURL:       "/employees",
+		DeleteURL:       "/employees",
+		FetchDDLURL:     "/ddl/employees",
+	}
+
+	tmp.TableInfo, _ = model.GetTableInfo("employees")
+	crudEndpoints["employees"] = tmp
+
+	tmp = &CrudAPI{
+		Name:            "genres",
+		CreateURL:       "/genres",
+		RetrieveOneURL:  "/genres",
+		RetrieveManyURL: "/genres",
+		UpdateURL:       "/genres",
+		DeleteURL:       "/genres",
+		FetchDDLURL:     "/ddl/genres",
+	}
+
+	tmp.TableInfo, _ = model.GetTableInfo("genres")
+	crudEndpoints["genres"] = tmp
+
+	tmp = &CrudAPI{
+		Name:            "invoice_items",
+		CreateURL:       "/invoiceitems",
+		RetrieveOneURL:  "/invoiceitems",
+		RetrieveManyURL: "/invoiceitems",
+		UpdateURL:       "/invoiceitems",
+		DeleteURL:       "/invoiceitems",
+		FetchDDLURL:     "/ddl/invoice_items",
+	}
+
+	tmp.TableInfo, _ = model.GetTableInfo("invoice_items")
+	crudEndpoints["invoice_items"] = tmp
+
+	tmp = &CrudAPI{
+		Name:            "invoices",
+		CreateURL:       "/invoices",
+		RetrieveOneURL:  "/invoices",
+		RetrieveManyURL: "/invoices",
+		UpdateURL:       "/invoices",
+		DeleteURL:       "/invoices",
+		FetchDDLURL:     "/ddl/invoices",
+	}
+
+	tmp.TableInfo, _ = model.GetTableInfo("invoices")
+	crudEndpoints["invoices"] = tmp
+
+	tmp = &CrudAPI{
+		Name:            "media_types",
+		CreateURL:       "/mediatypes",
+		RetrieveOneURL:  "/mediatypes",
+		RetrieveManyURL: "/mediatypes",
+		UpdateURL:       "/mediatypes",
+		DeleteURL:       "/mediatypes",
+		FetchDDLURL:     "/ddl/media_types",
+	}
+
+	tmp.TableInfo, _ = model.GetTableInfo("media_types")
+	crudEndpoints["media_types"] = tmp
+
+	tmp = &CrudAPI{
+		Name:            "playlist_track",
+		CreateURL:       "/playlisttrack",
+		RetrieveOneURL:  "/playlisttrack",
+		RetrieveManyURL: "/playlisttrack",
+		UpdateURL:       "/playlisttrack",
+		DeleteURL:       "/playlisttrack",
+		FetchDDLURL:     "/ddl/playlist_track",
+	}
+
+	tmp.TableInfo, _ = model.GetTableInfo("playlist_track")
+	crudEndpoints["playlist_track"] = tmp
+
+	tmp = &CrudAPI{
+		Name:            "playlists",
+		CreateURL:       "/playlists",
+		RetrieveOneURL:  "/playlists",
+		RetrieveManyURL: "/playlists",
+		UpdateURL:       "/playlists",
+		DeleteURL:       "/playlists",
+		FetchDDLURL:     "/ddl/playlists",
+	}
+
+	tmp.TableInfo, _ = model.GetTableInfo("playlists")
+	crudEndpoints["playlists"] = tmp
+
+	tmp = &CrudAPI{
+		Name:            "purchase_order",
+		CreateURL:       "/purchaseorder",
+		RetrieveOneURL:  "/purchaseorder",
+		RetrieveManyURL: "/purchaseorder",
+		UpdateURL:       "/purchaseorder",
+		DeleteURL:       "/purchaseorder",
+		FetchDDLURL:     "/ddl/purchase_order",
+	}
+
+	tmp.TableInfo, _ = model.GetTableInfo("purchase_order")
+	crudEndpoints["purchase_order"] = tmp
+
+	tmp = &CrudAPI{
+		Name:            "tracks",
+		CreateURL:       "/tracks",
+		RetrieveOneURL:  "/tracks",
+		RetrieveManyURL: "/tracks",
+		UpdateURL:       "/tracks",
+		DeleteURL:       "/tracks",
+		FetchDDLURL:     "/ddl/tracks",
+	}
+
+	tmp.TableInfo, _ = model.GetTableInfo("tracks")
+	crudEndpoints["tracks"] = tmp
+
+}
@@ -0,0 +1,446 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"example.com/rest/example/dao"
+	"example.com/rest/example/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guregu/null"
+	"github.com/julienschmidt/httprouter"
+)
+
+func configTracksRouter(router *httprouter.Router) {
+	router.GET("/tracks", GetAllTracks)
+	router.POST("/tracks", AddTracks)
+	router.POST("/tracks/upsert", UpsertTracks)
+	router.POST("/tracks/bulk", BulkAddTracks)
+	router.GET("/tracks/:argTrackID", GetTracks)
+	router.PUT("/tracks/:argTrackID", UpdateTracks)
+	router.PATCH("/tracks/:argTrackID", PatchTracks)
+	router.DELETE("/tracks/:argTrackID", DeleteTracks)
+}
+
+func configGinTracksRouter(router gin.IRoutes) {
+	router.GET("/tracks", ConverHttprouterToGin(GetAllTracks))
+	router.POST("/tracks", ConverHttprouterToGin(AddTracks))
+	router.POST("/tracks/upsert", ConverHttprouterToGin(UpsertTracks))
+	router.POST("/tracks/bulk", ConverHttprouterToGin(BulkAddTracks))
+	router.GET("/tracks/:argTrackID", ConverHttprouterToGin(GetTracks))
+	router.PUT("/tracks/:argTrackID", ConverHttprouterToGin(UpdateTracks))
+	router.PATCH("/tracks/:argTrackID", ConverHttprouterToGin(PatchTracks))
+	router.DELETE("/tracks/:argTrackID", ConverHttprouterToGin(DeleteTracks))
+}
+
+// GetAllTracks is a function to get a slice of record(s) from tracks table in the main database
+// @Summary Get list of Tracks
+// @Tags Tracks
+// @Description GetAllTracks is a handler to get a slice of record(s) from tracks table in the main database
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   order    query    string  false        "comma separated json names of the sort columns, descending if prefixed by -, e.g. col1,-col2"
+// @Param   cursor   query    string  false        "next_cursor of the previous page, empty for the first page, selects keyset pagination and ignores page"
+// @Param   count    query    string  false        "with a cursor, exact to count the records matching the filter, estimate to estimate the records of the table"
+// @Param   track_id query int false "filter on TrackId, track_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   name query string false "filter on Name, name__<op> with op one of eq, in, null, like, ilike"
+// @Param   album_id query int false "filter on AlbumId, album_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   media_type_id query int false "filter on MediaTypeId, media_type_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   genre_id query int false "filter on GenreId, genre_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   composer query string false "filter on Composer, composer__<op> with op one of eq, in, null, like, ilike"
+// @Param   milliseconds query int false "filter on Milliseconds, milliseconds__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   bytes query int false "filter on Bytes, bytes__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   unit_price query float64 false "filter on UnitPrice, unit_price__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Success 200 {object} api.PagedResults{data=[]model.Tracks}
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /tracks [get]
+// http "http://localhost:8080/tracks?page=0&pagesize=20" X-Api-User:user123
+func GetAllTracks(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	order := r.FormValue("order")
+
+	filter := &dao.TracksFilter{}
+	if err := readFilter(r, filter); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "tracks", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if _, ok := r.URL.Query()["cursor"]; ok {
+		count := dao.CountMode(r.FormValue("count"))
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+
+		records, nextCursor, totalRows, err := dao.GetPageTracks(ctx, r.FormValue("cursor"), pagesize, order, filter, count)
+		if err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+
+		result := &PagedResults{PageSize: pagesize, Data: records, TotalRecords: totalRows, NextCursor: nextCursor}
+		writeJSON(ctx, w, result)
+		return
+	}
+
+	records, totalRows, err := dao.GetAllTracks(ctx, page, pagesize, order, filter)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	writeJSON(ctx, w, result)
+}
+
+// GetTracks is a function to get a single record from the tracks table in the main database
+// @Summary Get record from table Tracks by  argTrackID
+// @Tags Tracks
+// @ID argTrackID
+// @Description GetTracks is a function to get a single record from the tracks table in the main database
+// @Accept  json
+// @Produce  json
+// @Param  argTrackID path int true "TrackId"
+// @Success 200 {object} model.Tracks
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /tracks/{argTrackID} [get]
+// http "http://localhost:8080/tracks/1" X-Api-User:user123
+func GetTracks(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argTrackID, err := parseInt32(ps, "argTrackID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "tracks", model.RetrieveOne); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, err := dao.GetTracks(ctx, argTrackID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, record)
+}
+
+// AddTracks add to add a single record to tracks table in the main database
+// @Summary Add an record to tracks table
+// @Description add to add a single record to tracks table in the main database
+// @Tags Tracks
+// @Accept  json
+// @Produce  json
+// @Param Tracks body model.Tracks true "Add Tracks"
+// @Success 200 {object} model.Tracks
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /tracks [post]
+// echo '{"track_id": 44,"name": "IfdZQdIFMTwCphCqKtZXPPdGV","album_id": 29,"media_type_id": 63,"genre_id": 13,"composer": "xBXbVJLIlmfjiLJPxQQtEZDRk","milliseconds": 8,"bytes": 51,"unit_price": 0.4293248187238436}' | http POST "http://localhost:8080/tracks" X-Api-User:user123
+func AddTracks(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	tracks := &model.Tracks{}
+
+	if err := readJSON(r, tracks); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := tracks.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	tracks.Prepare()
+
+	if err := tracks.Validate(model.Create); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "tracks", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var err error
+	tracks, _, err = dao.AddTracks(ctx, tracks)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, tracks)
+}
+
+// UpsertTracks add or update records of tracks table in the main database in one transaction
+// @Summary Add or update records of tracks table
+// @Description add the records of a json array to tracks table in the main database, updating the records with the same conflict columns
+// @Tags Tracks
+// @Accept  json
+// @Produce  json
+// @Param   on query string false "comma separated json names of the columns of a unique constraint, the primary key if empty"
+// @Param Tracks body []model.Tracks true "Upsert Tracks"
+// @Success 200 {array} model.Tracks
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /tracks/upsert [post]
+// echo '[{"track_id": 44,"name": "IfdZQdIFMTwCphCqKtZXPPdGV","album_id": 29,"media_type_id": 63,"genre_id": 13,"composer": "xBXbVJLIlmfjiLJPxQQtEZDRk","milliseconds": 8,"bytes": 51,"unit_price": 0.4293248187238436}]' | http POST "http://localhost:8080/tracks/upsert" X-Api-User:user123
+func UpsertTracks(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Tracks
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "tracks", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var conflict []string
+	if on := r.FormValue("on"); on != "" {
+		conflict = strings.Split(on, ",")
+	}
+
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, _, err := dao.UpsertTracks(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+		}
+		return nil
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// BulkAddTracks add records to tracks table in the main database in one transaction
+// @Summary Add records to tracks table
+// @Description add the records of a json array to tracks table in the main database with multi row inserts
+// @Tags Tracks
+// @Accept  json
+// @Produce  json
+// @Param   batch_size query int false "number of records inserted by each statement (defaults to dao.DefaultBatchSize)"
+// @Param Tracks body []model.Tracks true "Add Tracks"
+// @Success 200 {array} model.Tracks
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /tracks/bulk [post]
+// echo '[{"track_id": 44,"name": "IfdZQdIFMTwCphCqKtZXPPdGV","album_id": 29,"media_type_id": 63,"genre_id": 13,"composer": "xBXbVJLIlmfjiLJPxQQtEZDRk","milliseconds": 8,"bytes": 51,"unit_price": 0.4293248187238436}]' | http POST "http://localhost:8080/tracks/bulk" X-Api-User:user123
+func BulkAddTracks(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Tracks
+
+	batchSize, err := readInt(r, "batch_size", 0)
+	if err != nil || batchSize < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "tracks", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		records, _, err = dao.BulkAddTracks(ctx, records, int(batchSize))
+		return err
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// UpdateTracks Update a single record from tracks table in the main database
+// @Summary Update an record in table tracks
+// @Description Update a single record from tracks table in the main database
+// @Tags Tracks
+// @Accept  json
+// @Produce  json
+// @Param  argTrackID path int true "TrackId"
+// @Param  Tracks body model.Tracks true "Update Tracks record"
+// @Success 200 {object} model.Tracks
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /tracks/{argTrackID} [put]
+// echo '{"track_id": 44,"name": "IfdZQdIFMTwCphCqKtZXPPdGV","album_id": 29,"media_type_id": 63,"genre_id": 13,"composer": "xBXbVJLIlmfjiLJPxQQtEZDRk","milliseconds": 8,"bytes": 51,"unit_price": 0.4293248187238436}' | http PUT "http://localhost:8080/tracks/1"  X-Api-User:user123
+func UpdateTracks(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argTrackID, err := parseInt32(ps, "argTrackID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	tracks := &model.Tracks{}
+	if err := readJSON(r, tracks); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := tracks.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	tracks.Prepare()
+
+	if err := tracks.Validate(model.Update); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "tracks", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	tracks, _, err = dao.UpdateTracks(ctx,
+		argTrackID,
+		tracks)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, tracks)
+}
+
+// PatchTracks update the fields of a JSON merge patch of a single record from tracks table in the main database
+// @Summary Patch an record in table tracks
+// @Description Update the fields of a JSON merge patch (RFC 7396) of a single record from tracks table in the main database, zero values are written and null sets a field to null
+// @Tags Tracks
+// @Accept  json
+// @Produce  json
+// @Param  argTrackID path int true "TrackId"
+// @Param  Tracks body object true "JSON merge patch of the Tracks record"
+// @Success 200 {object} model.Tracks
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /tracks/{argTrackID} [patch]
+// echo '{"track_id": 44,"name": "IfdZQdIFMTwCphCqKtZXPPdGV","album_id": 29,"media_type_id": 63,"genre_id": 13,"composer": "xBXbVJLIlmfjiLJPxQQtEZDRk","milliseconds": 8,"bytes": 51,"unit_price": 0.4293248187238436}' | http PATCH "http://localhost:8080/tracks/1"  X-Api-User:user123
+func PatchTracks(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argTrackID, err := parseInt32(ps, "argTrackID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := readJSON(r, &patch); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "tracks", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	tracks, _, err := dao.PatchTracks(ctx, argTrackID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, tracks)
+}
+
+// DeleteTracks Delete a single record from tracks table in the main database
+// @Summary Delete a record from tracks
+// @Description Delete a single record from tracks table in the main database
+// @Tags Tracks
+// @Accept  json
+// @Produce  json
+// @Param  argTrackID path int true "TrackId"
+// @Success 204 {object} model.Tracks
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /tracks/{argTrackID} [delete]
+// http DELETE "http://localhost:8080/tracks/1" X-Api-User:user123
+func DeleteTracks(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argTrackID, err := parseInt32(ps, "argTrackID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "tracks", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	rowsAffected, err := dao.DeleteTracks(ctx, argTrackID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeRowsAffected(w, rowsAffected)
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	_ "github.com/jinzhu/gorm/dialects/mssql"
+	_ "github.com/jinzhu/gorm/dialects/mysql"
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+
+	"github.com/droundy/goopt"
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	"github.com/swaggo/files"       // swagger embed files
+	"github.com/swaggo/gin-swagger" // gin-swagger middleware
+
+	"example.com/rest/example/api"
+	"example.com/rest/example/dao"
+	_ "example.com/rest/example/docs"
+	"example.com/rest/example/model"
+)
+
+const UserKey = "user" // UserKey key used for storing User struct in context
+
+var (
+	// BuildDate date string of when build was performed filled in by -X compile flag
+	BuildDate string
+
+	// LatestCommit date string of when build was performed filled in by -X compile flag
+	LatestCommit string
+
+	// BuildNumber date string of when build was performed filled in by -X compile flag
+	BuildNumber string
+
+	// BuiltOnIP date string of when build was performed filled in by -X compile flag
+	BuiltOnIP string
+
+	// BuiltOnOs date string of when build was performed filled in by -X compile flag
+	BuiltOnOs string
+
+	// RuntimeVer date string of when build was performed filled in by -X compile flag
+	RuntimeVer string
+
+	// OsSignal signal used to shutdown
+	OsSignal chan os.Signal
+)
+
+// User struct to store info in context
+type User struct {
+	Name string
+}
+
+func (u *User) String() string {
+	return u.Name
+}
+
+// GinServer launch gin server
+func GinServer() (err error) {
+	url := ginSwagger.URL("http://localhost:8080/swagger/doc.json") // The url pointing to API definition
+
+	router := gin.Default()
+	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, url))
+
+	api.ConfigGinRouter(router)
+	router.Run(":8080")
+	if err != nil {
+		log.Fatalf("Error starting server, the error is '%v'", err)
+	}
+
+	return
+}
+
+// @title Swagger Example API
+// @version 1.0
+// @description This is a sample server Petstore server.
+// @termsOfService
+
+// @contact.name
+// @contact.url
+// @contact.email
+
+// @license.name Apache 2.0
+// @license.url http://www.apache.org/licenses/LICENSE-2.0.html
+
+// @host localhost:8080
+// @BasePath /
+func main() {
+	OsSignal = make(chan os.Signal, 1)
+
+	// Define version information
+	goopt.Version = fmt.Sprintf(
+		`Application build information
+  Build date      : %s
+  Build number    : %s
+  Git commit      : %s
+  Runtime version : %s
+  Built on OS     : %s
+`, BuildDate, BuildNumber, LatestCommit, RuntimeVer, BuiltOnOs)
+	goopt.Parse(nil)
+
+	db, err := gorm.Open("sqlite3", "./sample.db")
+	if err != nil {
+		log.Fatalf("Got error when connect database, the error is '%v'", err)
+	}
+
+	db.LogMode(true)
+	dao.DB = db
+
+	db.AutoMigrate(
+		&model.Albums{},
+		&model.Artists{},
+		&model.Customers{},
+		&model.Employees{},
+		&model.Genres{},
+		&model.InvoiceItems{},
+		&model.Invoices{},
+		&model.MediaTypes{},
+		&model.PlaylistTrack{},
+		&model.Playlists{},
+		&model.PurchaseOrder{},
+		&model.Tracks{},
+	)
+
+	dao.Logger = func(ctx context.Context, sql string) {
+		fmt.Printf("SQL: %s\n", sql)
+	}
+	dao.Actor = UserName
+	api.ContextInitializer = InitializeContext
+
+	go GinServer()
+	LoopForever()
+}
+
+// InitializeContext create the context of a request, storing the User from the X-Api-User header
+func InitializeContext(r *http.Request) (ctx context.Context) {
+	ctx = r.Context()
+
+	val, ok := r.Header["X-Api-User"]
+	if ok && len(val) > 0 {
+		ctx = context.WithValue(ctx, UserKey, &User{Name: val[0]})
+	}
+	return ctx
+}
+
+// UserFromContext retrieve a User from Context if available
+func UserFromContext(ctx context.Context) (*User, bool) {
+	u, ok := ctx.Value(UserKey).(*User)
+	return u, ok
+}
+
+// UserName retrieve the name of the User from Context if available, the actor of the dao audit columns
+func UserName(ctx context.Context) (string, bool) {
+	u, ok := UserFromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	return u.Name, true
+}
+
+// LoopForever on signal processing
+func LoopForever() {
+	fmt.Printf("Entering infinite loop\n")
+
+	signal.Notify(OsSignal, syscall.SIGINT, syscall.SIGTERM, syscall.SIGUSR1)
+	_ = <-OsSignal
+
+	fmt.Printf("Exiting infinite loop received OsSignal\n")
+
+}
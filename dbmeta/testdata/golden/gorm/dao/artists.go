@@ -0,0 +1,254 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"example.com/rest/example/model"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"gorm.io/gorm/clause"
+)
+
+var (
+	_ = time.Second
+	_ = null.Bool{}
+	_ = uuid.UUID{}
+)
+
+// GetAllArtists is a function to get a slice of record(s) from artists table in the main database
+// params - page     - page requested (defaults to 0)
+// params - pagesize - number of records in a page  (defaults to 20)
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -
+// params - filter   - conditions on the columns, nil for all records
+// error - ErrNotFound, db Find error
+func GetAllArtists(ctx context.Context, page, pagesize int, order string, filter *ArtistsFilter) (results []*model.Artists, totalRows int64, err error) {
+
+	resultOrm := Conn(ctx, DB).Model(&model.Artists{})
+	if where, args := filter.where(resultOrm.Dialector.Name()); where != "" {
+		resultOrm = resultOrm.Where(where, args...)
+	}
+	resultOrm.Count(&totalRows)
+
+	if page > 0 {
+		offset := (page - 1) * pagesize
+		resultOrm = resultOrm.Offset(offset).Limit(pagesize)
+	} else {
+		resultOrm = resultOrm.Limit(pagesize)
+	}
+
+	orderBy, err := ArtistsOrderBy(resultOrm.Dialector.Name(), order)
+	if err != nil {
+		return nil, -1, err
+	}
+	if orderBy != "" {
+		resultOrm = resultOrm.Order(orderBy)
+	}
+
+	if err = resultOrm.Find(&results).Error; err != nil {
+		err = ErrNotFound
+		return nil, -1, err
+	}
+
+	return results, totalRows, nil
+}
+
+// GetPageArtists is a function to get a page of record(s) from artists table in the main database with keyset pagination
+// params - cursor   - next cursor returned by the previous page, empty for the first page
+// params - pagesize - number of records in a page
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -, must be the order of the previous page
+// params - filter   - conditions on the columns, nil for all records
+// params - count    - CountNone, CountExact or CountEstimate
+// error - cursor or order error, ErrNotFound
+func GetPageArtists(ctx context.Context, cursor string, pagesize int, order string, filter *ArtistsFilter, count CountMode) (results []*model.Artists, nextCursor string, totalRows int64, err error) {
+	db := Conn(ctx, DB)
+	keyset, err := newArtistsKeyset(db.Dialector.Name(), order, cursor)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	filterWhere, filterArgs := filter.where(db.Dialector.Name())
+
+	totalRows = -1
+	switch count {
+	case CountExact:
+		countOrm := db.Model(&model.Artists{})
+		if filterWhere != "" {
+			countOrm = countOrm.Where(filterWhere, filterArgs...)
+		}
+		err = countOrm.Count(&totalRows).Error
+	case CountEstimate:
+		totalRows, err = EstimateRowCount(db, "artists")
+	}
+	if err != nil {
+		return nil, "", -2, err
+	}
+
+	resultOrm := db.Model(&model.Artists{})
+	if where, args := keyset.where(filterWhere, filterArgs); where != "" {
+		resultOrm = resultOrm.Where(where, args...)
+	}
+	resultOrm = resultOrm.Order(keyset.orderBy()).Limit(pagesize)
+
+	if err = resultOrm.Find(&results).Error; err != nil {
+		err = ErrNotFound
+		return nil, "", -1, err
+	}
+
+	if pagesize > 0 && len(results) == pagesize {
+		last := results[len(results)-1]
+		nextCursor, err = keyset.cursor(func(column string) interface{} { return artistsValue(last, column) })
+		if err != nil {
+			return nil, "", -1, err
+		}
+	}
+
+	return results, nextCursor, totalRows, nil
+}
+
+// GetArtists is a function to get a single record from the artists table in the main database
+// error - ErrNotFound, db Find error
+func GetArtists(ctx context.Context, argArtistID int32) (record *model.Artists, err error) {
+	record = &model.Artists{}
+	if err = Conn(ctx, DB).First(record, argArtistID).Error; err != nil {
+		err = ErrNotFound
+		return record, err
+	}
+
+	return record, nil
+}
+
+// AddArtists is a function to add a single record to artists table in the main database
+// error - ErrInsertFailed, db save call failed
+func AddArtists(ctx context.Context, record *model.Artists) (result *model.Artists, RowsAffected int64, err error) {
+	db := Conn(ctx, DB).Create(record)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrInsertFailed
+	}
+
+	return record, db.RowsAffected, nil
+}
+
+// UpsertArtists is a function to add a single record to artists table in the main database, or update the record with the same conflict columns
+// params - conflict - json names of the columns of a unique constraint, the primary key if empty
+// error - unknown conflict column, ErrInsertFailed
+func UpsertArtists(ctx context.Context, record *model.Artists, conflict ...string) (result *model.Artists, RowsAffected int64, err error) {
+	keyColumns := artistsPrimaryKeys
+	if len(conflict) > 0 {
+		if keyColumns, err = conflictColumns(conflict, artistsColumns); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	onConflict := clause.OnConflict{UpdateAll: true}
+	for _, column := range keyColumns {
+		onConflict.Columns = append(onConflict.Columns, clause.Column{Name: column})
+	}
+
+	db := Conn(ctx, DB).Clauses(onConflict).Create(record)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrInsertFailed
+	}
+
+	return record, db.RowsAffected, nil
+}
+
+// BulkAddArtists is a function to add records to artists table in the main database with multi row inserts of batchSize records
+// params - batchSize - number of records inserted by each statement, DefaultBatchSize if not positive
+// The generated keys are set on the records by gorm. Use WithTx to add all or none of the records.
+// error - ErrInsertFailed
+func BulkAddArtists(ctx context.Context, records []*model.Artists, batchSize int) (results []*model.Artists, RowsAffected int64, err error) {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	db := Conn(ctx, DB).CreateInBatches(records, batchSize)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrInsertFailed
+	}
+
+	return records, db.RowsAffected, nil
+}
+
+// UpdateArtists is a function to update a single record from artists table in the main database
+// error - ErrNotFound, db record for id not found
+// error - ErrUpdateFailed, db meta data copy failed or db.Save call failed
+func UpdateArtists(ctx context.Context, argArtistID int32, updated *model.Artists) (result *model.Artists, RowsAffected int64, err error) {
+
+	result = &model.Artists{}
+	db := Conn(ctx, DB).First(result, "ArtistId = ?", argArtistID)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrNotFound
+	}
+
+	if err = Copy(result, updated); err != nil {
+		return nil, -1, ErrUpdateFailed
+	}
+
+	db = db.Save(result)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrUpdateFailed
+	}
+
+	return result, db.RowsAffected, nil
+}
+
+// PatchArtists is a function to update the columns of a JSON merge patch, keyed by json name, of a single record from artists table in the main database
+// Zero values are written and null sets a column to NULL.
+// error - unknown, read only or not nullable patch field, ErrNotFound, ErrUpdateFailed
+func PatchArtists(ctx context.Context, argArtistID int32, patch map[string]json.RawMessage) (result *model.Artists, RowsAffected int64, err error) {
+	columns, values, err := artistsPatch(patch, &model.Artists{})
+	if err != nil {
+		return nil, -1, err
+	}
+
+	result = &model.Artists{}
+	db := Conn(ctx, DB).First(result, "ArtistId = ?", argArtistID)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrNotFound
+	}
+
+	if len(columns) == 0 {
+		return result, 0, nil
+	}
+
+	// a map updates the zero values a struct would skip
+	updates := make(map[string]interface{}, len(columns))
+	for i, column := range columns {
+		updates[column] = values[i]
+	}
+
+	db = Conn(ctx, DB).Model(result).Updates(updates)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrUpdateFailed
+	}
+	RowsAffected = db.RowsAffected
+
+	result = &model.Artists{}
+	if err = Conn(ctx, DB).First(result, "ArtistId = ?", argArtistID).Error; err != nil {
+		return nil, -1, ErrNotFound
+	}
+	return result, RowsAffected, nil
+}
+
+// DeleteArtists is a function to delete a single record from artists table in the main database
+// error - ErrNotFound, db Find error
+// error - ErrDeleteFailed, db Delete failed error
+func DeleteArtists(ctx context.Context, argArtistID int32) (rowsAffected int64, err error) {
+
+	record := &model.Artists{}
+	db := Conn(ctx, DB).First(record, "ArtistId = ?", argArtistID)
+	if db.Error != nil {
+		return -1, ErrNotFound
+	}
+
+	db = db.Delete(record)
+	if err = db.Error; err != nil {
+		return -1, ErrDeleteFailed
+	}
+
+	return db.RowsAffected, nil
+}
@@ -0,0 +1,151 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm"
+)
+
+// BuildInfo is used to define the application build info, and inject values into via the build process.
+type BuildInfo struct {
+
+	// BuildDate date string of when build was performed filled in by -X compile flag
+	BuildDate string
+
+	// LatestCommit date string of when build was performed filled in by -X compile flag
+	LatestCommit string
+
+	// BuildNumber date string of when build was performed filled in by -X compile flag
+	BuildNumber string
+
+	// BuiltOnIP date string of when build was performed filled in by -X compile flag
+	BuiltOnIP string
+
+	// BuiltOnOs date string of when build was performed filled in by -X compile flag
+	BuiltOnOs string
+
+	// RuntimeVer date string of when build was performed filled in by -X compile flag
+	RuntimeVer string
+}
+
+type LogSql func(ctx context.Context, sql string)
+
+var (
+	// ErrNotFound error when record not found
+	ErrNotFound = fmt.Errorf("record Not Found")
+
+	// ErrUnableToMarshalJSON error when json payload corrupt
+	ErrUnableToMarshalJSON = fmt.Errorf("json payload corrupt")
+
+	// ErrUpdateFailed error when update fails
+	ErrUpdateFailed = fmt.Errorf("db update error")
+
+	// ErrInsertFailed error when insert fails
+	ErrInsertFailed = fmt.Errorf("db insert error")
+
+	// ErrDeleteFailed error when delete fails
+	ErrDeleteFailed = fmt.Errorf("db delete error")
+
+	// ErrBadParams error when bad params passed in
+	ErrBadParams = fmt.Errorf("bad params error")
+
+	// DB reference to database
+	DB *gorm.DB
+
+	// AppBuildInfo reference to build info
+	AppBuildInfo *BuildInfo
+
+	// Logger function that will be invoked before executing sql
+	Logger LogSql
+
+	// Actor function returning the user acting in a context, written to the created by and updated by audit columns,
+	// the columns are cleared if it is nil or returns false
+	Actor func(ctx context.Context) (actor string, ok bool)
+)
+
+// Copy a src struct into a destination struct
+func Copy(dst interface{}, src interface{}) error {
+	dstV := reflect.Indirect(reflect.ValueOf(dst))
+	srcV := reflect.Indirect(reflect.ValueOf(src))
+
+	if !dstV.CanAddr() {
+		return errors.New("copy to value is unaddressable")
+	}
+
+	if srcV.Type() != dstV.Type() {
+		return errors.New("different types can not be copied")
+	}
+
+	for i := 0; i < dstV.NumField(); i++ {
+		f := srcV.Field(i)
+		if !isZeroOfUnderlyingType(f.Interface()) {
+			dstV.Field(i).Set(f)
+		}
+	}
+
+	return nil
+}
+
+func isZeroOfUnderlyingType(x interface{}) bool {
+	return x == nil || reflect.DeepEqual(x, reflect.Zero(reflect.TypeOf(x)).Interface())
+}
+
+type txKey struct{}
+
+// txContext the transaction of db stored in the context by RunInTx
+type txContext struct {
+	db *gorm.DB
+	tx *gorm.DB
+}
+
+// Conn return the transaction of db stored in ctx by WithTx or RunInTx, db if there is none
+func Conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if t, ok := ctx.Value(txKey{}).(*txContext); ok && t.db == db {
+		return t.tx
+	}
+	return db
+}
+
+// EstimateRowCount return the number of rows of a table estimated from the catalog statistics of the database, the
+// exact number of rows if the database has no statistics or the table was never analyzed
+func EstimateRowCount(db *gorm.DB, tableName string) (int64, error) {
+	var cnt sql.NullInt64
+	if query := DialectFor(db.Dialector.Name()).EstimateRowCountSQL(); query != "" {
+		if err := db.Raw(query, tableName).Row().Scan(&cnt); err != nil {
+			return -1, err
+		}
+	}
+	if cnt.Valid && cnt.Int64 >= 0 {
+		return cnt.Int64, nil
+	}
+
+	var totalRows int64
+	err := db.Table(tableName).Count(&totalRows).Error
+	return totalRows, err
+}
+
+// TxRunner run fn in a transaction, like WithTx on the package DB
+type TxRunner func(ctx context.Context, fn func(ctx context.Context) error) error
+
+// WithTx run fn in a transaction of the package DB, see RunInTx
+func WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return RunInTx(ctx, DB, fn)
+}
+
+// RunInTx run fn in a transaction of db. The dao functions called with the context passed to fn use the transaction,
+// which is committed when fn returns nil and rolled back when fn returns an error or panics. A nested call runs fn in
+// a savepoint, rolled back when fn fails, unless nested transactions are disabled in the gorm config. Without a
+// database handle, e.g. with fake repositories, fn runs without a transaction.
+func RunInTx(ctx context.Context, db *gorm.DB, fn func(ctx context.Context) error) error {
+	if db == nil {
+		return fn(ctx)
+	}
+
+	return Conn(ctx, db).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, &txContext{db: db, tx: tx}))
+	})
+}
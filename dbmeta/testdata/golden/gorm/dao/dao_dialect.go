@@ -0,0 +1,462 @@
+package dao
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Dialect hides the SQL differences between databases. The dao functions build their queries with the dialect of the
+// driver of their connection, see DialectFor.
+type Dialect interface {
+	// Name return the name of the database
+	Name() string
+
+	// Quote quote a table or column name
+	Quote(identifier string) string
+
+	// Placeholder return the placeholder of the n-th parameter of a query, starting at 1
+	Placeholder(n int) string
+
+	// Paginate append to an ordered query the clause selecting limit rows after offset rows
+	Paginate(query string, offset, limit int64) string
+
+	// Returning return the clauses making an insert return columns, output goes before VALUES and returning at the
+	// end of the statement, both are empty if the database cannot return the inserted row
+	Returning(columns []string) (output, returning string)
+
+	// Upsert return an insert of columns, updating updateColumns of the row with the same keyColumns if it exists
+	Upsert(table string, columns, keyColumns, updateColumns []string) string
+
+	// LastInsertID return how the auto increment key of an inserted row is read
+	LastInsertID() LastInsertIDStrategy
+
+	// ILike return the case insensitive LIKE condition on a column
+	ILike(column string) string
+
+	// EstimateRowCountSQL return the query estimating the number of rows of a table from the catalog statistics, with
+	// the table name as parameter, empty if the database has no statistics
+	EstimateRowCountSQL() string
+
+	// Now return the current time at the precision of the default time type of the database, the value of the audit
+	// time columns
+	Now() time.Time
+
+	// Savepoint return the statements creating, rolling back to and releasing the savepoint name of a nested
+	// transaction, release is empty if the database releases savepoints with the transaction and all are empty if it
+	// has no savepoints
+	Savepoint(name string) (create, rollback, release string)
+}
+
+// LastInsertIDStrategy how the auto increment key of an inserted row is read
+type LastInsertIDStrategy int
+
+const (
+	// LastInsertIDResult read the key with LastInsertId of the sql.Result of the insert
+	LastInsertIDResult LastInsertIDStrategy = iota
+
+	// LastInsertIDReturning read the key from the row returned by the insert, see Dialect.Returning
+	LastInsertIDReturning
+)
+
+var (
+	// Dialects the dialects keyed by database driver name, register a dialect at startup to use another driver
+	Dialects = map[string]Dialect{
+		"mysql":     MySQLDialect{},
+		"postgres":  PostgresDialect{},
+		"pgx":       PostgresDialect{},
+		"sqlite":    SQLiteDialect{},
+		"sqlite3":   SQLiteDialect{},
+		"mssql":     SQLServerDialect{},
+		"sqlserver": SQLServerDialect{AtPlaceholders: true},
+	}
+
+	// DefaultBatchSize the number of records inserted by each statement of the BulkAdd functions when their batchSize
+	// is not positive
+	DefaultBatchSize = 100
+)
+
+// DialectFor return the dialect of a database driver, MySQLDialect for an unregistered driver
+func DialectFor(driverName string) Dialect {
+	if d, ok := Dialects[driverName]; ok {
+		return d
+	}
+	return MySQLDialect{}
+}
+
+// MySQLDialect is the Dialect of MySQL and MariaDB
+type MySQLDialect struct{}
+
+// Name return mysql
+func (MySQLDialect) Name() string { return "mysql" }
+
+// Quote quote name with backticks
+func (MySQLDialect) Quote(name string) string {
+	return "`" + strings.Replace(name, "`", "``", -1) + "`"
+}
+
+// Placeholder return ?
+func (MySQLDialect) Placeholder(n int) string { return "?" }
+
+// Paginate append LIMIT offset, limit
+func (MySQLDialect) Paginate(query string, offset, limit int64) string {
+	return fmt.Sprintf("%s LIMIT %d, %d", query, offset, limit)
+}
+
+// Returning return empty clauses, MySQL cannot return the inserted row
+func (MySQLDialect) Returning(columns []string) (output, returning string) { return "", "" }
+
+// Upsert return an INSERT ... ON DUPLICATE KEY UPDATE
+func (d MySQLDialect) Upsert(table string, columns, keyColumns, updateColumns []string) string {
+	var set []string
+	for _, column := range updateColumns {
+		set = append(set, fmt.Sprintf("%s = VALUES(%s)", d.Quote(column), d.Quote(column)))
+	}
+	if len(set) == 0 {
+		set = append(set, fmt.Sprintf("%s = %s", d.Quote(keyColumns[0]), d.Quote(keyColumns[0])))
+	}
+	return InsertSQL(d, table, columns, nil) + " ON DUPLICATE KEY UPDATE " + strings.Join(set, ", ")
+}
+
+// LastInsertID return LastInsertIDResult
+func (MySQLDialect) LastInsertID() LastInsertIDStrategy { return LastInsertIDResult }
+
+// ILike return LOWER(column) LIKE LOWER(?)
+func (d MySQLDialect) ILike(column string) string {
+	return "LOWER(" + d.Quote(column) + ") LIKE LOWER(?)"
+}
+
+// EstimateRowCountSQL return the query of table_rows in information_schema
+func (MySQLDialect) EstimateRowCountSQL() string {
+	return "SELECT table_rows FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?"
+}
+
+// Now return the current time truncated to the second of DATETIME and TIMESTAMP
+func (MySQLDialect) Now() time.Time { return time.Now().Truncate(time.Second) }
+
+// Savepoint return SAVEPOINT, ROLLBACK TO SAVEPOINT and RELEASE SAVEPOINT
+func (MySQLDialect) Savepoint(name string) (create, rollback, release string) {
+	return "SAVEPOINT " + name, "ROLLBACK TO SAVEPOINT " + name, "RELEASE SAVEPOINT " + name
+}
+
+// PostgresDialect is the Dialect of PostgreSQL
+type PostgresDialect struct{}
+
+// Name return postgres
+func (PostgresDialect) Name() string { return "postgres" }
+
+// Quote quote name with double quotes
+func (PostgresDialect) Quote(name string) string {
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
+
+// Placeholder return $n
+func (PostgresDialect) Placeholder(n int) string { return fmt.Sprintf("$%d", n) }
+
+// Paginate append OFFSET offset LIMIT limit
+func (PostgresDialect) Paginate(query string, offset, limit int64) string {
+	return fmt.Sprintf("%s OFFSET %d LIMIT %d", query, offset, limit)
+}
+
+// Returning return a RETURNING clause
+func (d PostgresDialect) Returning(columns []string) (output, returning string) {
+	return "", "RETURNING " + quoteColumns(d, columns, "")
+}
+
+// Upsert return an INSERT ... ON CONFLICT DO UPDATE
+func (d PostgresDialect) Upsert(table string, columns, keyColumns, updateColumns []string) string {
+	return InsertSQL(d, table, columns, nil) + onConflict(d, keyColumns, updateColumns)
+}
+
+// LastInsertID return LastInsertIDReturning
+func (PostgresDialect) LastInsertID() LastInsertIDStrategy { return LastInsertIDReturning }
+
+// ILike return column ILIKE ?
+func (d PostgresDialect) ILike(column string) string {
+	return d.Quote(column) + " ILIKE ?"
+}
+
+// EstimateRowCountSQL return the query of reltuples in pg_class
+func (PostgresDialect) EstimateRowCountSQL() string {
+	return "SELECT reltuples::bigint FROM pg_class WHERE relname = ?"
+}
+
+// Now return the current time truncated to the microsecond of timestamp
+func (PostgresDialect) Now() time.Time { return time.Now().Truncate(time.Microsecond) }
+
+// Savepoint return SAVEPOINT, ROLLBACK TO SAVEPOINT and RELEASE SAVEPOINT
+func (PostgresDialect) Savepoint(name string) (create, rollback, release string) {
+	return "SAVEPOINT " + name, "ROLLBACK TO SAVEPOINT " + name, "RELEASE SAVEPOINT " + name
+}
+
+// SQLiteDialect is the Dialect of SQLite
+type SQLiteDialect struct{}
+
+// Name return sqlite3
+func (SQLiteDialect) Name() string { return "sqlite3" }
+
+// Quote quote name with double quotes
+func (SQLiteDialect) Quote(name string) string {
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
+
+// Placeholder return ?
+func (SQLiteDialect) Placeholder(n int) string { return "?" }
+
+// Paginate append LIMIT limit OFFSET offset
+func (SQLiteDialect) Paginate(query string, offset, limit int64) string {
+	return fmt.Sprintf("%s LIMIT %d OFFSET %d", query, limit, offset)
+}
+
+// Returning return a RETURNING clause, available since SQLite 3.35
+func (d SQLiteDialect) Returning(columns []string) (output, returning string) {
+	return "", "RETURNING " + quoteColumns(d, columns, "")
+}
+
+// Upsert return an INSERT ... ON CONFLICT DO UPDATE
+func (d SQLiteDialect) Upsert(table string, columns, keyColumns, updateColumns []string) string {
+	return InsertSQL(d, table, columns, nil) + onConflict(d, keyColumns, updateColumns)
+}
+
+// LastInsertID return LastInsertIDResult
+func (SQLiteDialect) LastInsertID() LastInsertIDStrategy { return LastInsertIDResult }
+
+// ILike return LOWER(column) LIKE LOWER(?)
+func (d SQLiteDialect) ILike(column string) string {
+	return "LOWER(" + d.Quote(column) + ") LIKE LOWER(?)"
+}
+
+// EstimateRowCountSQL return an empty query, SQLite has no row count statistics
+func (SQLiteDialect) EstimateRowCountSQL() string { return "" }
+
+// Now return the current time, SQLite stores times as text with nanoseconds
+func (SQLiteDialect) Now() time.Time { return time.Now() }
+
+// Savepoint return SAVEPOINT, ROLLBACK TO SAVEPOINT and RELEASE SAVEPOINT
+func (SQLiteDialect) Savepoint(name string) (create, rollback, release string) {
+	return "SAVEPOINT " + name, "ROLLBACK TO SAVEPOINT " + name, "RELEASE SAVEPOINT " + name
+}
+
+// SQLServerDialect is the Dialect of Microsoft SQL Server
+type SQLServerDialect struct {
+	// AtPlaceholders use @p1, @p2, ... placeholders required by the sqlserver driver instead of ?
+	AtPlaceholders bool
+}
+
+// Name return mssql
+func (SQLServerDialect) Name() string { return "mssql" }
+
+// Quote quote name with brackets
+func (SQLServerDialect) Quote(name string) string {
+	return "[" + strings.Replace(name, "]", "]]", -1) + "]"
+}
+
+// Placeholder return @pn or ?
+func (d SQLServerDialect) Placeholder(n int) string {
+	if d.AtPlaceholders {
+		return fmt.Sprintf("@p%d", n)
+	}
+	return "?"
+}
+
+// Paginate append OFFSET offset ROWS FETCH FIRST limit ROWS ONLY
+func (SQLServerDialect) Paginate(query string, offset, limit int64) string {
+	return fmt.Sprintf("%s OFFSET %d ROWS FETCH FIRST %d ROWS ONLY", query, offset, limit)
+}
+
+// Returning return an OUTPUT clause
+func (d SQLServerDialect) Returning(columns []string) (output, returning string) {
+	return "OUTPUT " + quoteColumns(d, columns, "INSERTED."), ""
+}
+
+// Upsert return a MERGE
+func (d SQLServerDialect) Upsert(table string, columns, keyColumns, updateColumns []string) string {
+	var on, set, values []string
+	for _, column := range keyColumns {
+		on = append(on, fmt.Sprintf("target.%s = source.%s", d.Quote(column), d.Quote(column)))
+	}
+	for _, column := range updateColumns {
+		set = append(set, fmt.Sprintf("target.%s = source.%s", d.Quote(column), d.Quote(column)))
+	}
+	for range columns {
+		values = append(values, "?")
+	}
+
+	query := fmt.Sprintf("MERGE INTO %s WITH (HOLDLOCK) AS target USING (VALUES (%s)) AS source (%s) ON %s",
+		d.Quote(table), strings.Join(values, ", "), quoteColumns(d, columns, ""), strings.Join(on, " AND "))
+	if len(set) > 0 {
+		query = query + " WHEN MATCHED THEN UPDATE SET " + strings.Join(set, ", ")
+	}
+	return query + fmt.Sprintf(" WHEN NOT MATCHED THEN INSERT (%s) VALUES (%s);",
+		quoteColumns(d, columns, ""), quoteColumns(d, columns, "source."))
+}
+
+// LastInsertID return LastInsertIDReturning
+func (SQLServerDialect) LastInsertID() LastInsertIDStrategy { return LastInsertIDReturning }
+
+// ILike return LOWER(column) LIKE LOWER(?)
+func (d SQLServerDialect) ILike(column string) string {
+	return "LOWER(" + d.Quote(column) + ") LIKE LOWER(?)"
+}
+
+// EstimateRowCountSQL return the query of row_count in sys.dm_db_partition_stats
+func (SQLServerDialect) EstimateRowCountSQL() string {
+	return "SELECT SUM(row_count) FROM sys.dm_db_partition_stats WHERE object_id = OBJECT_ID(?) AND index_id < 2"
+}
+
+// Now return the current time truncated to the 100 nanoseconds of datetime2
+func (SQLServerDialect) Now() time.Time { return time.Now().Truncate(100 * time.Nanosecond) }
+
+// Savepoint return SAVE TRANSACTION and ROLLBACK TRANSACTION, SQL Server releases savepoints with the transaction
+func (SQLServerDialect) Savepoint(name string) (create, rollback, release string) {
+	return "SAVE TRANSACTION " + name, "ROLLBACK TRANSACTION " + name, ""
+}
+
+// Rebind replace the ? placeholders of query by the placeholders of the dialect
+func Rebind(d Dialect, query string) string {
+	if d.Placeholder(1) == "?" {
+		return query
+	}
+
+	buf := &strings.Builder{}
+	n := 0
+	for _, c := range query {
+		if c == '?' {
+			n++
+			buf.WriteString(d.Placeholder(n))
+			continue
+		}
+		buf.WriteRune(c)
+	}
+	return buf.String()
+}
+
+// SelectSQL return the select of all columns of table, where is added unless it is empty
+func SelectSQL(d Dialect, table, where string) string {
+	query := "SELECT * FROM " + d.Quote(table)
+	if where != "" {
+		query = query + " WHERE " + where
+	}
+	return query
+}
+
+// InsertSQL return the insert of columns in table returning the returning columns, see Dialect.Returning
+func InsertSQL(d Dialect, table string, columns, returning []string) string {
+	return BulkInsertSQL(d, table, columns, 1, returning)
+}
+
+// BulkInsertSQL return the insert of rows rows of columns in table returning the returning columns of each row, see
+// Dialect.Returning
+func BulkInsertSQL(d Dialect, table string, columns []string, rows int, returning []string) string {
+	values := make([]string, rows)
+	for i := range values {
+		values[i] = "(" + strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ") + ")"
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (%s)", d.Quote(table), quoteColumns(d, columns, ""))
+	if len(returning) == 0 {
+		return query + " VALUES " + strings.Join(values, ", ")
+	}
+
+	output, returningClause := d.Returning(returning)
+	if output != "" {
+		query = query + " " + output
+	}
+	query = query + " VALUES " + strings.Join(values, ", ")
+	if returningClause != "" {
+		query = query + " " + returningClause
+	}
+	return query
+}
+
+// UpdateSQL return the update of columns of the row of table with the key columns, the key values follow the column
+// values in the args
+func UpdateSQL(d Dialect, table string, columns, keyColumns []string) string {
+	set := make([]string, len(columns))
+	for i, column := range columns {
+		set[i] = d.Quote(column) + " = ?"
+	}
+	return fmt.Sprintf("UPDATE %s SET %s WHERE %s", d.Quote(table), strings.Join(set, ", "), KeyWhere(d, keyColumns))
+}
+
+// DeleteSQL return the delete of the row of table with the key columns
+func DeleteSQL(d Dialect, table string, keyColumns []string) string {
+	return fmt.Sprintf("DELETE FROM %s WHERE %s", d.Quote(table), KeyWhere(d, keyColumns))
+}
+
+// SoftDeleteSQL return the update setting the soft delete column of the row of table with the key columns, the
+// deletion time precedes the key values, rows already soft deleted are not updated
+func SoftDeleteSQL(d Dialect, table, column string, keyColumns []string) string {
+	return UpdateSQL(d, table, []string{column}, keyColumns) + " AND " + d.Quote(column) + " IS NULL"
+}
+
+// RestoreSQL return the update clearing the soft delete column of the row of table with the key columns, rows that
+// are not soft deleted are not updated
+func RestoreSQL(d Dialect, table, column string, keyColumns []string) string {
+	return fmt.Sprintf("UPDATE %s SET %s = NULL WHERE %s AND %s IS NOT NULL", d.Quote(table), d.Quote(column), KeyWhere(d, keyColumns), d.Quote(column))
+}
+
+// KeyWhere return the where clause selecting the row with the key columns
+func KeyWhere(d Dialect, keyColumns []string) string {
+	conditions := make([]string, len(keyColumns))
+	for i, column := range keyColumns {
+		conditions[i] = d.Quote(column) + " = ?"
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+// quoteColumns return the comma separated quoted columns, each prefixed by prefix
+func quoteColumns(d Dialect, columns []string, prefix string) string {
+	quoted := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = prefix + d.Quote(column)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// onConflict return the ON CONFLICT clause of an upsert on PostgreSQL or SQLite
+func onConflict(d Dialect, keyColumns, updateColumns []string) string {
+	if len(updateColumns) == 0 {
+		return fmt.Sprintf(" ON CONFLICT (%s) DO NOTHING", quoteColumns(d, keyColumns, ""))
+	}
+
+	set := make([]string, len(updateColumns))
+	for i, column := range updateColumns {
+		set[i] = fmt.Sprintf("%s = EXCLUDED.%s", d.Quote(column), d.Quote(column))
+	}
+	return fmt.Sprintf(" ON CONFLICT (%s) DO UPDATE SET %s", quoteColumns(d, keyColumns, ""), strings.Join(set, ", "))
+}
+
+// conflictColumns return the columns of the json names in conflict, the unique constraint of an upsert
+func conflictColumns(conflict []string, columns map[string]string) ([]string, error) {
+	keyColumns := make([]string, len(conflict))
+	for i, name := range conflict {
+		column, ok := columns[name]
+		if !ok {
+			return nil, fmt.Errorf("upsert: unknown column %s", name)
+		}
+		keyColumns[i] = column
+	}
+	return keyColumns, nil
+}
+
+// exceptColumns return the columns not in except
+func exceptColumns(columns, except []string) []string {
+	var result []string
+	for _, column := range columns {
+		if !containsColumn(except, column) {
+			result = append(result, column)
+		}
+	}
+	return result
+}
+
+// containsColumn return true if columns contains column
+func containsColumn(columns []string, column string) bool {
+	for _, c := range columns {
+		if c == column {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,284 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"example.com/rest/example/model"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"gorm.io/gorm/clause"
+)
+
+var (
+	_ = time.Second
+	_ = null.Bool{}
+	_ = uuid.UUID{}
+)
+
+// GetAllEmployees is a function to get a slice of record(s) from employees table in the main database
+// params - page     - page requested (defaults to 0)
+// params - pagesize - number of records in a page  (defaults to 20)
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -
+// params - filter   - conditions on the columns, nil for all records
+// error - ErrNotFound, db Find error
+func GetAllEmployees(ctx context.Context, page, pagesize int, order string, filter *EmployeesFilter) (results []*model.Employees, totalRows int64, err error) {
+
+	resultOrm := Conn(ctx, DB).Model(&model.Employees{})
+	if where, args := filter.where(resultOrm.Dialector.Name()); where != "" {
+		resultOrm = resultOrm.Where(where, args...)
+	}
+	resultOrm.Count(&totalRows)
+
+	if page > 0 {
+		offset := (page - 1) * pagesize
+		resultOrm = resultOrm.Offset(offset).Limit(pagesize)
+	} else {
+		resultOrm = resultOrm.Limit(pagesize)
+	}
+
+	orderBy, err := EmployeesOrderBy(resultOrm.Dialector.Name(), order)
+	if err != nil {
+		return nil, -1, err
+	}
+	if orderBy != "" {
+		resultOrm = resultOrm.Order(orderBy)
+	}
+
+	if err = resultOrm.Find(&results).Error; err != nil {
+		err = ErrNotFound
+		return nil, -1, err
+	}
+
+	return results, totalRows, nil
+}
+
+// GetPageEmployees is a function to get a page of record(s) from employees table in the main database with keyset pagination
+// params - cursor   - next cursor returned by the previous page, empty for the first page
+// params - pagesize - number of records in a page
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -, must be the order of the previous page
+// params - filter   - conditions on the columns, nil for all records
+// params - count    - CountNone, CountExact or CountEstimate
+// error - cursor or order error, ErrNotFound
+func GetPageEmployees(ctx context.Context, cursor string, pagesize int, order string, filter *EmployeesFilter, count CountMode) (results []*model.Employees, nextCursor string, totalRows int64, err error) {
+	db := Conn(ctx, DB)
+	keyset, err := newEmployeesKeyset(db.Dialector.Name(), order, cursor)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	filterWhere, filterArgs := filter.where(db.Dialector.Name())
+
+	totalRows = -1
+	switch count {
+	case CountExact:
+		countOrm := db.Model(&model.Employees{})
+		if filterWhere != "" {
+			countOrm = countOrm.Where(filterWhere, filterArgs...)
+		}
+		err = countOrm.Count(&totalRows).Error
+	case CountEstimate:
+		totalRows, err = EstimateRowCount(db, "employees")
+	}
+	if err != nil {
+		return nil, "", -2, err
+	}
+
+	resultOrm := db.Model(&model.Employees{})
+	if where, args := keyset.where(filterWhere, filterArgs); where != "" {
+		resultOrm = resultOrm.Where(where, args...)
+	}
+	resultOrm = resultOrm.Order(keyset.orderBy()).Limit(pagesize)
+
+	if err = resultOrm.Find(&results).Error; err != nil {
+		err = ErrNotFound
+		return nil, "", -1, err
+	}
+
+	if pagesize > 0 && len(results) == pagesize {
+		last := results[len(results)-1]
+		nextCursor, err = keyset.cursor(func(column string) interface{} { return employeesValue(last, column) })
+		if err != nil {
+			return nil, "", -1, err
+		}
+	}
+
+	return results, nextCursor, totalRows, nil
+}
+
+// GetEmployees is a function to get a single record from the employees table in the main database
+// soft deleted records are not returned
+// error - ErrNotFound, db Find error
+func GetEmployees(ctx context.Context, argEmployeeID int32) (record *model.Employees, err error) {
+	record = &model.Employees{}
+	db := Conn(ctx, DB)
+	db = db.Where(QuoteIdentifier(db.Dialector.Name(), "HireDate") + " IS NULL")
+	if err = db.First(record, argEmployeeID).Error; err != nil {
+		err = ErrNotFound
+		return record, err
+	}
+
+	return record, nil
+}
+
+// AddEmployees is a function to add a single record to employees table in the main database
+// error - ErrInsertFailed, db save call failed
+func AddEmployees(ctx context.Context, record *model.Employees) (result *model.Employees, RowsAffected int64, err error) {
+	db := Conn(ctx, DB).Create(record)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrInsertFailed
+	}
+
+	return record, db.RowsAffected, nil
+}
+
+// UpsertEmployees is a function to add a single record to employees table in the main database, or update the record with the same conflict columns
+// params - conflict - json names of the columns of a unique constraint, the primary key if empty
+// error - unknown conflict column, ErrInsertFailed
+func UpsertEmployees(ctx context.Context, record *model.Employees, conflict ...string) (result *model.Employees, RowsAffected int64, err error) {
+	keyColumns := employeesPrimaryKeys
+	if len(conflict) > 0 {
+		if keyColumns, err = conflictColumns(conflict, employeesColumns); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	// an existing record keeps its soft delete column
+	updateColumns := []string{"LastName", "FirstName", "Title", "ReportsTo", "BirthDate", "Address", "City", "State", "Country", "PostalCode", "Phone", "Fax", "Email"}
+	onConflict := clause.OnConflict{DoUpdates: clause.AssignmentColumns(exceptColumns(updateColumns, keyColumns))}
+	for _, column := range keyColumns {
+		onConflict.Columns = append(onConflict.Columns, clause.Column{Name: column})
+	}
+
+	db := Conn(ctx, DB).Clauses(onConflict).Create(record)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrInsertFailed
+	}
+
+	return record, db.RowsAffected, nil
+}
+
+// BulkAddEmployees is a function to add records to employees table in the main database with multi row inserts of batchSize records
+// params - batchSize - number of records inserted by each statement, DefaultBatchSize if not positive
+// The generated keys are set on the records by gorm. Use WithTx to add all or none of the records.
+// error - ErrInsertFailed
+func BulkAddEmployees(ctx context.Context, records []*model.Employees, batchSize int) (results []*model.Employees, RowsAffected int64, err error) {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	db := Conn(ctx, DB).CreateInBatches(records, batchSize)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrInsertFailed
+	}
+
+	return records, db.RowsAffected, nil
+}
+
+// UpdateEmployees is a function to update a single record from employees table in the main database
+// error - ErrNotFound, db record for id not found
+// Soft deleted records are not updated and HireDate keeps its value, see DeleteEmployees and RestoreEmployees.
+// error - ErrUpdateFailed, db meta data copy failed or db.Save call failed
+func UpdateEmployees(ctx context.Context, argEmployeeID int32, updated *model.Employees) (result *model.Employees, RowsAffected int64, err error) {
+
+	result = &model.Employees{}
+	db := Conn(ctx, DB)
+	db = db.Where(QuoteIdentifier(db.Dialector.Name(), "HireDate")+" IS NULL").First(result, "EmployeeId = ?", argEmployeeID)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrNotFound
+	}
+	hireDate := result.HireDate
+
+	if err = Copy(result, updated); err != nil {
+		return nil, -1, ErrUpdateFailed
+	}
+	result.HireDate = hireDate
+
+	// Select("*") writes the zero values like Save, records soft deleted since the lookup are not updated
+	db = Conn(ctx, DB).Model(result).Where(QuoteIdentifier(db.Dialector.Name(), "HireDate") + " IS NULL").Select("*").Updates(result)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrUpdateFailed
+	}
+
+	return result, db.RowsAffected, nil
+}
+
+// PatchEmployees is a function to update the columns of a JSON merge patch, keyed by json name, of a single record from employees table in the main database
+// Zero values are written and null sets a column to NULL.
+// Soft deleted records are not updated, see RestoreEmployees.
+// error - unknown, read only or not nullable patch field, ErrNotFound, ErrUpdateFailed
+func PatchEmployees(ctx context.Context, argEmployeeID int32, patch map[string]json.RawMessage) (result *model.Employees, RowsAffected int64, err error) {
+	columns, values, err := employeesPatch(patch, &model.Employees{})
+	if err != nil {
+		return nil, -1, err
+	}
+
+	result = &model.Employees{}
+	db := Conn(ctx, DB)
+	notDeletedWhere := QuoteIdentifier(db.Dialector.Name(), "HireDate") + " IS NULL"
+	db = db.Where(notDeletedWhere).First(result, "EmployeeId = ?", argEmployeeID)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrNotFound
+	}
+
+	if len(columns) == 0 {
+		return result, 0, nil
+	}
+
+	// a map updates the zero values a struct would skip
+	updates := make(map[string]interface{}, len(columns))
+	for i, column := range columns {
+		updates[column] = values[i]
+	}
+
+	db = Conn(ctx, DB).Model(result).Where(notDeletedWhere).Updates(updates)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrUpdateFailed
+	}
+	RowsAffected = db.RowsAffected
+
+	result = &model.Employees{}
+	if err = Conn(ctx, DB).First(result, "EmployeeId = ?", argEmployeeID).Error; err != nil {
+		return nil, -1, ErrNotFound
+	}
+	return result, RowsAffected, nil
+}
+
+// DeleteEmployees is a function to soft delete a single record from employees table in the main database
+// by setting HireDate, see RestoreEmployees
+// error - ErrNotFound, db Find error
+// error - ErrDeleteFailed, db Delete failed error
+func DeleteEmployees(ctx context.Context, argEmployeeID int32) (rowsAffected int64, err error) {
+
+	record := &model.Employees{}
+	db := Conn(ctx, DB)
+	db = db.Where(QuoteIdentifier(db.Dialector.Name(), "HireDate")+" IS NULL").First(record, "EmployeeId = ?", argEmployeeID)
+	if db.Error != nil {
+		return -1, ErrNotFound
+	}
+
+	db = Conn(ctx, DB).Model(record).Update("HireDate", DialectFor(db.Dialector.Name()).Now())
+	if err = db.Error; err != nil {
+		return -1, ErrDeleteFailed
+	}
+
+	return db.RowsAffected, nil
+}
+
+// RestoreEmployees is a function to restore a single record soft deleted from employees table in the main database
+// by clearing HireDate, rowsAffected is 0 if the record is not soft deleted
+// error - ErrUpdateFailed, db Update failed error
+func RestoreEmployees(ctx context.Context, argEmployeeID int32) (rowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	db = db.Model(&model.Employees{}).Where("EmployeeId = ?", argEmployeeID).
+		Where(QuoteIdentifier(db.Dialector.Name(), "HireDate")+" IS NOT NULL").
+		Update("HireDate", nil)
+	if err = db.Error; err != nil {
+		return -1, ErrUpdateFailed
+	}
+
+	return db.RowsAffected, nil
+}
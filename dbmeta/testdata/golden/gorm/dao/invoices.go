@@ -0,0 +1,274 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"example.com/rest/example/model"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"gorm.io/gorm/clause"
+)
+
+var (
+	_ = time.Second
+	_ = null.Bool{}
+	_ = uuid.UUID{}
+)
+
+// GetAllInvoices is a function to get a slice of record(s) from invoices table in the main database
+// params - page     - page requested (defaults to 0)
+// params - pagesize - number of records in a page  (defaults to 20)
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -
+// params - filter   - conditions on the columns, nil for all records
+// error - ErrNotFound, db Find error
+func GetAllInvoices(ctx context.Context, page, pagesize int, order string, filter *InvoicesFilter) (results []*model.Invoices, totalRows int64, err error) {
+
+	resultOrm := Conn(ctx, DB).Model(&model.Invoices{})
+	if where, args := filter.where(resultOrm.Dialector.Name()); where != "" {
+		resultOrm = resultOrm.Where(where, args...)
+	}
+	resultOrm.Count(&totalRows)
+
+	if page > 0 {
+		offset := (page - 1) * pagesize
+		resultOrm = resultOrm.Offset(offset).Limit(pagesize)
+	} else {
+		resultOrm = resultOrm.Limit(pagesize)
+	}
+
+	orderBy, err := InvoicesOrderBy(resultOrm.Dialector.Name(), order)
+	if err != nil {
+		return nil, -1, err
+	}
+	if orderBy != "" {
+		resultOrm = resultOrm.Order(orderBy)
+	}
+
+	if err = resultOrm.Find(&results).Error; err != nil {
+		err = ErrNotFound
+		return nil, -1, err
+	}
+
+	return results, totalRows, nil
+}
+
+// GetPageInvoices is a function to get a page of record(s) from invoices table in the main database with keyset pagination
+// params - cursor   - next cursor returned by the previous page, empty for the first page
+// params - pagesize - number of records in a page
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -, must be the order of the previous page
+// params - filter   - conditions on the columns, nil for all records
+// params - count    - CountNone, CountExact or CountEstimate
+// error - cursor or order error, ErrNotFound
+func GetPageInvoices(ctx context.Context, cursor string, pagesize int, order string, filter *InvoicesFilter, count CountMode) (results []*model.Invoices, nextCursor string, totalRows int64, err error) {
+	db := Conn(ctx, DB)
+	keyset, err := newInvoicesKeyset(db.Dialector.Name(), order, cursor)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	filterWhere, filterArgs := filter.where(db.Dialector.Name())
+
+	totalRows = -1
+	switch count {
+	case CountExact:
+		countOrm := db.Model(&model.Invoices{})
+		if filterWhere != "" {
+			countOrm = countOrm.Where(filterWhere, filterArgs...)
+		}
+		err = countOrm.Count(&totalRows).Error
+	case CountEstimate:
+		totalRows, err = EstimateRowCount(db, "invoices")
+	}
+	if err != nil {
+		return nil, "", -2, err
+	}
+
+	resultOrm := db.Model(&model.Invoices{})
+	if where, args := keyset.where(filterWhere, filterArgs); where != "" {
+		resultOrm = resultOrm.Where(where, args...)
+	}
+	resultOrm = resultOrm.Order(keyset.orderBy()).Limit(pagesize)
+
+	if err = resultOrm.Find(&results).Error; err != nil {
+		err = ErrNotFound
+		return nil, "", -1, err
+	}
+
+	if pagesize > 0 && len(results) == pagesize {
+		last := results[len(results)-1]
+		nextCursor, err = keyset.cursor(func(column string) interface{} { return invoicesValue(last, column) })
+		if err != nil {
+			return nil, "", -1, err
+		}
+	}
+
+	return results, nextCursor, totalRows, nil
+}
+
+// GetInvoices is a function to get a single record from the invoices table in the main database
+// error - ErrNotFound, db Find error
+func GetInvoices(ctx context.Context, argInvoiceID int32) (record *model.Invoices, err error) {
+	record = &model.Invoices{}
+	if err = Conn(ctx, DB).First(record, argInvoiceID).Error; err != nil {
+		err = ErrNotFound
+		return record, err
+	}
+
+	return record, nil
+}
+
+// AddInvoices is a function to add a single record to invoices table in the main database
+// The audit columns of record are set, see invoicesAudit.
+// error - ErrInsertFailed, db save call failed
+func AddInvoices(ctx context.Context, record *model.Invoices) (result *model.Invoices, RowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	invoicesAudit(ctx, db.Dialector.Name(), record, true)
+	db = db.Create(record)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrInsertFailed
+	}
+
+	return record, db.RowsAffected, nil
+}
+
+// UpsertInvoices is a function to add a single record to invoices table in the main database, or update the record with the same conflict columns
+// params - conflict - json names of the columns of a unique constraint, the primary key if empty
+// error - unknown conflict column, ErrInsertFailed
+func UpsertInvoices(ctx context.Context, record *model.Invoices, conflict ...string) (result *model.Invoices, RowsAffected int64, err error) {
+	keyColumns := invoicesPrimaryKeys
+	if len(conflict) > 0 {
+		if keyColumns, err = conflictColumns(conflict, invoicesColumns); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	// an existing record keeps its created audit columns
+	updateColumns := []string{"CustomerId", "BillingAddress", "BillingCity", "BillingState", "BillingCountry", "BillingPostalCode", "Total"}
+	onConflict := clause.OnConflict{DoUpdates: clause.AssignmentColumns(exceptColumns(updateColumns, keyColumns))}
+	for _, column := range keyColumns {
+		onConflict.Columns = append(onConflict.Columns, clause.Column{Name: column})
+	}
+
+	db := Conn(ctx, DB)
+	invoicesAudit(ctx, db.Dialector.Name(), record, true)
+	db = db.Clauses(onConflict).Create(record)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrInsertFailed
+	}
+
+	return record, db.RowsAffected, nil
+}
+
+// BulkAddInvoices is a function to add records to invoices table in the main database with multi row inserts of batchSize records
+// params - batchSize - number of records inserted by each statement, DefaultBatchSize if not positive
+// The generated keys are set on the records by gorm. Use WithTx to add all or none of the records.
+// error - ErrInsertFailed
+func BulkAddInvoices(ctx context.Context, records []*model.Invoices, batchSize int) (results []*model.Invoices, RowsAffected int64, err error) {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	db := Conn(ctx, DB)
+	for _, record := range records {
+		invoicesAudit(ctx, db.Dialector.Name(), record, true)
+	}
+	db = db.CreateInBatches(records, batchSize)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrInsertFailed
+	}
+
+	return records, db.RowsAffected, nil
+}
+
+// UpdateInvoices is a function to update a single record from invoices table in the main database
+// error - ErrNotFound, db record for id not found
+// The audit columns are set, the created audit columns keep their values.
+// error - ErrUpdateFailed, db meta data copy failed or db.Save call failed
+func UpdateInvoices(ctx context.Context, argInvoiceID int32, updated *model.Invoices) (result *model.Invoices, RowsAffected int64, err error) {
+
+	result = &model.Invoices{}
+	db := Conn(ctx, DB).First(result, "InvoiceId = ?", argInvoiceID)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrNotFound
+	}
+	invoiceDate := result.InvoiceDate
+
+	if err = Copy(result, updated); err != nil {
+		return nil, -1, ErrUpdateFailed
+	}
+	result.InvoiceDate = invoiceDate
+	invoicesAudit(ctx, db.Dialector.Name(), result, false)
+
+	db = db.Save(result)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrUpdateFailed
+	}
+
+	return result, db.RowsAffected, nil
+}
+
+// PatchInvoices is a function to update the columns of a JSON merge patch, keyed by json name, of a single record from invoices table in the main database
+// Zero values are written and null sets a column to NULL.
+// error - unknown, read only or not nullable patch field, ErrNotFound, ErrUpdateFailed
+func PatchInvoices(ctx context.Context, argInvoiceID int32, patch map[string]json.RawMessage) (result *model.Invoices, RowsAffected int64, err error) {
+	columns, values, err := invoicesPatch(patch, &model.Invoices{})
+	if err != nil {
+		return nil, -1, err
+	}
+
+	result = &model.Invoices{}
+	db := Conn(ctx, DB).First(result, "InvoiceId = ?", argInvoiceID)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrNotFound
+	}
+
+	if len(columns) == 0 {
+		return result, 0, nil
+	}
+
+	// a map updates the zero values a struct would skip
+	updates := make(map[string]interface{}, len(columns))
+	for i, column := range columns {
+		updates[column] = values[i]
+	}
+
+	auditColumns, auditValues := invoicesAudit(ctx, db.Dialector.Name(), &model.Invoices{}, false)
+	for i, column := range auditColumns {
+		updates[column] = auditValues[i]
+	}
+
+	db = Conn(ctx, DB).Model(result).Updates(updates)
+	if err = db.Error; err != nil {
+		return nil, -1, ErrUpdateFailed
+	}
+	RowsAffected = db.RowsAffected
+
+	result = &model.Invoices{}
+	if err = Conn(ctx, DB).First(result, "InvoiceId = ?", argInvoiceID).Error; err != nil {
+		return nil, -1, ErrNotFound
+	}
+	return result, RowsAffected, nil
+}
+
+// DeleteInvoices is a function to delete a single record from invoices table in the main database
+// error - ErrNotFound, db Find error
+// error - ErrDeleteFailed, db Delete failed error
+func DeleteInvoices(ctx context.Context, argInvoiceID int32) (rowsAffected int64, err error) {
+
+	record := &model.Invoices{}
+	db := Conn(ctx, DB).First(record, "InvoiceId = ?", argInvoiceID)
+	if db.Error != nil {
+		return -1, ErrNotFound
+	}
+
+	db = db.Delete(record)
+	if err = db.Error; err != nil {
+		return -1, ErrDeleteFailed
+	}
+
+	return db.RowsAffected, nil
+}
@@ -0,0 +1,38 @@
+module example.com/rest/example
+
+go 1.14
+
+require (
+    cloud.google.com/go v0.37.4 // indirect
+    github.com/alecthomas/template v0.0.0-20190718012654-fb15b899a751
+    github.com/denisenkom/go-mssqldb v0.0.0-20200428022330-06a60b6afbbc
+    github.com/gin-gonic/gin v1.6.2
+    github.com/go-openapi/spec v0.19.7 // indirect
+    github.com/go-openapi/swag v0.19.9 // indirect
+    github.com/go-sql-driver/mysql v1.4.1
+    github.com/gogo/protobuf v1.3.1
+    github.com/golang/protobuf v1.4.0 // indirect
+    github.com/grpc-ecosystem/go-grpc-middleware v1.2.0
+    github.com/guregu/null v3.4.0+incompatible
+    github.com/jmoiron/sqlx v1.2.0
+    github.com/julienschmidt/httprouter v1.3.0
+    github.com/kr/pretty v0.2.0 // indirect
+    github.com/lib/pq v1.3.0
+    github.com/mailru/easyjson v0.7.1 // indirect
+    github.com/mattn/go-sqlite3 v2.0.2+incompatible
+    github.com/google/uuid v1.3.0
+    github.com/sirupsen/logrus v1.4.2
+    github.com/swaggo/files v0.0.0-20190704085106-630677cd5c14
+    github.com/swaggo/gin-swagger v1.2.0
+    github.com/swaggo/swag v1.6.5
+    golang.org/x/crypto v0.0.0-20191205180655-e7c4368fe9dd // indirect
+    golang.org/x/net v0.0.0-20200421231249-e086a090c8fd // indirect
+    golang.org/x/sys v0.0.0-20200420163511-1957bb5e6d1f // indirect
+    golang.org/x/tools v0.0.0-20200424195722-358506031216 // indirect
+    google.golang.org/appengine v1.6.5 // indirect
+    google.golang.org/grpc v1.19.0
+    gopkg.in/yaml.v2 v2.2.8
+)
+
+
+
@@ -0,0 +1,113 @@
+package model
+
+import (
+	"fmt"
+
+	gorm "gorm.io/gorm"
+)
+
+// Action CRUD actions
+type Action int32
+
+var (
+	// Create action when record is created
+	Create = Action(0)
+
+	// RetrieveOne action when a record is retrieved from db
+	RetrieveOne = Action(1)
+
+	// RetrieveMany action when record(s) are retrieved from db
+	RetrieveMany = Action(2)
+
+	// Update action when record is updated in db
+	Update = Action(3)
+
+	// Delete action when record is deleted in db
+	Delete = Action(4)
+
+	// FetchDDL action when fetching ddl info from db
+	FetchDDL = Action(5)
+
+	tables map[string]*TableInfo
+)
+
+func init() {
+	tables = make(map[string]*TableInfo)
+
+	tables["albums"] = albumsTableInfo
+	tables["artists"] = artistsTableInfo
+	tables["customers"] = customersTableInfo
+	tables["employees"] = employeesTableInfo
+	tables["genres"] = genresTableInfo
+	tables["invoice_items"] = invoice_itemsTableInfo
+	tables["invoices"] = invoicesTableInfo
+	tables["media_types"] = media_typesTableInfo
+	tables["playlist_track"] = playlist_trackTableInfo
+	tables["playlists"] = playlistsTableInfo
+	tables["purchase_order"] = purchase_orderTableInfo
+	tables["tracks"] = tracksTableInfo
+}
+
+// String describe the action
+func (i Action) String() string {
+	switch i {
+	case Create:
+		return "Create"
+	case RetrieveOne:
+		return "RetrieveOne"
+	case RetrieveMany:
+		return "RetrieveMany"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	case FetchDDL:
+		return "FetchDDL"
+	default:
+		return fmt.Sprintf("unknown action: %d", int(i))
+	}
+}
+
+// Model interface methods for database structs generated
+type Model interface {
+	TableName() string
+	BeforeSave(tx *gorm.DB) error
+	Prepare()
+	Validate(action Action) error
+	TableInfo() *TableInfo
+}
+
+// TableInfo describes a table in the database
+type TableInfo struct {
+	Name    string        `json:"name"`
+	Columns []*ColumnInfo `json:"columns"`
+}
+
+// ColumnInfo describes a column in the database table
+type ColumnInfo struct {
+	Index              int    `json:"index"`
+	GoFieldName        string `json:"go_field_name"`
+	GoFieldType        string `json:"go_field_type"`
+	JSONFieldName      string `json:"json_field_name"`
+	ProtobufFieldName  string `json:"protobuf_field_name"`
+	ProtobufType       string `json:"protobuf_field_type"`
+	ProtobufPos        int    `json:"protobuf_field_pos"`
+	Comment            string `json:"comment"`
+	Notes              string `json:"notes"`
+	Name               string `json:"name"`
+	Nullable           bool   `json:"is_nullable"`
+	DatabaseTypeName   string `json:"database_type_name"`
+	DatabaseTypePretty string `json:"database_type_pretty"`
+	IsPrimaryKey       bool   `json:"is_primary_key"`
+	IsAutoIncrement    bool   `json:"is_auto_increment"`
+	IsArray            bool   `json:"is_array"`
+	ColumnType         string `json:"column_type"`
+	ColumnLength       int64  `json:"column_length"`
+	DefaultValue       string `json:"default_value"`
+}
+
+// GetTableInfo retrieve TableInfo for a table
+func GetTableInfo(name string) (*TableInfo, bool) {
+	val, ok := tables[name]
+	return val, ok
+}
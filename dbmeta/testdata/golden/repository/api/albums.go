@@ -0,0 +1,518 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"example.com/rest/example/dao"
+	"example.com/rest/example/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guregu/null"
+	"github.com/julienschmidt/httprouter"
+)
+
+// AlbumsHandler handles the requests of the albums table with the injected repository
+type AlbumsHandler struct {
+	Repository dao.AlbumsRepository
+
+	// Tx run the requests writing several records in a transaction of the database handle of Repository
+	Tx dao.TxRunner
+}
+
+// NewAlbumsHandler create a AlbumsHandler using repository and its transactions tx, see Repositories.Tx
+func NewAlbumsHandler(repository dao.AlbumsRepository, tx dao.TxRunner) *AlbumsHandler {
+	return &AlbumsHandler{Repository: repository, Tx: tx}
+}
+
+func configAlbumsRouter(router *httprouter.Router, handler *AlbumsHandler) {
+	router.GET("/albums", handler.GetAll)
+	router.POST("/albums", handler.Add)
+	router.POST("/albums/upsert", handler.Upsert)
+	router.POST("/albums/bulk", handler.BulkAdd)
+	router.GET("/albums/:argAlbumID", handler.Get)
+	router.PUT("/albums/:argAlbumID", handler.Update)
+	router.PATCH("/albums/:argAlbumID", handler.Patch)
+	router.DELETE("/albums/:argAlbumID", handler.Delete)
+}
+
+func configGinAlbumsRouter(router gin.IRoutes, handler *AlbumsHandler) {
+	router.GET("/albums", ConverHttprouterToGin(handler.GetAll))
+	router.POST("/albums", ConverHttprouterToGin(handler.Add))
+	router.POST("/albums/upsert", ConverHttprouterToGin(handler.Upsert))
+	router.POST("/albums/bulk", ConverHttprouterToGin(handler.BulkAdd))
+	router.GET("/albums/:argAlbumID", ConverHttprouterToGin(handler.Get))
+	router.PUT("/albums/:argAlbumID", ConverHttprouterToGin(handler.Update))
+	router.PATCH("/albums/:argAlbumID", ConverHttprouterToGin(handler.Patch))
+	router.DELETE("/albums/:argAlbumID", ConverHttprouterToGin(handler.Delete))
+}
+
+// GetAll is a function to get a slice of record(s) from albums table in the main database
+// @Summary Get list of Albums
+// @Tags Albums
+// @Description GetAllAlbums is a handler to get a slice of record(s) from albums table in the main database
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   order    query    string  false        "comma separated json names of the sort columns, descending if prefixed by -, e.g. col1,-col2"
+// @Param   cursor   query    string  false        "next_cursor of the previous page, empty for the first page, selects keyset pagination and ignores page"
+// @Param   count    query    string  false        "with a cursor, exact to count the records matching the filter, estimate to estimate the records of the table"
+// @Param   album_id query int false "filter on AlbumId, album_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   title query string false "filter on Title, title__<op> with op one of eq, in, null, like, ilike"
+// @Param   artist_id query int false "filter on ArtistId, artist_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Success 200 {object} api.PagedResults{data=[]model.Albums}
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /albums [get]
+// http "http://localhost:8080/albums?page=0&pagesize=20" X-Api-User:user123
+func (h *AlbumsHandler) GetAll(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	order := r.FormValue("order")
+
+	filter := &dao.AlbumsFilter{}
+	if err := readFilter(r, filter); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "albums", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if _, ok := r.URL.Query()["cursor"]; ok {
+		count := dao.CountMode(r.FormValue("count"))
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+
+		records, nextCursor, totalRows, err := h.Repository.GetPage(ctx, r.FormValue("cursor"), pagesize, order, filter, count)
+		if err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+
+		result := &PagedResults{PageSize: pagesize, Data: records, TotalRecords: totalRows, NextCursor: nextCursor}
+		writeJSON(ctx, w, result)
+		return
+	}
+
+	records, totalRows, err := h.Repository.GetAll(ctx, page, pagesize, order, filter)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	writeJSON(ctx, w, result)
+}
+
+// Get is a function to get a single record from the albums table in the main database
+// @Summary Get record from table Albums by  argAlbumID
+// @Tags Albums
+// @ID argAlbumID
+// @Description GetAlbums is a function to get a single record from the albums table in the main database
+// @Accept  json
+// @Produce  json
+// @Param  argAlbumID path int true "AlbumId"
+// @Success 200 {object} model.Albums
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /albums/{argAlbumID} [get]
+// http "http://localhost:8080/albums/1" X-Api-User:user123
+func (h *AlbumsHandler) Get(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argAlbumID, err := parseInt32(ps, "argAlbumID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "albums", model.RetrieveOne); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, err := h.Repository.Get(ctx, argAlbumID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, record)
+}
+
+// Add add to add a single record to albums table in the main database
+// @Summary Add an record to albums table
+// @Description add to add a single record to albums table in the main database
+// @Tags Albums
+// @Accept  json
+// @Produce  json
+// @Param Albums body model.Albums true "Add Albums"
+// @Success 200 {object} model.Albums
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /albums [post]
+// echo '{"album_id": 69,"title": "scAtibAPxXGoaTqIDfpmArZSo","artist_id": 0}' | http POST "http://localhost:8080/albums" X-Api-User:user123
+func (h *AlbumsHandler) Add(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	albums := &model.Albums{}
+
+	if err := readJSON(r, albums); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := albums.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	albums.Prepare()
+
+	if err := albums.Validate(model.Create); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "albums", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var err error
+	albums, _, err = h.Repository.Add(ctx, albums)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, albums)
+}
+
+// Upsert add or update records of albums table in the main database in one transaction
+// @Summary Add or update records of albums table
+// @Description add the records of a json array to albums table in the main database, updating the records with the same conflict columns
+// @Tags Albums
+// @Accept  json
+// @Produce  json
+// @Param   on query string false "comma separated json names of the columns of a unique constraint, the primary key if empty"
+// @Param Albums body []model.Albums true "Upsert Albums"
+// @Success 200 {array} model.Albums
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /albums/upsert [post]
+// echo '[{"album_id": 69,"title": "scAtibAPxXGoaTqIDfpmArZSo","artist_id": 0}]' | http POST "http://localhost:8080/albums/upsert" X-Api-User:user123
+func (h *AlbumsHandler) Upsert(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Albums
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "albums", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var conflict []string
+	if on := r.FormValue("on"); on != "" {
+		conflict = strings.Split(on, ",")
+	}
+
+	err := h.Tx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, _, err := h.Repository.Upsert(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+		}
+		return nil
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// BulkAdd add records to albums table in the main database in one transaction
+// @Summary Add records to albums table
+// @Description add the records of a json array to albums table in the main database with multi row inserts
+// @Tags Albums
+// @Accept  json
+// @Produce  json
+// @Param   batch_size query int false "number of records inserted by each statement (defaults to dao.DefaultBatchSize)"
+// @Param Albums body []model.Albums true "Add Albums"
+// @Success 200 {array} model.Albums
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /albums/bulk [post]
+// echo '[{"album_id": 69,"title": "scAtibAPxXGoaTqIDfpmArZSo","artist_id": 0}]' | http POST "http://localhost:8080/albums/bulk" X-Api-User:user123
+func (h *AlbumsHandler) BulkAdd(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Albums
+
+	batchSize, err := readInt(r, "batch_size", 0)
+	if err != nil || batchSize < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "albums", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	err = h.Tx(ctx, func(ctx context.Context) error {
+		var err error
+		records, _, err = h.Repository.BulkAdd(ctx, records, int(batchSize))
+		return err
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// Update Update a single record from albums table in the main database
+// @Summary Update an record in table albums
+// @Description Update a single record from albums table in the main database
+// @Tags Albums
+// @Accept  json
+// @Produce  json
+// @Param  argAlbumID path int true "AlbumId"
+// @Param  Albums body model.Albums true "Update Albums record"
+// @Success 200 {object} model.Albums
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /albums/{argAlbumID} [put]
+// echo '{"album_id": 69,"title": "scAtibAPxXGoaTqIDfpmArZSo","artist_id": 0}' | http PUT "http://localhost:8080/albums/1"  X-Api-User:user123
+func (h *AlbumsHandler) Update(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argAlbumID, err := parseInt32(ps, "argAlbumID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	albums := &model.Albums{}
+	if err := readJSON(r, albums); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := albums.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	albums.Prepare()
+
+	if err := albums.Validate(model.Update); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "albums", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	albums, _, err = h.Repository.Update(ctx,
+		argAlbumID,
+		albums)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, albums)
+}
+
+// Patch update the fields of a JSON merge patch of a single record from albums table in the main database
+// @Summary Patch an record in table albums
+// @Description Update the fields of a JSON merge patch (RFC 7396) of a single record from albums table in the main database, zero values are written and null sets a field to null
+// @Tags Albums
+// @Accept  json
+// @Produce  json
+// @Param  argAlbumID path int true "AlbumId"
+// @Param  Albums body object true "JSON merge patch of the Albums record"
+// @Success 200 {object} model.Albums
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /albums/{argAlbumID} [patch]
+// echo '{"album_id": 69,"title": "scAtibAPxXGoaTqIDfpmArZSo","artist_id": 0}' | http PATCH "http://localhost:8080/albums/1"  X-Api-User:user123
+func (h *AlbumsHandler) Patch(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argAlbumID, err := parseInt32(ps, "argAlbumID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := readJSON(r, &patch); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "albums", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	albums, _, err := h.Repository.Patch(ctx, argAlbumID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, albums)
+}
+
+// Delete Delete a single record from albums table in the main database
+// @Summary Delete a record from albums
+// @Description Delete a single record from albums table in the main database
+// @Tags Albums
+// @Accept  json
+// @Produce  json
+// @Param  argAlbumID path int true "AlbumId"
+// @Success 204 {object} model.Albums
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /albums/{argAlbumID} [delete]
+// http DELETE "http://localhost:8080/albums/1" X-Api-User:user123
+func (h *AlbumsHandler) Delete(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argAlbumID, err := parseInt32(ps, "argAlbumID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "albums", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	rowsAffected, err := h.Repository.Delete(ctx, argAlbumID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeRowsAffected(w, rowsAffected)
+}
+
+// batch execute a create, update or delete of a albums record in a Batch
+func (h *AlbumsHandler) batch(ctx context.Context, r *http.Request, op *BatchOperation) (interface{}, error) {
+	albums := &model.Albums{}
+	if op.Op == "create" || op.Op == "update" {
+		if err := json.Unmarshal(op.Record, albums); err != nil {
+			return nil, dao.ErrBadParams
+		}
+	}
+
+	if op.Op == "create" {
+		albums.Prepare()
+
+		if err := albums.Validate(model.Create); err != nil {
+			return nil, dao.ErrBadParams
+		}
+
+		if err := ValidateRequest(ctx, r, "albums", model.Create); err != nil {
+			return nil, err
+		}
+
+		result, _, err := h.Repository.Add(ctx, albums)
+		return result, err
+	}
+
+	var ps httprouter.Params
+	for i, key := range []string{"argAlbumID"} {
+		if i >= len(op.ID) {
+			return nil, dao.ErrBadParams
+		}
+		ps = append(ps, httprouter.Param{Key: key, Value: op.ID[i]})
+	}
+
+	argAlbumID, err := parseInt32(ps, "argAlbumID")
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case "update":
+		albums.Prepare()
+
+		if err := albums.Validate(model.Update); err != nil {
+			return nil, dao.ErrBadParams
+		}
+
+		if err := ValidateRequest(ctx, r, "albums", model.Update); err != nil {
+			return nil, err
+		}
+
+		result, _, err := h.Repository.Update(ctx, argAlbumID, albums)
+		return result, err
+
+	case "delete":
+		if err := ValidateRequest(ctx, r, "albums", model.Delete); err != nil {
+			return nil, err
+		}
+
+		rowsAffected, err := h.Repository.Delete(ctx, argAlbumID)
+		return rowsAffected, err
+	}
+
+	return nil, fmt.Errorf("unknown batch operation %q", op.Op)
+}
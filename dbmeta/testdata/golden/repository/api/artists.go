@@ -0,0 +1,517 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"example.com/rest/example/dao"
+	"example.com/rest/example/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guregu/null"
+	"github.com/julienschmidt/httprouter"
+)
+
+// ArtistsHandler handles the requests of the artists table with the injected repository
+type ArtistsHandler struct {
+	Repository dao.ArtistsRepository
+
+	// Tx run the requests writing several records in a transaction of the database handle of Repository
+	Tx dao.TxRunner
+}
+
+// NewArtistsHandler create a ArtistsHandler using repository and its transactions tx, see Repositories.Tx
+func NewArtistsHandler(repository dao.ArtistsRepository, tx dao.TxRunner) *ArtistsHandler {
+	return &ArtistsHandler{Repository: repository, Tx: tx}
+}
+
+func configArtistsRouter(router *httprouter.Router, handler *ArtistsHandler) {
+	router.GET("/artists", handler.GetAll)
+	router.POST("/artists", handler.Add)
+	router.POST("/artists/upsert", handler.Upsert)
+	router.POST("/artists/bulk", handler.BulkAdd)
+	router.GET("/artists/:argArtistID", handler.Get)
+	router.PUT("/artists/:argArtistID", handler.Update)
+	router.PATCH("/artists/:argArtistID", handler.Patch)
+	router.DELETE("/artists/:argArtistID", handler.Delete)
+}
+
+func configGinArtistsRouter(router gin.IRoutes, handler *ArtistsHandler) {
+	router.GET("/artists", ConverHttprouterToGin(handler.GetAll))
+	router.POST("/artists", ConverHttprouterToGin(handler.Add))
+	router.POST("/artists/upsert", ConverHttprouterToGin(handler.Upsert))
+	router.POST("/artists/bulk", ConverHttprouterToGin(handler.BulkAdd))
+	router.GET("/artists/:argArtistID", ConverHttprouterToGin(handler.Get))
+	router.PUT("/artists/:argArtistID", ConverHttprouterToGin(handler.Update))
+	router.PATCH("/artists/:argArtistID", ConverHttprouterToGin(handler.Patch))
+	router.DELETE("/artists/:argArtistID", ConverHttprouterToGin(handler.Delete))
+}
+
+// GetAll is a function to get a slice of record(s) from artists table in the main database
+// @Summary Get list of Artists
+// @Tags Artists
+// @Description GetAllArtists is a handler to get a slice of record(s) from artists table in the main database
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   order    query    string  false        "comma separated json names of the sort columns, descending if prefixed by -, e.g. col1,-col2"
+// @Param   cursor   query    string  false        "next_cursor of the previous page, empty for the first page, selects keyset pagination and ignores page"
+// @Param   count    query    string  false        "with a cursor, exact to count the records matching the filter, estimate to estimate the records of the table"
+// @Param   artist_id query int false "filter on ArtistId, artist_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   name query string false "filter on Name, name__<op> with op one of eq, in, null, like, ilike"
+// @Success 200 {object} api.PagedResults{data=[]model.Artists}
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /artists [get]
+// http "http://localhost:8080/artists?page=0&pagesize=20" X-Api-User:user123
+func (h *ArtistsHandler) GetAll(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	order := r.FormValue("order")
+
+	filter := &dao.ArtistsFilter{}
+	if err := readFilter(r, filter); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "artists", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if _, ok := r.URL.Query()["cursor"]; ok {
+		count := dao.CountMode(r.FormValue("count"))
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+
+		records, nextCursor, totalRows, err := h.Repository.GetPage(ctx, r.FormValue("cursor"), pagesize, order, filter, count)
+		if err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+
+		result := &PagedResults{PageSize: pagesize, Data: records, TotalRecords: totalRows, NextCursor: nextCursor}
+		writeJSON(ctx, w, result)
+		return
+	}
+
+	records, totalRows, err := h.Repository.GetAll(ctx, page, pagesize, order, filter)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	writeJSON(ctx, w, result)
+}
+
+// Get is a function to get a single record from the artists table in the main database
+// @Summary Get record from table Artists by  argArtistID
+// @Tags Artists
+// @ID argArtistID
+// @Description GetArtists is a function to get a single record from the artists table in the main database
+// @Accept  json
+// @Produce  json
+// @Param  argArtistID path int true "ArtistId"
+// @Success 200 {object} model.Artists
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /artists/{argArtistID} [get]
+// http "http://localhost:8080/artists/1" X-Api-User:user123
+func (h *ArtistsHandler) Get(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argArtistID, err := parseInt32(ps, "argArtistID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "artists", model.RetrieveOne); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, err := h.Repository.Get(ctx, argArtistID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, record)
+}
+
+// Add add to add a single record to artists table in the main database
+// @Summary Add an record to artists table
+// @Description add to add a single record to artists table in the main database
+// @Tags Artists
+// @Accept  json
+// @Produce  json
+// @Param Artists body model.Artists true "Add Artists"
+// @Success 200 {object} model.Artists
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /artists [post]
+// echo '{"artist_id": 10,"name": "tWwxuAGKLOeRGYFqWJLOJblPt"}' | http POST "http://localhost:8080/artists" X-Api-User:user123
+func (h *ArtistsHandler) Add(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	artists := &model.Artists{}
+
+	if err := readJSON(r, artists); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := artists.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	artists.Prepare()
+
+	if err := artists.Validate(model.Create); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "artists", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var err error
+	artists, _, err = h.Repository.Add(ctx, artists)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, artists)
+}
+
+// Upsert add or update records of artists table in the main database in one transaction
+// @Summary Add or update records of artists table
+// @Description add the records of a json array to artists table in the main database, updating the records with the same conflict columns
+// @Tags Artists
+// @Accept  json
+// @Produce  json
+// @Param   on query string false "comma separated json names of the columns of a unique constraint, the primary key if empty"
+// @Param Artists body []model.Artists true "Upsert Artists"
+// @Success 200 {array} model.Artists
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /artists/upsert [post]
+// echo '[{"artist_id": 10,"name": "tWwxuAGKLOeRGYFqWJLOJblPt"}]' | http POST "http://localhost:8080/artists/upsert" X-Api-User:user123
+func (h *ArtistsHandler) Upsert(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Artists
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "artists", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var conflict []string
+	if on := r.FormValue("on"); on != "" {
+		conflict = strings.Split(on, ",")
+	}
+
+	err := h.Tx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, _, err := h.Repository.Upsert(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+		}
+		return nil
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// BulkAdd add records to artists table in the main database in one transaction
+// @Summary Add records to artists table
+// @Description add the records of a json array to artists table in the main database with multi row inserts
+// @Tags Artists
+// @Accept  json
+// @Produce  json
+// @Param   batch_size query int false "number of records inserted by each statement (defaults to dao.DefaultBatchSize)"
+// @Param Artists body []model.Artists true "Add Artists"
+// @Success 200 {array} model.Artists
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /artists/bulk [post]
+// echo '[{"artist_id": 10,"name": "tWwxuAGKLOeRGYFqWJLOJblPt"}]' | http POST "http://localhost:8080/artists/bulk" X-Api-User:user123
+func (h *ArtistsHandler) BulkAdd(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Artists
+
+	batchSize, err := readInt(r, "batch_size", 0)
+	if err != nil || batchSize < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "artists", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	err = h.Tx(ctx, func(ctx context.Context) error {
+		var err error
+		records, _, err = h.Repository.BulkAdd(ctx, records, int(batchSize))
+		return err
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// Update Update a single record from artists table in the main database
+// @Summary Update an record in table artists
+// @Description Update a single record from artists table in the main database
+// @Tags Artists
+// @Accept  json
+// @Produce  json
+// @Param  argArtistID path int true "ArtistId"
+// @Param  Artists body model.Artists true "Update Artists record"
+// @Success 200 {object} model.Artists
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /artists/{argArtistID} [put]
+// echo '{"artist_id": 10,"name": "tWwxuAGKLOeRGYFqWJLOJblPt"}' | http PUT "http://localhost:8080/artists/1"  X-Api-User:user123
+func (h *ArtistsHandler) Update(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argArtistID, err := parseInt32(ps, "argArtistID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	artists := &model.Artists{}
+	if err := readJSON(r, artists); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := artists.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	artists.Prepare()
+
+	if err := artists.Validate(model.Update); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "artists", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	artists, _, err = h.Repository.Update(ctx,
+		argArtistID,
+		artists)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, artists)
+}
+
+// Patch update the fields of a JSON merge patch of a single record from artists table in the main database
+// @Summary Patch an record in table artists
+// @Description Update the fields of a JSON merge patch (RFC 7396) of a single record from artists table in the main database, zero values are written and null sets a field to null
+// @Tags Artists
+// @Accept  json
+// @Produce  json
+// @Param  argArtistID path int true "ArtistId"
+// @Param  Artists body object true "JSON merge patch of the Artists record"
+// @Success 200 {object} model.Artists
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /artists/{argArtistID} [patch]
+// echo '{"artist_id": 10,"name": "tWwxuAGKLOeRGYFqWJLOJblPt"}' | http PATCH "http://localhost:8080/artists/1"  X-Api-User:user123
+func (h *ArtistsHandler) Patch(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argArtistID, err := parseInt32(ps, "argArtistID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := readJSON(r, &patch); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "artists", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	artists, _, err := h.Repository.Patch(ctx, argArtistID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, artists)
+}
+
+// Delete Delete a single record from artists table in the main database
+// @Summary Delete a record from artists
+// @Description Delete a single record from artists table in the main database
+// @Tags Artists
+// @Accept  json
+// @Produce  json
+// @Param  argArtistID path int true "ArtistId"
+// @Success 204 {object} model.Artists
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /artists/{argArtistID} [delete]
+// http DELETE "http://localhost:8080/artists/1" X-Api-User:user123
+func (h *ArtistsHandler) Delete(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argArtistID, err := parseInt32(ps, "argArtistID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "artists", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	rowsAffected, err := h.Repository.Delete(ctx, argArtistID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeRowsAffected(w, rowsAffected)
+}
+
+// batch execute a create, update or delete of a artists record in a Batch
+func (h *ArtistsHandler) batch(ctx context.Context, r *http.Request, op *BatchOperation) (interface{}, error) {
+	artists := &model.Artists{}
+	if op.Op == "create" || op.Op == "update" {
+		if err := json.Unmarshal(op.Record, artists); err != nil {
+			return nil, dao.ErrBadParams
+		}
+	}
+
+	if op.Op == "create" {
+		artists.Prepare()
+
+		if err := artists.Validate(model.Create); err != nil {
+			return nil, dao.ErrBadParams
+		}
+
+		if err := ValidateRequest(ctx, r, "artists", model.Create); err != nil {
+			return nil, err
+		}
+
+		result, _, err := h.Repository.Add(ctx, artists)
+		return result, err
+	}
+
+	var ps httprouter.Params
+	for i, key := range []string{"argArtistID"} {
+		if i >= len(op.ID) {
+			return nil, dao.ErrBadParams
+		}
+		ps = append(ps, httprouter.Param{Key: key, Value: op.ID[i]})
+	}
+
+	argArtistID, err := parseInt32(ps, "argArtistID")
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case "update":
+		artists.Prepare()
+
+		if err := artists.Validate(model.Update); err != nil {
+			return nil, dao.ErrBadParams
+		}
+
+		if err := ValidateRequest(ctx, r, "artists", model.Update); err != nil {
+			return nil, err
+		}
+
+		result, _, err := h.Repository.Update(ctx, argArtistID, artists)
+		return result, err
+
+	case "delete":
+		if err := ValidateRequest(ctx, r, "artists", model.Delete); err != nil {
+			return nil, err
+		}
+
+		rowsAffected, err := h.Repository.Delete(ctx, argArtistID)
+		return rowsAffected, err
+	}
+
+	return nil, fmt.Errorf("unknown batch operation %q", op.Op)
+}
@@ -0,0 +1,585 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"example.com/rest/example/dao"
+	"example.com/rest/example/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guregu/null"
+	"github.com/julienschmidt/httprouter"
+)
+
+// EmployeesHandler handles the requests of the employees table with the injected repository
+type EmployeesHandler struct {
+	Repository dao.EmployeesRepository
+
+	// Tx run the requests writing several records in a transaction of the database handle of Repository
+	Tx dao.TxRunner
+}
+
+// NewEmployeesHandler create a EmployeesHandler using repository and its transactions tx, see Repositories.Tx
+func NewEmployeesHandler(repository dao.EmployeesRepository, tx dao.TxRunner) *EmployeesHandler {
+	return &EmployeesHandler{Repository: repository, Tx: tx}
+}
+
+func configEmployeesRouter(router *httprouter.Router, handler *EmployeesHandler) {
+	router.GET("/employees", handler.GetAll)
+	router.POST("/employees", handler.Add)
+	router.POST("/employees/upsert", handler.Upsert)
+	router.POST("/employees/bulk", handler.BulkAdd)
+	router.GET("/employees/:argEmployeeID", handler.Get)
+	router.PUT("/employees/:argEmployeeID", handler.Update)
+	router.PATCH("/employees/:argEmployeeID", handler.Patch)
+	router.DELETE("/employees/:argEmployeeID", handler.Delete)
+	router.POST("/employees/restore/:argEmployeeID", handler.Restore)
+}
+
+func configGinEmployeesRouter(router gin.IRoutes, handler *EmployeesHandler) {
+	router.GET("/employees", ConverHttprouterToGin(handler.GetAll))
+	router.POST("/employees", ConverHttprouterToGin(handler.Add))
+	router.POST("/employees/upsert", ConverHttprouterToGin(handler.Upsert))
+	router.POST("/employees/bulk", ConverHttprouterToGin(handler.BulkAdd))
+	router.GET("/employees/:argEmployeeID", ConverHttprouterToGin(handler.Get))
+	router.PUT("/employees/:argEmployeeID", ConverHttprouterToGin(handler.Update))
+	router.PATCH("/employees/:argEmployeeID", ConverHttprouterToGin(handler.Patch))
+	router.DELETE("/employees/:argEmployeeID", ConverHttprouterToGin(handler.Delete))
+	router.POST("/employees/restore/:argEmployeeID", ConverHttprouterToGin(handler.Restore))
+}
+
+// GetAll is a function to get a slice of record(s) from employees table in the main database
+// @Summary Get list of Employees
+// @Tags Employees
+// @Description GetAllEmployees is a handler to get a slice of record(s) from employees table in the main database
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   order    query    string  false        "comma separated json names of the sort columns, descending if prefixed by -, e.g. col1,-col2"
+// @Param   cursor   query    string  false        "next_cursor of the previous page, empty for the first page, selects keyset pagination and ignores page"
+// @Param   count    query    string  false        "with a cursor, exact to count the records matching the filter, estimate to estimate the records of the table"
+// @Param   include_deleted query bool false   "include the records soft deleted by setting HireDate"
+// @Param   employee_id query int false "filter on EmployeeId, employee_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   last_name query string false "filter on LastName, last_name__<op> with op one of eq, in, null, like, ilike"
+// @Param   first_name query string false "filter on FirstName, first_name__<op> with op one of eq, in, null, like, ilike"
+// @Param   title query string false "filter on Title, title__<op> with op one of eq, in, null, like, ilike"
+// @Param   reports_to query int false "filter on ReportsTo, reports_to__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   birth_date query time.Time false "filter on BirthDate, birth_date__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   hire_date query time.Time false "filter on HireDate, hire_date__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   address query string false "filter on Address, address__<op> with op one of eq, in, null, like, ilike"
+// @Param   city query string false "filter on City, city__<op> with op one of eq, in, null, like, ilike"
+// @Param   state query string false "filter on State, state__<op> with op one of eq, in, null, like, ilike"
+// @Param   country query string false "filter on Country, country__<op> with op one of eq, in, null, like, ilike"
+// @Param   postal_code query string false "filter on PostalCode, postal_code__<op> with op one of eq, in, null, like, ilike"
+// @Param   phone query string false "filter on Phone, phone__<op> with op one of eq, in, null, like, ilike"
+// @Param   fax query string false "filter on Fax, fax__<op> with op one of eq, in, null, like, ilike"
+// @Param   email query string false "filter on Email, email__<op> with op one of eq, in, null, like, ilike"
+// @Success 200 {object} api.PagedResults{data=[]model.Employees}
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /employees [get]
+// http "http://localhost:8080/employees?page=0&pagesize=20" X-Api-User:user123
+func (h *EmployeesHandler) GetAll(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	order := r.FormValue("order")
+
+	filter := &dao.EmployeesFilter{}
+	if err := readFilter(r, filter); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	filter.IncludeDeleted, err = readBool(r, "include_deleted", false)
+	if err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "employees", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if _, ok := r.URL.Query()["cursor"]; ok {
+		count := dao.CountMode(r.FormValue("count"))
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+
+		records, nextCursor, totalRows, err := h.Repository.GetPage(ctx, r.FormValue("cursor"), pagesize, order, filter, count)
+		if err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+
+		result := &PagedResults{PageSize: pagesize, Data: records, TotalRecords: totalRows, NextCursor: nextCursor}
+		writeJSON(ctx, w, result)
+		return
+	}
+
+	records, totalRows, err := h.Repository.GetAll(ctx, page, pagesize, order, filter)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	writeJSON(ctx, w, result)
+}
+
+// Get is a function to get a single record from the employees table in the main database
+// @Summary Get record from table Employees by  argEmployeeID
+// @Tags Employees
+// @ID argEmployeeID
+// @Description GetEmployees is a function to get a single record from the employees table in the main database
+// @Accept  json
+// @Produce  json
+// @Param  argEmployeeID path int true "EmployeeId"
+// @Success 200 {object} model.Employees
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /employees/{argEmployeeID} [get]
+// http "http://localhost:8080/employees/1" X-Api-User:user123
+func (h *EmployeesHandler) Get(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argEmployeeID, err := parseInt32(ps, "argEmployeeID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "employees", model.RetrieveOne); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, err := h.Repository.Get(ctx, argEmployeeID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, record)
+}
+
+// Add add to add a single record to employees table in the main database
+// @Summary Add an record to employees table
+// @Description add to add a single record to employees table in the main database
+// @Tags Employees
+// @Accept  json
+// @Produce  json
+// @Param Employees body model.Employees true "Add Employees"
+// @Success 200 {object} model.Employees
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /employees [post]
+// echo '{"employee_id": 44,"last_name": "aqltlXbwFxfEMkGdlwcHCDyjg","first_name": "rdWQBMGKXNngmhISfhbiIJndO","title": "GUoUAeMSTVXBELWxLkAssSEQw","reports_to": 16,"birth_date": "2020-01-09T11:42:34Z","hire_date": "2020-05-24T23:48:10Z","address": "BydDOfXJdaNPTvLlBSICNfphL","city": "FgcghIWybVGUoMdlxwYqWyElT","state": "wttpOyonhLKXqdxpDucIuxqLA","country": "iBeqtAUEHkdXXApILJyYEVgQP","postal_code": "emYRjZaQXaVqmdFqMRlNpGOMe","phone": "hSxAtqDTxjxlUaYQpnUaDEnxI","fax": "WeXbswtXVKHXrYIcCFYDQYQju","email": "kmRBXOUFNOBVwCYQdEMQwGMJy"}' | http POST "http://localhost:8080/employees" X-Api-User:user123
+func (h *EmployeesHandler) Add(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	employees := &model.Employees{}
+
+	if err := readJSON(r, employees); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := employees.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	employees.Prepare()
+
+	if err := employees.Validate(model.Create); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "employees", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var err error
+	employees, _, err = h.Repository.Add(ctx, employees)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, employees)
+}
+
+// Upsert add or update records of employees table in the main database in one transaction
+// @Summary Add or update records of employees table
+// @Description add the records of a json array to employees table in the main database, updating the records with the same conflict columns
+// @Tags Employees
+// @Accept  json
+// @Produce  json
+// @Param   on query string false "comma separated json names of the columns of a unique constraint, the primary key if empty"
+// @Param Employees body []model.Employees true "Upsert Employees"
+// @Success 200 {array} model.Employees
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /employees/upsert [post]
+// echo '[{"employee_id": 44,"last_name": "aqltlXbwFxfEMkGdlwcHCDyjg","first_name": "rdWQBMGKXNngmhISfhbiIJndO","title": "GUoUAeMSTVXBELWxLkAssSEQw","reports_to": 16,"birth_date": "2020-01-09T11:42:34Z","hire_date": "2020-05-24T23:48:10Z","address": "BydDOfXJdaNPTvLlBSICNfphL","city": "FgcghIWybVGUoMdlxwYqWyElT","state": "wttpOyonhLKXqdxpDucIuxqLA","country": "iBeqtAUEHkdXXApILJyYEVgQP","postal_code": "emYRjZaQXaVqmdFqMRlNpGOMe","phone": "hSxAtqDTxjxlUaYQpnUaDEnxI","fax": "WeXbswtXVKHXrYIcCFYDQYQju","email": "kmRBXOUFNOBVwCYQdEMQwGMJy"}]' | http POST "http://localhost:8080/employees/upsert" X-Api-User:user123
+func (h *EmployeesHandler) Upsert(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Employees
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "employees", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var conflict []string
+	if on := r.FormValue("on"); on != "" {
+		conflict = strings.Split(on, ",")
+	}
+
+	err := h.Tx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, _, err := h.Repository.Upsert(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+		}
+		return nil
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// BulkAdd add records to employees table in the main database in one transaction
+// @Summary Add records to employees table
+// @Description add the records of a json array to employees table in the main database with multi row inserts
+// @Tags Employees
+// @Accept  json
+// @Produce  json
+// @Param   batch_size query int false "number of records inserted by each statement (defaults to dao.DefaultBatchSize)"
+// @Param Employees body []model.Employees true "Add Employees"
+// @Success 200 {array} model.Employees
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /employees/bulk [post]
+// echo '[{"employee_id": 44,"last_name": "aqltlXbwFxfEMkGdlwcHCDyjg","first_name": "rdWQBMGKXNngmhISfhbiIJndO","title": "GUoUAeMSTVXBELWxLkAssSEQw","reports_to": 16,"birth_date": "2020-01-09T11:42:34Z","hire_date": "2020-05-24T23:48:10Z","address": "BydDOfXJdaNPTvLlBSICNfphL","city": "FgcghIWybVGUoMdlxwYqWyElT","state": "wttpOyonhLKXqdxpDucIuxqLA","country": "iBeqtAUEHkdXXApILJyYEVgQP","postal_code": "emYRjZaQXaVqmdFqMRlNpGOMe","phone": "hSxAtqDTxjxlUaYQpnUaDEnxI","fax": "WeXbswtXVKHXrYIcCFYDQYQju","email": "kmRBXOUFNOBVwCYQdEMQwGMJy"}]' | http POST "http://localhost:8080/employees/bulk" X-Api-User:user123
+func (h *EmployeesHandler) BulkAdd(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Employees
+
+	batchSize, err := readInt(r, "batch_size", 0)
+	if err != nil || batchSize < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "employees", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	err = h.Tx(ctx, func(ctx context.Context) error {
+		var err error
+		records, _, err = h.Repository.BulkAdd(ctx, records, int(batchSize))
+		return err
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// Update Update a single record from employees table in the main database
+// @Summary Update an record in table employees
+// @Description Update a single record from employees table in the main database
+// @Tags Employees
+// @Accept  json
+// @Produce  json
+// @Param  argEmployeeID path int true "EmployeeId"
+// @Param  Employees body model.Employees true "Update Employees record"
+// @Success 200 {object} model.Employees
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /employees/{argEmployeeID} [put]
+// echo '{"employee_id": 44,"last_name": "aqltlXbwFxfEMkGdlwcHCDyjg","first_name": "rdWQBMGKXNngmhISfhbiIJndO","title": "GUoUAeMSTVXBELWxLkAssSEQw","reports_to": 16,"birth_date": "2020-01-09T11:42:34Z","hire_date": "2020-05-24T23:48:10Z","address": "BydDOfXJdaNPTvLlBSICNfphL","city": "FgcghIWybVGUoMdlxwYqWyElT","state": "wttpOyonhLKXqdxpDucIuxqLA","country": "iBeqtAUEHkdXXApILJyYEVgQP","postal_code": "emYRjZaQXaVqmdFqMRlNpGOMe","phone": "hSxAtqDTxjxlUaYQpnUaDEnxI","fax": "WeXbswtXVKHXrYIcCFYDQYQju","email": "kmRBXOUFNOBVwCYQdEMQwGMJy"}' | http PUT "http://localhost:8080/employees/1"  X-Api-User:user123
+func (h *EmployeesHandler) Update(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argEmployeeID, err := parseInt32(ps, "argEmployeeID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	employees := &model.Employees{}
+	if err := readJSON(r, employees); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := employees.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	employees.Prepare()
+
+	if err := employees.Validate(model.Update); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "employees", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	employees, _, err = h.Repository.Update(ctx,
+		argEmployeeID,
+		employees)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, employees)
+}
+
+// Patch update the fields of a JSON merge patch of a single record from employees table in the main database
+// @Summary Patch an record in table employees
+// @Description Update the fields of a JSON merge patch (RFC 7396) of a single record from employees table in the main database, zero values are written and null sets a field to null
+// @Tags Employees
+// @Accept  json
+// @Produce  json
+// @Param  argEmployeeID path int true "EmployeeId"
+// @Param  Employees body object true "JSON merge patch of the Employees record"
+// @Success 200 {object} model.Employees
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /employees/{argEmployeeID} [patch]
+// echo '{"employee_id": 44,"last_name": "aqltlXbwFxfEMkGdlwcHCDyjg","first_name": "rdWQBMGKXNngmhISfhbiIJndO","title": "GUoUAeMSTVXBELWxLkAssSEQw","reports_to": 16,"birth_date": "2020-01-09T11:42:34Z","hire_date": "2020-05-24T23:48:10Z","address": "BydDOfXJdaNPTvLlBSICNfphL","city": "FgcghIWybVGUoMdlxwYqWyElT","state": "wttpOyonhLKXqdxpDucIuxqLA","country": "iBeqtAUEHkdXXApILJyYEVgQP","postal_code": "emYRjZaQXaVqmdFqMRlNpGOMe","phone": "hSxAtqDTxjxlUaYQpnUaDEnxI","fax": "WeXbswtXVKHXrYIcCFYDQYQju","email": "kmRBXOUFNOBVwCYQdEMQwGMJy"}' | http PATCH "http://localhost:8080/employees/1"  X-Api-User:user123
+func (h *EmployeesHandler) Patch(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argEmployeeID, err := parseInt32(ps, "argEmployeeID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := readJSON(r, &patch); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "employees", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	employees, _, err := h.Repository.Patch(ctx, argEmployeeID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, employees)
+}
+
+// Delete Delete a single record from employees table in the main database
+// @Summary Delete a record from employees
+// @Description Delete a single record from employees table in the main database
+// @Tags Employees
+// @Accept  json
+// @Produce  json
+// @Param  argEmployeeID path int true "EmployeeId"
+// @Success 204 {object} model.Employees
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /employees/{argEmployeeID} [delete]
+// http DELETE "http://localhost:8080/employees/1" X-Api-User:user123
+func (h *EmployeesHandler) Delete(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argEmployeeID, err := parseInt32(ps, "argEmployeeID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "employees", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	rowsAffected, err := h.Repository.Delete(ctx, argEmployeeID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeRowsAffected(w, rowsAffected)
+}
+
+// Restore Restore a single record soft deleted from employees table in the main database
+// @Summary Restore a soft deleted record of employees
+// @Description Restore a single record soft deleted from employees table in the main database by clearing HireDate
+// @Tags Employees
+// @Accept  json
+// @Produce  json
+// @Param  argEmployeeID path int true "EmployeeId"
+// @Success 200 {object} model.Employees
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /employees/restore/{argEmployeeID} [post]
+// http POST "http://localhost:8080/employees/restore/1" X-Api-User:user123
+func (h *EmployeesHandler) Restore(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argEmployeeID, err := parseInt32(ps, "argEmployeeID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "employees", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	rowsAffected, err := h.Repository.Restore(ctx, argEmployeeID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+	if rowsAffected == 0 {
+		returnError(ctx, w, r, dao.ErrNotFound)
+		return
+	}
+
+	record, err := h.Repository.Get(ctx, argEmployeeID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, record)
+}
+
+// batch execute a create, update or delete of a employees record in a Batch
+func (h *EmployeesHandler) batch(ctx context.Context, r *http.Request, op *BatchOperation) (interface{}, error) {
+	employees := &model.Employees{}
+	if op.Op == "create" || op.Op == "update" {
+		if err := json.Unmarshal(op.Record, employees); err != nil {
+			return nil, dao.ErrBadParams
+		}
+	}
+
+	if op.Op == "create" {
+		employees.Prepare()
+
+		if err := employees.Validate(model.Create); err != nil {
+			return nil, dao.ErrBadParams
+		}
+
+		if err := ValidateRequest(ctx, r, "employees", model.Create); err != nil {
+			return nil, err
+		}
+
+		result, _, err := h.Repository.Add(ctx, employees)
+		return result, err
+	}
+
+	var ps httprouter.Params
+	for i, key := range []string{"argEmployeeID"} {
+		if i >= len(op.ID) {
+			return nil, dao.ErrBadParams
+		}
+		ps = append(ps, httprouter.Param{Key: key, Value: op.ID[i]})
+	}
+
+	argEmployeeID, err := parseInt32(ps, "argEmployeeID")
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case "update":
+		employees.Prepare()
+
+		if err := employees.Validate(model.Update); err != nil {
+			return nil, dao.ErrBadParams
+		}
+
+		if err := ValidateRequest(ctx, r, "employees", model.Update); err != nil {
+			return nil, err
+		}
+
+		result, _, err := h.Repository.Update(ctx, argEmployeeID, employees)
+		return result, err
+
+	case "delete":
+		if err := ValidateRequest(ctx, r, "employees", model.Delete); err != nil {
+			return nil, err
+		}
+
+		rowsAffected, err := h.Repository.Delete(ctx, argEmployeeID)
+		return rowsAffected, err
+	}
+
+	return nil, fmt.Errorf("unknown batch operation %q", op.Op)
+}
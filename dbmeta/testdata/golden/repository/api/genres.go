@@ -0,0 +1,517 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"example.com/rest/example/dao"
+	"example.com/rest/example/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guregu/null"
+	"github.com/julienschmidt/httprouter"
+)
+
+// GenresHandler handles the requests of the genres table with the injected repository
+type GenresHandler struct {
+	Repository dao.GenresRepository
+
+	// Tx run the requests writing several records in a transaction of the database handle of Repository
+	Tx dao.TxRunner
+}
+
+// NewGenresHandler create a GenresHandler using repository and its transactions tx, see Repositories.Tx
+func NewGenresHandler(repository dao.GenresRepository, tx dao.TxRunner) *GenresHandler {
+	return &GenresHandler{Repository: repository, Tx: tx}
+}
+
+func configGenresRouter(router *httprouter.Router, handler *GenresHandler) {
+	router.GET("/genres", handler.GetAll)
+	router.POST("/genres", handler.Add)
+	router.POST("/genres/upsert", handler.Upsert)
+	router.POST("/genres/bulk", handler.BulkAdd)
+	router.GET("/genres/:argGenreID", handler.Get)
+	router.PUT("/genres/:argGenreID", handler.Update)
+	router.PATCH("/genres/:argGenreID", handler.Patch)
+	router.DELETE("/genres/:argGenreID", handler.Delete)
+}
+
+func configGinGenresRouter(router gin.IRoutes, handler *GenresHandler) {
+	router.GET("/genres", ConverHttprouterToGin(handler.GetAll))
+	router.POST("/genres", ConverHttprouterToGin(handler.Add))
+	router.POST("/genres/upsert", ConverHttprouterToGin(handler.Upsert))
+	router.POST("/genres/bulk", ConverHttprouterToGin(handler.BulkAdd))
+	router.GET("/genres/:argGenreID", ConverHttprouterToGin(handler.Get))
+	router.PUT("/genres/:argGenreID", ConverHttprouterToGin(handler.Update))
+	router.PATCH("/genres/:argGenreID", ConverHttprouterToGin(handler.Patch))
+	router.DELETE("/genres/:argGenreID", ConverHttprouterToGin(handler.Delete))
+}
+
+// GetAll is a function to get a slice of record(s) from genres table in the main database
+// @Summary Get list of Genres
+// @Tags Genres
+// @Description GetAllGenres is a handler to get a slice of record(s) from genres table in the main database
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   order    query    string  false        "comma separated json names of the sort columns, descending if prefixed by -, e.g. col1,-col2"
+// @Param   cursor   query    string  false        "next_cursor of the previous page, empty for the first page, selects keyset pagination and ignores page"
+// @Param   count    query    string  false        "with a cursor, exact to count the records matching the filter, estimate to estimate the records of the table"
+// @Param   genre_id query int false "filter on GenreId, genre_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   name query string false "filter on Name, name__<op> with op one of eq, in, null, like, ilike"
+// @Success 200 {object} api.PagedResults{data=[]model.Genres}
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /genres [get]
+// http "http://localhost:8080/genres?page=0&pagesize=20" X-Api-User:user123
+func (h *GenresHandler) GetAll(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	order := r.FormValue("order")
+
+	filter := &dao.GenresFilter{}
+	if err := readFilter(r, filter); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "genres", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if _, ok := r.URL.Query()["cursor"]; ok {
+		count := dao.CountMode(r.FormValue("count"))
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+
+		records, nextCursor, totalRows, err := h.Repository.GetPage(ctx, r.FormValue("cursor"), pagesize, order, filter, count)
+		if err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+
+		result := &PagedResults{PageSize: pagesize, Data: records, TotalRecords: totalRows, NextCursor: nextCursor}
+		writeJSON(ctx, w, result)
+		return
+	}
+
+	records, totalRows, err := h.Repository.GetAll(ctx, page, pagesize, order, filter)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	writeJSON(ctx, w, result)
+}
+
+// Get is a function to get a single record from the genres table in the main database
+// @Summary Get record from table Genres by  argGenreID
+// @Tags Genres
+// @ID argGenreID
+// @Description GetGenres is a function to get a single record from the genres table in the main database
+// @Accept  json
+// @Produce  json
+// @Param  argGenreID path int true "GenreId"
+// @Success 200 {object} model.Genres
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /genres/{argGenreID} [get]
+// http "http://localhost:8080/genres/1" X-Api-User:user123
+func (h *GenresHandler) Get(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argGenreID, err := parseInt32(ps, "argGenreID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "genres", model.RetrieveOne); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, err := h.Repository.Get(ctx, argGenreID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, record)
+}
+
+// Add add to add a single record to genres table in the main database
+// @Summary Add an record to genres table
+// @Description add to add a single record to genres table in the main database
+// @Tags Genres
+// @Accept  json
+// @Produce  json
+// @Param Genres body model.Genres true "Add Genres"
+// @Success 200 {object} model.Genres
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /genres [post]
+// echo '{"genre_id": 46,"name": "LvVnZWsNjgsEvBcGIIQhdCeDx"}' | http POST "http://localhost:8080/genres" X-Api-User:user123
+func (h *GenresHandler) Add(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	genres := &model.Genres{}
+
+	if err := readJSON(r, genres); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := genres.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	genres.Prepare()
+
+	if err := genres.Validate(model.Create); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "genres", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var err error
+	genres, _, err = h.Repository.Add(ctx, genres)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, genres)
+}
+
+// Upsert add or update records of genres table in the main database in one transaction
+// @Summary Add or update records of genres table
+// @Description add the records of a json array to genres table in the main database, updating the records with the same conflict columns
+// @Tags Genres
+// @Accept  json
+// @Produce  json
+// @Param   on query string false "comma separated json names of the columns of a unique constraint, the primary key if empty"
+// @Param Genres body []model.Genres true "Upsert Genres"
+// @Success 200 {array} model.Genres
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /genres/upsert [post]
+// echo '[{"genre_id": 46,"name": "LvVnZWsNjgsEvBcGIIQhdCeDx"}]' | http POST "http://localhost:8080/genres/upsert" X-Api-User:user123
+func (h *GenresHandler) Upsert(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Genres
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "genres", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var conflict []string
+	if on := r.FormValue("on"); on != "" {
+		conflict = strings.Split(on, ",")
+	}
+
+	err := h.Tx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, _, err := h.Repository.Upsert(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+		}
+		return nil
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// BulkAdd add records to genres table in the main database in one transaction
+// @Summary Add records to genres table
+// @Description add the records of a json array to genres table in the main database with multi row inserts
+// @Tags Genres
+// @Accept  json
+// @Produce  json
+// @Param   batch_size query int false "number of records inserted by each statement (defaults to dao.DefaultBatchSize)"
+// @Param Genres body []model.Genres true "Add Genres"
+// @Success 200 {array} model.Genres
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /genres/bulk [post]
+// echo '[{"genre_id": 46,"name": "LvVnZWsNjgsEvBcGIIQhdCeDx"}]' | http POST "http://localhost:8080/genres/bulk" X-Api-User:user123
+func (h *GenresHandler) BulkAdd(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Genres
+
+	batchSize, err := readInt(r, "batch_size", 0)
+	if err != nil || batchSize < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "genres", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	err = h.Tx(ctx, func(ctx context.Context) error {
+		var err error
+		records, _, err = h.Repository.BulkAdd(ctx, records, int(batchSize))
+		return err
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// Update Update a single record from genres table in the main database
+// @Summary Update an record in table genres
+// @Description Update a single record from genres table in the main database
+// @Tags Genres
+// @Accept  json
+// @Produce  json
+// @Param  argGenreID path int true "GenreId"
+// @Param  Genres body model.Genres true "Update Genres record"
+// @Success 200 {object} model.Genres
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /genres/{argGenreID} [put]
+// echo '{"genre_id": 46,"name": "LvVnZWsNjgsEvBcGIIQhdCeDx"}' | http PUT "http://localhost:8080/genres/1"  X-Api-User:user123
+func (h *GenresHandler) Update(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argGenreID, err := parseInt32(ps, "argGenreID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	genres := &model.Genres{}
+	if err := readJSON(r, genres); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := genres.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	genres.Prepare()
+
+	if err := genres.Validate(model.Update); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "genres", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	genres, _, err = h.Repository.Update(ctx,
+		argGenreID,
+		genres)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, genres)
+}
+
+// Patch update the fields of a JSON merge patch of a single record from genres table in the main database
+// @Summary Patch an record in table genres
+// @Description Update the fields of a JSON merge patch (RFC 7396) of a single record from genres table in the main database, zero values are written and null sets a field to null
+// @Tags Genres
+// @Accept  json
+// @Produce  json
+// @Param  argGenreID path int true "GenreId"
+// @Param  Genres body object true "JSON merge patch of the Genres record"
+// @Success 200 {object} model.Genres
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /genres/{argGenreID} [patch]
+// echo '{"genre_id": 46,"name": "LvVnZWsNjgsEvBcGIIQhdCeDx"}' | http PATCH "http://localhost:8080/genres/1"  X-Api-User:user123
+func (h *GenresHandler) Patch(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argGenreID, err := parseInt32(ps, "argGenreID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := readJSON(r, &patch); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "genres", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	genres, _, err := h.Repository.Patch(ctx, argGenreID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, genres)
+}
+
+// Delete Delete a single record from genres table in the main database
+// @Summary Delete a record from genres
+// @Description Delete a single record from genres table in the main database
+// @Tags Genres
+// @Accept  json
+// @Produce  json
+// @Param  argGenreID path int true "GenreId"
+// @Success 204 {object} model.Genres
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /genres/{argGenreID} [delete]
+// http DELETE "http://localhost:8080/genres/1" X-Api-User:user123
+func (h *GenresHandler) Delete(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argGenreID, err := parseInt32(ps, "argGenreID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "genres", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	rowsAffected, err := h.Repository.Delete(ctx, argGenreID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeRowsAffected(w, rowsAffected)
+}
+
+// batch execute a create, update or delete of a genres record in a Batch
+func (h *GenresHandler) batch(ctx context.Context, r *http.Request, op *BatchOperation) (interface{}, error) {
+	genres := &model.Genres{}
+	if op.Op == "create" || op.Op == "update" {
+		if err := json.Unmarshal(op.Record, genres); err != nil {
+			return nil, dao.ErrBadParams
+		}
+	}
+
+	if op.Op == "create" {
+		genres.Prepare()
+
+		if err := genres.Validate(model.Create); err != nil {
+			return nil, dao.ErrBadParams
+		}
+
+		if err := ValidateRequest(ctx, r, "genres", model.Create); err != nil {
+			return nil, err
+		}
+
+		result, _, err := h.Repository.Add(ctx, genres)
+		return result, err
+	}
+
+	var ps httprouter.Params
+	for i, key := range []string{"argGenreID"} {
+		if i >= len(op.ID) {
+			return nil, dao.ErrBadParams
+		}
+		ps = append(ps, httprouter.Param{Key: key, Value: op.ID[i]})
+	}
+
+	argGenreID, err := parseInt32(ps, "argGenreID")
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case "update":
+		genres.Prepare()
+
+		if err := genres.Validate(model.Update); err != nil {
+			return nil, dao.ErrBadParams
+		}
+
+		if err := ValidateRequest(ctx, r, "genres", model.Update); err != nil {
+			return nil, err
+		}
+
+		result, _, err := h.Repository.Update(ctx, argGenreID, genres)
+		return result, err
+
+	case "delete":
+		if err := ValidateRequest(ctx, r, "genres", model.Delete); err != nil {
+			return nil, err
+		}
+
+		rowsAffected, err := h.Repository.Delete(ctx, argGenreID)
+		return rowsAffected, err
+	}
+
+	return nil, fmt.Errorf("unknown batch operation %q", op.Op)
+}
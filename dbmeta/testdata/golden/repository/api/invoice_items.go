@@ -0,0 +1,520 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"example.com/rest/example/dao"
+	"example.com/rest/example/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guregu/null"
+	"github.com/julienschmidt/httprouter"
+)
+
+// InvoiceItemsHandler handles the requests of the invoice_items table with the injected repository
+type InvoiceItemsHandler struct {
+	Repository dao.InvoiceItemsRepository
+
+	// Tx run the requests writing several records in a transaction of the database handle of Repository
+	Tx dao.TxRunner
+}
+
+// NewInvoiceItemsHandler create a InvoiceItemsHandler using repository and its transactions tx, see Repositories.Tx
+func NewInvoiceItemsHandler(repository dao.InvoiceItemsRepository, tx dao.TxRunner) *InvoiceItemsHandler {
+	return &InvoiceItemsHandler{Repository: repository, Tx: tx}
+}
+
+func configInvoiceItemsRouter(router *httprouter.Router, handler *InvoiceItemsHandler) {
+	router.GET("/invoiceitems", handler.GetAll)
+	router.POST("/invoiceitems", handler.Add)
+	router.POST("/invoiceitems/upsert", handler.Upsert)
+	router.POST("/invoiceitems/bulk", handler.BulkAdd)
+	router.GET("/invoiceitems/:argInvoiceLineID", handler.Get)
+	router.PUT("/invoiceitems/:argInvoiceLineID", handler.Update)
+	router.PATCH("/invoiceitems/:argInvoiceLineID", handler.Patch)
+	router.DELETE("/invoiceitems/:argInvoiceLineID", handler.Delete)
+}
+
+func configGinInvoiceItemsRouter(router gin.IRoutes, handler *InvoiceItemsHandler) {
+	router.GET("/invoiceitems", ConverHttprouterToGin(handler.GetAll))
+	router.POST("/invoiceitems", ConverHttprouterToGin(handler.Add))
+	router.POST("/invoiceitems/upsert", ConverHttprouterToGin(handler.Upsert))
+	router.POST("/invoiceitems/bulk", ConverHttprouterToGin(handler.BulkAdd))
+	router.GET("/invoiceitems/:argInvoiceLineID", ConverHttprouterToGin(handler.Get))
+	router.PUT("/invoiceitems/:argInvoiceLineID", ConverHttprouterToGin(handler.Update))
+	router.PATCH("/invoiceitems/:argInvoiceLineID", ConverHttprouterToGin(handler.Patch))
+	router.DELETE("/invoiceitems/:argInvoiceLineID", ConverHttprouterToGin(handler.Delete))
+}
+
+// GetAll is a function to get a slice of record(s) from invoice_items table in the main database
+// @Summary Get list of InvoiceItems
+// @Tags InvoiceItems
+// @Description GetAllInvoiceItems is a handler to get a slice of record(s) from invoice_items table in the main database
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   order    query    string  false        "comma separated json names of the sort columns, descending if prefixed by -, e.g. col1,-col2"
+// @Param   cursor   query    string  false        "next_cursor of the previous page, empty for the first page, selects keyset pagination and ignores page"
+// @Param   count    query    string  false        "with a cursor, exact to count the records matching the filter, estimate to estimate the records of the table"
+// @Param   invoice_line_id query int false "filter on InvoiceLineId, invoice_line_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   invoice_id query int false "filter on InvoiceId, invoice_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   track_id query int false "filter on TrackId, track_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   unit_price query float64 false "filter on UnitPrice, unit_price__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   quantity query int false "filter on Quantity, quantity__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Success 200 {object} api.PagedResults{data=[]model.InvoiceItems}
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoiceitems [get]
+// http "http://localhost:8080/invoiceitems?page=0&pagesize=20" X-Api-User:user123
+func (h *InvoiceItemsHandler) GetAll(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	order := r.FormValue("order")
+
+	filter := &dao.InvoiceItemsFilter{}
+	if err := readFilter(r, filter); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoice_items", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if _, ok := r.URL.Query()["cursor"]; ok {
+		count := dao.CountMode(r.FormValue("count"))
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+
+		records, nextCursor, totalRows, err := h.Repository.GetPage(ctx, r.FormValue("cursor"), pagesize, order, filter, count)
+		if err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+
+		result := &PagedResults{PageSize: pagesize, Data: records, TotalRecords: totalRows, NextCursor: nextCursor}
+		writeJSON(ctx, w, result)
+		return
+	}
+
+	records, totalRows, err := h.Repository.GetAll(ctx, page, pagesize, order, filter)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	writeJSON(ctx, w, result)
+}
+
+// Get is a function to get a single record from the invoice_items table in the main database
+// @Summary Get record from table InvoiceItems by  argInvoiceLineID
+// @Tags InvoiceItems
+// @ID argInvoiceLineID
+// @Description GetInvoiceItems is a function to get a single record from the invoice_items table in the main database
+// @Accept  json
+// @Produce  json
+// @Param  argInvoiceLineID path int true "InvoiceLineId"
+// @Success 200 {object} model.InvoiceItems
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /invoiceitems/{argInvoiceLineID} [get]
+// http "http://localhost:8080/invoiceitems/1" X-Api-User:user123
+func (h *InvoiceItemsHandler) Get(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argInvoiceLineID, err := parseInt32(ps, "argInvoiceLineID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoice_items", model.RetrieveOne); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, err := h.Repository.Get(ctx, argInvoiceLineID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, record)
+}
+
+// Add add to add a single record to invoice_items table in the main database
+// @Summary Add an record to invoice_items table
+// @Description add to add a single record to invoice_items table in the main database
+// @Tags InvoiceItems
+// @Accept  json
+// @Produce  json
+// @Param InvoiceItems body model.InvoiceItems true "Add InvoiceItems"
+// @Success 200 {object} model.InvoiceItems
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoiceitems [post]
+// echo '{"invoice_line_id": 47,"invoice_id": 63,"track_id": 97,"unit_price": 0.7157533995331656,"quantity": 19}' | http POST "http://localhost:8080/invoiceitems" X-Api-User:user123
+func (h *InvoiceItemsHandler) Add(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	invoiceitems := &model.InvoiceItems{}
+
+	if err := readJSON(r, invoiceitems); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := invoiceitems.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	invoiceitems.Prepare()
+
+	if err := invoiceitems.Validate(model.Create); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoice_items", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var err error
+	invoiceitems, _, err = h.Repository.Add(ctx, invoiceitems)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, invoiceitems)
+}
+
+// Upsert add or update records of invoice_items table in the main database in one transaction
+// @Summary Add or update records of invoice_items table
+// @Description add the records of a json array to invoice_items table in the main database, updating the records with the same conflict columns
+// @Tags InvoiceItems
+// @Accept  json
+// @Produce  json
+// @Param   on query string false "comma separated json names of the columns of a unique constraint, the primary key if empty"
+// @Param InvoiceItems body []model.InvoiceItems true "Upsert InvoiceItems"
+// @Success 200 {array} model.InvoiceItems
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoiceitems/upsert [post]
+// echo '[{"invoice_line_id": 47,"invoice_id": 63,"track_id": 97,"unit_price": 0.7157533995331656,"quantity": 19}]' | http POST "http://localhost:8080/invoiceitems/upsert" X-Api-User:user123
+func (h *InvoiceItemsHandler) Upsert(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.InvoiceItems
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "invoice_items", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var conflict []string
+	if on := r.FormValue("on"); on != "" {
+		conflict = strings.Split(on, ",")
+	}
+
+	err := h.Tx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, _, err := h.Repository.Upsert(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+		}
+		return nil
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// BulkAdd add records to invoice_items table in the main database in one transaction
+// @Summary Add records to invoice_items table
+// @Description add the records of a json array to invoice_items table in the main database with multi row inserts
+// @Tags InvoiceItems
+// @Accept  json
+// @Produce  json
+// @Param   batch_size query int false "number of records inserted by each statement (defaults to dao.DefaultBatchSize)"
+// @Param InvoiceItems body []model.InvoiceItems true "Add InvoiceItems"
+// @Success 200 {array} model.InvoiceItems
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoiceitems/bulk [post]
+// echo '[{"invoice_line_id": 47,"invoice_id": 63,"track_id": 97,"unit_price": 0.7157533995331656,"quantity": 19}]' | http POST "http://localhost:8080/invoiceitems/bulk" X-Api-User:user123
+func (h *InvoiceItemsHandler) BulkAdd(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.InvoiceItems
+
+	batchSize, err := readInt(r, "batch_size", 0)
+	if err != nil || batchSize < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "invoice_items", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	err = h.Tx(ctx, func(ctx context.Context) error {
+		var err error
+		records, _, err = h.Repository.BulkAdd(ctx, records, int(batchSize))
+		return err
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// Update Update a single record from invoice_items table in the main database
+// @Summary Update an record in table invoice_items
+// @Description Update a single record from invoice_items table in the main database
+// @Tags InvoiceItems
+// @Accept  json
+// @Produce  json
+// @Param  argInvoiceLineID path int true "InvoiceLineId"
+// @Param  InvoiceItems body model.InvoiceItems true "Update InvoiceItems record"
+// @Success 200 {object} model.InvoiceItems
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoiceitems/{argInvoiceLineID} [put]
+// echo '{"invoice_line_id": 47,"invoice_id": 63,"track_id": 97,"unit_price": 0.7157533995331656,"quantity": 19}' | http PUT "http://localhost:8080/invoiceitems/1"  X-Api-User:user123
+func (h *InvoiceItemsHandler) Update(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argInvoiceLineID, err := parseInt32(ps, "argInvoiceLineID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	invoiceitems := &model.InvoiceItems{}
+	if err := readJSON(r, invoiceitems); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := invoiceitems.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	invoiceitems.Prepare()
+
+	if err := invoiceitems.Validate(model.Update); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoice_items", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	invoiceitems, _, err = h.Repository.Update(ctx,
+		argInvoiceLineID,
+		invoiceitems)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, invoiceitems)
+}
+
+// Patch update the fields of a JSON merge patch of a single record from invoice_items table in the main database
+// @Summary Patch an record in table invoice_items
+// @Description Update the fields of a JSON merge patch (RFC 7396) of a single record from invoice_items table in the main database, zero values are written and null sets a field to null
+// @Tags InvoiceItems
+// @Accept  json
+// @Produce  json
+// @Param  argInvoiceLineID path int true "InvoiceLineId"
+// @Param  InvoiceItems body object true "JSON merge patch of the InvoiceItems record"
+// @Success 200 {object} model.InvoiceItems
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoiceitems/{argInvoiceLineID} [patch]
+// echo '{"invoice_line_id": 47,"invoice_id": 63,"track_id": 97,"unit_price": 0.7157533995331656,"quantity": 19}' | http PATCH "http://localhost:8080/invoiceitems/1"  X-Api-User:user123
+func (h *InvoiceItemsHandler) Patch(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argInvoiceLineID, err := parseInt32(ps, "argInvoiceLineID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := readJSON(r, &patch); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoice_items", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	invoiceitems, _, err := h.Repository.Patch(ctx, argInvoiceLineID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, invoiceitems)
+}
+
+// Delete Delete a single record from invoice_items table in the main database
+// @Summary Delete a record from invoice_items
+// @Description Delete a single record from invoice_items table in the main database
+// @Tags InvoiceItems
+// @Accept  json
+// @Produce  json
+// @Param  argInvoiceLineID path int true "InvoiceLineId"
+// @Success 204 {object} model.InvoiceItems
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /invoiceitems/{argInvoiceLineID} [delete]
+// http DELETE "http://localhost:8080/invoiceitems/1" X-Api-User:user123
+func (h *InvoiceItemsHandler) Delete(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argInvoiceLineID, err := parseInt32(ps, "argInvoiceLineID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoice_items", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	rowsAffected, err := h.Repository.Delete(ctx, argInvoiceLineID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeRowsAffected(w, rowsAffected)
+}
+
+// batch execute a create, update or delete of a invoice_items record in a Batch
+func (h *InvoiceItemsHandler) batch(ctx context.Context, r *http.Request, op *BatchOperation) (interface{}, error) {
+	invoiceitems := &model.InvoiceItems{}
+	if op.Op == "create" || op.Op == "update" {
+		if err := json.Unmarshal(op.Record, invoiceitems); err != nil {
+			return nil, dao.ErrBadParams
+		}
+	}
+
+	if op.Op == "create" {
+		invoiceitems.Prepare()
+
+		if err := invoiceitems.Validate(model.Create); err != nil {
+			return nil, dao.ErrBadParams
+		}
+
+		if err := ValidateRequest(ctx, r, "invoice_items", model.Create); err != nil {
+			return nil, err
+		}
+
+		result, _, err := h.Repository.Add(ctx, invoiceitems)
+		return result, err
+	}
+
+	var ps httprouter.Params
+	for i, key := range []string{"argInvoiceLineID"} {
+		if i >= len(op.ID) {
+			return nil, dao.ErrBadParams
+		}
+		ps = append(ps, httprouter.Param{Key: key, Value: op.ID[i]})
+	}
+
+	argInvoiceLineID, err := parseInt32(ps, "argInvoiceLineID")
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case "update":
+		invoiceitems.Prepare()
+
+		if err := invoiceitems.Validate(model.Update); err != nil {
+			return nil, dao.ErrBadParams
+		}
+
+		if err := ValidateRequest(ctx, r, "invoice_items", model.Update); err != nil {
+			return nil, err
+		}
+
+		result, _, err := h.Repository.Update(ctx, argInvoiceLineID, invoiceitems)
+		return result, err
+
+	case "delete":
+		if err := ValidateRequest(ctx, r, "invoice_items", model.Delete); err != nil {
+			return nil, err
+		}
+
+		rowsAffected, err := h.Repository.Delete(ctx, argInvoiceLineID)
+		return rowsAffected, err
+	}
+
+	return nil, fmt.Errorf("unknown batch operation %q", op.Op)
+}
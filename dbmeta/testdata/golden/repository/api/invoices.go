@@ -0,0 +1,524 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"example.com/rest/example/dao"
+	"example.com/rest/example/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guregu/null"
+	"github.com/julienschmidt/httprouter"
+)
+
+// InvoicesHandler handles the requests of the invoices table with the injected repository
+type InvoicesHandler struct {
+	Repository dao.InvoicesRepository
+
+	// Tx run the requests writing several records in a transaction of the database handle of Repository
+	Tx dao.TxRunner
+}
+
+// NewInvoicesHandler create a InvoicesHandler using repository and its transactions tx, see Repositories.Tx
+func NewInvoicesHandler(repository dao.InvoicesRepository, tx dao.TxRunner) *InvoicesHandler {
+	return &InvoicesHandler{Repository: repository, Tx: tx}
+}
+
+func configInvoicesRouter(router *httprouter.Router, handler *InvoicesHandler) {
+	router.GET("/invoices", handler.GetAll)
+	router.POST("/invoices", handler.Add)
+	router.POST("/invoices/upsert", handler.Upsert)
+	router.POST("/invoices/bulk", handler.BulkAdd)
+	router.GET("/invoices/:argInvoiceID", handler.Get)
+	router.PUT("/invoices/:argInvoiceID", handler.Update)
+	router.PATCH("/invoices/:argInvoiceID", handler.Patch)
+	router.DELETE("/invoices/:argInvoiceID", handler.Delete)
+}
+
+func configGinInvoicesRouter(router gin.IRoutes, handler *InvoicesHandler) {
+	router.GET("/invoices", ConverHttprouterToGin(handler.GetAll))
+	router.POST("/invoices", ConverHttprouterToGin(handler.Add))
+	router.POST("/invoices/upsert", ConverHttprouterToGin(handler.Upsert))
+	router.POST("/invoices/bulk", ConverHttprouterToGin(handler.BulkAdd))
+	router.GET("/invoices/:argInvoiceID", ConverHttprouterToGin(handler.Get))
+	router.PUT("/invoices/:argInvoiceID", ConverHttprouterToGin(handler.Update))
+	router.PATCH("/invoices/:argInvoiceID", ConverHttprouterToGin(handler.Patch))
+	router.DELETE("/invoices/:argInvoiceID", ConverHttprouterToGin(handler.Delete))
+}
+
+// GetAll is a function to get a slice of record(s) from invoices table in the main database
+// @Summary Get list of Invoices
+// @Tags Invoices
+// @Description GetAllInvoices is a handler to get a slice of record(s) from invoices table in the main database
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   order    query    string  false        "comma separated json names of the sort columns, descending if prefixed by -, e.g. col1,-col2"
+// @Param   cursor   query    string  false        "next_cursor of the previous page, empty for the first page, selects keyset pagination and ignores page"
+// @Param   count    query    string  false        "with a cursor, exact to count the records matching the filter, estimate to estimate the records of the table"
+// @Param   invoice_id query int false "filter on InvoiceId, invoice_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   customer_id query int false "filter on CustomerId, customer_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   invoice_date query time.Time false "filter on InvoiceDate, invoice_date__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   billing_address query string false "filter on BillingAddress, billing_address__<op> with op one of eq, in, null, like, ilike"
+// @Param   billing_city query string false "filter on BillingCity, billing_city__<op> with op one of eq, in, null, like, ilike"
+// @Param   billing_state query string false "filter on BillingState, billing_state__<op> with op one of eq, in, null, like, ilike"
+// @Param   billing_country query string false "filter on BillingCountry, billing_country__<op> with op one of eq, in, null, like, ilike"
+// @Param   billing_postal_code query string false "filter on BillingPostalCode, billing_postal_code__<op> with op one of eq, in, null, like, ilike"
+// @Param   total query float64 false "filter on Total, total__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Success 200 {object} api.PagedResults{data=[]model.Invoices}
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoices [get]
+// http "http://localhost:8080/invoices?page=0&pagesize=20" X-Api-User:user123
+func (h *InvoicesHandler) GetAll(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	order := r.FormValue("order")
+
+	filter := &dao.InvoicesFilter{}
+	if err := readFilter(r, filter); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoices", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if _, ok := r.URL.Query()["cursor"]; ok {
+		count := dao.CountMode(r.FormValue("count"))
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+
+		records, nextCursor, totalRows, err := h.Repository.GetPage(ctx, r.FormValue("cursor"), pagesize, order, filter, count)
+		if err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+
+		result := &PagedResults{PageSize: pagesize, Data: records, TotalRecords: totalRows, NextCursor: nextCursor}
+		writeJSON(ctx, w, result)
+		return
+	}
+
+	records, totalRows, err := h.Repository.GetAll(ctx, page, pagesize, order, filter)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	writeJSON(ctx, w, result)
+}
+
+// Get is a function to get a single record from the invoices table in the main database
+// @Summary Get record from table Invoices by  argInvoiceID
+// @Tags Invoices
+// @ID argInvoiceID
+// @Description GetInvoices is a function to get a single record from the invoices table in the main database
+// @Accept  json
+// @Produce  json
+// @Param  argInvoiceID path int true "InvoiceId"
+// @Success 200 {object} model.Invoices
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /invoices/{argInvoiceID} [get]
+// http "http://localhost:8080/invoices/1" X-Api-User:user123
+func (h *InvoicesHandler) Get(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argInvoiceID, err := parseInt32(ps, "argInvoiceID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoices", model.RetrieveOne); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, err := h.Repository.Get(ctx, argInvoiceID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, record)
+}
+
+// Add add to add a single record to invoices table in the main database
+// @Summary Add an record to invoices table
+// @Description add to add a single record to invoices table in the main database
+// @Tags Invoices
+// @Accept  json
+// @Produce  json
+// @Param Invoices body model.Invoices true "Add Invoices"
+// @Success 200 {object} model.Invoices
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoices [post]
+// echo '{"invoice_id": 33,"customer_id": 66,"invoice_date": "2020-01-23T05:24:50Z","billing_address": "voQxveuavXlKMDAmGUCDmDDUt","billing_city": "uuwIfeIplkkgqRrBSuOHQGCkK","billing_state": "YPDMZBqQDEPQSwvdDgpLbXuEf","billing_country": "NuBsUvxGnZSwSAMmiYbNMGTLS","billing_postal_code": "NvXQvHJkDJjxVWWMlbCTcwiZA","total": 0.4922718791101031}' | http POST "http://localhost:8080/invoices" X-Api-User:user123
+func (h *InvoicesHandler) Add(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	invoices := &model.Invoices{}
+
+	if err := readJSON(r, invoices); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := invoices.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	invoices.Prepare()
+
+	if err := invoices.Validate(model.Create); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoices", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var err error
+	invoices, _, err = h.Repository.Add(ctx, invoices)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, invoices)
+}
+
+// Upsert add or update records of invoices table in the main database in one transaction
+// @Summary Add or update records of invoices table
+// @Description add the records of a json array to invoices table in the main database, updating the records with the same conflict columns
+// @Tags Invoices
+// @Accept  json
+// @Produce  json
+// @Param   on query string false "comma separated json names of the columns of a unique constraint, the primary key if empty"
+// @Param Invoices body []model.Invoices true "Upsert Invoices"
+// @Success 200 {array} model.Invoices
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoices/upsert [post]
+// echo '[{"invoice_id": 33,"customer_id": 66,"invoice_date": "2020-01-23T05:24:50Z","billing_address": "voQxveuavXlKMDAmGUCDmDDUt","billing_city": "uuwIfeIplkkgqRrBSuOHQGCkK","billing_state": "YPDMZBqQDEPQSwvdDgpLbXuEf","billing_country": "NuBsUvxGnZSwSAMmiYbNMGTLS","billing_postal_code": "NvXQvHJkDJjxVWWMlbCTcwiZA","total": 0.4922718791101031}]' | http POST "http://localhost:8080/invoices/upsert" X-Api-User:user123
+func (h *InvoicesHandler) Upsert(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Invoices
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "invoices", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var conflict []string
+	if on := r.FormValue("on"); on != "" {
+		conflict = strings.Split(on, ",")
+	}
+
+	err := h.Tx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, _, err := h.Repository.Upsert(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+		}
+		return nil
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// BulkAdd add records to invoices table in the main database in one transaction
+// @Summary Add records to invoices table
+// @Description add the records of a json array to invoices table in the main database with multi row inserts
+// @Tags Invoices
+// @Accept  json
+// @Produce  json
+// @Param   batch_size query int false "number of records inserted by each statement (defaults to dao.DefaultBatchSize)"
+// @Param Invoices body []model.Invoices true "Add Invoices"
+// @Success 200 {array} model.Invoices
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoices/bulk [post]
+// echo '[{"invoice_id": 33,"customer_id": 66,"invoice_date": "2020-01-23T05:24:50Z","billing_address": "voQxveuavXlKMDAmGUCDmDDUt","billing_city": "uuwIfeIplkkgqRrBSuOHQGCkK","billing_state": "YPDMZBqQDEPQSwvdDgpLbXuEf","billing_country": "NuBsUvxGnZSwSAMmiYbNMGTLS","billing_postal_code": "NvXQvHJkDJjxVWWMlbCTcwiZA","total": 0.4922718791101031}]' | http POST "http://localhost:8080/invoices/bulk" X-Api-User:user123
+func (h *InvoicesHandler) BulkAdd(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.Invoices
+
+	batchSize, err := readInt(r, "batch_size", 0)
+	if err != nil || batchSize < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "invoices", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	err = h.Tx(ctx, func(ctx context.Context) error {
+		var err error
+		records, _, err = h.Repository.BulkAdd(ctx, records, int(batchSize))
+		return err
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// Update Update a single record from invoices table in the main database
+// @Summary Update an record in table invoices
+// @Description Update a single record from invoices table in the main database
+// @Tags Invoices
+// @Accept  json
+// @Produce  json
+// @Param  argInvoiceID path int true "InvoiceId"
+// @Param  Invoices body model.Invoices true "Update Invoices record"
+// @Success 200 {object} model.Invoices
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoices/{argInvoiceID} [put]
+// echo '{"invoice_id": 33,"customer_id": 66,"invoice_date": "2020-01-23T05:24:50Z","billing_address": "voQxveuavXlKMDAmGUCDmDDUt","billing_city": "uuwIfeIplkkgqRrBSuOHQGCkK","billing_state": "YPDMZBqQDEPQSwvdDgpLbXuEf","billing_country": "NuBsUvxGnZSwSAMmiYbNMGTLS","billing_postal_code": "NvXQvHJkDJjxVWWMlbCTcwiZA","total": 0.4922718791101031}' | http PUT "http://localhost:8080/invoices/1"  X-Api-User:user123
+func (h *InvoicesHandler) Update(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argInvoiceID, err := parseInt32(ps, "argInvoiceID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	invoices := &model.Invoices{}
+	if err := readJSON(r, invoices); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := invoices.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	invoices.Prepare()
+
+	if err := invoices.Validate(model.Update); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoices", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	invoices, _, err = h.Repository.Update(ctx,
+		argInvoiceID,
+		invoices)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, invoices)
+}
+
+// Patch update the fields of a JSON merge patch of a single record from invoices table in the main database
+// @Summary Patch an record in table invoices
+// @Description Update the fields of a JSON merge patch (RFC 7396) of a single record from invoices table in the main database, zero values are written and null sets a field to null
+// @Tags Invoices
+// @Accept  json
+// @Produce  json
+// @Param  argInvoiceID path int true "InvoiceId"
+// @Param  Invoices body object true "JSON merge patch of the Invoices record"
+// @Success 200 {object} model.Invoices
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /invoices/{argInvoiceID} [patch]
+// echo '{"invoice_id": 33,"customer_id": 66,"invoice_date": "2020-01-23T05:24:50Z","billing_address": "voQxveuavXlKMDAmGUCDmDDUt","billing_city": "uuwIfeIplkkgqRrBSuOHQGCkK","billing_state": "YPDMZBqQDEPQSwvdDgpLbXuEf","billing_country": "NuBsUvxGnZSwSAMmiYbNMGTLS","billing_postal_code": "NvXQvHJkDJjxVWWMlbCTcwiZA","total": 0.4922718791101031}' | http PATCH "http://localhost:8080/invoices/1"  X-Api-User:user123
+func (h *InvoicesHandler) Patch(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argInvoiceID, err := parseInt32(ps, "argInvoiceID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := readJSON(r, &patch); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoices", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	invoices, _, err := h.Repository.Patch(ctx, argInvoiceID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, invoices)
+}
+
+// Delete Delete a single record from invoices table in the main database
+// @Summary Delete a record from invoices
+// @Description Delete a single record from invoices table in the main database
+// @Tags Invoices
+// @Accept  json
+// @Produce  json
+// @Param  argInvoiceID path int true "InvoiceId"
+// @Success 204 {object} model.Invoices
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /invoices/{argInvoiceID} [delete]
+// http DELETE "http://localhost:8080/invoices/1" X-Api-User:user123
+func (h *InvoicesHandler) Delete(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argInvoiceID, err := parseInt32(ps, "argInvoiceID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "invoices", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	rowsAffected, err := h.Repository.Delete(ctx, argInvoiceID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeRowsAffected(w, rowsAffected)
+}
+
+// batch execute a create, update or delete of a invoices record in a Batch
+func (h *InvoicesHandler) batch(ctx context.Context, r *http.Request, op *BatchOperation) (interface{}, error) {
+	invoices := &model.Invoices{}
+	if op.Op == "create" || op.Op == "update" {
+		if err := json.Unmarshal(op.Record, invoices); err != nil {
+			return nil, dao.ErrBadParams
+		}
+	}
+
+	if op.Op == "create" {
+		invoices.Prepare()
+
+		if err := invoices.Validate(model.Create); err != nil {
+			return nil, dao.ErrBadParams
+		}
+
+		if err := ValidateRequest(ctx, r, "invoices", model.Create); err != nil {
+			return nil, err
+		}
+
+		result, _, err := h.Repository.Add(ctx, invoices)
+		return result, err
+	}
+
+	var ps httprouter.Params
+	for i, key := range []string{"argInvoiceID"} {
+		if i >= len(op.ID) {
+			return nil, dao.ErrBadParams
+		}
+		ps = append(ps, httprouter.Param{Key: key, Value: op.ID[i]})
+	}
+
+	argInvoiceID, err := parseInt32(ps, "argInvoiceID")
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case "update":
+		invoices.Prepare()
+
+		if err := invoices.Validate(model.Update); err != nil {
+			return nil, dao.ErrBadParams
+		}
+
+		if err := ValidateRequest(ctx, r, "invoices", model.Update); err != nil {
+			return nil, err
+		}
+
+		result, _, err := h.Repository.Update(ctx, argInvoiceID, invoices)
+		return result, err
+
+	case "delete":
+		if err := ValidateRequest(ctx, r, "invoices", model.Delete); err != nil {
+			return nil, err
+		}
+
+		rowsAffected, err := h.Repository.Delete(ctx, argInvoiceID)
+		return rowsAffected, err
+	}
+
+	return nil, fmt.Errorf("unknown batch operation %q", op.Op)
+}
@@ -0,0 +1,517 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"example.com/rest/example/dao"
+	"example.com/rest/example/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guregu/null"
+	"github.com/julienschmidt/httprouter"
+)
+
+// MediaTypesHandler handles the requests of the media_types table with the injected repository
+type MediaTypesHandler struct {
+	Repository dao.MediaTypesRepository
+
+	// Tx run the requests writing several records in a transaction of the database handle of Repository
+	Tx dao.TxRunner
+}
+
+// NewMediaTypesHandler create a MediaTypesHandler using repository and its transactions tx, see Repositories.Tx
+func NewMediaTypesHandler(repository dao.MediaTypesRepository, tx dao.TxRunner) *MediaTypesHandler {
+	return &MediaTypesHandler{Repository: repository, Tx: tx}
+}
+
+func configMediaTypesRouter(router *httprouter.Router, handler *MediaTypesHandler) {
+	router.GET("/mediatypes", handler.GetAll)
+	router.POST("/mediatypes", handler.Add)
+	router.POST("/mediatypes/upsert", handler.Upsert)
+	router.POST("/mediatypes/bulk", handler.BulkAdd)
+	router.GET("/mediatypes/:argMediaTypeID", handler.Get)
+	router.PUT("/mediatypes/:argMediaTypeID", handler.Update)
+	router.PATCH("/mediatypes/:argMediaTypeID", handler.Patch)
+	router.DELETE("/mediatypes/:argMediaTypeID", handler.Delete)
+}
+
+func configGinMediaTypesRouter(router gin.IRoutes, handler *MediaTypesHandler) {
+	router.GET("/mediatypes", ConverHttprouterToGin(handler.GetAll))
+	router.POST("/mediatypes", ConverHttprouterToGin(handler.Add))
+	router.POST("/mediatypes/upsert", ConverHttprouterToGin(handler.Upsert))
+	router.POST("/mediatypes/bulk", ConverHttprouterToGin(handler.BulkAdd))
+	router.GET("/mediatypes/:argMediaTypeID", ConverHttprouterToGin(handler.Get))
+	router.PUT("/mediatypes/:argMediaTypeID", ConverHttprouterToGin(handler.Update))
+	router.PATCH("/mediatypes/:argMediaTypeID", ConverHttprouterToGin(handler.Patch))
+	router.DELETE("/mediatypes/:argMediaTypeID", ConverHttprouterToGin(handler.Delete))
+}
+
+// GetAll is a function to get a slice of record(s) from media_types table in the main database
+// @Summary Get list of MediaTypes
+// @Tags MediaTypes
+// @Description GetAllMediaTypes is a handler to get a slice of record(s) from media_types table in the main database
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   order    query    string  false        "comma separated json names of the sort columns, descending if prefixed by -, e.g. col1,-col2"
+// @Param   cursor   query    string  false        "next_cursor of the previous page, empty for the first page, selects keyset pagination and ignores page"
+// @Param   count    query    string  false        "with a cursor, exact to count the records matching the filter, estimate to estimate the records of the table"
+// @Param   media_type_id query int false "filter on MediaTypeId, media_type_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   name query string false "filter on Name, name__<op> with op one of eq, in, null, like, ilike"
+// @Success 200 {object} api.PagedResults{data=[]model.MediaTypes}
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /mediatypes [get]
+// http "http://localhost:8080/mediatypes?page=0&pagesize=20" X-Api-User:user123
+func (h *MediaTypesHandler) GetAll(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	order := r.FormValue("order")
+
+	filter := &dao.MediaTypesFilter{}
+	if err := readFilter(r, filter); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "media_types", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if _, ok := r.URL.Query()["cursor"]; ok {
+		count := dao.CountMode(r.FormValue("count"))
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+
+		records, nextCursor, totalRows, err := h.Repository.GetPage(ctx, r.FormValue("cursor"), pagesize, order, filter, count)
+		if err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+
+		result := &PagedResults{PageSize: pagesize, Data: records, TotalRecords: totalRows, NextCursor: nextCursor}
+		writeJSON(ctx, w, result)
+		return
+	}
+
+	records, totalRows, err := h.Repository.GetAll(ctx, page, pagesize, order, filter)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	writeJSON(ctx, w, result)
+}
+
+// Get is a function to get a single record from the media_types table in the main database
+// @Summary Get record from table MediaTypes by  argMediaTypeID
+// @Tags MediaTypes
+// @ID argMediaTypeID
+// @Description GetMediaTypes is a function to get a single record from the media_types table in the main database
+// @Accept  json
+// @Produce  json
+// @Param  argMediaTypeID path int true "MediaTypeId"
+// @Success 200 {object} model.MediaTypes
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /mediatypes/{argMediaTypeID} [get]
+// http "http://localhost:8080/mediatypes/1" X-Api-User:user123
+func (h *MediaTypesHandler) Get(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argMediaTypeID, err := parseInt32(ps, "argMediaTypeID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "media_types", model.RetrieveOne); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, err := h.Repository.Get(ctx, argMediaTypeID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, record)
+}
+
+// Add add to add a single record to media_types table in the main database
+// @Summary Add an record to media_types table
+// @Description add to add a single record to media_types table in the main database
+// @Tags MediaTypes
+// @Accept  json
+// @Produce  json
+// @Param MediaTypes body model.MediaTypes true "Add MediaTypes"
+// @Success 200 {object} model.MediaTypes
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /mediatypes [post]
+// echo '{"media_type_id": 94,"name": "LJUlwBVLqEKdRaZuvogKeYXeN"}' | http POST "http://localhost:8080/mediatypes" X-Api-User:user123
+func (h *MediaTypesHandler) Add(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	mediatypes := &model.MediaTypes{}
+
+	if err := readJSON(r, mediatypes); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := mediatypes.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	mediatypes.Prepare()
+
+	if err := mediatypes.Validate(model.Create); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "media_types", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var err error
+	mediatypes, _, err = h.Repository.Add(ctx, mediatypes)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, mediatypes)
+}
+
+// Upsert add or update records of media_types table in the main database in one transaction
+// @Summary Add or update records of media_types table
+// @Description add the records of a json array to media_types table in the main database, updating the records with the same conflict columns
+// @Tags MediaTypes
+// @Accept  json
+// @Produce  json
+// @Param   on query string false "comma separated json names of the columns of a unique constraint, the primary key if empty"
+// @Param MediaTypes body []model.MediaTypes true "Upsert MediaTypes"
+// @Success 200 {array} model.MediaTypes
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /mediatypes/upsert [post]
+// echo '[{"media_type_id": 94,"name": "LJUlwBVLqEKdRaZuvogKeYXeN"}]' | http POST "http://localhost:8080/mediatypes/upsert" X-Api-User:user123
+func (h *MediaTypesHandler) Upsert(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.MediaTypes
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "media_types", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var conflict []string
+	if on := r.FormValue("on"); on != "" {
+		conflict = strings.Split(on, ",")
+	}
+
+	err := h.Tx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, _, err := h.Repository.Upsert(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+		}
+		return nil
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// BulkAdd add records to media_types table in the main database in one transaction
+// @Summary Add records to media_types table
+// @Description add the records of a json array to media_types table in the main database with multi row inserts
+// @Tags MediaTypes
+// @Accept  json
+// @Produce  json
+// @Param   batch_size query int false "number of records inserted by each statement (defaults to dao.DefaultBatchSize)"
+// @Param MediaTypes body []model.MediaTypes true "Add MediaTypes"
+// @Success 200 {array} model.MediaTypes
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /mediatypes/bulk [post]
+// echo '[{"media_type_id": 94,"name": "LJUlwBVLqEKdRaZuvogKeYXeN"}]' | http POST "http://localhost:8080/mediatypes/bulk" X-Api-User:user123
+func (h *MediaTypesHandler) BulkAdd(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.MediaTypes
+
+	batchSize, err := readInt(r, "batch_size", 0)
+	if err != nil || batchSize < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "media_types", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	err = h.Tx(ctx, func(ctx context.Context) error {
+		var err error
+		records, _, err = h.Repository.BulkAdd(ctx, records, int(batchSize))
+		return err
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// Update Update a single record from media_types table in the main database
+// @Summary Update an record in table media_types
+// @Description Update a single record from media_types table in the main database
+// @Tags MediaTypes
+// @Accept  json
+// @Produce  json
+// @Param  argMediaTypeID path int true "MediaTypeId"
+// @Param  MediaTypes body model.MediaTypes true "Update MediaTypes record"
+// @Success 200 {object} model.MediaTypes
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /mediatypes/{argMediaTypeID} [put]
+// echo '{"media_type_id": 94,"name": "LJUlwBVLqEKdRaZuvogKeYXeN"}' | http PUT "http://localhost:8080/mediatypes/1"  X-Api-User:user123
+func (h *MediaTypesHandler) Update(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argMediaTypeID, err := parseInt32(ps, "argMediaTypeID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	mediatypes := &model.MediaTypes{}
+	if err := readJSON(r, mediatypes); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := mediatypes.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	mediatypes.Prepare()
+
+	if err := mediatypes.Validate(model.Update); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "media_types", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	mediatypes, _, err = h.Repository.Update(ctx,
+		argMediaTypeID,
+		mediatypes)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, mediatypes)
+}
+
+// Patch update the fields of a JSON merge patch of a single record from media_types table in the main database
+// @Summary Patch an record in table media_types
+// @Description Update the fields of a JSON merge patch (RFC 7396) of a single record from media_types table in the main database, zero values are written and null sets a field to null
+// @Tags MediaTypes
+// @Accept  json
+// @Produce  json
+// @Param  argMediaTypeID path int true "MediaTypeId"
+// @Param  MediaTypes body object true "JSON merge patch of the MediaTypes record"
+// @Success 200 {object} model.MediaTypes
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /mediatypes/{argMediaTypeID} [patch]
+// echo '{"media_type_id": 94,"name": "LJUlwBVLqEKdRaZuvogKeYXeN"}' | http PATCH "http://localhost:8080/mediatypes/1"  X-Api-User:user123
+func (h *MediaTypesHandler) Patch(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argMediaTypeID, err := parseInt32(ps, "argMediaTypeID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := readJSON(r, &patch); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "media_types", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	mediatypes, _, err := h.Repository.Patch(ctx, argMediaTypeID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, mediatypes)
+}
+
+// Delete Delete a single record from media_types table in the main database
+// @Summary Delete a record from media_types
+// @Description Delete a single record from media_types table in the main database
+// @Tags MediaTypes
+// @Accept  json
+// @Produce  json
+// @Param  argMediaTypeID path int true "MediaTypeId"
+// @Success 204 {object} model.MediaTypes
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /mediatypes/{argMediaTypeID} [delete]
+// http DELETE "http://localhost:8080/mediatypes/1" X-Api-User:user123
+func (h *MediaTypesHandler) Delete(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argMediaTypeID, err := parseInt32(ps, "argMediaTypeID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "media_types", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	rowsAffected, err := h.Repository.Delete(ctx, argMediaTypeID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeRowsAffected(w, rowsAffected)
+}
+
+// batch execute a create, update or delete of a media_types record in a Batch
+func (h *MediaTypesHandler) batch(ctx context.Context, r *http.Request, op *BatchOperation) (interface{}, error) {
+	mediatypes := &model.MediaTypes{}
+	if op.Op == "create" || op.Op == "update" {
+		if err := json.Unmarshal(op.Record, mediatypes); err != nil {
+			return nil, dao.ErrBadParams
+		}
+	}
+
+	if op.Op == "create" {
+		mediatypes.Prepare()
+
+		if err := mediatypes.Validate(model.Create); err != nil {
+			return nil, dao.ErrBadParams
+		}
+
+		if err := ValidateRequest(ctx, r, "media_types", model.Create); err != nil {
+			return nil, err
+		}
+
+		result, _, err := h.Repository.Add(ctx, mediatypes)
+		return result, err
+	}
+
+	var ps httprouter.Params
+	for i, key := range []string{"argMediaTypeID"} {
+		if i >= len(op.ID) {
+			return nil, dao.ErrBadParams
+		}
+		ps = append(ps, httprouter.Param{Key: key, Value: op.ID[i]})
+	}
+
+	argMediaTypeID, err := parseInt32(ps, "argMediaTypeID")
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case "update":
+		mediatypes.Prepare()
+
+		if err := mediatypes.Validate(model.Update); err != nil {
+			return nil, dao.ErrBadParams
+		}
+
+		if err := ValidateRequest(ctx, r, "media_types", model.Update); err != nil {
+			return nil, err
+		}
+
+		result, _, err := h.Repository.Update(ctx, argMediaTypeID, mediatypes)
+		return result, err
+
+	case "delete":
+		if err := ValidateRequest(ctx, r, "media_types", model.Delete); err != nil {
+			return nil, err
+		}
+
+		rowsAffected, err := h.Repository.Delete(ctx, argMediaTypeID)
+		return rowsAffected, err
+	}
+
+	return nil, fmt.Errorf("unknown batch operation %q", op.Op)
+}
@@ -0,0 +1,517 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"example.com/rest/example/dao"
+	"example.com/rest/example/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guregu/null"
+	"github.com/julienschmidt/httprouter"
+)
+
+// PlaylistTrackHandler handles the requests of the playlist_track table with the injected repository
+type PlaylistTrackHandler struct {
+	Repository dao.PlaylistTrackRepository
+
+	// Tx run the requests writing several records in a transaction of the database handle of Repository
+	Tx dao.TxRunner
+}
+
+// NewPlaylistTrackHandler create a PlaylistTrackHandler using repository and its transactions tx, see Repositories.Tx
+func NewPlaylistTrackHandler(repository dao.PlaylistTrackRepository, tx dao.TxRunner) *PlaylistTrackHandler {
+	return &PlaylistTrackHandler{Repository: repository, Tx: tx}
+}
+
+func configPlaylistTrackRouter(router *httprouter.Router, handler *PlaylistTrackHandler) {
+	router.GET("/playlisttrack", handler.GetAll)
+	router.POST("/playlisttrack", handler.Add)
+	router.POST("/playlisttrack/upsert", handler.Upsert)
+	router.POST("/playlisttrack/bulk", handler.BulkAdd)
+	router.GET("/playlisttrack/:argPlaylistID", handler.Get)
+	router.PUT("/playlisttrack/:argPlaylistID", handler.Update)
+	router.PATCH("/playlisttrack/:argPlaylistID", handler.Patch)
+	router.DELETE("/playlisttrack/:argPlaylistID", handler.Delete)
+}
+
+func configGinPlaylistTrackRouter(router gin.IRoutes, handler *PlaylistTrackHandler) {
+	router.GET("/playlisttrack", ConverHttprouterToGin(handler.GetAll))
+	router.POST("/playlisttrack", ConverHttprouterToGin(handler.Add))
+	router.POST("/playlisttrack/upsert", ConverHttprouterToGin(handler.Upsert))
+	router.POST("/playlisttrack/bulk", ConverHttprouterToGin(handler.BulkAdd))
+	router.GET("/playlisttrack/:argPlaylistID", ConverHttprouterToGin(handler.Get))
+	router.PUT("/playlisttrack/:argPlaylistID", ConverHttprouterToGin(handler.Update))
+	router.PATCH("/playlisttrack/:argPlaylistID", ConverHttprouterToGin(handler.Patch))
+	router.DELETE("/playlisttrack/:argPlaylistID", ConverHttprouterToGin(handler.Delete))
+}
+
+// GetAll is a function to get a slice of record(s) from playlist_track table in the main database
+// @Summary Get list of PlaylistTrack
+// @Tags PlaylistTrack
+// @Description GetAllPlaylistTrack is a handler to get a slice of record(s) from playlist_track table in the main database
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   order    query    string  false        "comma separated json names of the sort columns, descending if prefixed by -, e.g. col1,-col2"
+// @Param   cursor   query    string  false        "next_cursor of the previous page, empty for the first page, selects keyset pagination and ignores page"
+// @Param   count    query    string  false        "with a cursor, exact to count the records matching the filter, estimate to estimate the records of the table"
+// @Param   playlist_id query int false "filter on PlaylistId, playlist_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   track_id query int false "filter on TrackId, track_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Success 200 {object} api.PagedResults{data=[]model.PlaylistTrack}
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /playlisttrack [get]
+// http "http://localhost:8080/playlisttrack?page=0&pagesize=20" X-Api-User:user123
+func (h *PlaylistTrackHandler) GetAll(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	order := r.FormValue("order")
+
+	filter := &dao.PlaylistTrackFilter{}
+	if err := readFilter(r, filter); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "playlist_track", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if _, ok := r.URL.Query()["cursor"]; ok {
+		count := dao.CountMode(r.FormValue("count"))
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+
+		records, nextCursor, totalRows, err := h.Repository.GetPage(ctx, r.FormValue("cursor"), pagesize, order, filter, count)
+		if err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+
+		result := &PagedResults{PageSize: pagesize, Data: records, TotalRecords: totalRows, NextCursor: nextCursor}
+		writeJSON(ctx, w, result)
+		return
+	}
+
+	records, totalRows, err := h.Repository.GetAll(ctx, page, pagesize, order, filter)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	writeJSON(ctx, w, result)
+}
+
+// Get is a function to get a single record from the playlist_track table in the main database
+// @Summary Get record from table PlaylistTrack by  argPlaylistID
+// @Tags PlaylistTrack
+// @ID argPlaylistID
+// @Description GetPlaylistTrack is a function to get a single record from the playlist_track table in the main database
+// @Accept  json
+// @Produce  json
+// @Param  argPlaylistID path int true "PlaylistId"
+// @Success 200 {object} model.PlaylistTrack
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /playlisttrack/{argPlaylistID} [get]
+// http "http://localhost:8080/playlisttrack/1" X-Api-User:user123
+func (h *PlaylistTrackHandler) Get(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argPlaylistID, err := parseInt32(ps, "argPlaylistID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "playlist_track", model.RetrieveOne); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, err := h.Repository.Get(ctx, argPlaylistID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, record)
+}
+
+// Add add to add a single record to playlist_track table in the main database
+// @Summary Add an record to playlist_track table
+// @Description add to add a single record to playlist_track table in the main database
+// @Tags PlaylistTrack
+// @Accept  json
+// @Produce  json
+// @Param PlaylistTrack body model.PlaylistTrack true "Add PlaylistTrack"
+// @Success 200 {object} model.PlaylistTrack
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /playlisttrack [post]
+// echo '{"playlist_id": 78,"track_id": 45}' | http POST "http://localhost:8080/playlisttrack" X-Api-User:user123
+func (h *PlaylistTrackHandler) Add(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	playlisttrack := &model.PlaylistTrack{}
+
+	if err := readJSON(r, playlisttrack); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := playlisttrack.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	playlisttrack.Prepare()
+
+	if err := playlisttrack.Validate(model.Create); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "playlist_track", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var err error
+	playlisttrack, _, err = h.Repository.Add(ctx, playlisttrack)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, playlisttrack)
+}
+
+// Upsert add or update records of playlist_track table in the main database in one transaction
+// @Summary Add or update records of playlist_track table
+// @Description add the records of a json array to playlist_track table in the main database, updating the records with the same conflict columns
+// @Tags PlaylistTrack
+// @Accept  json
+// @Produce  json
+// @Param   on query string false "comma separated json names of the columns of a unique constraint, the primary key if empty"
+// @Param PlaylistTrack body []model.PlaylistTrack true "Upsert PlaylistTrack"
+// @Success 200 {array} model.PlaylistTrack
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /playlisttrack/upsert [post]
+// echo '[{"playlist_id": 78,"track_id": 45}]' | http POST "http://localhost:8080/playlisttrack/upsert" X-Api-User:user123
+func (h *PlaylistTrackHandler) Upsert(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.PlaylistTrack
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "playlist_track", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var conflict []string
+	if on := r.FormValue("on"); on != "" {
+		conflict = strings.Split(on, ",")
+	}
+
+	err := h.Tx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, _, err := h.Repository.Upsert(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+		}
+		return nil
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// BulkAdd add records to playlist_track table in the main database in one transaction
+// @Summary Add records to playlist_track table
+// @Description add the records of a json array to playlist_track table in the main database with multi row inserts
+// @Tags PlaylistTrack
+// @Accept  json
+// @Produce  json
+// @Param   batch_size query int false "number of records inserted by each statement (defaults to dao.DefaultBatchSize)"
+// @Param PlaylistTrack body []model.PlaylistTrack true "Add PlaylistTrack"
+// @Success 200 {array} model.PlaylistTrack
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /playlisttrack/bulk [post]
+// echo '[{"playlist_id": 78,"track_id": 45}]' | http POST "http://localhost:8080/playlisttrack/bulk" X-Api-User:user123
+func (h *PlaylistTrackHandler) BulkAdd(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.PlaylistTrack
+
+	batchSize, err := readInt(r, "batch_size", 0)
+	if err != nil || batchSize < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "playlist_track", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	err = h.Tx(ctx, func(ctx context.Context) error {
+		var err error
+		records, _, err = h.Repository.BulkAdd(ctx, records, int(batchSize))
+		return err
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// Update Update a single record from playlist_track table in the main database
+// @Summary Update an record in table playlist_track
+// @Description Update a single record from playlist_track table in the main database
+// @Tags PlaylistTrack
+// @Accept  json
+// @Produce  json
+// @Param  argPlaylistID path int true "PlaylistId"
+// @Param  PlaylistTrack body model.PlaylistTrack true "Update PlaylistTrack record"
+// @Success 200 {object} model.PlaylistTrack
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /playlisttrack/{argPlaylistID} [put]
+// echo '{"playlist_id": 78,"track_id": 45}' | http PUT "http://localhost:8080/playlisttrack/1"  X-Api-User:user123
+func (h *PlaylistTrackHandler) Update(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argPlaylistID, err := parseInt32(ps, "argPlaylistID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	playlisttrack := &model.PlaylistTrack{}
+	if err := readJSON(r, playlisttrack); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := playlisttrack.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	playlisttrack.Prepare()
+
+	if err := playlisttrack.Validate(model.Update); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "playlist_track", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	playlisttrack, _, err = h.Repository.Update(ctx,
+		argPlaylistID,
+		playlisttrack)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, playlisttrack)
+}
+
+// Patch update the fields of a JSON merge patch of a single record from playlist_track table in the main database
+// @Summary Patch an record in table playlist_track
+// @Description Update the fields of a JSON merge patch (RFC 7396) of a single record from playlist_track table in the main database, zero values are written and null sets a field to null
+// @Tags PlaylistTrack
+// @Accept  json
+// @Produce  json
+// @Param  argPlaylistID path int true "PlaylistId"
+// @Param  PlaylistTrack body object true "JSON merge patch of the PlaylistTrack record"
+// @Success 200 {object} model.PlaylistTrack
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /playlisttrack/{argPlaylistID} [patch]
+// echo '{"playlist_id": 78,"track_id": 45}' | http PATCH "http://localhost:8080/playlisttrack/1"  X-Api-User:user123
+func (h *PlaylistTrackHandler) Patch(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argPlaylistID, err := parseInt32(ps, "argPlaylistID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := readJSON(r, &patch); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "playlist_track", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	playlisttrack, _, err := h.Repository.Patch(ctx, argPlaylistID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, playlisttrack)
+}
+
+// Delete Delete a single record from playlist_track table in the main database
+// @Summary Delete a record from playlist_track
+// @Description Delete a single record from playlist_track table in the main database
+// @Tags PlaylistTrack
+// @Accept  json
+// @Produce  json
+// @Param  argPlaylistID path int true "PlaylistId"
+// @Success 204 {object} model.PlaylistTrack
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /playlisttrack/{argPlaylistID} [delete]
+// http DELETE "http://localhost:8080/playlisttrack/1" X-Api-User:user123
+func (h *PlaylistTrackHandler) Delete(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argPlaylistID, err := parseInt32(ps, "argPlaylistID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "playlist_track", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	rowsAffected, err := h.Repository.Delete(ctx, argPlaylistID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeRowsAffected(w, rowsAffected)
+}
+
+// batch execute a create, update or delete of a playlist_track record in a Batch
+func (h *PlaylistTrackHandler) batch(ctx context.Context, r *http.Request, op *BatchOperation) (interface{}, error) {
+	playlisttrack := &model.PlaylistTrack{}
+	if op.Op == "create" || op.Op == "update" {
+		if err := json.Unmarshal(op.Record, playlisttrack); err != nil {
+			return nil, dao.ErrBadParams
+		}
+	}
+
+	if op.Op == "create" {
+		playlisttrack.Prepare()
+
+		if err := playlisttrack.Validate(model.Create); err != nil {
+			return nil, dao.ErrBadParams
+		}
+
+		if err := ValidateRequest(ctx, r, "playlist_track", model.Create); err != nil {
+			return nil, err
+		}
+
+		result, _, err := h.Repository.Add(ctx, playlisttrack)
+		return result, err
+	}
+
+	var ps httprouter.Params
+	for i, key := range []string{"argPlaylistID"} {
+		if i >= len(op.ID) {
+			return nil, dao.ErrBadParams
+		}
+		ps = append(ps, httprouter.Param{Key: key, Value: op.ID[i]})
+	}
+
+	argPlaylistID, err := parseInt32(ps, "argPlaylistID")
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case "update":
+		playlisttrack.Prepare()
+
+		if err := playlisttrack.Validate(model.Update); err != nil {
+			return nil, dao.ErrBadParams
+		}
+
+		if err := ValidateRequest(ctx, r, "playlist_track", model.Update); err != nil {
+			return nil, err
+		}
+
+		result, _, err := h.Repository.Update(ctx, argPlaylistID, playlisttrack)
+		return result, err
+
+	case "delete":
+		if err := ValidateRequest(ctx, r, "playlist_track", model.Delete); err != nil {
+			return nil, err
+		}
+
+		rowsAffected, err := h.Repository.Delete(ctx, argPlaylistID)
+		return rowsAffected, err
+	}
+
+	return nil, fmt.Errorf("unknown batch operation %q", op.Op)
+}
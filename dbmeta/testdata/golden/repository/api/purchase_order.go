@@ -0,0 +1,518 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"example.com/rest/example/dao"
+	"example.com/rest/example/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guregu/null"
+	"github.com/julienschmidt/httprouter"
+)
+
+// PurchaseOrderHandler handles the requests of the purchase_order table with the injected repository
+type PurchaseOrderHandler struct {
+	Repository dao.PurchaseOrderRepository
+
+	// Tx run the requests writing several records in a transaction of the database handle of Repository
+	Tx dao.TxRunner
+}
+
+// NewPurchaseOrderHandler create a PurchaseOrderHandler using repository and its transactions tx, see Repositories.Tx
+func NewPurchaseOrderHandler(repository dao.PurchaseOrderRepository, tx dao.TxRunner) *PurchaseOrderHandler {
+	return &PurchaseOrderHandler{Repository: repository, Tx: tx}
+}
+
+func configPurchaseOrderRouter(router *httprouter.Router, handler *PurchaseOrderHandler) {
+	router.GET("/purchaseorder", handler.GetAll)
+	router.POST("/purchaseorder", handler.Add)
+	router.POST("/purchaseorder/upsert", handler.Upsert)
+	router.POST("/purchaseorder/bulk", handler.BulkAdd)
+	router.GET("/purchaseorder/:argID", handler.Get)
+	router.PUT("/purchaseorder/:argID", handler.Update)
+	router.PATCH("/purchaseorder/:argID", handler.Patch)
+	router.DELETE("/purchaseorder/:argID", handler.Delete)
+}
+
+func configGinPurchaseOrderRouter(router gin.IRoutes, handler *PurchaseOrderHandler) {
+	router.GET("/purchaseorder", ConverHttprouterToGin(handler.GetAll))
+	router.POST("/purchaseorder", ConverHttprouterToGin(handler.Add))
+	router.POST("/purchaseorder/upsert", ConverHttprouterToGin(handler.Upsert))
+	router.POST("/purchaseorder/bulk", ConverHttprouterToGin(handler.BulkAdd))
+	router.GET("/purchaseorder/:argID", ConverHttprouterToGin(handler.Get))
+	router.PUT("/purchaseorder/:argID", ConverHttprouterToGin(handler.Update))
+	router.PATCH("/purchaseorder/:argID", ConverHttprouterToGin(handler.Patch))
+	router.DELETE("/purchaseorder/:argID", ConverHttprouterToGin(handler.Delete))
+}
+
+// GetAll is a function to get a slice of record(s) from purchase_order table in the main database
+// @Summary Get list of PurchaseOrder
+// @Tags PurchaseOrder
+// @Description GetAllPurchaseOrder is a handler to get a slice of record(s) from purchase_order table in the main database
+// @Accept  json
+// @Produce  json
+// @Param   page     query    int     false        "page requested (defaults to 0)"
+// @Param   pagesize query    int     false        "number of records in a page  (defaults to 20)"
+// @Param   order    query    string  false        "comma separated json names of the sort columns, descending if prefixed by -, e.g. col1,-col2"
+// @Param   cursor   query    string  false        "next_cursor of the previous page, empty for the first page, selects keyset pagination and ignores page"
+// @Param   count    query    string  false        "with a cursor, exact to count the records matching the filter, estimate to estimate the records of the table"
+// @Param   id query int false "filter on id, id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   payment_id query int false "filter on payment_id, payment_id__<op> with op one of eq, in, null, gt, gte, lt, lte"
+// @Param   full_name query string false "filter on full_name, full_name__<op> with op one of eq, in, null, like, ilike"
+// @Success 200 {object} api.PagedResults{data=[]model.PurchaseOrder}
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /purchaseorder [get]
+// http "http://localhost:8080/purchaseorder?page=0&pagesize=20" X-Api-User:user123
+func (h *PurchaseOrderHandler) GetAll(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	page, err := readInt(r, "page", 0)
+	if err != nil || page < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	pagesize, err := readInt(r, "pagesize", 20)
+	if err != nil || pagesize <= 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	order := r.FormValue("order")
+
+	filter := &dao.PurchaseOrderFilter{}
+	if err := readFilter(r, filter); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "purchase_order", model.RetrieveMany); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if _, ok := r.URL.Query()["cursor"]; ok {
+		count := dao.CountMode(r.FormValue("count"))
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+
+		records, nextCursor, totalRows, err := h.Repository.GetPage(ctx, r.FormValue("cursor"), pagesize, order, filter, count)
+		if err != nil {
+			returnError(ctx, w, r, err)
+			return
+		}
+
+		result := &PagedResults{PageSize: pagesize, Data: records, TotalRecords: totalRows, NextCursor: nextCursor}
+		writeJSON(ctx, w, result)
+		return
+	}
+
+	records, totalRows, err := h.Repository.GetAll(ctx, page, pagesize, order, filter)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	result := &PagedResults{Page: page, PageSize: pagesize, Data: records, TotalRecords: totalRows}
+	writeJSON(ctx, w, result)
+}
+
+// Get is a function to get a single record from the purchase_order table in the main database
+// @Summary Get record from table PurchaseOrder by  argID
+// @Tags PurchaseOrder
+// @ID argID
+// @Description GetPurchaseOrder is a function to get a single record from the purchase_order table in the main database
+// @Accept  json
+// @Produce  json
+// @Param  argID path int true "id"
+// @Success 200 {object} model.PurchaseOrder
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError "ErrNotFound, db record for id not found - returns NotFound HTTP 404 not found error"
+// @Router /purchaseorder/{argID} [get]
+// http "http://localhost:8080/purchaseorder/1" X-Api-User:user123
+func (h *PurchaseOrderHandler) Get(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt32(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "purchase_order", model.RetrieveOne); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	record, err := h.Repository.Get(ctx, argID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, record)
+}
+
+// Add add to add a single record to purchase_order table in the main database
+// @Summary Add an record to purchase_order table
+// @Description add to add a single record to purchase_order table in the main database
+// @Tags PurchaseOrder
+// @Accept  json
+// @Produce  json
+// @Param PurchaseOrder body model.PurchaseOrder true "Add PurchaseOrder"
+// @Success 200 {object} model.PurchaseOrder
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /purchaseorder [post]
+// echo '{"id": 17,"payment_id": 11,"full_name": "gVGpJwwRFcMPhrmRAXIdIfLQx"}' | http POST "http://localhost:8080/purchaseorder" X-Api-User:user123
+func (h *PurchaseOrderHandler) Add(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	purchaseorder := &model.PurchaseOrder{}
+
+	if err := readJSON(r, purchaseorder); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := purchaseorder.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	purchaseorder.Prepare()
+
+	if err := purchaseorder.Validate(model.Create); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "purchase_order", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var err error
+	purchaseorder, _, err = h.Repository.Add(ctx, purchaseorder)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, purchaseorder)
+}
+
+// Upsert add or update records of purchase_order table in the main database in one transaction
+// @Summary Add or update records of purchase_order table
+// @Description add the records of a json array to purchase_order table in the main database, updating the records with the same conflict columns
+// @Tags PurchaseOrder
+// @Accept  json
+// @Produce  json
+// @Param   on query string false "comma separated json names of the columns of a unique constraint, the primary key if empty"
+// @Param PurchaseOrder body []model.PurchaseOrder true "Upsert PurchaseOrder"
+// @Success 200 {array} model.PurchaseOrder
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /purchaseorder/upsert [post]
+// echo '[{"id": 17,"payment_id": 11,"full_name": "gVGpJwwRFcMPhrmRAXIdIfLQx"}]' | http POST "http://localhost:8080/purchaseorder/upsert" X-Api-User:user123
+func (h *PurchaseOrderHandler) Upsert(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.PurchaseOrder
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "purchase_order", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var conflict []string
+	if on := r.FormValue("on"); on != "" {
+		conflict = strings.Split(on, ",")
+	}
+
+	err := h.Tx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, _, err := h.Repository.Upsert(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+		}
+		return nil
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// BulkAdd add records to purchase_order table in the main database in one transaction
+// @Summary Add records to purchase_order table
+// @Description add the records of a json array to purchase_order table in the main database with multi row inserts
+// @Tags PurchaseOrder
+// @Accept  json
+// @Produce  json
+// @Param   batch_size query int false "number of records inserted by each statement (defaults to dao.DefaultBatchSize)"
+// @Param PurchaseOrder body []model.PurchaseOrder true "Add PurchaseOrder"
+// @Success 200 {array} model.PurchaseOrder
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /purchaseorder/bulk [post]
+// echo '[{"id": 17,"payment_id": 11,"full_name": "gVGpJwwRFcMPhrmRAXIdIfLQx"}]' | http POST "http://localhost:8080/purchaseorder/bulk" X-Api-User:user123
+func (h *PurchaseOrderHandler) BulkAdd(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+	var records []*model.PurchaseOrder
+
+	batchSize, err := readInt(r, "batch_size", 0)
+	if err != nil || batchSize < 0 {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := readJSON(r, &records); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	for _, record := range records {
+		record.Prepare()
+
+		if err := record.Validate(model.Create); err != nil {
+			returnError(ctx, w, r, dao.ErrBadParams)
+			return
+		}
+	}
+
+	if err := ValidateRequest(ctx, r, "purchase_order", model.Create); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	err = h.Tx(ctx, func(ctx context.Context) error {
+		var err error
+		records, _, err = h.Repository.BulkAdd(ctx, records, int(batchSize))
+		return err
+	})
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, records)
+}
+
+// Update Update a single record from purchase_order table in the main database
+// @Summary Update an record in table purchase_order
+// @Description Update a single record from purchase_order table in the main database
+// @Tags PurchaseOrder
+// @Accept  json
+// @Produce  json
+// @Param  argID path int true "id"
+// @Param  PurchaseOrder body model.PurchaseOrder true "Update PurchaseOrder record"
+// @Success 200 {object} model.PurchaseOrder
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /purchaseorder/{argID} [put]
+// echo '{"id": 17,"payment_id": 11,"full_name": "gVGpJwwRFcMPhrmRAXIdIfLQx"}' | http PUT "http://localhost:8080/purchaseorder/1"  X-Api-User:user123
+func (h *PurchaseOrderHandler) Update(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt32(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	purchaseorder := &model.PurchaseOrder{}
+	if err := readJSON(r, purchaseorder); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := purchaseorder.BeforeSave(); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+	}
+
+	purchaseorder.Prepare()
+
+	if err := purchaseorder.Validate(model.Update); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "purchase_order", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	purchaseorder, _, err = h.Repository.Update(ctx,
+		argID,
+		purchaseorder)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, purchaseorder)
+}
+
+// Patch update the fields of a JSON merge patch of a single record from purchase_order table in the main database
+// @Summary Patch an record in table purchase_order
+// @Description Update the fields of a JSON merge patch (RFC 7396) of a single record from purchase_order table in the main database, zero values are written and null sets a field to null
+// @Tags PurchaseOrder
+// @Accept  json
+// @Produce  json
+// @Param  argID path int true "id"
+// @Param  PurchaseOrder body object true "JSON merge patch of the PurchaseOrder record"
+// @Success 200 {object} model.PurchaseOrder
+// @Failure 400 {object} api.HTTPError
+// @Failure 404 {object} api.HTTPError
+// @Router /purchaseorder/{argID} [patch]
+// echo '{"id": 17,"payment_id": 11,"full_name": "gVGpJwwRFcMPhrmRAXIdIfLQx"}' | http PATCH "http://localhost:8080/purchaseorder/1"  X-Api-User:user123
+func (h *PurchaseOrderHandler) Patch(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt32(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := readJSON(r, &patch); err != nil {
+		returnError(ctx, w, r, dao.ErrBadParams)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "purchase_order", model.Update); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	purchaseorder, _, err := h.Repository.Patch(ctx, argID, patch)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeJSON(ctx, w, purchaseorder)
+}
+
+// Delete Delete a single record from purchase_order table in the main database
+// @Summary Delete a record from purchase_order
+// @Description Delete a single record from purchase_order table in the main database
+// @Tags PurchaseOrder
+// @Accept  json
+// @Produce  json
+// @Param  argID path int true "id"
+// @Success 204 {object} model.PurchaseOrder
+// @Failure 400 {object} api.HTTPError
+// @Failure 500 {object} api.HTTPError
+// @Router /purchaseorder/{argID} [delete]
+// http DELETE "http://localhost:8080/purchaseorder/1" X-Api-User:user123
+func (h *PurchaseOrderHandler) Delete(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	ctx := initializeContext(r)
+
+	argID, err := parseInt32(ps, "argID")
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	if err := ValidateRequest(ctx, r, "purchase_order", model.Delete); err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	rowsAffected, err := h.Repository.Delete(ctx, argID)
+	if err != nil {
+		returnError(ctx, w, r, err)
+		return
+	}
+
+	writeRowsAffected(w, rowsAffected)
+}
+
+// batch execute a create, update or delete of a purchase_order record in a Batch
+func (h *PurchaseOrderHandler) batch(ctx context.Context, r *http.Request, op *BatchOperation) (interface{}, error) {
+	purchaseorder := &model.PurchaseOrder{}
+	if op.Op == "create" || op.Op == "update" {
+		if err := json.Unmarshal(op.Record, purchaseorder); err != nil {
+			return nil, dao.ErrBadParams
+		}
+	}
+
+	if op.Op == "create" {
+		purchaseorder.Prepare()
+
+		if err := purchaseorder.Validate(model.Create); err != nil {
+			return nil, dao.ErrBadParams
+		}
+
+		if err := ValidateRequest(ctx, r, "purchase_order", model.Create); err != nil {
+			return nil, err
+		}
+
+		result, _, err := h.Repository.Add(ctx, purchaseorder)
+		return result, err
+	}
+
+	var ps httprouter.Params
+	for i, key := range []string{"argID"} {
+		if i >= len(op.ID) {
+			return nil, dao.ErrBadParams
+		}
+		ps = append(ps, httprouter.Param{Key: key, Value: op.ID[i]})
+	}
+
+	argID, err := parseInt32(ps, "argID")
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case "update":
+		purchaseorder.Prepare()
+
+		if err := purchaseorder.Validate(model.Update); err != nil {
+			return nil, dao.ErrBadParams
+		}
+
+		if err := ValidateRequest(ctx, r, "purchase_order", model.Update); err != nil {
+			return nil, err
+		}
+
+		result, _, err := h.Repository.Update(ctx, argID, purchaseorder)
+		return result, err
+
+	case "delete":
+		if err := ValidateRequest(ctx, r, "purchase_order", model.Delete); err != nil {
+			return nil, err
+		}
+
+		rowsAffected, err := h.Repository.Delete(ctx, argID)
+		return rowsAffected, err
+	}
+
+	return nil, fmt.Errorf("unknown batch operation %q", op.Op)
+}
@@ -0,0 +1,457 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"example.com/rest/example/model"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	_ = time.Second
+	_ = null.Bool{}
+	_ = uuid.UUID{}
+)
+
+/*
+
+
+DB Table Details
+-------------------------------------
+CREATE TABLE "employees"
+(
+    [EmployeeId] INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    [LastName] NVARCHAR(20)  NOT NULL,
+    [FirstName] NVARCHAR(20)  NOT NULL,
+    [Title] NVARCHAR(30),
+    [ReportsTo] INTEGER,
+    [BirthDate] DATETIME,
+    [HireDate] DATETIME,
+    [Address] NVARCHAR(70),
+    [City] NVARCHAR(40),
+    [State] NVARCHAR(40),
+    [Country] NVARCHAR(40),
+    [PostalCode] NVARCHAR(10),
+    [Phone] NVARCHAR(24),
+    [Fax] NVARCHAR(24),
+    [Email] NVARCHAR(60),
+    FOREIGN KEY ([ReportsTo]) REFERENCES "employees" ([EmployeeId])
+		ON DELETE NO ACTION ON UPDATE NO ACTION
+)
+
+
+PrimaryKeyNamesList    : [EmployeeId]
+PrimaryKeysJoined      : EmployeeId
+NonPrimaryKeyNamesList : [LastName FirstName Title ReportsTo BirthDate HireDate Address City State Country PostalCode Phone Fax Email]
+NonPrimaryKeysJoined   : LastName,FirstName,Title,ReportsTo,BirthDate,HireDate,Address,City,State,Country,PostalCode,Phone,Fax,Email
+
+
+*/
+
+// EmployeesRepository is the repository of the employees table in the main database
+type EmployeesRepository interface {
+	GetAll(ctx context.Context, page, pagesize int64, order string, filter *EmployeesFilter) (results []*model.Employees, totalRows int, err error)
+	GetPage(ctx context.Context, cursor string, pagesize int64, order string, filter *EmployeesFilter, count CountMode) (results []*model.Employees, nextCursor string, totalRows int, err error)
+	Get(ctx context.Context, argEmployeeID int32) (record *model.Employees, err error)
+	Add(ctx context.Context, record *model.Employees) (result *model.Employees, RowsAffected int64, err error)
+	Upsert(ctx context.Context, record *model.Employees, conflict ...string) (result *model.Employees, RowsAffected int64, err error)
+	BulkAdd(ctx context.Context, records []*model.Employees, batchSize int) (results []*model.Employees, RowsAffected int64, err error)
+	Update(ctx context.Context, argEmployeeID int32, updated *model.Employees) (result *model.Employees, RowsAffected int64, err error)
+	Patch(ctx context.Context, argEmployeeID int32, patch map[string]json.RawMessage) (result *model.Employees, RowsAffected int64, err error)
+	Delete(ctx context.Context, argEmployeeID int32) (rowsAffected int64, err error)
+	Restore(ctx context.Context, argEmployeeID int32) (rowsAffected int64, err error)
+}
+
+// DBEmployeesRepository is a EmployeesRepository executing the queries with its own database handle
+type DBEmployeesRepository struct {
+	DB *sqlx.DB
+}
+
+// NewEmployeesRepository create a EmployeesRepository using the database handle db
+func NewEmployeesRepository(db *sqlx.DB) EmployeesRepository {
+	return &DBEmployeesRepository{DB: db}
+}
+
+// GetAll is a function to get a slice of record(s) from employees table in the main database
+// params - page     - page requested (defaults to 0)
+// params - pagesize - number of records in a page  (defaults to 20)
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -
+// params - filter   - conditions on the columns, nil for all records
+// error - ErrNotFound, db Find error
+func (r *DBEmployeesRepository) GetAll(ctx context.Context, page, pagesize int64, order string, filter *EmployeesFilter) (results []*model.Employees, totalRows int, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	where, args := filter.where(db.DriverName())
+	sql := SelectSQL(dialect, "employees", where)
+
+	orderBy, err := EmployeesOrderBy(db.DriverName(), order)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	if orderBy == "" {
+		orderBy = "(SELECT NULL)"
+	}
+	sql = Rebind(dialect, dialect.Paginate(sql+" ORDER BY "+orderBy, page, pagesize))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	err = db.SelectContext(ctx, &results, sql, args...)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	cnt, err := RowCount(ctx, db, "employees", where, args...)
+	if err != nil {
+		return results, -2, err
+	}
+
+	return results, cnt, err
+}
+
+// GetPage is a function to get a page of record(s) from employees table in the main database with keyset pagination
+// params - cursor   - next cursor returned by the previous page, empty for the first page
+// params - pagesize - number of records in a page
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -, must be the order of the previous page
+// params - filter   - conditions on the columns, nil for all records
+// params - count    - CountNone, CountExact or CountEstimate
+// error - cursor or order error, db Find error
+func (r *DBEmployeesRepository) GetPage(ctx context.Context, cursor string, pagesize int64, order string, filter *EmployeesFilter, count CountMode) (results []*model.Employees, nextCursor string, totalRows int, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyset, err := newEmployeesKeyset(db.DriverName(), order, cursor)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	filterWhere, filterArgs := filter.where(db.DriverName())
+	where, args := keyset.where(filterWhere, filterArgs)
+	sql := SelectSQL(dialect, "employees", where)
+	sql = Rebind(dialect, dialect.Paginate(sql+" ORDER BY "+keyset.orderBy(), 0, pagesize))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	err = db.SelectContext(ctx, &results, sql, args...)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	if pagesize > 0 && int64(len(results)) == pagesize {
+		last := results[len(results)-1]
+		nextCursor, err = keyset.cursor(func(column string) interface{} { return employeesValue(last, column) })
+		if err != nil {
+			return nil, "", -1, err
+		}
+	}
+
+	totalRows = -1
+	switch count {
+	case CountExact:
+		totalRows, err = RowCount(ctx, db, "employees", filterWhere, filterArgs...)
+	case CountEstimate:
+		totalRows, err = EstimateRowCount(ctx, db, "employees")
+	}
+	if err != nil {
+		return results, nextCursor, -2, err
+	}
+
+	return results, nextCursor, totalRows, nil
+}
+
+// Get is a function to get a single record from the employees table in the main database
+// soft deleted records are not returned
+// error - ErrNotFound, db Find error
+func (r *DBEmployeesRepository) Get(ctx context.Context, argEmployeeID int32) (record *model.Employees, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := []string{"EmployeeId"}
+	sql := Rebind(dialect, SelectSQL(dialect, "employees", KeyWhere(dialect, keyColumns)+" AND "+dialect.Quote("HireDate")+" IS NULL"))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	record = &model.Employees{}
+	err = db.GetContext(ctx, record, sql, argEmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// Add is a function to add a single record to employees table in the main database
+// error - ErrInsertFailed, db save call failed
+func (r *DBEmployeesRepository) Add(ctx context.Context, record *model.Employees) (result *model.Employees, RowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	columns := []string{"LastName", "FirstName", "Title", "ReportsTo", "BirthDate", "HireDate", "Address", "City", "State", "Country", "PostalCode", "Phone", "Fax", "Email"}
+	args := []interface{}{record.LastName, record.FirstName, record.Title, record.ReportsTo, record.BirthDate, record.HireDate, record.Address, record.City, record.State, record.Country, record.PostalCode, record.Phone, record.Fax, record.Email}
+
+	if dialect.LastInsertID() == LastInsertIDReturning {
+		sql := Rebind(dialect, InsertSQL(dialect, "employees", columns, []string{"EmployeeId"}))
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		err = db.QueryRowContext(ctx, sql, args...).Scan(&record.EmployeeID)
+		if err != nil {
+			return nil, 0, err
+		}
+		return record, 1, nil
+	}
+
+	sql := Rebind(dialect, InsertSQL(dialect, "employees", columns, nil))
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	id, err := dbResult.LastInsertId()
+	if err != nil {
+		return nil, 0, err
+	}
+	record.EmployeeID = int32(id)
+
+	rows, err := dbResult.RowsAffected()
+	return record, rows, err
+}
+
+// Upsert is a function to add a single record to employees table in the main database, or update the record with the same conflict columns
+// params - conflict - json names of the columns of a unique constraint, the primary key if empty
+// error - unknown conflict column, db upsert error
+func (r *DBEmployeesRepository) Upsert(ctx context.Context, record *model.Employees, conflict ...string) (result *model.Employees, RowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := employeesPrimaryKeys
+	if len(conflict) > 0 {
+		if keyColumns, err = conflictColumns(conflict, employeesColumns); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// auto increment columns are only written when they identify the record
+	columns := []string{"LastName", "FirstName", "Title", "ReportsTo", "BirthDate", "HireDate", "Address", "City", "State", "Country", "PostalCode", "Phone", "Fax", "Email"}
+	if containsColumn(keyColumns, "EmployeeId") {
+		columns = append([]string{"EmployeeId"}, columns...)
+	}
+
+	args := make([]interface{}, len(columns))
+	for i, column := range columns {
+		args[i] = employeesValue(record, column)
+	}
+
+	// an existing record keeps its soft delete column
+	updateColumns := exceptColumns(exceptColumns(columns, keyColumns), []string{"HireDate"})
+	sql := Rebind(dialect, dialect.Upsert("employees", columns, keyColumns, updateColumns))
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbResult.RowsAffected()
+	return record, rows, err
+}
+
+// BulkAdd is a function to add records to employees table in the main database with multi row inserts of batchSize records
+// params - batchSize - number of records inserted by each statement, DefaultBatchSize if not positive
+// The generated keys are set on the records on databases returning the inserted rows. Use WithTx to add all or none of the records.
+// error - db insert error
+func (r *DBEmployeesRepository) BulkAdd(ctx context.Context, records []*model.Employees, batchSize int) (results []*model.Employees, RowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	columns := []string{"LastName", "FirstName", "Title", "ReportsTo", "BirthDate", "HireDate", "Address", "City", "State", "Country", "PostalCode", "Phone", "Fax", "Email"}
+	for start := 0; start < len(records); start += batchSize {
+		end := start + batchSize
+		if end > len(records) {
+			end = len(records)
+		}
+		batch := records[start:end]
+
+		args := make([]interface{}, 0, len(batch)*len(columns))
+		for _, record := range batch {
+			args = append(args, record.LastName, record.FirstName, record.Title, record.ReportsTo, record.BirthDate, record.HireDate, record.Address, record.City, record.State, record.Country, record.PostalCode, record.Phone, record.Fax, record.Email)
+		}
+
+		if dialect.LastInsertID() == LastInsertIDReturning {
+			sql := Rebind(dialect, BulkInsertSQL(dialect, "employees", columns, len(batch), []string{"EmployeeId"}))
+			if Logger != nil {
+				Logger(ctx, sql)
+			}
+
+			rows, err := db.QueryContext(ctx, sql, args...)
+			if err != nil {
+				return nil, RowsAffected, err
+			}
+
+			for i := 0; rows.Next() && i < len(batch); i++ {
+				if err = rows.Scan(&batch[i].EmployeeID); err != nil {
+					rows.Close()
+					return nil, RowsAffected, err
+				}
+				RowsAffected++
+			}
+			rows.Close()
+
+			if err = rows.Err(); err != nil {
+				return nil, RowsAffected, err
+			}
+			continue
+		}
+
+		sql := Rebind(dialect, BulkInsertSQL(dialect, "employees", columns, len(batch), nil))
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		dbResult, err := db.ExecContext(ctx, sql, args...)
+		if err != nil {
+			return nil, RowsAffected, err
+		}
+
+		rows, err := dbResult.RowsAffected()
+		if err != nil {
+			return nil, RowsAffected, err
+		}
+		RowsAffected += rows
+	}
+
+	return records, RowsAffected, nil
+}
+
+// Update is a function to update a single record from employees table in the main database
+// error - ErrNotFound, db record for id not found
+// Soft deleted records are not updated and HireDate is not written, see Delete and Restore.
+// error - ErrUpdateFailed, db meta data copy failed or db.Save call failed
+func (r *DBEmployeesRepository) Update(ctx context.Context, argEmployeeID int32, updated *model.Employees) (result *model.Employees, RowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	columns := []string{"LastName", "FirstName", "Title", "ReportsTo", "BirthDate", "Address", "City", "State", "Country", "PostalCode", "Phone", "Fax", "Email"}
+	keyColumns := []string{"EmployeeId"}
+	sql := Rebind(dialect, UpdateSQL(dialect, "employees", columns, keyColumns)+" AND "+dialect.Quote("HireDate")+" IS NULL")
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, updated.LastName, updated.FirstName, updated.Title, updated.ReportsTo, updated.BirthDate, updated.Address, updated.City, updated.State, updated.Country, updated.PostalCode, updated.Phone, updated.Fax, updated.Email, argEmployeeID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbResult.RowsAffected()
+	updated.EmployeeID = argEmployeeID
+
+	return updated, rows, err
+}
+
+// Patch is a function to update the columns of a JSON merge patch, keyed by json name, of a single record from employees table in the main database
+// Zero values are written and null sets a column to NULL.
+// Soft deleted records are not updated, see Restore.
+// error - unknown, read only or not nullable patch field, db update error, db Find error
+func (r *DBEmployeesRepository) Patch(ctx context.Context, argEmployeeID int32, patch map[string]json.RawMessage) (result *model.Employees, RowsAffected int64, err error) {
+	columns, args, err := employeesPatch(patch, &model.Employees{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(columns) > 0 {
+		db := Conn(ctx, r.DB)
+		dialect := DialectFor(db.DriverName())
+
+		keyColumns := []string{"EmployeeId"}
+		sql := Rebind(dialect, UpdateSQL(dialect, "employees", columns, keyColumns)+" AND "+dialect.Quote("HireDate")+" IS NULL")
+
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		args = append(args, argEmployeeID)
+		dbResult, err := db.ExecContext(ctx, sql, args...)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if RowsAffected, err = dbResult.RowsAffected(); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	result, err = r.Get(ctx, argEmployeeID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, RowsAffected, nil
+}
+
+// Delete is a function to soft delete a single record from employees table in the main database
+// by setting HireDate, see Restore
+// error - ErrNotFound, db Find error
+// error - ErrDeleteFailed, db Delete failed error
+func (r *DBEmployeesRepository) Delete(ctx context.Context, argEmployeeID int32) (rowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := []string{"EmployeeId"}
+	sql := Rebind(dialect, SoftDeleteSQL(dialect, "employees", "HireDate", keyColumns))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	result, err := db.ExecContext(ctx, sql, dialect.Now(), argEmployeeID)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// Restore is a function to restore a single record soft deleted from employees table in the main database
+// by clearing HireDate, rowsAffected is 0 if the record is not soft deleted
+func (r *DBEmployeesRepository) Restore(ctx context.Context, argEmployeeID int32) (rowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := []string{"EmployeeId"}
+	sql := Rebind(dialect, RestoreSQL(dialect, "employees", "HireDate", keyColumns))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	result, err := db.ExecContext(ctx, sql, argEmployeeID)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,307 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"example.com/rest/example/model"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+var (
+	_                     = time.Second
+	_                     = null.Bool{}
+	_                     = uuid.UUID{}
+	_ EmployeesRepository = &FakeEmployeesRepository{}
+)
+
+// FakeEmployeesRepository is an in memory EmployeesRepository for unit tests. Records are kept in insertion
+// order, GetAll and GetPage evaluate the filter and sort by order in memory and the cursors of GetPage are offsets.
+// Soft deleted records are moved to Deleted until they are restored, they are only returned if the filter includes the
+// deleted records.
+type FakeEmployeesRepository struct {
+	mu      sync.Mutex
+	Records []*model.Employees
+	Deleted []*model.Employees
+}
+
+// NewFakeEmployeesRepository create a FakeEmployeesRepository holding records
+func NewFakeEmployeesRepository(records ...*model.Employees) *FakeEmployeesRepository {
+	return &FakeEmployeesRepository{Records: records}
+}
+
+// GetAll return a page of the records matching filter sorted by order, page is the offset of the first record like in
+// DBEmployeesRepository
+func (f *FakeEmployeesRepository) GetAll(ctx context.Context, page, pagesize int64, order string, filter *EmployeesFilter) (results []*model.Employees, totalRows int, err error) {
+	records, err := f.records(order, filter)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	offset := int(page)
+	end := offset + int(pagesize)
+	if offset > len(records) {
+		offset = len(records)
+	}
+	if end > len(records) {
+		end = len(records)
+	}
+
+	results = append(results, records[offset:end]...)
+	return results, len(records), nil
+}
+
+// GetPage return the page of the records matching filter sorted by order after cursor
+func (f *FakeEmployeesRepository) GetPage(ctx context.Context, cursor string, pagesize int64, order string, filter *EmployeesFilter, count CountMode) (results []*model.Employees, nextCursor string, totalRows int, err error) {
+	// the order is validated like a keyset order, the cursor is an offset
+	if _, err = newEmployeesKeyset("", order, ""); err != nil {
+		return nil, "", -1, err
+	}
+	records, err := f.records(order, filter)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	results, nextOffset, err := f.page(records, cursor, int(pagesize))
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	if nextOffset < len(records) {
+		nextCursor = strconv.Itoa(nextOffset)
+	}
+	totalRows = -1
+	if count != CountNone {
+		totalRows = len(records)
+	}
+	return results, nextCursor, totalRows, nil
+}
+
+// Get return the record with the primary key, ErrNotFound if there is none
+func (f *FakeEmployeesRepository) Get(ctx context.Context, argEmployeeID int32) (record *model.Employees, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	i := f.find(argEmployeeID)
+	if i < 0 {
+		return nil, ErrNotFound
+	}
+	return f.Records[i], nil
+}
+
+// Add append record, ErrInsertFailed if a record with the same primary key exists
+func (f *FakeEmployeesRepository) Add(ctx context.Context, record *model.Employees) (result *model.Employees, RowsAffected int64, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.find(record.EmployeeID) >= 0 {
+		return nil, 0, ErrInsertFailed
+	}
+
+	f.Records = append(f.Records, record)
+	return record, 1, nil
+}
+
+// Upsert replace the record with the same conflict columns, the primary key if empty, or append record
+func (f *FakeEmployeesRepository) Upsert(ctx context.Context, record *model.Employees, conflict ...string) (result *model.Employees, RowsAffected int64, err error) {
+	keyColumns := employeesPrimaryKeys
+	if len(conflict) > 0 {
+		if keyColumns, err = conflictColumns(conflict, employeesColumns); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, existing := range f.Records {
+		if f.conflicts(existing, record, keyColumns) {
+			record.HireDate = existing.HireDate
+			f.Records[i] = record
+			return record, 1, nil
+		}
+	}
+
+	// a soft deleted record is updated and stays deleted
+	for i, existing := range f.Deleted {
+		if f.conflicts(existing, record, keyColumns) {
+			record.HireDate = existing.HireDate
+			f.Deleted[i] = record
+			return record, 1, nil
+		}
+	}
+
+	f.Records = append(f.Records, record)
+	return record, 1, nil
+}
+
+// BulkAdd append records, ErrInsertFailed if a record with the same primary key exists
+func (f *FakeEmployeesRepository) BulkAdd(ctx context.Context, records []*model.Employees, batchSize int) (results []*model.Employees, RowsAffected int64, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, record := range records {
+		for _, existing := range f.Records {
+			if f.conflicts(existing, record, employeesPrimaryKeys) {
+				return nil, 0, ErrInsertFailed
+			}
+		}
+		for _, existing := range records[:i] {
+			if f.conflicts(existing, record, employeesPrimaryKeys) {
+				return nil, 0, ErrInsertFailed
+			}
+		}
+	}
+
+	f.Records = append(f.Records, records...)
+	return records, int64(len(records)), nil
+}
+
+// Update replace the record with the primary key, ErrNotFound if there is none or it is soft deleted
+func (f *FakeEmployeesRepository) Update(ctx context.Context, argEmployeeID int32, updated *model.Employees) (result *model.Employees, RowsAffected int64, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	i := f.find(argEmployeeID)
+	if i < 0 {
+		return nil, 0, ErrNotFound
+	}
+
+	updated.EmployeeID = argEmployeeID
+
+	updated.HireDate = f.Records[i].HireDate
+	f.Records[i] = updated
+	return updated, 1, nil
+}
+
+// Patch apply the JSON merge patch to the record with the primary key, ErrNotFound if there is none or it is soft deleted
+func (f *FakeEmployeesRepository) Patch(ctx context.Context, argEmployeeID int32, patch map[string]json.RawMessage) (result *model.Employees, RowsAffected int64, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// the patch is applied to a copy, so an invalid patch leaves the record unchanged
+	patched := model.Employees{}
+	i := f.find(argEmployeeID)
+	if i >= 0 {
+		patched = *f.Records[i]
+	}
+	if _, _, err = employeesPatch(patch, &patched); err != nil {
+		return nil, 0, err
+	}
+	if i < 0 {
+		return nil, 0, ErrNotFound
+	}
+
+	f.Records[i] = &patched
+	return &patched, 1, nil
+}
+
+// Delete move the record with the primary key to Deleted, ErrNotFound if there is none
+func (f *FakeEmployeesRepository) Delete(ctx context.Context, argEmployeeID int32) (rowsAffected int64, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	i := f.find(argEmployeeID)
+	if i < 0 {
+		return 0, ErrNotFound
+	}
+
+	f.Deleted = append(f.Deleted, f.Records[i])
+	f.Records = append(f.Records[:i], f.Records[i+1:]...)
+	return 1, nil
+}
+
+// Restore move the record with the primary key from Deleted back to Records, 0 rows affected if it is not deleted
+func (f *FakeEmployeesRepository) Restore(ctx context.Context, argEmployeeID int32) (rowsAffected int64, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, record := range f.Deleted {
+		if record.EmployeeID != argEmployeeID {
+			continue
+		}
+		f.Deleted = append(f.Deleted[:i], f.Deleted[i+1:]...)
+		f.Records = append(f.Records, record)
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// records return the records matching filter sorted by order
+func (f *FakeEmployeesRepository) records(order string, filter *EmployeesFilter) (results []*model.Employees, err error) {
+	terms, err := orderTerms(order, employeesColumns, employeesPrimaryKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	records := f.Records
+	if filter != nil && filter.IncludeDeleted {
+		records = append(append([]*model.Employees{}, f.Records...), f.Deleted...)
+	}
+	for _, record := range records {
+		if filter.matches(record) {
+			results = append(results, record)
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return lessRecord(terms, func(column string) interface{} {
+			return employeesValue(results[i], column)
+		}, func(column string) interface{} {
+			return employeesValue(results[j], column)
+		})
+	})
+	return results, nil
+}
+
+// page return the records of the page after cursor and the offset of the next page
+func (f *FakeEmployeesRepository) page(records []*model.Employees, cursor string, pagesize int) (results []*model.Employees, nextOffset int, err error) {
+	offset := 0
+	if cursor != "" {
+		if offset, err = strconv.Atoi(cursor); err != nil || offset < 0 {
+			return nil, 0, fmt.Errorf("cursor: invalid offset %s", cursor)
+		}
+	}
+
+	if offset > len(records) {
+		offset = len(records)
+	}
+	nextOffset = offset + pagesize
+	if nextOffset > len(records) {
+		nextOffset = len(records)
+	}
+
+	results = append(results, records[offset:nextOffset]...)
+	return results, nextOffset, nil
+}
+
+// conflicts return true if a and b have the same values in keyColumns, compared by their driver values
+func (f *FakeEmployeesRepository) conflicts(a, b *model.Employees, keyColumns []string) bool {
+	for _, column := range keyColumns {
+		if cmp, ok := compareValues(employeesValue(a, column), employeesValue(b, column)); !ok || cmp != 0 {
+			return false
+		}
+	}
+	return len(keyColumns) > 0
+}
+
+// find return the index of the record with the primary key, -1 if there is none
+func (f *FakeEmployeesRepository) find(argEmployeeID int32) int {
+	for i, record := range f.Records {
+		if record.EmployeeID != argEmployeeID {
+			continue
+		}
+		return i
+	}
+	return -1
+}
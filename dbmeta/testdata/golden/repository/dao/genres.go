@@ -0,0 +1,414 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"example.com/rest/example/model"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	_ = time.Second
+	_ = null.Bool{}
+	_ = uuid.UUID{}
+)
+
+/*
+
+
+DB Table Details
+-------------------------------------
+CREATE TABLE "genres"
+(
+    [GenreId] INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    [Name] NVARCHAR(120)
+)
+
+
+PrimaryKeyNamesList    : [GenreId]
+PrimaryKeysJoined      : GenreId
+NonPrimaryKeyNamesList : [Name]
+NonPrimaryKeysJoined   : Name
+
+
+*/
+
+// GenresRepository is the repository of the genres table in the main database
+type GenresRepository interface {
+	GetAll(ctx context.Context, page, pagesize int64, order string, filter *GenresFilter) (results []*model.Genres, totalRows int, err error)
+	GetPage(ctx context.Context, cursor string, pagesize int64, order string, filter *GenresFilter, count CountMode) (results []*model.Genres, nextCursor string, totalRows int, err error)
+	Get(ctx context.Context, argGenreID int32) (record *model.Genres, err error)
+	Add(ctx context.Context, record *model.Genres) (result *model.Genres, RowsAffected int64, err error)
+	Upsert(ctx context.Context, record *model.Genres, conflict ...string) (result *model.Genres, RowsAffected int64, err error)
+	BulkAdd(ctx context.Context, records []*model.Genres, batchSize int) (results []*model.Genres, RowsAffected int64, err error)
+	Update(ctx context.Context, argGenreID int32, updated *model.Genres) (result *model.Genres, RowsAffected int64, err error)
+	Patch(ctx context.Context, argGenreID int32, patch map[string]json.RawMessage) (result *model.Genres, RowsAffected int64, err error)
+	Delete(ctx context.Context, argGenreID int32) (rowsAffected int64, err error)
+}
+
+// DBGenresRepository is a GenresRepository executing the queries with its own database handle
+type DBGenresRepository struct {
+	DB *sqlx.DB
+}
+
+// NewGenresRepository create a GenresRepository using the database handle db
+func NewGenresRepository(db *sqlx.DB) GenresRepository {
+	return &DBGenresRepository{DB: db}
+}
+
+// GetAll is a function to get a slice of record(s) from genres table in the main database
+// params - page     - page requested (defaults to 0)
+// params - pagesize - number of records in a page  (defaults to 20)
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -
+// params - filter   - conditions on the columns, nil for all records
+// error - ErrNotFound, db Find error
+func (r *DBGenresRepository) GetAll(ctx context.Context, page, pagesize int64, order string, filter *GenresFilter) (results []*model.Genres, totalRows int, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	where, args := filter.where(db.DriverName())
+	sql := SelectSQL(dialect, "genres", where)
+
+	orderBy, err := GenresOrderBy(db.DriverName(), order)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	if orderBy == "" {
+		orderBy = "(SELECT NULL)"
+	}
+	sql = Rebind(dialect, dialect.Paginate(sql+" ORDER BY "+orderBy, page, pagesize))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	err = db.SelectContext(ctx, &results, sql, args...)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	cnt, err := RowCount(ctx, db, "genres", where, args...)
+	if err != nil {
+		return results, -2, err
+	}
+
+	return results, cnt, err
+}
+
+// GetPage is a function to get a page of record(s) from genres table in the main database with keyset pagination
+// params - cursor   - next cursor returned by the previous page, empty for the first page
+// params - pagesize - number of records in a page
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -, must be the order of the previous page
+// params - filter   - conditions on the columns, nil for all records
+// params - count    - CountNone, CountExact or CountEstimate
+// error - cursor or order error, db Find error
+func (r *DBGenresRepository) GetPage(ctx context.Context, cursor string, pagesize int64, order string, filter *GenresFilter, count CountMode) (results []*model.Genres, nextCursor string, totalRows int, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyset, err := newGenresKeyset(db.DriverName(), order, cursor)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	filterWhere, filterArgs := filter.where(db.DriverName())
+	where, args := keyset.where(filterWhere, filterArgs)
+	sql := SelectSQL(dialect, "genres", where)
+	sql = Rebind(dialect, dialect.Paginate(sql+" ORDER BY "+keyset.orderBy(), 0, pagesize))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	err = db.SelectContext(ctx, &results, sql, args...)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	if pagesize > 0 && int64(len(results)) == pagesize {
+		last := results[len(results)-1]
+		nextCursor, err = keyset.cursor(func(column string) interface{} { return genresValue(last, column) })
+		if err != nil {
+			return nil, "", -1, err
+		}
+	}
+
+	totalRows = -1
+	switch count {
+	case CountExact:
+		totalRows, err = RowCount(ctx, db, "genres", filterWhere, filterArgs...)
+	case CountEstimate:
+		totalRows, err = EstimateRowCount(ctx, db, "genres")
+	}
+	if err != nil {
+		return results, nextCursor, -2, err
+	}
+
+	return results, nextCursor, totalRows, nil
+}
+
+// Get is a function to get a single record from the genres table in the main database
+// error - ErrNotFound, db Find error
+func (r *DBGenresRepository) Get(ctx context.Context, argGenreID int32) (record *model.Genres, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := []string{"GenreId"}
+	sql := Rebind(dialect, SelectSQL(dialect, "genres", KeyWhere(dialect, keyColumns)))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	record = &model.Genres{}
+	err = db.GetContext(ctx, record, sql, argGenreID)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// Add is a function to add a single record to genres table in the main database
+// error - ErrInsertFailed, db save call failed
+func (r *DBGenresRepository) Add(ctx context.Context, record *model.Genres) (result *model.Genres, RowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	columns := []string{"Name"}
+	args := []interface{}{record.Name}
+
+	if dialect.LastInsertID() == LastInsertIDReturning {
+		sql := Rebind(dialect, InsertSQL(dialect, "genres", columns, []string{"GenreId"}))
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		err = db.QueryRowContext(ctx, sql, args...).Scan(&record.GenreID)
+		if err != nil {
+			return nil, 0, err
+		}
+		return record, 1, nil
+	}
+
+	sql := Rebind(dialect, InsertSQL(dialect, "genres", columns, nil))
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	id, err := dbResult.LastInsertId()
+	if err != nil {
+		return nil, 0, err
+	}
+	record.GenreID = int32(id)
+
+	rows, err := dbResult.RowsAffected()
+	return record, rows, err
+}
+
+// Upsert is a function to add a single record to genres table in the main database, or update the record with the same conflict columns
+// params - conflict - json names of the columns of a unique constraint, the primary key if empty
+// error - unknown conflict column, db upsert error
+func (r *DBGenresRepository) Upsert(ctx context.Context, record *model.Genres, conflict ...string) (result *model.Genres, RowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := genresPrimaryKeys
+	if len(conflict) > 0 {
+		if keyColumns, err = conflictColumns(conflict, genresColumns); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// auto increment columns are only written when they identify the record
+	columns := []string{"Name"}
+	if containsColumn(keyColumns, "GenreId") {
+		columns = append([]string{"GenreId"}, columns...)
+	}
+
+	args := make([]interface{}, len(columns))
+	for i, column := range columns {
+		args[i] = genresValue(record, column)
+	}
+
+	sql := Rebind(dialect, dialect.Upsert("genres", columns, keyColumns, exceptColumns(columns, keyColumns)))
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbResult.RowsAffected()
+	return record, rows, err
+}
+
+// BulkAdd is a function to add records to genres table in the main database with multi row inserts of batchSize records
+// params - batchSize - number of records inserted by each statement, DefaultBatchSize if not positive
+// The generated keys are set on the records on databases returning the inserted rows. Use WithTx to add all or none of the records.
+// error - db insert error
+func (r *DBGenresRepository) BulkAdd(ctx context.Context, records []*model.Genres, batchSize int) (results []*model.Genres, RowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	columns := []string{"Name"}
+	for start := 0; start < len(records); start += batchSize {
+		end := start + batchSize
+		if end > len(records) {
+			end = len(records)
+		}
+		batch := records[start:end]
+
+		args := make([]interface{}, 0, len(batch)*len(columns))
+		for _, record := range batch {
+			args = append(args, record.Name)
+		}
+
+		if dialect.LastInsertID() == LastInsertIDReturning {
+			sql := Rebind(dialect, BulkInsertSQL(dialect, "genres", columns, len(batch), []string{"GenreId"}))
+			if Logger != nil {
+				Logger(ctx, sql)
+			}
+
+			rows, err := db.QueryContext(ctx, sql, args...)
+			if err != nil {
+				return nil, RowsAffected, err
+			}
+
+			for i := 0; rows.Next() && i < len(batch); i++ {
+				if err = rows.Scan(&batch[i].GenreID); err != nil {
+					rows.Close()
+					return nil, RowsAffected, err
+				}
+				RowsAffected++
+			}
+			rows.Close()
+
+			if err = rows.Err(); err != nil {
+				return nil, RowsAffected, err
+			}
+			continue
+		}
+
+		sql := Rebind(dialect, BulkInsertSQL(dialect, "genres", columns, len(batch), nil))
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		dbResult, err := db.ExecContext(ctx, sql, args...)
+		if err != nil {
+			return nil, RowsAffected, err
+		}
+
+		rows, err := dbResult.RowsAffected()
+		if err != nil {
+			return nil, RowsAffected, err
+		}
+		RowsAffected += rows
+	}
+
+	return records, RowsAffected, nil
+}
+
+// Update is a function to update a single record from genres table in the main database
+// error - ErrNotFound, db record for id not found
+// error - ErrUpdateFailed, db meta data copy failed or db.Save call failed
+func (r *DBGenresRepository) Update(ctx context.Context, argGenreID int32, updated *model.Genres) (result *model.Genres, RowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	columns := []string{"Name"}
+	keyColumns := []string{"GenreId"}
+	sql := Rebind(dialect, UpdateSQL(dialect, "genres", columns, keyColumns))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, updated.Name, argGenreID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbResult.RowsAffected()
+	updated.GenreID = argGenreID
+
+	return updated, rows, err
+}
+
+// Patch is a function to update the columns of a JSON merge patch, keyed by json name, of a single record from genres table in the main database
+// Zero values are written and null sets a column to NULL.
+// error - unknown, read only or not nullable patch field, db update error, db Find error
+func (r *DBGenresRepository) Patch(ctx context.Context, argGenreID int32, patch map[string]json.RawMessage) (result *model.Genres, RowsAffected int64, err error) {
+	columns, args, err := genresPatch(patch, &model.Genres{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(columns) > 0 {
+		db := Conn(ctx, r.DB)
+		dialect := DialectFor(db.DriverName())
+
+		keyColumns := []string{"GenreId"}
+		sql := Rebind(dialect, UpdateSQL(dialect, "genres", columns, keyColumns))
+
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		args = append(args, argGenreID)
+		dbResult, err := db.ExecContext(ctx, sql, args...)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if RowsAffected, err = dbResult.RowsAffected(); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	result, err = r.Get(ctx, argGenreID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, RowsAffected, nil
+}
+
+// Delete is a function to delete a single record from genres table in the main database
+// error - ErrNotFound, db Find error
+// error - ErrDeleteFailed, db Delete failed error
+func (r *DBGenresRepository) Delete(ctx context.Context, argGenreID int32) (rowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := []string{"GenreId"}
+	sql := Rebind(dialect, DeleteSQL(dialect, "genres", keyColumns))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	result, err := db.ExecContext(ctx, sql, argGenreID)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,421 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"example.com/rest/example/model"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	_ = time.Second
+	_ = null.Bool{}
+	_ = uuid.UUID{}
+)
+
+/*
+
+
+DB Table Details
+-------------------------------------
+CREATE TABLE "invoice_items"
+(
+    [InvoiceLineId] INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    [InvoiceId] INTEGER  NOT NULL,
+    [TrackId] INTEGER  NOT NULL,
+    [UnitPrice] NUMERIC(10,2)  NOT NULL,
+    [Quantity] INTEGER  NOT NULL,
+    FOREIGN KEY ([InvoiceId]) REFERENCES "invoices" ([InvoiceId])
+		ON DELETE NO ACTION ON UPDATE NO ACTION,
+    FOREIGN KEY ([TrackId]) REFERENCES "tracks" ([TrackId])
+		ON DELETE NO ACTION ON UPDATE NO ACTION
+)
+
+
+PrimaryKeyNamesList    : [InvoiceLineId]
+PrimaryKeysJoined      : InvoiceLineId
+NonPrimaryKeyNamesList : [InvoiceId TrackId UnitPrice Quantity]
+NonPrimaryKeysJoined   : InvoiceId,TrackId,UnitPrice,Quantity
+
+
+*/
+
+// InvoiceItemsRepository is the repository of the invoice_items table in the main database
+type InvoiceItemsRepository interface {
+	GetAll(ctx context.Context, page, pagesize int64, order string, filter *InvoiceItemsFilter) (results []*model.InvoiceItems, totalRows int, err error)
+	GetPage(ctx context.Context, cursor string, pagesize int64, order string, filter *InvoiceItemsFilter, count CountMode) (results []*model.InvoiceItems, nextCursor string, totalRows int, err error)
+	Get(ctx context.Context, argInvoiceLineID int32) (record *model.InvoiceItems, err error)
+	Add(ctx context.Context, record *model.InvoiceItems) (result *model.InvoiceItems, RowsAffected int64, err error)
+	Upsert(ctx context.Context, record *model.InvoiceItems, conflict ...string) (result *model.InvoiceItems, RowsAffected int64, err error)
+	BulkAdd(ctx context.Context, records []*model.InvoiceItems, batchSize int) (results []*model.InvoiceItems, RowsAffected int64, err error)
+	Update(ctx context.Context, argInvoiceLineID int32, updated *model.InvoiceItems) (result *model.InvoiceItems, RowsAffected int64, err error)
+	Patch(ctx context.Context, argInvoiceLineID int32, patch map[string]json.RawMessage) (result *model.InvoiceItems, RowsAffected int64, err error)
+	Delete(ctx context.Context, argInvoiceLineID int32) (rowsAffected int64, err error)
+}
+
+// DBInvoiceItemsRepository is a InvoiceItemsRepository executing the queries with its own database handle
+type DBInvoiceItemsRepository struct {
+	DB *sqlx.DB
+}
+
+// NewInvoiceItemsRepository create a InvoiceItemsRepository using the database handle db
+func NewInvoiceItemsRepository(db *sqlx.DB) InvoiceItemsRepository {
+	return &DBInvoiceItemsRepository{DB: db}
+}
+
+// GetAll is a function to get a slice of record(s) from invoice_items table in the main database
+// params - page     - page requested (defaults to 0)
+// params - pagesize - number of records in a page  (defaults to 20)
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -
+// params - filter   - conditions on the columns, nil for all records
+// error - ErrNotFound, db Find error
+func (r *DBInvoiceItemsRepository) GetAll(ctx context.Context, page, pagesize int64, order string, filter *InvoiceItemsFilter) (results []*model.InvoiceItems, totalRows int, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	where, args := filter.where(db.DriverName())
+	sql := SelectSQL(dialect, "invoice_items", where)
+
+	orderBy, err := InvoiceItemsOrderBy(db.DriverName(), order)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	if orderBy == "" {
+		orderBy = "(SELECT NULL)"
+	}
+	sql = Rebind(dialect, dialect.Paginate(sql+" ORDER BY "+orderBy, page, pagesize))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	err = db.SelectContext(ctx, &results, sql, args...)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	cnt, err := RowCount(ctx, db, "invoice_items", where, args...)
+	if err != nil {
+		return results, -2, err
+	}
+
+	return results, cnt, err
+}
+
+// GetPage is a function to get a page of record(s) from invoice_items table in the main database with keyset pagination
+// params - cursor   - next cursor returned by the previous page, empty for the first page
+// params - pagesize - number of records in a page
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -, must be the order of the previous page
+// params - filter   - conditions on the columns, nil for all records
+// params - count    - CountNone, CountExact or CountEstimate
+// error - cursor or order error, db Find error
+func (r *DBInvoiceItemsRepository) GetPage(ctx context.Context, cursor string, pagesize int64, order string, filter *InvoiceItemsFilter, count CountMode) (results []*model.InvoiceItems, nextCursor string, totalRows int, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyset, err := newInvoiceItemsKeyset(db.DriverName(), order, cursor)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	filterWhere, filterArgs := filter.where(db.DriverName())
+	where, args := keyset.where(filterWhere, filterArgs)
+	sql := SelectSQL(dialect, "invoice_items", where)
+	sql = Rebind(dialect, dialect.Paginate(sql+" ORDER BY "+keyset.orderBy(), 0, pagesize))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	err = db.SelectContext(ctx, &results, sql, args...)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	if pagesize > 0 && int64(len(results)) == pagesize {
+		last := results[len(results)-1]
+		nextCursor, err = keyset.cursor(func(column string) interface{} { return invoiceItemsValue(last, column) })
+		if err != nil {
+			return nil, "", -1, err
+		}
+	}
+
+	totalRows = -1
+	switch count {
+	case CountExact:
+		totalRows, err = RowCount(ctx, db, "invoice_items", filterWhere, filterArgs...)
+	case CountEstimate:
+		totalRows, err = EstimateRowCount(ctx, db, "invoice_items")
+	}
+	if err != nil {
+		return results, nextCursor, -2, err
+	}
+
+	return results, nextCursor, totalRows, nil
+}
+
+// Get is a function to get a single record from the invoice_items table in the main database
+// error - ErrNotFound, db Find error
+func (r *DBInvoiceItemsRepository) Get(ctx context.Context, argInvoiceLineID int32) (record *model.InvoiceItems, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := []string{"InvoiceLineId"}
+	sql := Rebind(dialect, SelectSQL(dialect, "invoice_items", KeyWhere(dialect, keyColumns)))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	record = &model.InvoiceItems{}
+	err = db.GetContext(ctx, record, sql, argInvoiceLineID)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// Add is a function to add a single record to invoice_items table in the main database
+// error - ErrInsertFailed, db save call failed
+func (r *DBInvoiceItemsRepository) Add(ctx context.Context, record *model.InvoiceItems) (result *model.InvoiceItems, RowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	columns := []string{"InvoiceId", "TrackId", "UnitPrice", "Quantity"}
+	args := []interface{}{record.InvoiceID, record.TrackID, record.UnitPrice, record.Quantity}
+
+	if dialect.LastInsertID() == LastInsertIDReturning {
+		sql := Rebind(dialect, InsertSQL(dialect, "invoice_items", columns, []string{"InvoiceLineId"}))
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		err = db.QueryRowContext(ctx, sql, args...).Scan(&record.InvoiceLineID)
+		if err != nil {
+			return nil, 0, err
+		}
+		return record, 1, nil
+	}
+
+	sql := Rebind(dialect, InsertSQL(dialect, "invoice_items", columns, nil))
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	id, err := dbResult.LastInsertId()
+	if err != nil {
+		return nil, 0, err
+	}
+	record.InvoiceLineID = int32(id)
+
+	rows, err := dbResult.RowsAffected()
+	return record, rows, err
+}
+
+// Upsert is a function to add a single record to invoice_items table in the main database, or update the record with the same conflict columns
+// params - conflict - json names of the columns of a unique constraint, the primary key if empty
+// error - unknown conflict column, db upsert error
+func (r *DBInvoiceItemsRepository) Upsert(ctx context.Context, record *model.InvoiceItems, conflict ...string) (result *model.InvoiceItems, RowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := invoiceItemsPrimaryKeys
+	if len(conflict) > 0 {
+		if keyColumns, err = conflictColumns(conflict, invoiceItemsColumns); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// auto increment columns are only written when they identify the record
+	columns := []string{"InvoiceId", "TrackId", "UnitPrice", "Quantity"}
+	if containsColumn(keyColumns, "InvoiceLineId") {
+		columns = append([]string{"InvoiceLineId"}, columns...)
+	}
+
+	args := make([]interface{}, len(columns))
+	for i, column := range columns {
+		args[i] = invoiceItemsValue(record, column)
+	}
+
+	sql := Rebind(dialect, dialect.Upsert("invoice_items", columns, keyColumns, exceptColumns(columns, keyColumns)))
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbResult.RowsAffected()
+	return record, rows, err
+}
+
+// BulkAdd is a function to add records to invoice_items table in the main database with multi row inserts of batchSize records
+// params - batchSize - number of records inserted by each statement, DefaultBatchSize if not positive
+// The generated keys are set on the records on databases returning the inserted rows. Use WithTx to add all or none of the records.
+// error - db insert error
+func (r *DBInvoiceItemsRepository) BulkAdd(ctx context.Context, records []*model.InvoiceItems, batchSize int) (results []*model.InvoiceItems, RowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	columns := []string{"InvoiceId", "TrackId", "UnitPrice", "Quantity"}
+	for start := 0; start < len(records); start += batchSize {
+		end := start + batchSize
+		if end > len(records) {
+			end = len(records)
+		}
+		batch := records[start:end]
+
+		args := make([]interface{}, 0, len(batch)*len(columns))
+		for _, record := range batch {
+			args = append(args, record.InvoiceID, record.TrackID, record.UnitPrice, record.Quantity)
+		}
+
+		if dialect.LastInsertID() == LastInsertIDReturning {
+			sql := Rebind(dialect, BulkInsertSQL(dialect, "invoice_items", columns, len(batch), []string{"InvoiceLineId"}))
+			if Logger != nil {
+				Logger(ctx, sql)
+			}
+
+			rows, err := db.QueryContext(ctx, sql, args...)
+			if err != nil {
+				return nil, RowsAffected, err
+			}
+
+			for i := 0; rows.Next() && i < len(batch); i++ {
+				if err = rows.Scan(&batch[i].InvoiceLineID); err != nil {
+					rows.Close()
+					return nil, RowsAffected, err
+				}
+				RowsAffected++
+			}
+			rows.Close()
+
+			if err = rows.Err(); err != nil {
+				return nil, RowsAffected, err
+			}
+			continue
+		}
+
+		sql := Rebind(dialect, BulkInsertSQL(dialect, "invoice_items", columns, len(batch), nil))
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		dbResult, err := db.ExecContext(ctx, sql, args...)
+		if err != nil {
+			return nil, RowsAffected, err
+		}
+
+		rows, err := dbResult.RowsAffected()
+		if err != nil {
+			return nil, RowsAffected, err
+		}
+		RowsAffected += rows
+	}
+
+	return records, RowsAffected, nil
+}
+
+// Update is a function to update a single record from invoice_items table in the main database
+// error - ErrNotFound, db record for id not found
+// error - ErrUpdateFailed, db meta data copy failed or db.Save call failed
+func (r *DBInvoiceItemsRepository) Update(ctx context.Context, argInvoiceLineID int32, updated *model.InvoiceItems) (result *model.InvoiceItems, RowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	columns := []string{"InvoiceId", "TrackId", "UnitPrice", "Quantity"}
+	keyColumns := []string{"InvoiceLineId"}
+	sql := Rebind(dialect, UpdateSQL(dialect, "invoice_items", columns, keyColumns))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, updated.InvoiceID, updated.TrackID, updated.UnitPrice, updated.Quantity, argInvoiceLineID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbResult.RowsAffected()
+	updated.InvoiceLineID = argInvoiceLineID
+
+	return updated, rows, err
+}
+
+// Patch is a function to update the columns of a JSON merge patch, keyed by json name, of a single record from invoice_items table in the main database
+// Zero values are written and null sets a column to NULL.
+// error - unknown, read only or not nullable patch field, db update error, db Find error
+func (r *DBInvoiceItemsRepository) Patch(ctx context.Context, argInvoiceLineID int32, patch map[string]json.RawMessage) (result *model.InvoiceItems, RowsAffected int64, err error) {
+	columns, args, err := invoiceItemsPatch(patch, &model.InvoiceItems{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(columns) > 0 {
+		db := Conn(ctx, r.DB)
+		dialect := DialectFor(db.DriverName())
+
+		keyColumns := []string{"InvoiceLineId"}
+		sql := Rebind(dialect, UpdateSQL(dialect, "invoice_items", columns, keyColumns))
+
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		args = append(args, argInvoiceLineID)
+		dbResult, err := db.ExecContext(ctx, sql, args...)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if RowsAffected, err = dbResult.RowsAffected(); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	result, err = r.Get(ctx, argInvoiceLineID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, RowsAffected, nil
+}
+
+// Delete is a function to delete a single record from invoice_items table in the main database
+// error - ErrNotFound, db Find error
+// error - ErrDeleteFailed, db Delete failed error
+func (r *DBInvoiceItemsRepository) Delete(ctx context.Context, argInvoiceLineID int32) (rowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := []string{"InvoiceLineId"}
+	sql := Rebind(dialect, DeleteSQL(dialect, "invoice_items", keyColumns))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	result, err := db.ExecContext(ctx, sql, argInvoiceLineID)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,273 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"example.com/rest/example/model"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+var (
+	_                      = time.Second
+	_                      = null.Bool{}
+	_                      = uuid.UUID{}
+	_ MediaTypesRepository = &FakeMediaTypesRepository{}
+)
+
+// FakeMediaTypesRepository is an in memory MediaTypesRepository for unit tests. Records are kept in insertion
+// order, GetAll and GetPage evaluate the filter and sort by order in memory and the cursors of GetPage are offsets.
+type FakeMediaTypesRepository struct {
+	mu      sync.Mutex
+	Records []*model.MediaTypes
+}
+
+// NewFakeMediaTypesRepository create a FakeMediaTypesRepository holding records
+func NewFakeMediaTypesRepository(records ...*model.MediaTypes) *FakeMediaTypesRepository {
+	return &FakeMediaTypesRepository{Records: records}
+}
+
+// GetAll return a page of the records matching filter sorted by order, page is the offset of the first record like in
+// DBMediaTypesRepository
+func (f *FakeMediaTypesRepository) GetAll(ctx context.Context, page, pagesize int64, order string, filter *MediaTypesFilter) (results []*model.MediaTypes, totalRows int, err error) {
+	records, err := f.records(order, filter)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	offset := int(page)
+	end := offset + int(pagesize)
+	if offset > len(records) {
+		offset = len(records)
+	}
+	if end > len(records) {
+		end = len(records)
+	}
+
+	results = append(results, records[offset:end]...)
+	return results, len(records), nil
+}
+
+// GetPage return the page of the records matching filter sorted by order after cursor
+func (f *FakeMediaTypesRepository) GetPage(ctx context.Context, cursor string, pagesize int64, order string, filter *MediaTypesFilter, count CountMode) (results []*model.MediaTypes, nextCursor string, totalRows int, err error) {
+	// the order is validated like a keyset order, the cursor is an offset
+	if _, err = newMediaTypesKeyset("", order, ""); err != nil {
+		return nil, "", -1, err
+	}
+	records, err := f.records(order, filter)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	results, nextOffset, err := f.page(records, cursor, int(pagesize))
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	if nextOffset < len(records) {
+		nextCursor = strconv.Itoa(nextOffset)
+	}
+	totalRows = -1
+	if count != CountNone {
+		totalRows = len(records)
+	}
+	return results, nextCursor, totalRows, nil
+}
+
+// Get return the record with the primary key, ErrNotFound if there is none
+func (f *FakeMediaTypesRepository) Get(ctx context.Context, argMediaTypeID int32) (record *model.MediaTypes, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	i := f.find(argMediaTypeID)
+	if i < 0 {
+		return nil, ErrNotFound
+	}
+	return f.Records[i], nil
+}
+
+// Add append record, ErrInsertFailed if a record with the same primary key exists
+func (f *FakeMediaTypesRepository) Add(ctx context.Context, record *model.MediaTypes) (result *model.MediaTypes, RowsAffected int64, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.find(record.MediaTypeID) >= 0 {
+		return nil, 0, ErrInsertFailed
+	}
+
+	f.Records = append(f.Records, record)
+	return record, 1, nil
+}
+
+// Upsert replace the record with the same conflict columns, the primary key if empty, or append record
+func (f *FakeMediaTypesRepository) Upsert(ctx context.Context, record *model.MediaTypes, conflict ...string) (result *model.MediaTypes, RowsAffected int64, err error) {
+	keyColumns := mediaTypesPrimaryKeys
+	if len(conflict) > 0 {
+		if keyColumns, err = conflictColumns(conflict, mediaTypesColumns); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, existing := range f.Records {
+		if f.conflicts(existing, record, keyColumns) {
+			f.Records[i] = record
+			return record, 1, nil
+		}
+	}
+
+	f.Records = append(f.Records, record)
+	return record, 1, nil
+}
+
+// BulkAdd append records, ErrInsertFailed if a record with the same primary key exists
+func (f *FakeMediaTypesRepository) BulkAdd(ctx context.Context, records []*model.MediaTypes, batchSize int) (results []*model.MediaTypes, RowsAffected int64, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, record := range records {
+		for _, existing := range f.Records {
+			if f.conflicts(existing, record, mediaTypesPrimaryKeys) {
+				return nil, 0, ErrInsertFailed
+			}
+		}
+		for _, existing := range records[:i] {
+			if f.conflicts(existing, record, mediaTypesPrimaryKeys) {
+				return nil, 0, ErrInsertFailed
+			}
+		}
+	}
+
+	f.Records = append(f.Records, records...)
+	return records, int64(len(records)), nil
+}
+
+// Update replace the record with the primary key, ErrNotFound if there is none
+func (f *FakeMediaTypesRepository) Update(ctx context.Context, argMediaTypeID int32, updated *model.MediaTypes) (result *model.MediaTypes, RowsAffected int64, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	i := f.find(argMediaTypeID)
+	if i < 0 {
+		return nil, 0, ErrNotFound
+	}
+
+	updated.MediaTypeID = argMediaTypeID
+
+	f.Records[i] = updated
+	return updated, 1, nil
+}
+
+// Patch apply the JSON merge patch to the record with the primary key, ErrNotFound if there is none
+func (f *FakeMediaTypesRepository) Patch(ctx context.Context, argMediaTypeID int32, patch map[string]json.RawMessage) (result *model.MediaTypes, RowsAffected int64, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// the patch is applied to a copy, so an invalid patch leaves the record unchanged
+	patched := model.MediaTypes{}
+	i := f.find(argMediaTypeID)
+	if i >= 0 {
+		patched = *f.Records[i]
+	}
+	if _, _, err = mediaTypesPatch(patch, &patched); err != nil {
+		return nil, 0, err
+	}
+	if i < 0 {
+		return nil, 0, ErrNotFound
+	}
+
+	f.Records[i] = &patched
+	return &patched, 1, nil
+}
+
+// Delete remove the record with the primary key, ErrNotFound if there is none
+func (f *FakeMediaTypesRepository) Delete(ctx context.Context, argMediaTypeID int32) (rowsAffected int64, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	i := f.find(argMediaTypeID)
+	if i < 0 {
+		return 0, ErrNotFound
+	}
+
+	f.Records = append(f.Records[:i], f.Records[i+1:]...)
+	return 1, nil
+}
+
+// records return the records matching filter sorted by order
+func (f *FakeMediaTypesRepository) records(order string, filter *MediaTypesFilter) (results []*model.MediaTypes, err error) {
+	terms, err := orderTerms(order, mediaTypesColumns, mediaTypesPrimaryKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	records := f.Records
+	for _, record := range records {
+		if filter.matches(record) {
+			results = append(results, record)
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return lessRecord(terms, func(column string) interface{} {
+			return mediaTypesValue(results[i], column)
+		}, func(column string) interface{} {
+			return mediaTypesValue(results[j], column)
+		})
+	})
+	return results, nil
+}
+
+// page return the records of the page after cursor and the offset of the next page
+func (f *FakeMediaTypesRepository) page(records []*model.MediaTypes, cursor string, pagesize int) (results []*model.MediaTypes, nextOffset int, err error) {
+	offset := 0
+	if cursor != "" {
+		if offset, err = strconv.Atoi(cursor); err != nil || offset < 0 {
+			return nil, 0, fmt.Errorf("cursor: invalid offset %s", cursor)
+		}
+	}
+
+	if offset > len(records) {
+		offset = len(records)
+	}
+	nextOffset = offset + pagesize
+	if nextOffset > len(records) {
+		nextOffset = len(records)
+	}
+
+	results = append(results, records[offset:nextOffset]...)
+	return results, nextOffset, nil
+}
+
+// conflicts return true if a and b have the same values in keyColumns, compared by their driver values
+func (f *FakeMediaTypesRepository) conflicts(a, b *model.MediaTypes, keyColumns []string) bool {
+	for _, column := range keyColumns {
+		if cmp, ok := compareValues(mediaTypesValue(a, column), mediaTypesValue(b, column)); !ok || cmp != 0 {
+			return false
+		}
+	}
+	return len(keyColumns) > 0
+}
+
+// find return the index of the record with the primary key, -1 if there is none
+func (f *FakeMediaTypesRepository) find(argMediaTypeID int32) int {
+	for i, record := range f.Records {
+		if record.MediaTypeID != argMediaTypeID {
+			continue
+		}
+		return i
+	}
+	return -1
+}
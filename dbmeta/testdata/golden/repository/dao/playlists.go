@@ -0,0 +1,414 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"example.com/rest/example/model"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	_ = time.Second
+	_ = null.Bool{}
+	_ = uuid.UUID{}
+)
+
+/*
+
+
+DB Table Details
+-------------------------------------
+CREATE TABLE "playlists"
+(
+    [PlaylistId] INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    [Name] NVARCHAR(120)
+)
+
+
+PrimaryKeyNamesList    : [PlaylistId]
+PrimaryKeysJoined      : PlaylistId
+NonPrimaryKeyNamesList : [Name]
+NonPrimaryKeysJoined   : Name
+
+
+*/
+
+// PlaylistsRepository is the repository of the playlists table in the main database
+type PlaylistsRepository interface {
+	GetAll(ctx context.Context, page, pagesize int64, order string, filter *PlaylistsFilter) (results []*model.Playlists, totalRows int, err error)
+	GetPage(ctx context.Context, cursor string, pagesize int64, order string, filter *PlaylistsFilter, count CountMode) (results []*model.Playlists, nextCursor string, totalRows int, err error)
+	Get(ctx context.Context, argPlaylistID int32) (record *model.Playlists, err error)
+	Add(ctx context.Context, record *model.Playlists) (result *model.Playlists, RowsAffected int64, err error)
+	Upsert(ctx context.Context, record *model.Playlists, conflict ...string) (result *model.Playlists, RowsAffected int64, err error)
+	BulkAdd(ctx context.Context, records []*model.Playlists, batchSize int) (results []*model.Playlists, RowsAffected int64, err error)
+	Update(ctx context.Context, argPlaylistID int32, updated *model.Playlists) (result *model.Playlists, RowsAffected int64, err error)
+	Patch(ctx context.Context, argPlaylistID int32, patch map[string]json.RawMessage) (result *model.Playlists, RowsAffected int64, err error)
+	Delete(ctx context.Context, argPlaylistID int32) (rowsAffected int64, err error)
+}
+
+// DBPlaylistsRepository is a PlaylistsRepository executing the queries with its own database handle
+type DBPlaylistsRepository struct {
+	DB *sqlx.DB
+}
+
+// NewPlaylistsRepository create a PlaylistsRepository using the database handle db
+func NewPlaylistsRepository(db *sqlx.DB) PlaylistsRepository {
+	return &DBPlaylistsRepository{DB: db}
+}
+
+// GetAll is a function to get a slice of record(s) from playlists table in the main database
+// params - page     - page requested (defaults to 0)
+// params - pagesize - number of records in a page  (defaults to 20)
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -
+// params - filter   - conditions on the columns, nil for all records
+// error - ErrNotFound, db Find error
+func (r *DBPlaylistsRepository) GetAll(ctx context.Context, page, pagesize int64, order string, filter *PlaylistsFilter) (results []*model.Playlists, totalRows int, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	where, args := filter.where(db.DriverName())
+	sql := SelectSQL(dialect, "playlists", where)
+
+	orderBy, err := PlaylistsOrderBy(db.DriverName(), order)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	if orderBy == "" {
+		orderBy = "(SELECT NULL)"
+	}
+	sql = Rebind(dialect, dialect.Paginate(sql+" ORDER BY "+orderBy, page, pagesize))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	err = db.SelectContext(ctx, &results, sql, args...)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	cnt, err := RowCount(ctx, db, "playlists", where, args...)
+	if err != nil {
+		return results, -2, err
+	}
+
+	return results, cnt, err
+}
+
+// GetPage is a function to get a page of record(s) from playlists table in the main database with keyset pagination
+// params - cursor   - next cursor returned by the previous page, empty for the first page
+// params - pagesize - number of records in a page
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -, must be the order of the previous page
+// params - filter   - conditions on the columns, nil for all records
+// params - count    - CountNone, CountExact or CountEstimate
+// error - cursor or order error, db Find error
+func (r *DBPlaylistsRepository) GetPage(ctx context.Context, cursor string, pagesize int64, order string, filter *PlaylistsFilter, count CountMode) (results []*model.Playlists, nextCursor string, totalRows int, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyset, err := newPlaylistsKeyset(db.DriverName(), order, cursor)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	filterWhere, filterArgs := filter.where(db.DriverName())
+	where, args := keyset.where(filterWhere, filterArgs)
+	sql := SelectSQL(dialect, "playlists", where)
+	sql = Rebind(dialect, dialect.Paginate(sql+" ORDER BY "+keyset.orderBy(), 0, pagesize))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	err = db.SelectContext(ctx, &results, sql, args...)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	if pagesize > 0 && int64(len(results)) == pagesize {
+		last := results[len(results)-1]
+		nextCursor, err = keyset.cursor(func(column string) interface{} { return playlistsValue(last, column) })
+		if err != nil {
+			return nil, "", -1, err
+		}
+	}
+
+	totalRows = -1
+	switch count {
+	case CountExact:
+		totalRows, err = RowCount(ctx, db, "playlists", filterWhere, filterArgs...)
+	case CountEstimate:
+		totalRows, err = EstimateRowCount(ctx, db, "playlists")
+	}
+	if err != nil {
+		return results, nextCursor, -2, err
+	}
+
+	return results, nextCursor, totalRows, nil
+}
+
+// Get is a function to get a single record from the playlists table in the main database
+// error - ErrNotFound, db Find error
+func (r *DBPlaylistsRepository) Get(ctx context.Context, argPlaylistID int32) (record *model.Playlists, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := []string{"PlaylistId"}
+	sql := Rebind(dialect, SelectSQL(dialect, "playlists", KeyWhere(dialect, keyColumns)))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	record = &model.Playlists{}
+	err = db.GetContext(ctx, record, sql, argPlaylistID)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// Add is a function to add a single record to playlists table in the main database
+// error - ErrInsertFailed, db save call failed
+func (r *DBPlaylistsRepository) Add(ctx context.Context, record *model.Playlists) (result *model.Playlists, RowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	columns := []string{"Name"}
+	args := []interface{}{record.Name}
+
+	if dialect.LastInsertID() == LastInsertIDReturning {
+		sql := Rebind(dialect, InsertSQL(dialect, "playlists", columns, []string{"PlaylistId"}))
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		err = db.QueryRowContext(ctx, sql, args...).Scan(&record.PlaylistID)
+		if err != nil {
+			return nil, 0, err
+		}
+		return record, 1, nil
+	}
+
+	sql := Rebind(dialect, InsertSQL(dialect, "playlists", columns, nil))
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	id, err := dbResult.LastInsertId()
+	if err != nil {
+		return nil, 0, err
+	}
+	record.PlaylistID = int32(id)
+
+	rows, err := dbResult.RowsAffected()
+	return record, rows, err
+}
+
+// Upsert is a function to add a single record to playlists table in the main database, or update the record with the same conflict columns
+// params - conflict - json names of the columns of a unique constraint, the primary key if empty
+// error - unknown conflict column, db upsert error
+func (r *DBPlaylistsRepository) Upsert(ctx context.Context, record *model.Playlists, conflict ...string) (result *model.Playlists, RowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := playlistsPrimaryKeys
+	if len(conflict) > 0 {
+		if keyColumns, err = conflictColumns(conflict, playlistsColumns); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// auto increment columns are only written when they identify the record
+	columns := []string{"Name"}
+	if containsColumn(keyColumns, "PlaylistId") {
+		columns = append([]string{"PlaylistId"}, columns...)
+	}
+
+	args := make([]interface{}, len(columns))
+	for i, column := range columns {
+		args[i] = playlistsValue(record, column)
+	}
+
+	sql := Rebind(dialect, dialect.Upsert("playlists", columns, keyColumns, exceptColumns(columns, keyColumns)))
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbResult.RowsAffected()
+	return record, rows, err
+}
+
+// BulkAdd is a function to add records to playlists table in the main database with multi row inserts of batchSize records
+// params - batchSize - number of records inserted by each statement, DefaultBatchSize if not positive
+// The generated keys are set on the records on databases returning the inserted rows. Use WithTx to add all or none of the records.
+// error - db insert error
+func (r *DBPlaylistsRepository) BulkAdd(ctx context.Context, records []*model.Playlists, batchSize int) (results []*model.Playlists, RowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	columns := []string{"Name"}
+	for start := 0; start < len(records); start += batchSize {
+		end := start + batchSize
+		if end > len(records) {
+			end = len(records)
+		}
+		batch := records[start:end]
+
+		args := make([]interface{}, 0, len(batch)*len(columns))
+		for _, record := range batch {
+			args = append(args, record.Name)
+		}
+
+		if dialect.LastInsertID() == LastInsertIDReturning {
+			sql := Rebind(dialect, BulkInsertSQL(dialect, "playlists", columns, len(batch), []string{"PlaylistId"}))
+			if Logger != nil {
+				Logger(ctx, sql)
+			}
+
+			rows, err := db.QueryContext(ctx, sql, args...)
+			if err != nil {
+				return nil, RowsAffected, err
+			}
+
+			for i := 0; rows.Next() && i < len(batch); i++ {
+				if err = rows.Scan(&batch[i].PlaylistID); err != nil {
+					rows.Close()
+					return nil, RowsAffected, err
+				}
+				RowsAffected++
+			}
+			rows.Close()
+
+			if err = rows.Err(); err != nil {
+				return nil, RowsAffected, err
+			}
+			continue
+		}
+
+		sql := Rebind(dialect, BulkInsertSQL(dialect, "playlists", columns, len(batch), nil))
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		dbResult, err := db.ExecContext(ctx, sql, args...)
+		if err != nil {
+			return nil, RowsAffected, err
+		}
+
+		rows, err := dbResult.RowsAffected()
+		if err != nil {
+			return nil, RowsAffected, err
+		}
+		RowsAffected += rows
+	}
+
+	return records, RowsAffected, nil
+}
+
+// Update is a function to update a single record from playlists table in the main database
+// error - ErrNotFound, db record for id not found
+// error - ErrUpdateFailed, db meta data copy failed or db.Save call failed
+func (r *DBPlaylistsRepository) Update(ctx context.Context, argPlaylistID int32, updated *model.Playlists) (result *model.Playlists, RowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	columns := []string{"Name"}
+	keyColumns := []string{"PlaylistId"}
+	sql := Rebind(dialect, UpdateSQL(dialect, "playlists", columns, keyColumns))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, updated.Name, argPlaylistID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbResult.RowsAffected()
+	updated.PlaylistID = argPlaylistID
+
+	return updated, rows, err
+}
+
+// Patch is a function to update the columns of a JSON merge patch, keyed by json name, of a single record from playlists table in the main database
+// Zero values are written and null sets a column to NULL.
+// error - unknown, read only or not nullable patch field, db update error, db Find error
+func (r *DBPlaylistsRepository) Patch(ctx context.Context, argPlaylistID int32, patch map[string]json.RawMessage) (result *model.Playlists, RowsAffected int64, err error) {
+	columns, args, err := playlistsPatch(patch, &model.Playlists{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(columns) > 0 {
+		db := Conn(ctx, r.DB)
+		dialect := DialectFor(db.DriverName())
+
+		keyColumns := []string{"PlaylistId"}
+		sql := Rebind(dialect, UpdateSQL(dialect, "playlists", columns, keyColumns))
+
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		args = append(args, argPlaylistID)
+		dbResult, err := db.ExecContext(ctx, sql, args...)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if RowsAffected, err = dbResult.RowsAffected(); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	result, err = r.Get(ctx, argPlaylistID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, RowsAffected, nil
+}
+
+// Delete is a function to delete a single record from playlists table in the main database
+// error - ErrNotFound, db Find error
+// error - ErrDeleteFailed, db Delete failed error
+func (r *DBPlaylistsRepository) Delete(ctx context.Context, argPlaylistID int32) (rowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := []string{"PlaylistId"}
+	sql := Rebind(dialect, DeleteSQL(dialect, "playlists", keyColumns))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	result, err := db.ExecContext(ctx, sql, argPlaylistID)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
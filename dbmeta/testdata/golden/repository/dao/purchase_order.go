@@ -0,0 +1,367 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"example.com/rest/example/model"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	_ = time.Second
+	_ = null.Bool{}
+	_ = uuid.UUID{}
+)
+
+/*
+
+
+DB Table Details
+-------------------------------------
+CREATE TABLE purchase_order (
+    id INTEGER NOT NULL,
+    payment_id INTEGER NOT NULL,
+    full_name TEXT NOT NULL,
+    PRIMARY KEY (id, payment_id)
+)
+
+
+PrimaryKeyNamesList    : [id]
+PrimaryKeysJoined      : id
+NonPrimaryKeyNamesList : [payment_id full_name]
+NonPrimaryKeysJoined   : payment_id,full_name
+
+
+*/
+
+// PurchaseOrderRepository is the repository of the purchase_order table in the main database
+type PurchaseOrderRepository interface {
+	GetAll(ctx context.Context, page, pagesize int64, order string, filter *PurchaseOrderFilter) (results []*model.PurchaseOrder, totalRows int, err error)
+	GetPage(ctx context.Context, cursor string, pagesize int64, order string, filter *PurchaseOrderFilter, count CountMode) (results []*model.PurchaseOrder, nextCursor string, totalRows int, err error)
+	Get(ctx context.Context, argID int32) (record *model.PurchaseOrder, err error)
+	Add(ctx context.Context, record *model.PurchaseOrder) (result *model.PurchaseOrder, RowsAffected int64, err error)
+	Upsert(ctx context.Context, record *model.PurchaseOrder, conflict ...string) (result *model.PurchaseOrder, RowsAffected int64, err error)
+	BulkAdd(ctx context.Context, records []*model.PurchaseOrder, batchSize int) (results []*model.PurchaseOrder, RowsAffected int64, err error)
+	Update(ctx context.Context, argID int32, updated *model.PurchaseOrder) (result *model.PurchaseOrder, RowsAffected int64, err error)
+	Patch(ctx context.Context, argID int32, patch map[string]json.RawMessage) (result *model.PurchaseOrder, RowsAffected int64, err error)
+	Delete(ctx context.Context, argID int32) (rowsAffected int64, err error)
+}
+
+// DBPurchaseOrderRepository is a PurchaseOrderRepository executing the queries with its own database handle
+type DBPurchaseOrderRepository struct {
+	DB *sqlx.DB
+}
+
+// NewPurchaseOrderRepository create a PurchaseOrderRepository using the database handle db
+func NewPurchaseOrderRepository(db *sqlx.DB) PurchaseOrderRepository {
+	return &DBPurchaseOrderRepository{DB: db}
+}
+
+// GetAll is a function to get a slice of record(s) from purchase_order table in the main database
+// params - page     - page requested (defaults to 0)
+// params - pagesize - number of records in a page  (defaults to 20)
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -
+// params - filter   - conditions on the columns, nil for all records
+// error - ErrNotFound, db Find error
+func (r *DBPurchaseOrderRepository) GetAll(ctx context.Context, page, pagesize int64, order string, filter *PurchaseOrderFilter) (results []*model.PurchaseOrder, totalRows int, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	where, args := filter.where(db.DriverName())
+	sql := SelectSQL(dialect, "purchase_order", where)
+
+	orderBy, err := PurchaseOrderOrderBy(db.DriverName(), order)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	if orderBy == "" {
+		orderBy = "(SELECT NULL)"
+	}
+	sql = Rebind(dialect, dialect.Paginate(sql+" ORDER BY "+orderBy, page, pagesize))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	err = db.SelectContext(ctx, &results, sql, args...)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	cnt, err := RowCount(ctx, db, "purchase_order", where, args...)
+	if err != nil {
+		return results, -2, err
+	}
+
+	return results, cnt, err
+}
+
+// GetPage is a function to get a page of record(s) from purchase_order table in the main database with keyset pagination
+// params - cursor   - next cursor returned by the previous page, empty for the first page
+// params - pagesize - number of records in a page
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -, must be the order of the previous page
+// params - filter   - conditions on the columns, nil for all records
+// params - count    - CountNone, CountExact or CountEstimate
+// error - cursor or order error, db Find error
+func (r *DBPurchaseOrderRepository) GetPage(ctx context.Context, cursor string, pagesize int64, order string, filter *PurchaseOrderFilter, count CountMode) (results []*model.PurchaseOrder, nextCursor string, totalRows int, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyset, err := newPurchaseOrderKeyset(db.DriverName(), order, cursor)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	filterWhere, filterArgs := filter.where(db.DriverName())
+	where, args := keyset.where(filterWhere, filterArgs)
+	sql := SelectSQL(dialect, "purchase_order", where)
+	sql = Rebind(dialect, dialect.Paginate(sql+" ORDER BY "+keyset.orderBy(), 0, pagesize))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	err = db.SelectContext(ctx, &results, sql, args...)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	if pagesize > 0 && int64(len(results)) == pagesize {
+		last := results[len(results)-1]
+		nextCursor, err = keyset.cursor(func(column string) interface{} { return purchaseOrderValue(last, column) })
+		if err != nil {
+			return nil, "", -1, err
+		}
+	}
+
+	totalRows = -1
+	switch count {
+	case CountExact:
+		totalRows, err = RowCount(ctx, db, "purchase_order", filterWhere, filterArgs...)
+	case CountEstimate:
+		totalRows, err = EstimateRowCount(ctx, db, "purchase_order")
+	}
+	if err != nil {
+		return results, nextCursor, -2, err
+	}
+
+	return results, nextCursor, totalRows, nil
+}
+
+// Get is a function to get a single record from the purchase_order table in the main database
+// error - ErrNotFound, db Find error
+func (r *DBPurchaseOrderRepository) Get(ctx context.Context, argID int32) (record *model.PurchaseOrder, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := []string{"id"}
+	sql := Rebind(dialect, SelectSQL(dialect, "purchase_order", KeyWhere(dialect, keyColumns)))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	record = &model.PurchaseOrder{}
+	err = db.GetContext(ctx, record, sql, argID)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// Add is a function to add a single record to purchase_order table in the main database
+// error - ErrInsertFailed, db save call failed
+func (r *DBPurchaseOrderRepository) Add(ctx context.Context, record *model.PurchaseOrder) (result *model.PurchaseOrder, RowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	columns := []string{"id", "payment_id", "full_name"}
+	args := []interface{}{record.ID, record.PaymentID, record.FullName}
+
+	sql := Rebind(dialect, InsertSQL(dialect, "purchase_order", columns, nil))
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbResult.RowsAffected()
+	return record, rows, err
+}
+
+// Upsert is a function to add a single record to purchase_order table in the main database, or update the record with the same conflict columns
+// params - conflict - json names of the columns of a unique constraint, the primary key if empty
+// error - unknown conflict column, db upsert error
+func (r *DBPurchaseOrderRepository) Upsert(ctx context.Context, record *model.PurchaseOrder, conflict ...string) (result *model.PurchaseOrder, RowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := purchaseOrderPrimaryKeys
+	if len(conflict) > 0 {
+		if keyColumns, err = conflictColumns(conflict, purchaseOrderColumns); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// auto increment columns are only written when they identify the record
+	columns := []string{"id", "payment_id", "full_name"}
+
+	args := make([]interface{}, len(columns))
+	for i, column := range columns {
+		args[i] = purchaseOrderValue(record, column)
+	}
+
+	sql := Rebind(dialect, dialect.Upsert("purchase_order", columns, keyColumns, exceptColumns(columns, keyColumns)))
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbResult.RowsAffected()
+	return record, rows, err
+}
+
+// BulkAdd is a function to add records to purchase_order table in the main database with multi row inserts of batchSize records
+// params - batchSize - number of records inserted by each statement, DefaultBatchSize if not positive
+// The generated keys are set on the records on databases returning the inserted rows. Use WithTx to add all or none of the records.
+// error - db insert error
+func (r *DBPurchaseOrderRepository) BulkAdd(ctx context.Context, records []*model.PurchaseOrder, batchSize int) (results []*model.PurchaseOrder, RowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	columns := []string{"id", "payment_id", "full_name"}
+	for start := 0; start < len(records); start += batchSize {
+		end := start + batchSize
+		if end > len(records) {
+			end = len(records)
+		}
+		batch := records[start:end]
+
+		args := make([]interface{}, 0, len(batch)*len(columns))
+		for _, record := range batch {
+			args = append(args, record.ID, record.PaymentID, record.FullName)
+		}
+
+		sql := Rebind(dialect, BulkInsertSQL(dialect, "purchase_order", columns, len(batch), nil))
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		dbResult, err := db.ExecContext(ctx, sql, args...)
+		if err != nil {
+			return nil, RowsAffected, err
+		}
+
+		rows, err := dbResult.RowsAffected()
+		if err != nil {
+			return nil, RowsAffected, err
+		}
+		RowsAffected += rows
+	}
+
+	return records, RowsAffected, nil
+}
+
+// Update is a function to update a single record from purchase_order table in the main database
+// error - ErrNotFound, db record for id not found
+// error - ErrUpdateFailed, db meta data copy failed or db.Save call failed
+func (r *DBPurchaseOrderRepository) Update(ctx context.Context, argID int32, updated *model.PurchaseOrder) (result *model.PurchaseOrder, RowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	columns := []string{"payment_id", "full_name"}
+	keyColumns := []string{"id"}
+	sql := Rebind(dialect, UpdateSQL(dialect, "purchase_order", columns, keyColumns))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, updated.PaymentID, updated.FullName, argID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbResult.RowsAffected()
+	updated.ID = argID
+
+	return updated, rows, err
+}
+
+// Patch is a function to update the columns of a JSON merge patch, keyed by json name, of a single record from purchase_order table in the main database
+// Zero values are written and null sets a column to NULL.
+// error - unknown, read only or not nullable patch field, db update error, db Find error
+func (r *DBPurchaseOrderRepository) Patch(ctx context.Context, argID int32, patch map[string]json.RawMessage) (result *model.PurchaseOrder, RowsAffected int64, err error) {
+	columns, args, err := purchaseOrderPatch(patch, &model.PurchaseOrder{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(columns) > 0 {
+		db := Conn(ctx, r.DB)
+		dialect := DialectFor(db.DriverName())
+
+		keyColumns := []string{"id"}
+		sql := Rebind(dialect, UpdateSQL(dialect, "purchase_order", columns, keyColumns))
+
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		args = append(args, argID)
+		dbResult, err := db.ExecContext(ctx, sql, args...)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if RowsAffected, err = dbResult.RowsAffected(); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	result, err = r.Get(ctx, argID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, RowsAffected, nil
+}
+
+// Delete is a function to delete a single record from purchase_order table in the main database
+// error - ErrNotFound, db Find error
+// error - ErrDeleteFailed, db Delete failed error
+func (r *DBPurchaseOrderRepository) Delete(ctx context.Context, argID int32) (rowsAffected int64, err error) {
+	db := Conn(ctx, r.DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := []string{"id"}
+	sql := Rebind(dialect, DeleteSQL(dialect, "purchase_order", keyColumns))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	result, err := db.ExecContext(ctx, sql, argID)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
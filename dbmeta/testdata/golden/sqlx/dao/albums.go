@@ -0,0 +1,392 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"example.com/rest/example/model"
+
+	"github.com/google/uuid"
+)
+
+var (
+	_ = time.Second
+
+	_ = uuid.UUID{}
+)
+
+/*
+
+
+DB Table Details
+-------------------------------------
+CREATE TABLE "albums"
+(
+    [AlbumId] INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    [Title] NVARCHAR(160)  NOT NULL,
+    [ArtistId] INTEGER  NOT NULL,
+    FOREIGN KEY ([ArtistId]) REFERENCES "artists" ([ArtistId])
+		ON DELETE NO ACTION ON UPDATE NO ACTION
+)
+
+
+PrimaryKeyNamesList    : [AlbumId]
+PrimaryKeysJoined      : AlbumId
+NonPrimaryKeyNamesList : [Title ArtistId]
+NonPrimaryKeysJoined   : Title,ArtistId
+
+
+*/
+
+// GetAllAlbums is a function to get a slice of record(s) from albums table in the main database
+// params - page     - page requested (defaults to 0)
+// params - pagesize - number of records in a page  (defaults to 20)
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -
+// params - filter   - conditions on the columns, nil for all records
+// error - ErrNotFound, db Find error
+func GetAllAlbums(ctx context.Context, page, pagesize int64, order string, filter *AlbumsFilter) (results []*model.Albums, totalRows int, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	where, args := filter.where(db.DriverName())
+	sql := SelectSQL(dialect, "albums", where)
+
+	orderBy, err := AlbumsOrderBy(db.DriverName(), order)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	if orderBy == "" {
+		orderBy = "(SELECT NULL)"
+	}
+	sql = Rebind(dialect, dialect.Paginate(sql+" ORDER BY "+orderBy, page, pagesize))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	err = db.SelectContext(ctx, &results, sql, args...)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	cnt, err := RowCount(ctx, db, "albums", where, args...)
+	if err != nil {
+		return results, -2, err
+	}
+
+	return results, cnt, err
+}
+
+// GetPageAlbums is a function to get a page of record(s) from albums table in the main database with keyset pagination
+// params - cursor   - next cursor returned by the previous page, empty for the first page
+// params - pagesize - number of records in a page
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -, must be the order of the previous page
+// params - filter   - conditions on the columns, nil for all records
+// params - count    - CountNone, CountExact or CountEstimate
+// error - cursor or order error, db Find error
+func GetPageAlbums(ctx context.Context, cursor string, pagesize int64, order string, filter *AlbumsFilter, count CountMode) (results []*model.Albums, nextCursor string, totalRows int, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyset, err := newAlbumsKeyset(db.DriverName(), order, cursor)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	filterWhere, filterArgs := filter.where(db.DriverName())
+	where, args := keyset.where(filterWhere, filterArgs)
+	sql := SelectSQL(dialect, "albums", where)
+	sql = Rebind(dialect, dialect.Paginate(sql+" ORDER BY "+keyset.orderBy(), 0, pagesize))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	err = db.SelectContext(ctx, &results, sql, args...)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	if pagesize > 0 && int64(len(results)) == pagesize {
+		last := results[len(results)-1]
+		nextCursor, err = keyset.cursor(func(column string) interface{} { return albumsValue(last, column) })
+		if err != nil {
+			return nil, "", -1, err
+		}
+	}
+
+	totalRows = -1
+	switch count {
+	case CountExact:
+		totalRows, err = RowCount(ctx, db, "albums", filterWhere, filterArgs...)
+	case CountEstimate:
+		totalRows, err = EstimateRowCount(ctx, db, "albums")
+	}
+	if err != nil {
+		return results, nextCursor, -2, err
+	}
+
+	return results, nextCursor, totalRows, nil
+}
+
+// GetAlbums is a function to get a single record from the albums table in the main database
+// error - ErrNotFound, db Find error
+func GetAlbums(ctx context.Context, argAlbumID int32) (record *model.Albums, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := []string{"AlbumId"}
+	sql := Rebind(dialect, SelectSQL(dialect, "albums", KeyWhere(dialect, keyColumns)))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	record = &model.Albums{}
+	err = db.GetContext(ctx, record, sql, argAlbumID)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// AddAlbums is a function to add a single record to albums table in the main database
+// error - ErrInsertFailed, db save call failed
+func AddAlbums(ctx context.Context, record *model.Albums) (result *model.Albums, RowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	columns := []string{"Title", "ArtistId"}
+	args := []interface{}{record.Title, record.ArtistID}
+
+	if dialect.LastInsertID() == LastInsertIDReturning {
+		sql := Rebind(dialect, InsertSQL(dialect, "albums", columns, []string{"AlbumId"}))
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		err = db.QueryRowContext(ctx, sql, args...).Scan(&record.AlbumID)
+		if err != nil {
+			return nil, 0, err
+		}
+		return record, 1, nil
+	}
+
+	sql := Rebind(dialect, InsertSQL(dialect, "albums", columns, nil))
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	id, err := dbResult.LastInsertId()
+	if err != nil {
+		return nil, 0, err
+	}
+	record.AlbumID = int32(id)
+
+	rows, err := dbResult.RowsAffected()
+	return record, rows, err
+}
+
+// UpsertAlbums is a function to add a single record to albums table in the main database, or update the record with the same conflict columns
+// params - conflict - json names of the columns of a unique constraint, the primary key if empty
+// error - unknown conflict column, db upsert error
+func UpsertAlbums(ctx context.Context, record *model.Albums, conflict ...string) (result *model.Albums, RowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := albumsPrimaryKeys
+	if len(conflict) > 0 {
+		if keyColumns, err = conflictColumns(conflict, albumsColumns); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// auto increment columns are only written when they identify the record
+	columns := []string{"Title", "ArtistId"}
+	if containsColumn(keyColumns, "AlbumId") {
+		columns = append([]string{"AlbumId"}, columns...)
+	}
+
+	args := make([]interface{}, len(columns))
+	for i, column := range columns {
+		args[i] = albumsValue(record, column)
+	}
+
+	sql := Rebind(dialect, dialect.Upsert("albums", columns, keyColumns, exceptColumns(columns, keyColumns)))
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbResult.RowsAffected()
+	return record, rows, err
+}
+
+// BulkAddAlbums is a function to add records to albums table in the main database with multi row inserts of batchSize records
+// params - batchSize - number of records inserted by each statement, DefaultBatchSize if not positive
+// The generated keys are set on the records on databases returning the inserted rows. Use WithTx to add all or none of the records.
+// error - db insert error
+func BulkAddAlbums(ctx context.Context, records []*model.Albums, batchSize int) (results []*model.Albums, RowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	columns := []string{"Title", "ArtistId"}
+	for start := 0; start < len(records); start += batchSize {
+		end := start + batchSize
+		if end > len(records) {
+			end = len(records)
+		}
+		batch := records[start:end]
+
+		args := make([]interface{}, 0, len(batch)*len(columns))
+		for _, record := range batch {
+			args = append(args, record.Title, record.ArtistID)
+		}
+
+		if dialect.LastInsertID() == LastInsertIDReturning {
+			sql := Rebind(dialect, BulkInsertSQL(dialect, "albums", columns, len(batch), []string{"AlbumId"}))
+			if Logger != nil {
+				Logger(ctx, sql)
+			}
+
+			rows, err := db.QueryContext(ctx, sql, args...)
+			if err != nil {
+				return nil, RowsAffected, err
+			}
+
+			for i := 0; rows.Next() && i < len(batch); i++ {
+				if err = rows.Scan(&batch[i].AlbumID); err != nil {
+					rows.Close()
+					return nil, RowsAffected, err
+				}
+				RowsAffected++
+			}
+			rows.Close()
+
+			if err = rows.Err(); err != nil {
+				return nil, RowsAffected, err
+			}
+			continue
+		}
+
+		sql := Rebind(dialect, BulkInsertSQL(dialect, "albums", columns, len(batch), nil))
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		dbResult, err := db.ExecContext(ctx, sql, args...)
+		if err != nil {
+			return nil, RowsAffected, err
+		}
+
+		rows, err := dbResult.RowsAffected()
+		if err != nil {
+			return nil, RowsAffected, err
+		}
+		RowsAffected += rows
+	}
+
+	return records, RowsAffected, nil
+}
+
+// UpdateAlbums is a function to update a single record from albums table in the main database
+// error - ErrNotFound, db record for id not found
+// error - ErrUpdateFailed, db meta data copy failed or db.Save call failed
+func UpdateAlbums(ctx context.Context, argAlbumID int32, updated *model.Albums) (result *model.Albums, RowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	columns := []string{"Title", "ArtistId"}
+	keyColumns := []string{"AlbumId"}
+	sql := Rebind(dialect, UpdateSQL(dialect, "albums", columns, keyColumns))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, updated.Title, updated.ArtistID, argAlbumID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbResult.RowsAffected()
+	updated.AlbumID = argAlbumID
+
+	return updated, rows, err
+}
+
+// PatchAlbums is a function to update the columns of a JSON merge patch, keyed by json name, of a single record from albums table in the main database
+// Zero values are written and null sets a column to NULL.
+// error - unknown, read only or not nullable patch field, db update error, db Find error
+func PatchAlbums(ctx context.Context, argAlbumID int32, patch map[string]json.RawMessage) (result *model.Albums, RowsAffected int64, err error) {
+	columns, args, err := albumsPatch(patch, &model.Albums{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(columns) > 0 {
+		db := Conn(ctx, DB)
+		dialect := DialectFor(db.DriverName())
+
+		keyColumns := []string{"AlbumId"}
+		sql := Rebind(dialect, UpdateSQL(dialect, "albums", columns, keyColumns))
+
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		args = append(args, argAlbumID)
+		dbResult, err := db.ExecContext(ctx, sql, args...)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if RowsAffected, err = dbResult.RowsAffected(); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	result, err = GetAlbums(ctx, argAlbumID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, RowsAffected, nil
+}
+
+// DeleteAlbums is a function to delete a single record from albums table in the main database
+// error - ErrNotFound, db Find error
+// error - ErrDeleteFailed, db Delete failed error
+func DeleteAlbums(ctx context.Context, argAlbumID int32) (rowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := []string{"AlbumId"}
+	sql := Rebind(dialect, DeleteSQL(dialect, "albums", keyColumns))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	result, err := db.ExecContext(ctx, sql, argAlbumID)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
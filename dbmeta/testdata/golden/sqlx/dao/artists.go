@@ -0,0 +1,389 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"example.com/rest/example/model"
+
+	"github.com/google/uuid"
+)
+
+var (
+	_ = time.Second
+
+	_ = uuid.UUID{}
+)
+
+/*
+
+
+DB Table Details
+-------------------------------------
+CREATE TABLE "artists"
+(
+    [ArtistId] INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    [Name] NVARCHAR(120)
+)
+
+
+PrimaryKeyNamesList    : [ArtistId]
+PrimaryKeysJoined      : ArtistId
+NonPrimaryKeyNamesList : [Name]
+NonPrimaryKeysJoined   : Name
+
+
+*/
+
+// GetAllArtists is a function to get a slice of record(s) from artists table in the main database
+// params - page     - page requested (defaults to 0)
+// params - pagesize - number of records in a page  (defaults to 20)
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -
+// params - filter   - conditions on the columns, nil for all records
+// error - ErrNotFound, db Find error
+func GetAllArtists(ctx context.Context, page, pagesize int64, order string, filter *ArtistsFilter) (results []*model.Artists, totalRows int, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	where, args := filter.where(db.DriverName())
+	sql := SelectSQL(dialect, "artists", where)
+
+	orderBy, err := ArtistsOrderBy(db.DriverName(), order)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	if orderBy == "" {
+		orderBy = "(SELECT NULL)"
+	}
+	sql = Rebind(dialect, dialect.Paginate(sql+" ORDER BY "+orderBy, page, pagesize))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	err = db.SelectContext(ctx, &results, sql, args...)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	cnt, err := RowCount(ctx, db, "artists", where, args...)
+	if err != nil {
+		return results, -2, err
+	}
+
+	return results, cnt, err
+}
+
+// GetPageArtists is a function to get a page of record(s) from artists table in the main database with keyset pagination
+// params - cursor   - next cursor returned by the previous page, empty for the first page
+// params - pagesize - number of records in a page
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -, must be the order of the previous page
+// params - filter   - conditions on the columns, nil for all records
+// params - count    - CountNone, CountExact or CountEstimate
+// error - cursor or order error, db Find error
+func GetPageArtists(ctx context.Context, cursor string, pagesize int64, order string, filter *ArtistsFilter, count CountMode) (results []*model.Artists, nextCursor string, totalRows int, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyset, err := newArtistsKeyset(db.DriverName(), order, cursor)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	filterWhere, filterArgs := filter.where(db.DriverName())
+	where, args := keyset.where(filterWhere, filterArgs)
+	sql := SelectSQL(dialect, "artists", where)
+	sql = Rebind(dialect, dialect.Paginate(sql+" ORDER BY "+keyset.orderBy(), 0, pagesize))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	err = db.SelectContext(ctx, &results, sql, args...)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	if pagesize > 0 && int64(len(results)) == pagesize {
+		last := results[len(results)-1]
+		nextCursor, err = keyset.cursor(func(column string) interface{} { return artistsValue(last, column) })
+		if err != nil {
+			return nil, "", -1, err
+		}
+	}
+
+	totalRows = -1
+	switch count {
+	case CountExact:
+		totalRows, err = RowCount(ctx, db, "artists", filterWhere, filterArgs...)
+	case CountEstimate:
+		totalRows, err = EstimateRowCount(ctx, db, "artists")
+	}
+	if err != nil {
+		return results, nextCursor, -2, err
+	}
+
+	return results, nextCursor, totalRows, nil
+}
+
+// GetArtists is a function to get a single record from the artists table in the main database
+// error - ErrNotFound, db Find error
+func GetArtists(ctx context.Context, argArtistID int32) (record *model.Artists, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := []string{"ArtistId"}
+	sql := Rebind(dialect, SelectSQL(dialect, "artists", KeyWhere(dialect, keyColumns)))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	record = &model.Artists{}
+	err = db.GetContext(ctx, record, sql, argArtistID)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// AddArtists is a function to add a single record to artists table in the main database
+// error - ErrInsertFailed, db save call failed
+func AddArtists(ctx context.Context, record *model.Artists) (result *model.Artists, RowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	columns := []string{"Name"}
+	args := []interface{}{record.Name}
+
+	if dialect.LastInsertID() == LastInsertIDReturning {
+		sql := Rebind(dialect, InsertSQL(dialect, "artists", columns, []string{"ArtistId"}))
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		err = db.QueryRowContext(ctx, sql, args...).Scan(&record.ArtistID)
+		if err != nil {
+			return nil, 0, err
+		}
+		return record, 1, nil
+	}
+
+	sql := Rebind(dialect, InsertSQL(dialect, "artists", columns, nil))
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	id, err := dbResult.LastInsertId()
+	if err != nil {
+		return nil, 0, err
+	}
+	record.ArtistID = int32(id)
+
+	rows, err := dbResult.RowsAffected()
+	return record, rows, err
+}
+
+// UpsertArtists is a function to add a single record to artists table in the main database, or update the record with the same conflict columns
+// params - conflict - json names of the columns of a unique constraint, the primary key if empty
+// error - unknown conflict column, db upsert error
+func UpsertArtists(ctx context.Context, record *model.Artists, conflict ...string) (result *model.Artists, RowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := artistsPrimaryKeys
+	if len(conflict) > 0 {
+		if keyColumns, err = conflictColumns(conflict, artistsColumns); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// auto increment columns are only written when they identify the record
+	columns := []string{"Name"}
+	if containsColumn(keyColumns, "ArtistId") {
+		columns = append([]string{"ArtistId"}, columns...)
+	}
+
+	args := make([]interface{}, len(columns))
+	for i, column := range columns {
+		args[i] = artistsValue(record, column)
+	}
+
+	sql := Rebind(dialect, dialect.Upsert("artists", columns, keyColumns, exceptColumns(columns, keyColumns)))
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbResult.RowsAffected()
+	return record, rows, err
+}
+
+// BulkAddArtists is a function to add records to artists table in the main database with multi row inserts of batchSize records
+// params - batchSize - number of records inserted by each statement, DefaultBatchSize if not positive
+// The generated keys are set on the records on databases returning the inserted rows. Use WithTx to add all or none of the records.
+// error - db insert error
+func BulkAddArtists(ctx context.Context, records []*model.Artists, batchSize int) (results []*model.Artists, RowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	columns := []string{"Name"}
+	for start := 0; start < len(records); start += batchSize {
+		end := start + batchSize
+		if end > len(records) {
+			end = len(records)
+		}
+		batch := records[start:end]
+
+		args := make([]interface{}, 0, len(batch)*len(columns))
+		for _, record := range batch {
+			args = append(args, record.Name)
+		}
+
+		if dialect.LastInsertID() == LastInsertIDReturning {
+			sql := Rebind(dialect, BulkInsertSQL(dialect, "artists", columns, len(batch), []string{"ArtistId"}))
+			if Logger != nil {
+				Logger(ctx, sql)
+			}
+
+			rows, err := db.QueryContext(ctx, sql, args...)
+			if err != nil {
+				return nil, RowsAffected, err
+			}
+
+			for i := 0; rows.Next() && i < len(batch); i++ {
+				if err = rows.Scan(&batch[i].ArtistID); err != nil {
+					rows.Close()
+					return nil, RowsAffected, err
+				}
+				RowsAffected++
+			}
+			rows.Close()
+
+			if err = rows.Err(); err != nil {
+				return nil, RowsAffected, err
+			}
+			continue
+		}
+
+		sql := Rebind(dialect, BulkInsertSQL(dialect, "artists", columns, len(batch), nil))
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		dbResult, err := db.ExecContext(ctx, sql, args...)
+		if err != nil {
+			return nil, RowsAffected, err
+		}
+
+		rows, err := dbResult.RowsAffected()
+		if err != nil {
+			return nil, RowsAffected, err
+		}
+		RowsAffected += rows
+	}
+
+	return records, RowsAffected, nil
+}
+
+// UpdateArtists is a function to update a single record from artists table in the main database
+// error - ErrNotFound, db record for id not found
+// error - ErrUpdateFailed, db meta data copy failed or db.Save call failed
+func UpdateArtists(ctx context.Context, argArtistID int32, updated *model.Artists) (result *model.Artists, RowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	columns := []string{"Name"}
+	keyColumns := []string{"ArtistId"}
+	sql := Rebind(dialect, UpdateSQL(dialect, "artists", columns, keyColumns))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, updated.Name, argArtistID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbResult.RowsAffected()
+	updated.ArtistID = argArtistID
+
+	return updated, rows, err
+}
+
+// PatchArtists is a function to update the columns of a JSON merge patch, keyed by json name, of a single record from artists table in the main database
+// Zero values are written and null sets a column to NULL.
+// error - unknown, read only or not nullable patch field, db update error, db Find error
+func PatchArtists(ctx context.Context, argArtistID int32, patch map[string]json.RawMessage) (result *model.Artists, RowsAffected int64, err error) {
+	columns, args, err := artistsPatch(patch, &model.Artists{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(columns) > 0 {
+		db := Conn(ctx, DB)
+		dialect := DialectFor(db.DriverName())
+
+		keyColumns := []string{"ArtistId"}
+		sql := Rebind(dialect, UpdateSQL(dialect, "artists", columns, keyColumns))
+
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		args = append(args, argArtistID)
+		dbResult, err := db.ExecContext(ctx, sql, args...)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if RowsAffected, err = dbResult.RowsAffected(); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	result, err = GetArtists(ctx, argArtistID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, RowsAffected, nil
+}
+
+// DeleteArtists is a function to delete a single record from artists table in the main database
+// error - ErrNotFound, db Find error
+// error - ErrDeleteFailed, db Delete failed error
+func DeleteArtists(ctx context.Context, argArtistID int32) (rowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := []string{"ArtistId"}
+	sql := Rebind(dialect, DeleteSQL(dialect, "artists", keyColumns))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	result, err := db.ExecContext(ctx, sql, argArtistID)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
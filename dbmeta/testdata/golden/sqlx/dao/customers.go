@@ -0,0 +1,402 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"example.com/rest/example/model"
+
+	"github.com/google/uuid"
+)
+
+var (
+	_ = time.Second
+
+	_ = uuid.UUID{}
+)
+
+/*
+
+
+DB Table Details
+-------------------------------------
+CREATE TABLE "customers"
+(
+    [CustomerId] INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    [FirstName] NVARCHAR(40)  NOT NULL,
+    [LastName] NVARCHAR(20)  NOT NULL,
+    [Company] NVARCHAR(80),
+    [Address] NVARCHAR(70),
+    [City] NVARCHAR(40),
+    [State] NVARCHAR(40),
+    [Country] NVARCHAR(40),
+    [PostalCode] NVARCHAR(10),
+    [Phone] NVARCHAR(24),
+    [Fax] NVARCHAR(24),
+    [Email] NVARCHAR(60)  NOT NULL,
+    [SupportRepId] INTEGER,
+    FOREIGN KEY ([SupportRepId]) REFERENCES "employees" ([EmployeeId])
+		ON DELETE NO ACTION ON UPDATE NO ACTION
+)
+
+
+PrimaryKeyNamesList    : [CustomerId]
+PrimaryKeysJoined      : CustomerId
+NonPrimaryKeyNamesList : [FirstName LastName Company Address City State Country PostalCode Phone Fax Email SupportRepId]
+NonPrimaryKeysJoined   : FirstName,LastName,Company,Address,City,State,Country,PostalCode,Phone,Fax,Email,SupportRepId
+
+
+*/
+
+// GetAllCustomers is a function to get a slice of record(s) from customers table in the main database
+// params - page     - page requested (defaults to 0)
+// params - pagesize - number of records in a page  (defaults to 20)
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -
+// params - filter   - conditions on the columns, nil for all records
+// error - ErrNotFound, db Find error
+func GetAllCustomers(ctx context.Context, page, pagesize int64, order string, filter *CustomersFilter) (results []*model.Customers, totalRows int, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	where, args := filter.where(db.DriverName())
+	sql := SelectSQL(dialect, "customers", where)
+
+	orderBy, err := CustomersOrderBy(db.DriverName(), order)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	if orderBy == "" {
+		orderBy = "(SELECT NULL)"
+	}
+	sql = Rebind(dialect, dialect.Paginate(sql+" ORDER BY "+orderBy, page, pagesize))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	err = db.SelectContext(ctx, &results, sql, args...)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	cnt, err := RowCount(ctx, db, "customers", where, args...)
+	if err != nil {
+		return results, -2, err
+	}
+
+	return results, cnt, err
+}
+
+// GetPageCustomers is a function to get a page of record(s) from customers table in the main database with keyset pagination
+// params - cursor   - next cursor returned by the previous page, empty for the first page
+// params - pagesize - number of records in a page
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -, must be the order of the previous page
+// params - filter   - conditions on the columns, nil for all records
+// params - count    - CountNone, CountExact or CountEstimate
+// error - cursor or order error, db Find error
+func GetPageCustomers(ctx context.Context, cursor string, pagesize int64, order string, filter *CustomersFilter, count CountMode) (results []*model.Customers, nextCursor string, totalRows int, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyset, err := newCustomersKeyset(db.DriverName(), order, cursor)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	filterWhere, filterArgs := filter.where(db.DriverName())
+	where, args := keyset.where(filterWhere, filterArgs)
+	sql := SelectSQL(dialect, "customers", where)
+	sql = Rebind(dialect, dialect.Paginate(sql+" ORDER BY "+keyset.orderBy(), 0, pagesize))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	err = db.SelectContext(ctx, &results, sql, args...)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	if pagesize > 0 && int64(len(results)) == pagesize {
+		last := results[len(results)-1]
+		nextCursor, err = keyset.cursor(func(column string) interface{} { return customersValue(last, column) })
+		if err != nil {
+			return nil, "", -1, err
+		}
+	}
+
+	totalRows = -1
+	switch count {
+	case CountExact:
+		totalRows, err = RowCount(ctx, db, "customers", filterWhere, filterArgs...)
+	case CountEstimate:
+		totalRows, err = EstimateRowCount(ctx, db, "customers")
+	}
+	if err != nil {
+		return results, nextCursor, -2, err
+	}
+
+	return results, nextCursor, totalRows, nil
+}
+
+// GetCustomers is a function to get a single record from the customers table in the main database
+// error - ErrNotFound, db Find error
+func GetCustomers(ctx context.Context, argCustomerID int32) (record *model.Customers, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := []string{"CustomerId"}
+	sql := Rebind(dialect, SelectSQL(dialect, "customers", KeyWhere(dialect, keyColumns)))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	record = &model.Customers{}
+	err = db.GetContext(ctx, record, sql, argCustomerID)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// AddCustomers is a function to add a single record to customers table in the main database
+// error - ErrInsertFailed, db save call failed
+func AddCustomers(ctx context.Context, record *model.Customers) (result *model.Customers, RowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	columns := []string{"FirstName", "LastName", "Company", "Address", "City", "State", "Country", "PostalCode", "Phone", "Fax", "Email", "SupportRepId"}
+	args := []interface{}{record.FirstName, record.LastName, record.Company, record.Address, record.City, record.State, record.Country, record.PostalCode, record.Phone, record.Fax, record.Email, record.SupportRepID}
+
+	if dialect.LastInsertID() == LastInsertIDReturning {
+		sql := Rebind(dialect, InsertSQL(dialect, "customers", columns, []string{"CustomerId"}))
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		err = db.QueryRowContext(ctx, sql, args...).Scan(&record.CustomerID)
+		if err != nil {
+			return nil, 0, err
+		}
+		return record, 1, nil
+	}
+
+	sql := Rebind(dialect, InsertSQL(dialect, "customers", columns, nil))
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	id, err := dbResult.LastInsertId()
+	if err != nil {
+		return nil, 0, err
+	}
+	record.CustomerID = int32(id)
+
+	rows, err := dbResult.RowsAffected()
+	return record, rows, err
+}
+
+// UpsertCustomers is a function to add a single record to customers table in the main database, or update the record with the same conflict columns
+// params - conflict - json names of the columns of a unique constraint, the primary key if empty
+// error - unknown conflict column, db upsert error
+func UpsertCustomers(ctx context.Context, record *model.Customers, conflict ...string) (result *model.Customers, RowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := customersPrimaryKeys
+	if len(conflict) > 0 {
+		if keyColumns, err = conflictColumns(conflict, customersColumns); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// auto increment columns are only written when they identify the record
+	columns := []string{"FirstName", "LastName", "Company", "Address", "City", "State", "Country", "PostalCode", "Phone", "Fax", "Email", "SupportRepId"}
+	if containsColumn(keyColumns, "CustomerId") {
+		columns = append([]string{"CustomerId"}, columns...)
+	}
+
+	args := make([]interface{}, len(columns))
+	for i, column := range columns {
+		args[i] = customersValue(record, column)
+	}
+
+	sql := Rebind(dialect, dialect.Upsert("customers", columns, keyColumns, exceptColumns(columns, keyColumns)))
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbResult.RowsAffected()
+	return record, rows, err
+}
+
+// BulkAddCustomers is a function to add records to customers table in the main database with multi row inserts of batchSize records
+// params - batchSize - number of records inserted by each statement, DefaultBatchSize if not positive
+// The generated keys are set on the records on databases returning the inserted rows. Use WithTx to add all or none of the records.
+// error - db insert error
+func BulkAddCustomers(ctx context.Context, records []*model.Customers, batchSize int) (results []*model.Customers, RowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	columns := []string{"FirstName", "LastName", "Company", "Address", "City", "State", "Country", "PostalCode", "Phone", "Fax", "Email", "SupportRepId"}
+	for start := 0; start < len(records); start += batchSize {
+		end := start + batchSize
+		if end > len(records) {
+			end = len(records)
+		}
+		batch := records[start:end]
+
+		args := make([]interface{}, 0, len(batch)*len(columns))
+		for _, record := range batch {
+			args = append(args, record.FirstName, record.LastName, record.Company, record.Address, record.City, record.State, record.Country, record.PostalCode, record.Phone, record.Fax, record.Email, record.SupportRepID)
+		}
+
+		if dialect.LastInsertID() == LastInsertIDReturning {
+			sql := Rebind(dialect, BulkInsertSQL(dialect, "customers", columns, len(batch), []string{"CustomerId"}))
+			if Logger != nil {
+				Logger(ctx, sql)
+			}
+
+			rows, err := db.QueryContext(ctx, sql, args...)
+			if err != nil {
+				return nil, RowsAffected, err
+			}
+
+			for i := 0; rows.Next() && i < len(batch); i++ {
+				if err = rows.Scan(&batch[i].CustomerID); err != nil {
+					rows.Close()
+					return nil, RowsAffected, err
+				}
+				RowsAffected++
+			}
+			rows.Close()
+
+			if err = rows.Err(); err != nil {
+				return nil, RowsAffected, err
+			}
+			continue
+		}
+
+		sql := Rebind(dialect, BulkInsertSQL(dialect, "customers", columns, len(batch), nil))
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		dbResult, err := db.ExecContext(ctx, sql, args...)
+		if err != nil {
+			return nil, RowsAffected, err
+		}
+
+		rows, err := dbResult.RowsAffected()
+		if err != nil {
+			return nil, RowsAffected, err
+		}
+		RowsAffected += rows
+	}
+
+	return records, RowsAffected, nil
+}
+
+// UpdateCustomers is a function to update a single record from customers table in the main database
+// error - ErrNotFound, db record for id not found
+// error - ErrUpdateFailed, db meta data copy failed or db.Save call failed
+func UpdateCustomers(ctx context.Context, argCustomerID int32, updated *model.Customers) (result *model.Customers, RowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	columns := []string{"FirstName", "LastName", "Company", "Address", "City", "State", "Country", "PostalCode", "Phone", "Fax", "Email", "SupportRepId"}
+	keyColumns := []string{"CustomerId"}
+	sql := Rebind(dialect, UpdateSQL(dialect, "customers", columns, keyColumns))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, updated.FirstName, updated.LastName, updated.Company, updated.Address, updated.City, updated.State, updated.Country, updated.PostalCode, updated.Phone, updated.Fax, updated.Email, updated.SupportRepID, argCustomerID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbResult.RowsAffected()
+	updated.CustomerID = argCustomerID
+
+	return updated, rows, err
+}
+
+// PatchCustomers is a function to update the columns of a JSON merge patch, keyed by json name, of a single record from customers table in the main database
+// Zero values are written and null sets a column to NULL.
+// error - unknown, read only or not nullable patch field, db update error, db Find error
+func PatchCustomers(ctx context.Context, argCustomerID int32, patch map[string]json.RawMessage) (result *model.Customers, RowsAffected int64, err error) {
+	columns, args, err := customersPatch(patch, &model.Customers{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(columns) > 0 {
+		db := Conn(ctx, DB)
+		dialect := DialectFor(db.DriverName())
+
+		keyColumns := []string{"CustomerId"}
+		sql := Rebind(dialect, UpdateSQL(dialect, "customers", columns, keyColumns))
+
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		args = append(args, argCustomerID)
+		dbResult, err := db.ExecContext(ctx, sql, args...)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if RowsAffected, err = dbResult.RowsAffected(); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	result, err = GetCustomers(ctx, argCustomerID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, RowsAffected, nil
+}
+
+// DeleteCustomers is a function to delete a single record from customers table in the main database
+// error - ErrNotFound, db Find error
+// error - ErrDeleteFailed, db Delete failed error
+func DeleteCustomers(ctx context.Context, argCustomerID int32) (rowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := []string{"CustomerId"}
+	sql := Rebind(dialect, DeleteSQL(dialect, "customers", keyColumns))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	result, err := db.ExecContext(ctx, sql, argCustomerID)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
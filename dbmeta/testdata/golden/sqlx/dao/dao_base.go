@@ -0,0 +1,254 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// BuildInfo is used to define the application build info, and inject values into via the build process.
+type BuildInfo struct {
+
+	// BuildDate date string of when build was performed filled in by -X compile flag
+	BuildDate string
+
+	// LatestCommit date string of when build was performed filled in by -X compile flag
+	LatestCommit string
+
+	// BuildNumber date string of when build was performed filled in by -X compile flag
+	BuildNumber string
+
+	// BuiltOnIP date string of when build was performed filled in by -X compile flag
+	BuiltOnIP string
+
+	// BuiltOnOs date string of when build was performed filled in by -X compile flag
+	BuiltOnOs string
+
+	// RuntimeVer date string of when build was performed filled in by -X compile flag
+	RuntimeVer string
+}
+
+type LogSql func(ctx context.Context, sql string)
+
+var (
+	// ErrNotFound error when record not found
+	ErrNotFound = fmt.Errorf("record Not Found")
+
+	// ErrUnableToMarshalJSON error when json payload corrupt
+	ErrUnableToMarshalJSON = fmt.Errorf("json payload corrupt")
+
+	// ErrUpdateFailed error when update fails
+	ErrUpdateFailed = fmt.Errorf("db update error")
+
+	// ErrInsertFailed error when insert fails
+	ErrInsertFailed = fmt.Errorf("db insert error")
+
+	// ErrDeleteFailed error when delete fails
+	ErrDeleteFailed = fmt.Errorf("db delete error")
+
+	// ErrBadParams error when bad params passed in
+	ErrBadParams = fmt.Errorf("bad params error")
+
+	// DB reference to database
+	DB *sqlx.DB
+
+	// AppBuildInfo reference to build info
+	AppBuildInfo *BuildInfo
+
+	// Logger function that will be invoked before executing sql
+	Logger LogSql
+
+	// Actor function returning the user acting in a context, written to the created by and updated by audit columns,
+	// the columns are cleared if it is nil or returns false
+	Actor func(ctx context.Context) (actor string, ok bool)
+)
+
+// Copy a src struct into a destination struct
+func Copy(dst interface{}, src interface{}) error {
+	dstV := reflect.Indirect(reflect.ValueOf(dst))
+	srcV := reflect.Indirect(reflect.ValueOf(src))
+
+	if !dstV.CanAddr() {
+		return errors.New("copy to value is unaddressable")
+	}
+
+	if srcV.Type() != dstV.Type() {
+		return errors.New("different types can not be copied")
+	}
+
+	for i := 0; i < dstV.NumField(); i++ {
+		f := srcV.Field(i)
+		if !isZeroOfUnderlyingType(f.Interface()) {
+			dstV.Field(i).Set(f)
+		}
+	}
+
+	return nil
+}
+
+func isZeroOfUnderlyingType(x interface{}) bool {
+	return x == nil || reflect.DeepEqual(x, reflect.Zero(reflect.TypeOf(x)).Interface())
+}
+
+// GetRowCount return the number of rows of a table using the package DB
+func GetRowCount(ctx context.Context, tableName string) (int, error) {
+	return RowCount(ctx, DB, tableName, "")
+}
+
+// RowCount return the number of rows of a table matching the where clause, all rows if it is empty, using the database
+// handle db
+func RowCount(ctx context.Context, db Queryer, tableName string, where string, args ...interface{}) (int, error) {
+	dialect := DialectFor(db.DriverName())
+	sql := "SELECT count(*) FROM " + dialect.Quote(tableName)
+	if where != "" {
+		sql = Rebind(dialect, sql+" WHERE "+where)
+	}
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	cnt := 0
+	row := db.QueryRowContext(ctx, sql, args...)
+	err := row.Scan(&cnt)
+	if err != nil {
+		return -1, err
+	}
+
+	return cnt, err
+}
+
+// EstimateRowCount return the number of rows of a table estimated from the catalog statistics of the database, the
+// exact number of rows if the database has no statistics or the table was never analyzed
+func EstimateRowCount(ctx context.Context, db Queryer, tableName string) (int, error) {
+	dialect := DialectFor(db.DriverName())
+	query := dialect.EstimateRowCountSQL()
+	if query == "" {
+		return RowCount(ctx, db, tableName, "")
+	}
+
+	query = Rebind(dialect, query)
+	if Logger != nil {
+		Logger(ctx, query)
+	}
+
+	var cnt sql.NullInt64
+	err := db.QueryRowContext(ctx, query, tableName).Scan(&cnt)
+	if err != nil {
+		return -1, err
+	}
+	if !cnt.Valid || cnt.Int64 < 0 {
+		return RowCount(ctx, db, tableName, "")
+	}
+
+	return int(cnt.Int64), nil
+}
+
+// Queryer is implemented by *sqlx.DB and *sqlx.Tx, the dao functions execute their queries with the Queryer returned
+// by Conn
+type Queryer interface {
+	DriverName() string
+	Rebind(query string) string
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+}
+
+type txKey struct{}
+
+// txContext the transaction of db stored in the context by RunInTx
+type txContext struct {
+	db         *sqlx.DB
+	tx         *sqlx.Tx
+	savepoints int
+}
+
+// Conn return the transaction of db stored in ctx by WithTx or RunInTx, db if there is none
+func Conn(ctx context.Context, db *sqlx.DB) Queryer {
+	if t, ok := ctx.Value(txKey{}).(*txContext); ok && t.db == db {
+		return t.tx
+	}
+	return db
+}
+
+// TxRunner run fn in a transaction, like WithTx on the package DB
+type TxRunner func(ctx context.Context, fn func(ctx context.Context) error) error
+
+// WithTx run fn in a transaction of the package DB, see RunInTx
+func WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return RunInTx(ctx, DB, fn)
+}
+
+// RunInTx run fn in a transaction of db. The dao functions called with the context passed to fn use the transaction,
+// which is committed when fn returns nil and rolled back when fn returns an error or panics. A nested call runs fn in
+// a savepoint, rolled back when fn fails, on databases supporting savepoints and joins the outer transaction
+// otherwise. Without a database handle, e.g. with fake repositories, fn runs without a transaction.
+func RunInTx(ctx context.Context, db *sqlx.DB, fn func(ctx context.Context) error) (err error) {
+	if db == nil {
+		return fn(ctx)
+	}
+
+	if t, ok := ctx.Value(txKey{}).(*txContext); ok && t.db == db {
+		return t.savepoint(ctx, fn)
+	}
+
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err = fn(context.WithValue(ctx, txKey{}, &txContext{db: db, tx: tx})); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// savepoint run fn in a savepoint of the transaction, see Dialect.Savepoint. A failed rollback to the savepoint is
+// returned with the error of fn, the outer transaction can not be used anymore.
+func (t *txContext) savepoint(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	t.savepoints++
+	name := fmt.Sprintf("sp_%d", t.savepoints)
+
+	create, rollback, release := DialectFor(t.db.DriverName()).Savepoint(name)
+	if create == "" {
+		return fn(ctx)
+	}
+
+	if Logger != nil {
+		Logger(ctx, create)
+	}
+	if _, err = t.tx.ExecContext(ctx, create); err != nil {
+		return err
+	}
+
+	if err = fn(ctx); err != nil {
+		if Logger != nil {
+			Logger(ctx, rollback)
+		}
+		if _, rollbackErr := t.tx.ExecContext(ctx, rollback); rollbackErr != nil {
+			return fmt.Errorf("%w, %s: %v", err, rollback, rollbackErr)
+		}
+		return err
+	}
+
+	if release != "" {
+		if Logger != nil {
+			Logger(ctx, release)
+		}
+		_, err = t.tx.ExecContext(ctx, release)
+	}
+	return err
+}
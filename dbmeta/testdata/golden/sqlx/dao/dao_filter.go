@@ -0,0 +1,3017 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"example.com/rest/example/model"
+)
+
+var (
+	_ = sql.NullString{}
+)
+
+// Filter conditions on a column of a table filter, all conditions set must hold
+type Filter struct {
+	Eq     interface{}
+	In     []interface{}
+	Gt     interface{}
+	Gte    interface{}
+	Lt     interface{}
+	Lte    interface{}
+	Like   string
+	ILike  string
+	IsNull *bool
+}
+
+// filterColumn a column and its filter
+type filterColumn struct {
+	name   string
+	filter *Filter
+}
+
+// SplitFilterParam split a query parameter name into the column and operation, <column> filters on equality and
+// <column>__<op> with op one of in, gt, gte, lt, lte, like, ilike and null
+func SplitFilterParam(name string) (column, op string) {
+	if i := strings.LastIndex(name, "__"); i > 0 {
+		switch name[i+2:] {
+		case "eq", "in", "gt", "gte", "lt", "lte", "like", "ilike", "null":
+			return name[:i], name[i+2:]
+		}
+	}
+	return name, "eq"
+}
+
+// set parse values to the kind of the column and set the condition op
+func (f *Filter) set(column, kind, op string, values []string) error {
+	if op == "" {
+		op = "eq"
+	}
+	if len(values) == 0 || (op != "in" && len(values) > 1) {
+		return fmt.Errorf("filter %s %s: invalid number of values %d", column, op, len(values))
+	}
+
+	switch op {
+	case "gt", "gte", "lt", "lte":
+		if kind != "int" && kind != "float" && kind != "time" {
+			return fmt.Errorf("filter %s %s: only numeric and time columns support ranges", column, op)
+		}
+	case "like", "ilike":
+		if kind != "string" {
+			return fmt.Errorf("filter %s %s: only string columns support like", column, op)
+		}
+	case "null":
+		isNull, err := strconv.ParseBool(values[0])
+		if err != nil {
+			return fmt.Errorf("filter %s %s: %v", column, op, err)
+		}
+		f.IsNull = &isNull
+		return nil
+	case "eq", "in":
+	default:
+		return fmt.Errorf("filter %s: unknown operation %s", column, op)
+	}
+
+	parsed := make([]interface{}, len(values))
+	for i, value := range values {
+		var err error
+		switch kind {
+		case "int":
+			parsed[i], err = strconv.ParseInt(value, 10, 64)
+		case "float":
+			parsed[i], err = strconv.ParseFloat(value, 64)
+		case "bool":
+			parsed[i], err = strconv.ParseBool(value)
+		case "time":
+			parsed[i], err = time.Parse(time.RFC3339, value)
+		default:
+			parsed[i] = value
+		}
+		if err != nil {
+			return fmt.Errorf("filter %s %s: %v", column, op, err)
+		}
+	}
+
+	switch op {
+	case "eq":
+		f.Eq = parsed[0]
+	case "in":
+		f.In = parsed
+	case "gt":
+		f.Gt = parsed[0]
+	case "gte":
+		f.Gte = parsed[0]
+	case "lt":
+		f.Lt = parsed[0]
+	case "lte":
+		f.Lte = parsed[0]
+	case "like":
+		f.Like = values[0]
+	case "ilike":
+		f.ILike = values[0]
+	}
+	return nil
+}
+
+// filterWhere return the where clause, using ? placeholders, and its args for the column filters
+func filterWhere(driverName string, columns []filterColumn) (string, []interface{}) {
+	dialect := DialectFor(driverName)
+	var conditions []string
+	var args []interface{}
+	add := func(condition string, values ...interface{}) {
+		conditions = append(conditions, condition)
+		args = append(args, values...)
+	}
+
+	for _, column := range columns {
+		f := column.filter
+		if f == nil {
+			continue
+		}
+
+		name := dialect.Quote(column.name)
+		if f.Eq != nil {
+			add(name+" = ?", f.Eq)
+		}
+		if len(f.In) > 0 {
+			add(name+" IN (?"+strings.Repeat(", ?", len(f.In)-1)+")", f.In...)
+		}
+		if f.Gt != nil {
+			add(name+" > ?", f.Gt)
+		}
+		if f.Gte != nil {
+			add(name+" >= ?", f.Gte)
+		}
+		if f.Lt != nil {
+			add(name+" < ?", f.Lt)
+		}
+		if f.Lte != nil {
+			add(name+" <= ?", f.Lte)
+		}
+		if f.Like != "" {
+			add(name+" LIKE ?", f.Like)
+		}
+		if f.ILike != "" {
+			add(dialect.ILike(column.name), f.ILike)
+		}
+		if f.IsNull != nil {
+			if *f.IsNull {
+				add(name + " IS NULL")
+			} else {
+				add(name + " IS NOT NULL")
+			}
+		}
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
+// filterMatches return true if the values of a record, returned by value, hold the conditions of the column filters.
+// It evaluates the filters in memory, for the fake repositories.
+func filterMatches(columns []filterColumn, value func(column string) interface{}) bool {
+	for _, column := range columns {
+		if !column.filter.matches(value(column.name)) {
+			return false
+		}
+	}
+	return true
+}
+
+// matches return true if value holds the conditions of the filter, NULL values only match IsNull like in sql
+func (f *Filter) matches(value interface{}) bool {
+	if f == nil {
+		return true
+	}
+
+	v, err := driver.DefaultParameterConverter.ConvertValue(value)
+	if err != nil {
+		return false
+	}
+	if f.IsNull != nil && *f.IsNull != (v == nil) {
+		return false
+	}
+
+	holds := func(operand interface{}, accept func(cmp int) bool) bool {
+		if operand == nil {
+			return true
+		}
+		cmp, ok := compareValues(v, operand)
+		return ok && accept(cmp)
+	}
+	if !holds(f.Eq, func(cmp int) bool { return cmp == 0 }) ||
+		!holds(f.Gt, func(cmp int) bool { return cmp > 0 }) ||
+		!holds(f.Gte, func(cmp int) bool { return cmp >= 0 }) ||
+		!holds(f.Lt, func(cmp int) bool { return cmp < 0 }) ||
+		!holds(f.Lte, func(cmp int) bool { return cmp <= 0 }) {
+		return false
+	}
+
+	if len(f.In) > 0 {
+		found := false
+		for _, operand := range f.In {
+			if cmp, ok := compareValues(v, operand); ok && cmp == 0 {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if f.Like != "" && !likeMatches(f.Like, v, false) {
+		return false
+	}
+	if f.ILike != "" && !likeMatches(f.ILike, v, true) {
+		return false
+	}
+	return true
+}
+
+// compareValues compare the driver values of a and b, returning -1, 0 or 1. ok is false if either is NULL or if the
+// values can not be compared.
+func compareValues(a, b interface{}) (cmp int, ok bool) {
+	x, err := driver.DefaultParameterConverter.ConvertValue(a)
+	if err != nil || x == nil {
+		return 0, false
+	}
+	y, err := driver.DefaultParameterConverter.ConvertValue(b)
+	if err != nil || y == nil {
+		return 0, false
+	}
+
+	switch x := x.(type) {
+	case int64:
+		switch y := y.(type) {
+		case int64:
+			return compareResult(x < y, x > y), true
+		case float64:
+			return compareResult(float64(x) < y, float64(x) > y), true
+		}
+	case float64:
+		switch y := y.(type) {
+		case int64:
+			return compareResult(x < float64(y), x > float64(y)), true
+		case float64:
+			return compareResult(x < y, x > y), true
+		}
+	case bool:
+		if y, ok := y.(bool); ok {
+			return compareResult(!x && y, x && !y), true
+		}
+	case time.Time:
+		if y, ok := y.(time.Time); ok {
+			return compareResult(x.Before(y), x.After(y)), true
+		}
+	case string, []byte:
+		switch y.(type) {
+		case string, []byte:
+			return strings.Compare(fmt.Sprintf("%s", x), fmt.Sprintf("%s", y)), true
+		}
+	}
+	return 0, false
+}
+
+// compareResult return -1 if less, 1 if greater and 0 otherwise
+func compareResult(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	}
+	return 0
+}
+
+// likeMatches return true if value is a string matching the sql like pattern, case insensitively if fold is set
+func likeMatches(pattern string, value driver.Value, fold bool) bool {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return false
+	}
+
+	expr := "(?s)^"
+	if fold {
+		expr = "(?is)^"
+	}
+	for _, r := range pattern {
+		switch r {
+		case '%':
+			expr += ".*"
+		case '_':
+			expr += "."
+		default:
+			expr += regexp.QuoteMeta(string(r))
+		}
+	}
+	matched, _ := regexp.MatchString(expr+"$", s)
+	return matched
+}
+
+// QuoteIdentifier quote a table or column name for the database driver, see Dialect
+func QuoteIdentifier(driverName, name string) string {
+	return DialectFor(driverName).Quote(name)
+}
+
+// orderTerm a sort column
+type orderTerm struct {
+	column string
+	desc   bool
+}
+
+// String return the column prefixed by - if descending
+func (t orderTerm) String() string {
+	if t.desc {
+		return "-" + t.column
+	}
+	return t.column
+}
+
+// orderTerms return the sort columns of order, a comma separated list of json column names, descending if prefixed by
+// -. columns maps the json names to the column names. The primary key columns not in order are appended as a stable
+// tiebreaker.
+func orderTerms(order string, columns map[string]string, primaryKeys []string) ([]orderTerm, error) {
+	var terms []orderTerm
+	sorted := make(map[string]bool)
+	for _, name := range strings.Split(order, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		desc := strings.HasPrefix(name, "-")
+		if desc {
+			name = name[1:]
+		}
+
+		column, ok := columns[name]
+		if !ok {
+			return nil, fmt.Errorf("order: unknown column %s", name)
+		}
+		if sorted[column] {
+			continue
+		}
+		sorted[column] = true
+		terms = append(terms, orderTerm{column: column, desc: desc})
+	}
+
+	for _, column := range primaryKeys {
+		if !sorted[column] {
+			terms = append(terms, orderTerm{column: column})
+		}
+	}
+	return terms, nil
+}
+
+// orderBy return the columns of an order by clause, quoted for the database driver
+func orderBy(driverName string, terms []orderTerm) string {
+	columns := make([]string, len(terms))
+	for i, term := range terms {
+		columns[i] = QuoteIdentifier(driverName, term.column) + " ASC"
+		if term.desc {
+			columns[i] = QuoteIdentifier(driverName, term.column) + " DESC"
+		}
+	}
+	return strings.Join(columns, ", ")
+}
+
+// lessRecord return true if the record whose column values are returned by a sorts before the one of b by terms, NULL
+// values sort first. It sorts in memory, for the fake repositories.
+func lessRecord(terms []orderTerm, a, b func(column string) interface{}) bool {
+	for _, term := range terms {
+		x, y := a(term.column), b(term.column)
+		cmp, ok := compareValues(x, y)
+		if !ok {
+			xNull, yNull := isNullValue(x), isNullValue(y)
+			cmp = compareResult(xNull && !yNull, !xNull && yNull)
+		}
+		if cmp != 0 {
+			return (cmp < 0) != term.desc
+		}
+	}
+	return false
+}
+
+// isNullValue return true if the driver value of v is NULL
+func isNullValue(v interface{}) bool {
+	value, err := driver.DefaultParameterConverter.ConvertValue(v)
+	return err == nil && value == nil
+}
+
+// CountMode how the GetPage functions count the records
+type CountMode string
+
+const (
+	// CountNone do not count the records, totalRows is -1
+	CountNone CountMode = ""
+
+	// CountExact count the records matching the filter
+	CountExact CountMode = "exact"
+
+	// CountEstimate estimate the number of records of the table from the catalog statistics, ignoring the filter
+	CountEstimate CountMode = "estimate"
+)
+
+// keyset the sort columns of a keyset paginated query and the values of the last record of the previous page
+type keyset struct {
+	driverName string
+	terms      []orderTerm
+	values     []interface{}
+}
+
+// cursorData content of a cursor
+type cursorData struct {
+	Columns []string      `json:"c"`
+	Values  []interface{} `json:"v"`
+}
+
+// newKeyset return the keyset of the page after cursor sorted by order, the first page if cursor is empty. The
+// comparisons of the keyset never hold for NULL values and databases sort NULL first or last, so the nullable columns
+// can not be sort columns, ErrBadParams. The cursor values are parsed back to the kinds of their columns, see
+// cursorValue.
+func newKeyset(driverName, order, cursor string, columns, kinds map[string]string, primaryKeys, nullableColumns []string) (*keyset, error) {
+	terms, err := orderTerms(order, columns, primaryKeys)
+	if err != nil {
+		return nil, err
+	}
+	for _, term := range terms {
+		for _, column := range nullableColumns {
+			if term.column == column {
+				return nil, fmt.Errorf("order: nullable column %s can not be a keyset sort column: %w", column, ErrBadParams)
+			}
+		}
+	}
+
+	k := &keyset{driverName: driverName, terms: terms}
+	if cursor == "" {
+		return k, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("cursor: %v", err)
+	}
+
+	data := &cursorData{}
+	decoder := json.NewDecoder(strings.NewReader(string(b)))
+	decoder.UseNumber()
+	if err = decoder.Decode(data); err != nil {
+		return nil, fmt.Errorf("cursor: %v", err)
+	}
+
+	if len(data.Columns) != len(terms) || len(data.Values) != len(terms) {
+		return nil, fmt.Errorf("cursor: does not match the order %s", order)
+	}
+	for i, term := range terms {
+		if data.Columns[i] != term.String() {
+			return nil, fmt.Errorf("cursor: does not match the order %s", order)
+		}
+	}
+
+	for i, term := range terms {
+		if data.Values[i], err = cursorValue(kinds[term.column], data.Values[i]); err != nil {
+			return nil, fmt.Errorf("cursor: %s: %v", term.column, err)
+		}
+	}
+
+	k.values = data.Values
+	return k, nil
+}
+
+// cursorValue parse a value decoded from the JSON of a cursor to the kind of its column, JSON has no time or binary
+// values and a time bound as its RFC3339 string does not compare as a time in every database
+func cursorValue(kind string, v interface{}) (interface{}, error) {
+	switch value := v.(type) {
+	case json.Number:
+		if kind == "float" {
+			return value.Float64()
+		}
+		if n, err := value.Int64(); err == nil {
+			return n, nil
+		}
+		return value.Float64()
+	case string:
+		switch kind {
+		case "time":
+			return time.Parse(time.RFC3339Nano, value)
+		case "bytes":
+			return base64.StdEncoding.DecodeString(value)
+		}
+	}
+	return v, nil
+}
+
+// orderBy return the order by columns of the keyset
+func (k *keyset) orderBy() string {
+	return orderBy(k.driverName, k.terms)
+}
+
+// where return the where clause selecting the records after the cursor, combined with the where clause of a filter
+func (k *keyset) where(filterWhere string, filterArgs []interface{}) (string, []interface{}) {
+	if k.values == nil {
+		return filterWhere, filterArgs
+	}
+
+	// (c1 > v1) OR (c1 = v1 AND c2 > v2) OR ..., < for descending columns
+	var alternatives []string
+	args := append([]interface{}{}, filterArgs...)
+	for i, term := range k.terms {
+		var conditions []string
+		for j := 0; j < i; j++ {
+			conditions = append(conditions, QuoteIdentifier(k.driverName, k.terms[j].column)+" = ?")
+			args = append(args, k.values[j])
+		}
+
+		op := " > ?"
+		if term.desc {
+			op = " < ?"
+		}
+		conditions = append(conditions, QuoteIdentifier(k.driverName, term.column)+op)
+		args = append(args, k.values[i])
+		alternatives = append(alternatives, "("+strings.Join(conditions, " AND ")+")")
+	}
+
+	where := "(" + strings.Join(alternatives, " OR ") + ")"
+	if filterWhere != "" {
+		where = "(" + filterWhere + ") AND " + where
+	}
+	return where, args
+}
+
+// cursor return the cursor of the page after the record whose column values are returned by value
+func (k *keyset) cursor(value func(column string) interface{}) (string, error) {
+	data := &cursorData{}
+	for _, term := range k.terms {
+		v := value(term.column)
+		if valuer, ok := v.(driver.Valuer); ok {
+			var err error
+			if v, err = valuer.Value(); err != nil {
+				return "", err
+			}
+		}
+
+		data.Columns = append(data.Columns, term.String())
+		data.Values = append(data.Values, v)
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// patchNames return the sorted field names of a JSON merge patch
+func patchNames(patch map[string]json.RawMessage) []string {
+	names := make([]string, 0, len(patch))
+	for name := range patch {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// isJSONNull return true if raw is the JSON null
+func isJSONNull(raw json.RawMessage) bool {
+	return strings.TrimSpace(string(raw)) == "null"
+}
+
+// actorOf return the user acting in ctx, see Actor
+func actorOf(ctx context.Context) (string, bool) {
+	if Actor == nil {
+		return "", false
+	}
+	return Actor(ctx)
+}
+
+// notDeleted filters the soft delete column of the rows that are not soft deleted
+var notDeleted = func() *Filter {
+	isNull := true
+	return &Filter{IsNull: &isNull}
+}()
+
+// AlbumsFilter filters the records of the albums table returned by GetAll, nil column filters are ignored
+type AlbumsFilter struct {
+	AlbumID  *Filter
+	Title    *Filter
+	ArtistID *Filter
+}
+
+// Set parse values and set the condition op on a column of the filter, columns are named by their json name
+func (f *AlbumsFilter) Set(column, op string, values ...string) error {
+	switch column {
+	case "album_id":
+		if f.AlbumID == nil {
+			f.AlbumID = &Filter{}
+		}
+		return f.AlbumID.set(column, "int", op, values)
+	case "title":
+		if f.Title == nil {
+			f.Title = &Filter{}
+		}
+		return f.Title.set(column, "string", op, values)
+	case "artist_id":
+		if f.ArtistID == nil {
+			f.ArtistID = &Filter{}
+		}
+		return f.ArtistID.set(column, "int", op, values)
+	}
+	return fmt.Errorf("filter: unknown column %s", column)
+}
+
+// columns return the column filters
+func (f *AlbumsFilter) columns() []filterColumn {
+	return []filterColumn{
+		{"AlbumId", f.AlbumID},
+		{"Title", f.Title},
+		{"ArtistId", f.ArtistID},
+	}
+}
+
+// where return the where clause and its args, an empty where clause if f is nil
+func (f *AlbumsFilter) where(driverName string) (string, []interface{}) {
+	if f == nil {
+		return "", nil
+	}
+
+	return filterWhere(driverName, f.columns())
+}
+
+// matches return true if record holds the column filters, true if f is nil. It evaluates the filter in memory, for
+// the fake repositories.
+func (f *AlbumsFilter) matches(record *model.Albums) bool {
+	if f == nil {
+		return true
+	}
+
+	return filterMatches(f.columns(), func(column string) interface{} {
+		return albumsValue(record, column)
+	})
+}
+
+var (
+	// albumsColumns the columns of the albums table keyed by json name
+	albumsColumns = map[string]string{
+		"album_id":  "AlbumId",
+		"title":     "Title",
+		"artist_id": "ArtistId",
+	}
+
+	// albumsCursorKinds the kinds of the values of the columns of the albums table in a keyset cursor
+	albumsCursorKinds = map[string]string{
+		"AlbumId":  "int",
+		"Title":    "string",
+		"ArtistId": "int",
+	}
+
+	// albumsPrimaryKeys the primary key columns of the albums table
+	albumsPrimaryKeys = []string{"AlbumId"}
+
+	// albumsNullableColumns the nullable columns of the albums table
+	albumsNullableColumns = []string{}
+)
+
+// AlbumsOrderBy return the order by columns of the albums table for order, see orderTerms
+func AlbumsOrderBy(driverName, order string) (string, error) {
+	terms, err := orderTerms(order, albumsColumns, albumsPrimaryKeys)
+	if err != nil {
+		return "", err
+	}
+	return orderBy(driverName, terms), nil
+}
+
+// newAlbumsKeyset return the keyset of the page of the albums table after cursor
+func newAlbumsKeyset(driverName, order, cursor string) (*keyset, error) {
+	return newKeyset(driverName, order, cursor, albumsColumns, albumsCursorKinds, albumsPrimaryKeys, albumsNullableColumns)
+}
+
+// albumsValue return the value of a column of record
+func albumsValue(record *model.Albums, column string) interface{} {
+	switch column {
+	case "AlbumId":
+		return record.AlbumID
+	case "Title":
+		return record.Title
+	case "ArtistId":
+		return record.ArtistID
+	}
+	return nil
+}
+
+// albumsPatch apply a JSON merge patch of a Albums keyed by json name to record and return the columns
+// and values set, null sets a column to NULL. Unknown fields, primary key and audit fields and null values of columns
+// that are not nullable are rejected.
+func albumsPatch(patch map[string]json.RawMessage, record *model.Albums) (columns []string, values []interface{}, err error) {
+	for _, name := range patchNames(patch) {
+		raw := patch[name]
+		switch name {
+		case "album_id":
+			return nil, nil, fmt.Errorf("patch: primary key %s is read only", name)
+		case "title":
+			if isJSONNull(raw) {
+				return nil, nil, fmt.Errorf("patch: %s is not nullable", name)
+			}
+			if err = json.Unmarshal(raw, &record.Title); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "Title"), append(values, record.Title)
+		case "artist_id":
+			if isJSONNull(raw) {
+				return nil, nil, fmt.Errorf("patch: %s is not nullable", name)
+			}
+			if err = json.Unmarshal(raw, &record.ArtistID); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "ArtistId"), append(values, record.ArtistID)
+		default:
+			return nil, nil, fmt.Errorf("patch: unknown field %s", name)
+		}
+	}
+	return columns, values, nil
+}
+
+// ArtistsFilter filters the records of the artists table returned by GetAll, nil column filters are ignored
+type ArtistsFilter struct {
+	ArtistID *Filter
+	Name     *Filter
+}
+
+// Set parse values and set the condition op on a column of the filter, columns are named by their json name
+func (f *ArtistsFilter) Set(column, op string, values ...string) error {
+	switch column {
+	case "artist_id":
+		if f.ArtistID == nil {
+			f.ArtistID = &Filter{}
+		}
+		return f.ArtistID.set(column, "int", op, values)
+	case "name":
+		if f.Name == nil {
+			f.Name = &Filter{}
+		}
+		return f.Name.set(column, "string", op, values)
+	}
+	return fmt.Errorf("filter: unknown column %s", column)
+}
+
+// columns return the column filters
+func (f *ArtistsFilter) columns() []filterColumn {
+	return []filterColumn{
+		{"ArtistId", f.ArtistID},
+		{"Name", f.Name},
+	}
+}
+
+// where return the where clause and its args, an empty where clause if f is nil
+func (f *ArtistsFilter) where(driverName string) (string, []interface{}) {
+	if f == nil {
+		return "", nil
+	}
+
+	return filterWhere(driverName, f.columns())
+}
+
+// matches return true if record holds the column filters, true if f is nil. It evaluates the filter in memory, for
+// the fake repositories.
+func (f *ArtistsFilter) matches(record *model.Artists) bool {
+	if f == nil {
+		return true
+	}
+
+	return filterMatches(f.columns(), func(column string) interface{} {
+		return artistsValue(record, column)
+	})
+}
+
+var (
+	// artistsColumns the columns of the artists table keyed by json name
+	artistsColumns = map[string]string{
+		"artist_id": "ArtistId",
+		"name":      "Name",
+	}
+
+	// artistsCursorKinds the kinds of the values of the columns of the artists table in a keyset cursor
+	artistsCursorKinds = map[string]string{
+		"ArtistId": "int",
+		"Name":     "string",
+	}
+
+	// artistsPrimaryKeys the primary key columns of the artists table
+	artistsPrimaryKeys = []string{"ArtistId"}
+
+	// artistsNullableColumns the nullable columns of the artists table
+	artistsNullableColumns = []string{"Name"}
+)
+
+// ArtistsOrderBy return the order by columns of the artists table for order, see orderTerms
+func ArtistsOrderBy(driverName, order string) (string, error) {
+	terms, err := orderTerms(order, artistsColumns, artistsPrimaryKeys)
+	if err != nil {
+		return "", err
+	}
+	return orderBy(driverName, terms), nil
+}
+
+// newArtistsKeyset return the keyset of the page of the artists table after cursor
+func newArtistsKeyset(driverName, order, cursor string) (*keyset, error) {
+	return newKeyset(driverName, order, cursor, artistsColumns, artistsCursorKinds, artistsPrimaryKeys, artistsNullableColumns)
+}
+
+// artistsValue return the value of a column of record
+func artistsValue(record *model.Artists, column string) interface{} {
+	switch column {
+	case "ArtistId":
+		return record.ArtistID
+	case "Name":
+		return record.Name
+	}
+	return nil
+}
+
+// artistsPatch apply a JSON merge patch of a Artists keyed by json name to record and return the columns
+// and values set, null sets a column to NULL. Unknown fields, primary key and audit fields and null values of columns
+// that are not nullable are rejected.
+func artistsPatch(patch map[string]json.RawMessage, record *model.Artists) (columns []string, values []interface{}, err error) {
+	empty := &model.Artists{}
+	for _, name := range patchNames(patch) {
+		raw := patch[name]
+		switch name {
+		case "artist_id":
+			return nil, nil, fmt.Errorf("patch: primary key %s is read only", name)
+		case "name":
+			if isJSONNull(raw) {
+				record.Name = empty.Name
+				columns, values = append(columns, "Name"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.Name = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "Name"), append(values, record.Name)
+		default:
+			return nil, nil, fmt.Errorf("patch: unknown field %s", name)
+		}
+	}
+	return columns, values, nil
+}
+
+// CustomersFilter filters the records of the customers table returned by GetAll, nil column filters are ignored
+type CustomersFilter struct {
+	CustomerID   *Filter
+	FirstName    *Filter
+	LastName     *Filter
+	Company      *Filter
+	Address      *Filter
+	City         *Filter
+	State        *Filter
+	Country      *Filter
+	PostalCode   *Filter
+	Phone        *Filter
+	Fax          *Filter
+	Email        *Filter
+	SupportRepID *Filter
+}
+
+// Set parse values and set the condition op on a column of the filter, columns are named by their json name
+func (f *CustomersFilter) Set(column, op string, values ...string) error {
+	switch column {
+	case "customer_id":
+		if f.CustomerID == nil {
+			f.CustomerID = &Filter{}
+		}
+		return f.CustomerID.set(column, "int", op, values)
+	case "first_name":
+		if f.FirstName == nil {
+			f.FirstName = &Filter{}
+		}
+		return f.FirstName.set(column, "string", op, values)
+	case "last_name":
+		if f.LastName == nil {
+			f.LastName = &Filter{}
+		}
+		return f.LastName.set(column, "string", op, values)
+	case "company":
+		if f.Company == nil {
+			f.Company = &Filter{}
+		}
+		return f.Company.set(column, "string", op, values)
+	case "address":
+		if f.Address == nil {
+			f.Address = &Filter{}
+		}
+		return f.Address.set(column, "string", op, values)
+	case "city":
+		if f.City == nil {
+			f.City = &Filter{}
+		}
+		return f.City.set(column, "string", op, values)
+	case "state":
+		if f.State == nil {
+			f.State = &Filter{}
+		}
+		return f.State.set(column, "string", op, values)
+	case "country":
+		if f.Country == nil {
+			f.Country = &Filter{}
+		}
+		return f.Country.set(column, "string", op, values)
+	case "postal_code":
+		if f.PostalCode == nil {
+			f.PostalCode = &Filter{}
+		}
+		return f.PostalCode.set(column, "string", op, values)
+	case "phone":
+		if f.Phone == nil {
+			f.Phone = &Filter{}
+		}
+		return f.Phone.set(column, "string", op, values)
+	case "fax":
+		if f.Fax == nil {
+			f.Fax = &Filter{}
+		}
+		return f.Fax.set(column, "string", op, values)
+	case "email":
+		if f.Email == nil {
+			f.Email = &Filter{}
+		}
+		return f.Email.set(column, "string", op, values)
+	case "support_rep_id":
+		if f.SupportRepID == nil {
+			f.SupportRepID = &Filter{}
+		}
+		return f.SupportRepID.set(column, "int", op, values)
+	}
+	return fmt.Errorf("filter: unknown column %s", column)
+}
+
+// columns return the column filters
+func (f *CustomersFilter) columns() []filterColumn {
+	return []filterColumn{
+		{"CustomerId", f.CustomerID},
+		{"FirstName", f.FirstName},
+		{"LastName", f.LastName},
+		{"Company", f.Company},
+		{"Address", f.Address},
+		{"City", f.City},
+		{"State", f.State},
+		{"Country", f.Country},
+		{"PostalCode", f.PostalCode},
+		{"Phone", f.Phone},
+		{"Fax", f.Fax},
+		{"Email", f.Email},
+		{"SupportRepId", f.SupportRepID},
+	}
+}
+
+// where return the where clause and its args, an empty where clause if f is nil
+func (f *CustomersFilter) where(driverName string) (string, []interface{}) {
+	if f == nil {
+		return "", nil
+	}
+
+	return filterWhere(driverName, f.columns())
+}
+
+// matches return true if record holds the column filters, true if f is nil. It evaluates the filter in memory, for
+// the fake repositories.
+func (f *CustomersFilter) matches(record *model.Customers) bool {
+	if f == nil {
+		return true
+	}
+
+	return filterMatches(f.columns(), func(column string) interface{} {
+		return customersValue(record, column)
+	})
+}
+
+var (
+	// customersColumns the columns of the customers table keyed by json name
+	customersColumns = map[string]string{
+		"customer_id":    "CustomerId",
+		"first_name":     "FirstName",
+		"last_name":      "LastName",
+		"company":        "Company",
+		"address":        "Address",
+		"city":           "City",
+		"state":          "State",
+		"country":        "Country",
+		"postal_code":    "PostalCode",
+		"phone":          "Phone",
+		"fax":            "Fax",
+		"email":          "Email",
+		"support_rep_id": "SupportRepId",
+	}
+
+	// customersCursorKinds the kinds of the values of the columns of the customers table in a keyset cursor
+	customersCursorKinds = map[string]string{
+		"CustomerId":   "int",
+		"FirstName":    "string",
+		"LastName":     "string",
+		"Company":      "string",
+		"Address":      "string",
+		"City":         "string",
+		"State":        "string",
+		"Country":      "string",
+		"PostalCode":   "string",
+		"Phone":        "string",
+		"Fax":          "string",
+		"Email":        "string",
+		"SupportRepId": "int",
+	}
+
+	// customersPrimaryKeys the primary key columns of the customers table
+	customersPrimaryKeys = []string{"CustomerId"}
+
+	// customersNullableColumns the nullable columns of the customers table
+	customersNullableColumns = []string{"Company", "Address", "City", "State", "Country", "PostalCode", "Phone", "Fax", "SupportRepId"}
+)
+
+// CustomersOrderBy return the order by columns of the customers table for order, see orderTerms
+func CustomersOrderBy(driverName, order string) (string, error) {
+	terms, err := orderTerms(order, customersColumns, customersPrimaryKeys)
+	if err != nil {
+		return "", err
+	}
+	return orderBy(driverName, terms), nil
+}
+
+// newCustomersKeyset return the keyset of the page of the customers table after cursor
+func newCustomersKeyset(driverName, order, cursor string) (*keyset, error) {
+	return newKeyset(driverName, order, cursor, customersColumns, customersCursorKinds, customersPrimaryKeys, customersNullableColumns)
+}
+
+// customersValue return the value of a column of record
+func customersValue(record *model.Customers, column string) interface{} {
+	switch column {
+	case "CustomerId":
+		return record.CustomerID
+	case "FirstName":
+		return record.FirstName
+	case "LastName":
+		return record.LastName
+	case "Company":
+		return record.Company
+	case "Address":
+		return record.Address
+	case "City":
+		return record.City
+	case "State":
+		return record.State
+	case "Country":
+		return record.Country
+	case "PostalCode":
+		return record.PostalCode
+	case "Phone":
+		return record.Phone
+	case "Fax":
+		return record.Fax
+	case "Email":
+		return record.Email
+	case "SupportRepId":
+		return record.SupportRepID
+	}
+	return nil
+}
+
+// customersPatch apply a JSON merge patch of a Customers keyed by json name to record and return the columns
+// and values set, null sets a column to NULL. Unknown fields, primary key and audit fields and null values of columns
+// that are not nullable are rejected.
+func customersPatch(patch map[string]json.RawMessage, record *model.Customers) (columns []string, values []interface{}, err error) {
+	empty := &model.Customers{}
+	for _, name := range patchNames(patch) {
+		raw := patch[name]
+		switch name {
+		case "customer_id":
+			return nil, nil, fmt.Errorf("patch: primary key %s is read only", name)
+		case "first_name":
+			if isJSONNull(raw) {
+				return nil, nil, fmt.Errorf("patch: %s is not nullable", name)
+			}
+			if err = json.Unmarshal(raw, &record.FirstName); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "FirstName"), append(values, record.FirstName)
+		case "last_name":
+			if isJSONNull(raw) {
+				return nil, nil, fmt.Errorf("patch: %s is not nullable", name)
+			}
+			if err = json.Unmarshal(raw, &record.LastName); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "LastName"), append(values, record.LastName)
+		case "company":
+			if isJSONNull(raw) {
+				record.Company = empty.Company
+				columns, values = append(columns, "Company"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.Company = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "Company"), append(values, record.Company)
+		case "address":
+			if isJSONNull(raw) {
+				record.Address = empty.Address
+				columns, values = append(columns, "Address"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.Address = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "Address"), append(values, record.Address)
+		case "city":
+			if isJSONNull(raw) {
+				record.City = empty.City
+				columns, values = append(columns, "City"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.City = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "City"), append(values, record.City)
+		case "state":
+			if isJSONNull(raw) {
+				record.State = empty.State
+				columns, values = append(columns, "State"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.State = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "State"), append(values, record.State)
+		case "country":
+			if isJSONNull(raw) {
+				record.Country = empty.Country
+				columns, values = append(columns, "Country"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.Country = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "Country"), append(values, record.Country)
+		case "postal_code":
+			if isJSONNull(raw) {
+				record.PostalCode = empty.PostalCode
+				columns, values = append(columns, "PostalCode"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.PostalCode = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "PostalCode"), append(values, record.PostalCode)
+		case "phone":
+			if isJSONNull(raw) {
+				record.Phone = empty.Phone
+				columns, values = append(columns, "Phone"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.Phone = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "Phone"), append(values, record.Phone)
+		case "fax":
+			if isJSONNull(raw) {
+				record.Fax = empty.Fax
+				columns, values = append(columns, "Fax"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.Fax = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "Fax"), append(values, record.Fax)
+		case "email":
+			if isJSONNull(raw) {
+				return nil, nil, fmt.Errorf("patch: %s is not nullable", name)
+			}
+			if err = json.Unmarshal(raw, &record.Email); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "Email"), append(values, record.Email)
+		case "support_rep_id":
+			if isJSONNull(raw) {
+				record.SupportRepID = empty.SupportRepID
+				columns, values = append(columns, "SupportRepId"), append(values, nil)
+				continue
+			}
+			var value int32
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.SupportRepID = sql.NullInt32{Int32: value, Valid: true}
+			columns, values = append(columns, "SupportRepId"), append(values, record.SupportRepID)
+		default:
+			return nil, nil, fmt.Errorf("patch: unknown field %s", name)
+		}
+	}
+	return columns, values, nil
+}
+
+// EmployeesFilter filters the records of the employees table returned by GetAll, nil column filters are ignored
+type EmployeesFilter struct {
+	EmployeeID *Filter
+	LastName   *Filter
+	FirstName  *Filter
+	Title      *Filter
+	ReportsTo  *Filter
+	BirthDate  *Filter
+	HireDate   *Filter
+	Address    *Filter
+	City       *Filter
+	State      *Filter
+	Country    *Filter
+	PostalCode *Filter
+	Phone      *Filter
+	Fax        *Filter
+	Email      *Filter
+}
+
+// Set parse values and set the condition op on a column of the filter, columns are named by their json name
+func (f *EmployeesFilter) Set(column, op string, values ...string) error {
+	switch column {
+	case "employee_id":
+		if f.EmployeeID == nil {
+			f.EmployeeID = &Filter{}
+		}
+		return f.EmployeeID.set(column, "int", op, values)
+	case "last_name":
+		if f.LastName == nil {
+			f.LastName = &Filter{}
+		}
+		return f.LastName.set(column, "string", op, values)
+	case "first_name":
+		if f.FirstName == nil {
+			f.FirstName = &Filter{}
+		}
+		return f.FirstName.set(column, "string", op, values)
+	case "title":
+		if f.Title == nil {
+			f.Title = &Filter{}
+		}
+		return f.Title.set(column, "string", op, values)
+	case "reports_to":
+		if f.ReportsTo == nil {
+			f.ReportsTo = &Filter{}
+		}
+		return f.ReportsTo.set(column, "int", op, values)
+	case "birth_date":
+		if f.BirthDate == nil {
+			f.BirthDate = &Filter{}
+		}
+		return f.BirthDate.set(column, "time", op, values)
+	case "hire_date":
+		if f.HireDate == nil {
+			f.HireDate = &Filter{}
+		}
+		return f.HireDate.set(column, "time", op, values)
+	case "address":
+		if f.Address == nil {
+			f.Address = &Filter{}
+		}
+		return f.Address.set(column, "string", op, values)
+	case "city":
+		if f.City == nil {
+			f.City = &Filter{}
+		}
+		return f.City.set(column, "string", op, values)
+	case "state":
+		if f.State == nil {
+			f.State = &Filter{}
+		}
+		return f.State.set(column, "string", op, values)
+	case "country":
+		if f.Country == nil {
+			f.Country = &Filter{}
+		}
+		return f.Country.set(column, "string", op, values)
+	case "postal_code":
+		if f.PostalCode == nil {
+			f.PostalCode = &Filter{}
+		}
+		return f.PostalCode.set(column, "string", op, values)
+	case "phone":
+		if f.Phone == nil {
+			f.Phone = &Filter{}
+		}
+		return f.Phone.set(column, "string", op, values)
+	case "fax":
+		if f.Fax == nil {
+			f.Fax = &Filter{}
+		}
+		return f.Fax.set(column, "string", op, values)
+	case "email":
+		if f.Email == nil {
+			f.Email = &Filter{}
+		}
+		return f.Email.set(column, "string", op, values)
+	}
+	return fmt.Errorf("filter: unknown column %s", column)
+}
+
+// columns return the column filters
+func (f *EmployeesFilter) columns() []filterColumn {
+	return []filterColumn{
+		{"EmployeeId", f.EmployeeID},
+		{"LastName", f.LastName},
+		{"FirstName", f.FirstName},
+		{"Title", f.Title},
+		{"ReportsTo", f.ReportsTo},
+		{"BirthDate", f.BirthDate},
+		{"HireDate", f.HireDate},
+		{"Address", f.Address},
+		{"City", f.City},
+		{"State", f.State},
+		{"Country", f.Country},
+		{"PostalCode", f.PostalCode},
+		{"Phone", f.Phone},
+		{"Fax", f.Fax},
+		{"Email", f.Email},
+	}
+}
+
+// where return the where clause and its args, an empty where clause if f is nil
+func (f *EmployeesFilter) where(driverName string) (string, []interface{}) {
+	if f == nil {
+		return "", nil
+	}
+
+	return filterWhere(driverName, f.columns())
+}
+
+// matches return true if record holds the column filters, true if f is nil. It evaluates the filter in memory, for
+// the fake repositories.
+func (f *EmployeesFilter) matches(record *model.Employees) bool {
+	if f == nil {
+		return true
+	}
+
+	return filterMatches(f.columns(), func(column string) interface{} {
+		return employeesValue(record, column)
+	})
+}
+
+var (
+	// employeesColumns the columns of the employees table keyed by json name
+	employeesColumns = map[string]string{
+		"employee_id": "EmployeeId",
+		"last_name":   "LastName",
+		"first_name":  "FirstName",
+		"title":       "Title",
+		"reports_to":  "ReportsTo",
+		"birth_date":  "BirthDate",
+		"hire_date":   "HireDate",
+		"address":     "Address",
+		"city":        "City",
+		"state":       "State",
+		"country":     "Country",
+		"postal_code": "PostalCode",
+		"phone":       "Phone",
+		"fax":         "Fax",
+		"email":       "Email",
+	}
+
+	// employeesCursorKinds the kinds of the values of the columns of the employees table in a keyset cursor
+	employeesCursorKinds = map[string]string{
+		"EmployeeId": "int",
+		"LastName":   "string",
+		"FirstName":  "string",
+		"Title":      "string",
+		"ReportsTo":  "int",
+		"BirthDate":  "time",
+		"HireDate":   "time",
+		"Address":    "string",
+		"City":       "string",
+		"State":      "string",
+		"Country":    "string",
+		"PostalCode": "string",
+		"Phone":      "string",
+		"Fax":        "string",
+		"Email":      "string",
+	}
+
+	// employeesPrimaryKeys the primary key columns of the employees table
+	employeesPrimaryKeys = []string{"EmployeeId"}
+
+	// employeesNullableColumns the nullable columns of the employees table
+	employeesNullableColumns = []string{"Title", "ReportsTo", "BirthDate", "HireDate", "Address", "City", "State", "Country", "PostalCode", "Phone", "Fax", "Email"}
+)
+
+// EmployeesOrderBy return the order by columns of the employees table for order, see orderTerms
+func EmployeesOrderBy(driverName, order string) (string, error) {
+	terms, err := orderTerms(order, employeesColumns, employeesPrimaryKeys)
+	if err != nil {
+		return "", err
+	}
+	return orderBy(driverName, terms), nil
+}
+
+// newEmployeesKeyset return the keyset of the page of the employees table after cursor
+func newEmployeesKeyset(driverName, order, cursor string) (*keyset, error) {
+	return newKeyset(driverName, order, cursor, employeesColumns, employeesCursorKinds, employeesPrimaryKeys, employeesNullableColumns)
+}
+
+// employeesValue return the value of a column of record
+func employeesValue(record *model.Employees, column string) interface{} {
+	switch column {
+	case "EmployeeId":
+		return record.EmployeeID
+	case "LastName":
+		return record.LastName
+	case "FirstName":
+		return record.FirstName
+	case "Title":
+		return record.Title
+	case "ReportsTo":
+		return record.ReportsTo
+	case "BirthDate":
+		return record.BirthDate
+	case "HireDate":
+		return record.HireDate
+	case "Address":
+		return record.Address
+	case "City":
+		return record.City
+	case "State":
+		return record.State
+	case "Country":
+		return record.Country
+	case "PostalCode":
+		return record.PostalCode
+	case "Phone":
+		return record.Phone
+	case "Fax":
+		return record.Fax
+	case "Email":
+		return record.Email
+	}
+	return nil
+}
+
+// employeesPatch apply a JSON merge patch of a Employees keyed by json name to record and return the columns
+// and values set, null sets a column to NULL. Unknown fields, primary key and audit fields and null values of columns
+// that are not nullable are rejected.
+func employeesPatch(patch map[string]json.RawMessage, record *model.Employees) (columns []string, values []interface{}, err error) {
+	empty := &model.Employees{}
+	for _, name := range patchNames(patch) {
+		raw := patch[name]
+		switch name {
+		case "employee_id":
+			return nil, nil, fmt.Errorf("patch: primary key %s is read only", name)
+		case "last_name":
+			if isJSONNull(raw) {
+				return nil, nil, fmt.Errorf("patch: %s is not nullable", name)
+			}
+			if err = json.Unmarshal(raw, &record.LastName); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "LastName"), append(values, record.LastName)
+		case "first_name":
+			if isJSONNull(raw) {
+				return nil, nil, fmt.Errorf("patch: %s is not nullable", name)
+			}
+			if err = json.Unmarshal(raw, &record.FirstName); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "FirstName"), append(values, record.FirstName)
+		case "title":
+			if isJSONNull(raw) {
+				record.Title = empty.Title
+				columns, values = append(columns, "Title"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.Title = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "Title"), append(values, record.Title)
+		case "reports_to":
+			if isJSONNull(raw) {
+				record.ReportsTo = empty.ReportsTo
+				columns, values = append(columns, "ReportsTo"), append(values, nil)
+				continue
+			}
+			var value int32
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.ReportsTo = sql.NullInt32{Int32: value, Valid: true}
+			columns, values = append(columns, "ReportsTo"), append(values, record.ReportsTo)
+		case "birth_date":
+			if isJSONNull(raw) {
+				record.BirthDate = empty.BirthDate
+				columns, values = append(columns, "BirthDate"), append(values, nil)
+				continue
+			}
+			if err = json.Unmarshal(raw, &record.BirthDate); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "BirthDate"), append(values, record.BirthDate)
+		case "hire_date":
+			if isJSONNull(raw) {
+				record.HireDate = empty.HireDate
+				columns, values = append(columns, "HireDate"), append(values, nil)
+				continue
+			}
+			if err = json.Unmarshal(raw, &record.HireDate); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "HireDate"), append(values, record.HireDate)
+		case "address":
+			if isJSONNull(raw) {
+				record.Address = empty.Address
+				columns, values = append(columns, "Address"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.Address = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "Address"), append(values, record.Address)
+		case "city":
+			if isJSONNull(raw) {
+				record.City = empty.City
+				columns, values = append(columns, "City"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.City = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "City"), append(values, record.City)
+		case "state":
+			if isJSONNull(raw) {
+				record.State = empty.State
+				columns, values = append(columns, "State"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.State = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "State"), append(values, record.State)
+		case "country":
+			if isJSONNull(raw) {
+				record.Country = empty.Country
+				columns, values = append(columns, "Country"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.Country = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "Country"), append(values, record.Country)
+		case "postal_code":
+			if isJSONNull(raw) {
+				record.PostalCode = empty.PostalCode
+				columns, values = append(columns, "PostalCode"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.PostalCode = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "PostalCode"), append(values, record.PostalCode)
+		case "phone":
+			if isJSONNull(raw) {
+				record.Phone = empty.Phone
+				columns, values = append(columns, "Phone"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.Phone = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "Phone"), append(values, record.Phone)
+		case "fax":
+			if isJSONNull(raw) {
+				record.Fax = empty.Fax
+				columns, values = append(columns, "Fax"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.Fax = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "Fax"), append(values, record.Fax)
+		case "email":
+			if isJSONNull(raw) {
+				record.Email = empty.Email
+				columns, values = append(columns, "Email"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.Email = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "Email"), append(values, record.Email)
+		default:
+			return nil, nil, fmt.Errorf("patch: unknown field %s", name)
+		}
+	}
+	return columns, values, nil
+}
+
+// GenresFilter filters the records of the genres table returned by GetAll, nil column filters are ignored
+type GenresFilter struct {
+	GenreID *Filter
+	Name    *Filter
+}
+
+// Set parse values and set the condition op on a column of the filter, columns are named by their json name
+func (f *GenresFilter) Set(column, op string, values ...string) error {
+	switch column {
+	case "genre_id":
+		if f.GenreID == nil {
+			f.GenreID = &Filter{}
+		}
+		return f.GenreID.set(column, "int", op, values)
+	case "name":
+		if f.Name == nil {
+			f.Name = &Filter{}
+		}
+		return f.Name.set(column, "string", op, values)
+	}
+	return fmt.Errorf("filter: unknown column %s", column)
+}
+
+// columns return the column filters
+func (f *GenresFilter) columns() []filterColumn {
+	return []filterColumn{
+		{"GenreId", f.GenreID},
+		{"Name", f.Name},
+	}
+}
+
+// where return the where clause and its args, an empty where clause if f is nil
+func (f *GenresFilter) where(driverName string) (string, []interface{}) {
+	if f == nil {
+		return "", nil
+	}
+
+	return filterWhere(driverName, f.columns())
+}
+
+// matches return true if record holds the column filters, true if f is nil. It evaluates the filter in memory, for
+// the fake repositories.
+func (f *GenresFilter) matches(record *model.Genres) bool {
+	if f == nil {
+		return true
+	}
+
+	return filterMatches(f.columns(), func(column string) interface{} {
+		return genresValue(record, column)
+	})
+}
+
+var (
+	// genresColumns the columns of the genres table keyed by json name
+	genresColumns = map[string]string{
+		"genre_id": "GenreId",
+		"name":     "Name",
+	}
+
+	// genresCursorKinds the kinds of the values of the columns of the genres table in a keyset cursor
+	genresCursorKinds = map[string]string{
+		"GenreId": "int",
+		"Name":    "string",
+	}
+
+	// genresPrimaryKeys the primary key columns of the genres table
+	genresPrimaryKeys = []string{"GenreId"}
+
+	// genresNullableColumns the nullable columns of the genres table
+	genresNullableColumns = []string{"Name"}
+)
+
+// GenresOrderBy return the order by columns of the genres table for order, see orderTerms
+func GenresOrderBy(driverName, order string) (string, error) {
+	terms, err := orderTerms(order, genresColumns, genresPrimaryKeys)
+	if err != nil {
+		return "", err
+	}
+	return orderBy(driverName, terms), nil
+}
+
+// newGenresKeyset return the keyset of the page of the genres table after cursor
+func newGenresKeyset(driverName, order, cursor string) (*keyset, error) {
+	return newKeyset(driverName, order, cursor, genresColumns, genresCursorKinds, genresPrimaryKeys, genresNullableColumns)
+}
+
+// genresValue return the value of a column of record
+func genresValue(record *model.Genres, column string) interface{} {
+	switch column {
+	case "GenreId":
+		return record.GenreID
+	case "Name":
+		return record.Name
+	}
+	return nil
+}
+
+// genresPatch apply a JSON merge patch of a Genres keyed by json name to record and return the columns
+// and values set, null sets a column to NULL. Unknown fields, primary key and audit fields and null values of columns
+// that are not nullable are rejected.
+func genresPatch(patch map[string]json.RawMessage, record *model.Genres) (columns []string, values []interface{}, err error) {
+	empty := &model.Genres{}
+	for _, name := range patchNames(patch) {
+		raw := patch[name]
+		switch name {
+		case "genre_id":
+			return nil, nil, fmt.Errorf("patch: primary key %s is read only", name)
+		case "name":
+			if isJSONNull(raw) {
+				record.Name = empty.Name
+				columns, values = append(columns, "Name"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.Name = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "Name"), append(values, record.Name)
+		default:
+			return nil, nil, fmt.Errorf("patch: unknown field %s", name)
+		}
+	}
+	return columns, values, nil
+}
+
+// InvoiceItemsFilter filters the records of the invoice_items table returned by GetAll, nil column filters are ignored
+type InvoiceItemsFilter struct {
+	InvoiceLineID *Filter
+	InvoiceID     *Filter
+	TrackID       *Filter
+	UnitPrice     *Filter
+	Quantity      *Filter
+}
+
+// Set parse values and set the condition op on a column of the filter, columns are named by their json name
+func (f *InvoiceItemsFilter) Set(column, op string, values ...string) error {
+	switch column {
+	case "invoice_line_id":
+		if f.InvoiceLineID == nil {
+			f.InvoiceLineID = &Filter{}
+		}
+		return f.InvoiceLineID.set(column, "int", op, values)
+	case "invoice_id":
+		if f.InvoiceID == nil {
+			f.InvoiceID = &Filter{}
+		}
+		return f.InvoiceID.set(column, "int", op, values)
+	case "track_id":
+		if f.TrackID == nil {
+			f.TrackID = &Filter{}
+		}
+		return f.TrackID.set(column, "int", op, values)
+	case "unit_price":
+		if f.UnitPrice == nil {
+			f.UnitPrice = &Filter{}
+		}
+		return f.UnitPrice.set(column, "float", op, values)
+	case "quantity":
+		if f.Quantity == nil {
+			f.Quantity = &Filter{}
+		}
+		return f.Quantity.set(column, "int", op, values)
+	}
+	return fmt.Errorf("filter: unknown column %s", column)
+}
+
+// columns return the column filters
+func (f *InvoiceItemsFilter) columns() []filterColumn {
+	return []filterColumn{
+		{"InvoiceLineId", f.InvoiceLineID},
+		{"InvoiceId", f.InvoiceID},
+		{"TrackId", f.TrackID},
+		{"UnitPrice", f.UnitPrice},
+		{"Quantity", f.Quantity},
+	}
+}
+
+// where return the where clause and its args, an empty where clause if f is nil
+func (f *InvoiceItemsFilter) where(driverName string) (string, []interface{}) {
+	if f == nil {
+		return "", nil
+	}
+
+	return filterWhere(driverName, f.columns())
+}
+
+// matches return true if record holds the column filters, true if f is nil. It evaluates the filter in memory, for
+// the fake repositories.
+func (f *InvoiceItemsFilter) matches(record *model.InvoiceItems) bool {
+	if f == nil {
+		return true
+	}
+
+	return filterMatches(f.columns(), func(column string) interface{} {
+		return invoiceItemsValue(record, column)
+	})
+}
+
+var (
+	// invoiceItemsColumns the columns of the invoice_items table keyed by json name
+	invoiceItemsColumns = map[string]string{
+		"invoice_line_id": "InvoiceLineId",
+		"invoice_id":      "InvoiceId",
+		"track_id":        "TrackId",
+		"unit_price":      "UnitPrice",
+		"quantity":        "Quantity",
+	}
+
+	// invoiceItemsCursorKinds the kinds of the values of the columns of the invoice_items table in a keyset cursor
+	invoiceItemsCursorKinds = map[string]string{
+		"InvoiceLineId": "int",
+		"InvoiceId":     "int",
+		"TrackId":       "int",
+		"UnitPrice":     "float",
+		"Quantity":      "int",
+	}
+
+	// invoiceItemsPrimaryKeys the primary key columns of the invoice_items table
+	invoiceItemsPrimaryKeys = []string{"InvoiceLineId"}
+
+	// invoiceItemsNullableColumns the nullable columns of the invoice_items table
+	invoiceItemsNullableColumns = []string{}
+)
+
+// InvoiceItemsOrderBy return the order by columns of the invoice_items table for order, see orderTerms
+func InvoiceItemsOrderBy(driverName, order string) (string, error) {
+	terms, err := orderTerms(order, invoiceItemsColumns, invoiceItemsPrimaryKeys)
+	if err != nil {
+		return "", err
+	}
+	return orderBy(driverName, terms), nil
+}
+
+// newInvoiceItemsKeyset return the keyset of the page of the invoice_items table after cursor
+func newInvoiceItemsKeyset(driverName, order, cursor string) (*keyset, error) {
+	return newKeyset(driverName, order, cursor, invoiceItemsColumns, invoiceItemsCursorKinds, invoiceItemsPrimaryKeys, invoiceItemsNullableColumns)
+}
+
+// invoiceItemsValue return the value of a column of record
+func invoiceItemsValue(record *model.InvoiceItems, column string) interface{} {
+	switch column {
+	case "InvoiceLineId":
+		return record.InvoiceLineID
+	case "InvoiceId":
+		return record.InvoiceID
+	case "TrackId":
+		return record.TrackID
+	case "UnitPrice":
+		return record.UnitPrice
+	case "Quantity":
+		return record.Quantity
+	}
+	return nil
+}
+
+// invoiceItemsPatch apply a JSON merge patch of a InvoiceItems keyed by json name to record and return the columns
+// and values set, null sets a column to NULL. Unknown fields, primary key and audit fields and null values of columns
+// that are not nullable are rejected.
+func invoiceItemsPatch(patch map[string]json.RawMessage, record *model.InvoiceItems) (columns []string, values []interface{}, err error) {
+	for _, name := range patchNames(patch) {
+		raw := patch[name]
+		switch name {
+		case "invoice_line_id":
+			return nil, nil, fmt.Errorf("patch: primary key %s is read only", name)
+		case "invoice_id":
+			if isJSONNull(raw) {
+				return nil, nil, fmt.Errorf("patch: %s is not nullable", name)
+			}
+			if err = json.Unmarshal(raw, &record.InvoiceID); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "InvoiceId"), append(values, record.InvoiceID)
+		case "track_id":
+			if isJSONNull(raw) {
+				return nil, nil, fmt.Errorf("patch: %s is not nullable", name)
+			}
+			if err = json.Unmarshal(raw, &record.TrackID); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "TrackId"), append(values, record.TrackID)
+		case "unit_price":
+			if isJSONNull(raw) {
+				return nil, nil, fmt.Errorf("patch: %s is not nullable", name)
+			}
+			if err = json.Unmarshal(raw, &record.UnitPrice); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "UnitPrice"), append(values, record.UnitPrice)
+		case "quantity":
+			if isJSONNull(raw) {
+				return nil, nil, fmt.Errorf("patch: %s is not nullable", name)
+			}
+			if err = json.Unmarshal(raw, &record.Quantity); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "Quantity"), append(values, record.Quantity)
+		default:
+			return nil, nil, fmt.Errorf("patch: unknown field %s", name)
+		}
+	}
+	return columns, values, nil
+}
+
+// InvoicesFilter filters the records of the invoices table returned by GetAll, nil column filters are ignored
+type InvoicesFilter struct {
+	InvoiceID         *Filter
+	CustomerID        *Filter
+	InvoiceDate       *Filter
+	BillingAddress    *Filter
+	BillingCity       *Filter
+	BillingState      *Filter
+	BillingCountry    *Filter
+	BillingPostalCode *Filter
+	Total             *Filter
+}
+
+// Set parse values and set the condition op on a column of the filter, columns are named by their json name
+func (f *InvoicesFilter) Set(column, op string, values ...string) error {
+	switch column {
+	case "invoice_id":
+		if f.InvoiceID == nil {
+			f.InvoiceID = &Filter{}
+		}
+		return f.InvoiceID.set(column, "int", op, values)
+	case "customer_id":
+		if f.CustomerID == nil {
+			f.CustomerID = &Filter{}
+		}
+		return f.CustomerID.set(column, "int", op, values)
+	case "invoice_date":
+		if f.InvoiceDate == nil {
+			f.InvoiceDate = &Filter{}
+		}
+		return f.InvoiceDate.set(column, "time", op, values)
+	case "billing_address":
+		if f.BillingAddress == nil {
+			f.BillingAddress = &Filter{}
+		}
+		return f.BillingAddress.set(column, "string", op, values)
+	case "billing_city":
+		if f.BillingCity == nil {
+			f.BillingCity = &Filter{}
+		}
+		return f.BillingCity.set(column, "string", op, values)
+	case "billing_state":
+		if f.BillingState == nil {
+			f.BillingState = &Filter{}
+		}
+		return f.BillingState.set(column, "string", op, values)
+	case "billing_country":
+		if f.BillingCountry == nil {
+			f.BillingCountry = &Filter{}
+		}
+		return f.BillingCountry.set(column, "string", op, values)
+	case "billing_postal_code":
+		if f.BillingPostalCode == nil {
+			f.BillingPostalCode = &Filter{}
+		}
+		return f.BillingPostalCode.set(column, "string", op, values)
+	case "total":
+		if f.Total == nil {
+			f.Total = &Filter{}
+		}
+		return f.Total.set(column, "float", op, values)
+	}
+	return fmt.Errorf("filter: unknown column %s", column)
+}
+
+// columns return the column filters
+func (f *InvoicesFilter) columns() []filterColumn {
+	return []filterColumn{
+		{"InvoiceId", f.InvoiceID},
+		{"CustomerId", f.CustomerID},
+		{"InvoiceDate", f.InvoiceDate},
+		{"BillingAddress", f.BillingAddress},
+		{"BillingCity", f.BillingCity},
+		{"BillingState", f.BillingState},
+		{"BillingCountry", f.BillingCountry},
+		{"BillingPostalCode", f.BillingPostalCode},
+		{"Total", f.Total},
+	}
+}
+
+// where return the where clause and its args, an empty where clause if f is nil
+func (f *InvoicesFilter) where(driverName string) (string, []interface{}) {
+	if f == nil {
+		return "", nil
+	}
+
+	return filterWhere(driverName, f.columns())
+}
+
+// matches return true if record holds the column filters, true if f is nil. It evaluates the filter in memory, for
+// the fake repositories.
+func (f *InvoicesFilter) matches(record *model.Invoices) bool {
+	if f == nil {
+		return true
+	}
+
+	return filterMatches(f.columns(), func(column string) interface{} {
+		return invoicesValue(record, column)
+	})
+}
+
+var (
+	// invoicesColumns the columns of the invoices table keyed by json name
+	invoicesColumns = map[string]string{
+		"invoice_id":          "InvoiceId",
+		"customer_id":         "CustomerId",
+		"invoice_date":        "InvoiceDate",
+		"billing_address":     "BillingAddress",
+		"billing_city":        "BillingCity",
+		"billing_state":       "BillingState",
+		"billing_country":     "BillingCountry",
+		"billing_postal_code": "BillingPostalCode",
+		"total":               "Total",
+	}
+
+	// invoicesCursorKinds the kinds of the values of the columns of the invoices table in a keyset cursor
+	invoicesCursorKinds = map[string]string{
+		"InvoiceId":         "int",
+		"CustomerId":        "int",
+		"InvoiceDate":       "time",
+		"BillingAddress":    "string",
+		"BillingCity":       "string",
+		"BillingState":      "string",
+		"BillingCountry":    "string",
+		"BillingPostalCode": "string",
+		"Total":             "float",
+	}
+
+	// invoicesPrimaryKeys the primary key columns of the invoices table
+	invoicesPrimaryKeys = []string{"InvoiceId"}
+
+	// invoicesNullableColumns the nullable columns of the invoices table
+	invoicesNullableColumns = []string{"BillingAddress", "BillingCity", "BillingState", "BillingCountry", "BillingPostalCode"}
+
+	// invoicesCreatedColumns the audit columns of the invoices table set on insert only
+	invoicesCreatedColumns = []string{"InvoiceDate"}
+)
+
+// InvoicesOrderBy return the order by columns of the invoices table for order, see orderTerms
+func InvoicesOrderBy(driverName, order string) (string, error) {
+	terms, err := orderTerms(order, invoicesColumns, invoicesPrimaryKeys)
+	if err != nil {
+		return "", err
+	}
+	return orderBy(driverName, terms), nil
+}
+
+// newInvoicesKeyset return the keyset of the page of the invoices table after cursor
+func newInvoicesKeyset(driverName, order, cursor string) (*keyset, error) {
+	return newKeyset(driverName, order, cursor, invoicesColumns, invoicesCursorKinds, invoicesPrimaryKeys, invoicesNullableColumns)
+}
+
+// invoicesValue return the value of a column of record
+func invoicesValue(record *model.Invoices, column string) interface{} {
+	switch column {
+	case "InvoiceId":
+		return record.InvoiceID
+	case "CustomerId":
+		return record.CustomerID
+	case "InvoiceDate":
+		return record.InvoiceDate
+	case "BillingAddress":
+		return record.BillingAddress
+	case "BillingCity":
+		return record.BillingCity
+	case "BillingState":
+		return record.BillingState
+	case "BillingCountry":
+		return record.BillingCountry
+	case "BillingPostalCode":
+		return record.BillingPostalCode
+	case "Total":
+		return record.Total
+	}
+	return nil
+}
+
+// invoicesAudit set the audit columns of record to the current time of the dialect of driverName and to the Actor of
+// ctx, the created columns only if created is true, and return the columns set and their values
+func invoicesAudit(ctx context.Context, driverName string, record *model.Invoices, created bool) (columns []string, values []interface{}) {
+	now := DialectFor(driverName).Now()
+	actor, ok := actorOf(ctx)
+	if created {
+		record.InvoiceDate = now
+		columns, values = append(columns, "InvoiceDate"), append(values, record.InvoiceDate)
+	}
+	record.BillingCity = sql.NullString{}
+	if ok {
+		record.BillingCity = sql.NullString{String: actor, Valid: true}
+	}
+	columns, values = append(columns, "BillingCity"), append(values, record.BillingCity)
+	return columns, values
+}
+
+// invoicesPatch apply a JSON merge patch of a Invoices keyed by json name to record and return the columns
+// and values set, null sets a column to NULL. Unknown fields, primary key and audit fields and null values of columns
+// that are not nullable are rejected.
+func invoicesPatch(patch map[string]json.RawMessage, record *model.Invoices) (columns []string, values []interface{}, err error) {
+	empty := &model.Invoices{}
+	for _, name := range patchNames(patch) {
+		raw := patch[name]
+		switch name {
+		case "invoice_id":
+			return nil, nil, fmt.Errorf("patch: primary key %s is read only", name)
+		case "customer_id":
+			if isJSONNull(raw) {
+				return nil, nil, fmt.Errorf("patch: %s is not nullable", name)
+			}
+			if err = json.Unmarshal(raw, &record.CustomerID); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "CustomerId"), append(values, record.CustomerID)
+		case "invoice_date":
+			return nil, nil, fmt.Errorf("patch: audit field %s is read only", name)
+		case "billing_address":
+			if isJSONNull(raw) {
+				record.BillingAddress = empty.BillingAddress
+				columns, values = append(columns, "BillingAddress"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.BillingAddress = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "BillingAddress"), append(values, record.BillingAddress)
+		case "billing_city":
+			return nil, nil, fmt.Errorf("patch: audit field %s is read only", name)
+		case "billing_state":
+			if isJSONNull(raw) {
+				record.BillingState = empty.BillingState
+				columns, values = append(columns, "BillingState"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.BillingState = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "BillingState"), append(values, record.BillingState)
+		case "billing_country":
+			if isJSONNull(raw) {
+				record.BillingCountry = empty.BillingCountry
+				columns, values = append(columns, "BillingCountry"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.BillingCountry = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "BillingCountry"), append(values, record.BillingCountry)
+		case "billing_postal_code":
+			if isJSONNull(raw) {
+				record.BillingPostalCode = empty.BillingPostalCode
+				columns, values = append(columns, "BillingPostalCode"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.BillingPostalCode = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "BillingPostalCode"), append(values, record.BillingPostalCode)
+		case "total":
+			if isJSONNull(raw) {
+				return nil, nil, fmt.Errorf("patch: %s is not nullable", name)
+			}
+			if err = json.Unmarshal(raw, &record.Total); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "Total"), append(values, record.Total)
+		default:
+			return nil, nil, fmt.Errorf("patch: unknown field %s", name)
+		}
+	}
+	return columns, values, nil
+}
+
+// MediaTypesFilter filters the records of the media_types table returned by GetAll, nil column filters are ignored
+type MediaTypesFilter struct {
+	MediaTypeID *Filter
+	Name        *Filter
+}
+
+// Set parse values and set the condition op on a column of the filter, columns are named by their json name
+func (f *MediaTypesFilter) Set(column, op string, values ...string) error {
+	switch column {
+	case "media_type_id":
+		if f.MediaTypeID == nil {
+			f.MediaTypeID = &Filter{}
+		}
+		return f.MediaTypeID.set(column, "int", op, values)
+	case "name":
+		if f.Name == nil {
+			f.Name = &Filter{}
+		}
+		return f.Name.set(column, "string", op, values)
+	}
+	return fmt.Errorf("filter: unknown column %s", column)
+}
+
+// columns return the column filters
+func (f *MediaTypesFilter) columns() []filterColumn {
+	return []filterColumn{
+		{"MediaTypeId", f.MediaTypeID},
+		{"Name", f.Name},
+	}
+}
+
+// where return the where clause and its args, an empty where clause if f is nil
+func (f *MediaTypesFilter) where(driverName string) (string, []interface{}) {
+	if f == nil {
+		return "", nil
+	}
+
+	return filterWhere(driverName, f.columns())
+}
+
+// matches return true if record holds the column filters, true if f is nil. It evaluates the filter in memory, for
+// the fake repositories.
+func (f *MediaTypesFilter) matches(record *model.MediaTypes) bool {
+	if f == nil {
+		return true
+	}
+
+	return filterMatches(f.columns(), func(column string) interface{} {
+		return mediaTypesValue(record, column)
+	})
+}
+
+var (
+	// mediaTypesColumns the columns of the media_types table keyed by json name
+	mediaTypesColumns = map[string]string{
+		"media_type_id": "MediaTypeId",
+		"name":          "Name",
+	}
+
+	// mediaTypesCursorKinds the kinds of the values of the columns of the media_types table in a keyset cursor
+	mediaTypesCursorKinds = map[string]string{
+		"MediaTypeId": "int",
+		"Name":        "string",
+	}
+
+	// mediaTypesPrimaryKeys the primary key columns of the media_types table
+	mediaTypesPrimaryKeys = []string{"MediaTypeId"}
+
+	// mediaTypesNullableColumns the nullable columns of the media_types table
+	mediaTypesNullableColumns = []string{"Name"}
+)
+
+// MediaTypesOrderBy return the order by columns of the media_types table for order, see orderTerms
+func MediaTypesOrderBy(driverName, order string) (string, error) {
+	terms, err := orderTerms(order, mediaTypesColumns, mediaTypesPrimaryKeys)
+	if err != nil {
+		return "", err
+	}
+	return orderBy(driverName, terms), nil
+}
+
+// newMediaTypesKeyset return the keyset of the page of the media_types table after cursor
+func newMediaTypesKeyset(driverName, order, cursor string) (*keyset, error) {
+	return newKeyset(driverName, order, cursor, mediaTypesColumns, mediaTypesCursorKinds, mediaTypesPrimaryKeys, mediaTypesNullableColumns)
+}
+
+// mediaTypesValue return the value of a column of record
+func mediaTypesValue(record *model.MediaTypes, column string) interface{} {
+	switch column {
+	case "MediaTypeId":
+		return record.MediaTypeID
+	case "Name":
+		return record.Name
+	}
+	return nil
+}
+
+// mediaTypesPatch apply a JSON merge patch of a MediaTypes keyed by json name to record and return the columns
+// and values set, null sets a column to NULL. Unknown fields, primary key and audit fields and null values of columns
+// that are not nullable are rejected.
+func mediaTypesPatch(patch map[string]json.RawMessage, record *model.MediaTypes) (columns []string, values []interface{}, err error) {
+	empty := &model.MediaTypes{}
+	for _, name := range patchNames(patch) {
+		raw := patch[name]
+		switch name {
+		case "media_type_id":
+			return nil, nil, fmt.Errorf("patch: primary key %s is read only", name)
+		case "name":
+			if isJSONNull(raw) {
+				record.Name = empty.Name
+				columns, values = append(columns, "Name"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.Name = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "Name"), append(values, record.Name)
+		default:
+			return nil, nil, fmt.Errorf("patch: unknown field %s", name)
+		}
+	}
+	return columns, values, nil
+}
+
+// PlaylistTrackFilter filters the records of the playlist_track table returned by GetAll, nil column filters are ignored
+type PlaylistTrackFilter struct {
+	PlaylistID *Filter
+	TrackID    *Filter
+}
+
+// Set parse values and set the condition op on a column of the filter, columns are named by their json name
+func (f *PlaylistTrackFilter) Set(column, op string, values ...string) error {
+	switch column {
+	case "playlist_id":
+		if f.PlaylistID == nil {
+			f.PlaylistID = &Filter{}
+		}
+		return f.PlaylistID.set(column, "int", op, values)
+	case "track_id":
+		if f.TrackID == nil {
+			f.TrackID = &Filter{}
+		}
+		return f.TrackID.set(column, "int", op, values)
+	}
+	return fmt.Errorf("filter: unknown column %s", column)
+}
+
+// columns return the column filters
+func (f *PlaylistTrackFilter) columns() []filterColumn {
+	return []filterColumn{
+		{"PlaylistId", f.PlaylistID},
+		{"TrackId", f.TrackID},
+	}
+}
+
+// where return the where clause and its args, an empty where clause if f is nil
+func (f *PlaylistTrackFilter) where(driverName string) (string, []interface{}) {
+	if f == nil {
+		return "", nil
+	}
+
+	return filterWhere(driverName, f.columns())
+}
+
+// matches return true if record holds the column filters, true if f is nil. It evaluates the filter in memory, for
+// the fake repositories.
+func (f *PlaylistTrackFilter) matches(record *model.PlaylistTrack) bool {
+	if f == nil {
+		return true
+	}
+
+	return filterMatches(f.columns(), func(column string) interface{} {
+		return playlistTrackValue(record, column)
+	})
+}
+
+var (
+	// playlistTrackColumns the columns of the playlist_track table keyed by json name
+	playlistTrackColumns = map[string]string{
+		"playlist_id": "PlaylistId",
+		"track_id":    "TrackId",
+	}
+
+	// playlistTrackCursorKinds the kinds of the values of the columns of the playlist_track table in a keyset cursor
+	playlistTrackCursorKinds = map[string]string{
+		"PlaylistId": "int",
+		"TrackId":    "int",
+	}
+
+	// playlistTrackPrimaryKeys the primary key columns of the playlist_track table
+	playlistTrackPrimaryKeys = []string{"PlaylistId"}
+
+	// playlistTrackNullableColumns the nullable columns of the playlist_track table
+	playlistTrackNullableColumns = []string{}
+)
+
+// PlaylistTrackOrderBy return the order by columns of the playlist_track table for order, see orderTerms
+func PlaylistTrackOrderBy(driverName, order string) (string, error) {
+	terms, err := orderTerms(order, playlistTrackColumns, playlistTrackPrimaryKeys)
+	if err != nil {
+		return "", err
+	}
+	return orderBy(driverName, terms), nil
+}
+
+// newPlaylistTrackKeyset return the keyset of the page of the playlist_track table after cursor
+func newPlaylistTrackKeyset(driverName, order, cursor string) (*keyset, error) {
+	return newKeyset(driverName, order, cursor, playlistTrackColumns, playlistTrackCursorKinds, playlistTrackPrimaryKeys, playlistTrackNullableColumns)
+}
+
+// playlistTrackValue return the value of a column of record
+func playlistTrackValue(record *model.PlaylistTrack, column string) interface{} {
+	switch column {
+	case "PlaylistId":
+		return record.PlaylistID
+	case "TrackId":
+		return record.TrackID
+	}
+	return nil
+}
+
+// playlistTrackPatch apply a JSON merge patch of a PlaylistTrack keyed by json name to record and return the columns
+// and values set, null sets a column to NULL. Unknown fields, primary key and audit fields and null values of columns
+// that are not nullable are rejected.
+func playlistTrackPatch(patch map[string]json.RawMessage, record *model.PlaylistTrack) (columns []string, values []interface{}, err error) {
+	for _, name := range patchNames(patch) {
+		raw := patch[name]
+		switch name {
+		case "playlist_id":
+			return nil, nil, fmt.Errorf("patch: primary key %s is read only", name)
+		case "track_id":
+			if isJSONNull(raw) {
+				return nil, nil, fmt.Errorf("patch: %s is not nullable", name)
+			}
+			if err = json.Unmarshal(raw, &record.TrackID); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "TrackId"), append(values, record.TrackID)
+		default:
+			return nil, nil, fmt.Errorf("patch: unknown field %s", name)
+		}
+	}
+	return columns, values, nil
+}
+
+// PlaylistsFilter filters the records of the playlists table returned by GetAll, nil column filters are ignored
+type PlaylistsFilter struct {
+	PlaylistID *Filter
+	Name       *Filter
+}
+
+// Set parse values and set the condition op on a column of the filter, columns are named by their json name
+func (f *PlaylistsFilter) Set(column, op string, values ...string) error {
+	switch column {
+	case "playlist_id":
+		if f.PlaylistID == nil {
+			f.PlaylistID = &Filter{}
+		}
+		return f.PlaylistID.set(column, "int", op, values)
+	case "name":
+		if f.Name == nil {
+			f.Name = &Filter{}
+		}
+		return f.Name.set(column, "string", op, values)
+	}
+	return fmt.Errorf("filter: unknown column %s", column)
+}
+
+// columns return the column filters
+func (f *PlaylistsFilter) columns() []filterColumn {
+	return []filterColumn{
+		{"PlaylistId", f.PlaylistID},
+		{"Name", f.Name},
+	}
+}
+
+// where return the where clause and its args, an empty where clause if f is nil
+func (f *PlaylistsFilter) where(driverName string) (string, []interface{}) {
+	if f == nil {
+		return "", nil
+	}
+
+	return filterWhere(driverName, f.columns())
+}
+
+// matches return true if record holds the column filters, true if f is nil. It evaluates the filter in memory, for
+// the fake repositories.
+func (f *PlaylistsFilter) matches(record *model.Playlists) bool {
+	if f == nil {
+		return true
+	}
+
+	return filterMatches(f.columns(), func(column string) interface{} {
+		return playlistsValue(record, column)
+	})
+}
+
+var (
+	// playlistsColumns the columns of the playlists table keyed by json name
+	playlistsColumns = map[string]string{
+		"playlist_id": "PlaylistId",
+		"name":        "Name",
+	}
+
+	// playlistsCursorKinds the kinds of the values of the columns of the playlists table in a keyset cursor
+	playlistsCursorKinds = map[string]string{
+		"PlaylistId": "int",
+		"Name":       "string",
+	}
+
+	// playlistsPrimaryKeys the primary key columns of the playlists table
+	playlistsPrimaryKeys = []string{"PlaylistId"}
+
+	// playlistsNullableColumns the nullable columns of the playlists table
+	playlistsNullableColumns = []string{"Name"}
+)
+
+// PlaylistsOrderBy return the order by columns of the playlists table for order, see orderTerms
+func PlaylistsOrderBy(driverName, order string) (string, error) {
+	terms, err := orderTerms(order, playlistsColumns, playlistsPrimaryKeys)
+	if err != nil {
+		return "", err
+	}
+	return orderBy(driverName, terms), nil
+}
+
+// newPlaylistsKeyset return the keyset of the page of the playlists table after cursor
+func newPlaylistsKeyset(driverName, order, cursor string) (*keyset, error) {
+	return newKeyset(driverName, order, cursor, playlistsColumns, playlistsCursorKinds, playlistsPrimaryKeys, playlistsNullableColumns)
+}
+
+// playlistsValue return the value of a column of record
+func playlistsValue(record *model.Playlists, column string) interface{} {
+	switch column {
+	case "PlaylistId":
+		return record.PlaylistID
+	case "Name":
+		return record.Name
+	}
+	return nil
+}
+
+// playlistsPatch apply a JSON merge patch of a Playlists keyed by json name to record and return the columns
+// and values set, null sets a column to NULL. Unknown fields, primary key and audit fields and null values of columns
+// that are not nullable are rejected.
+func playlistsPatch(patch map[string]json.RawMessage, record *model.Playlists) (columns []string, values []interface{}, err error) {
+	empty := &model.Playlists{}
+	for _, name := range patchNames(patch) {
+		raw := patch[name]
+		switch name {
+		case "playlist_id":
+			return nil, nil, fmt.Errorf("patch: primary key %s is read only", name)
+		case "name":
+			if isJSONNull(raw) {
+				record.Name = empty.Name
+				columns, values = append(columns, "Name"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.Name = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "Name"), append(values, record.Name)
+		default:
+			return nil, nil, fmt.Errorf("patch: unknown field %s", name)
+		}
+	}
+	return columns, values, nil
+}
+
+// PurchaseOrderFilter filters the records of the purchase_order table returned by GetAll, nil column filters are ignored
+type PurchaseOrderFilter struct {
+	ID        *Filter
+	PaymentID *Filter
+	FullName  *Filter
+}
+
+// Set parse values and set the condition op on a column of the filter, columns are named by their json name
+func (f *PurchaseOrderFilter) Set(column, op string, values ...string) error {
+	switch column {
+	case "id":
+		if f.ID == nil {
+			f.ID = &Filter{}
+		}
+		return f.ID.set(column, "int", op, values)
+	case "payment_id":
+		if f.PaymentID == nil {
+			f.PaymentID = &Filter{}
+		}
+		return f.PaymentID.set(column, "int", op, values)
+	case "full_name":
+		if f.FullName == nil {
+			f.FullName = &Filter{}
+		}
+		return f.FullName.set(column, "string", op, values)
+	}
+	return fmt.Errorf("filter: unknown column %s", column)
+}
+
+// columns return the column filters
+func (f *PurchaseOrderFilter) columns() []filterColumn {
+	return []filterColumn{
+		{"id", f.ID},
+		{"payment_id", f.PaymentID},
+		{"full_name", f.FullName},
+	}
+}
+
+// where return the where clause and its args, an empty where clause if f is nil
+func (f *PurchaseOrderFilter) where(driverName string) (string, []interface{}) {
+	if f == nil {
+		return "", nil
+	}
+
+	return filterWhere(driverName, f.columns())
+}
+
+// matches return true if record holds the column filters, true if f is nil. It evaluates the filter in memory, for
+// the fake repositories.
+func (f *PurchaseOrderFilter) matches(record *model.PurchaseOrder) bool {
+	if f == nil {
+		return true
+	}
+
+	return filterMatches(f.columns(), func(column string) interface{} {
+		return purchaseOrderValue(record, column)
+	})
+}
+
+var (
+	// purchaseOrderColumns the columns of the purchase_order table keyed by json name
+	purchaseOrderColumns = map[string]string{
+		"id":         "id",
+		"payment_id": "payment_id",
+		"full_name":  "full_name",
+	}
+
+	// purchaseOrderCursorKinds the kinds of the values of the columns of the purchase_order table in a keyset cursor
+	purchaseOrderCursorKinds = map[string]string{
+		"id":         "int",
+		"payment_id": "int",
+		"full_name":  "string",
+	}
+
+	// purchaseOrderPrimaryKeys the primary key columns of the purchase_order table
+	purchaseOrderPrimaryKeys = []string{"id"}
+
+	// purchaseOrderNullableColumns the nullable columns of the purchase_order table
+	purchaseOrderNullableColumns = []string{}
+)
+
+// PurchaseOrderOrderBy return the order by columns of the purchase_order table for order, see orderTerms
+func PurchaseOrderOrderBy(driverName, order string) (string, error) {
+	terms, err := orderTerms(order, purchaseOrderColumns, purchaseOrderPrimaryKeys)
+	if err != nil {
+		return "", err
+	}
+	return orderBy(driverName, terms), nil
+}
+
+// newPurchaseOrderKeyset return the keyset of the page of the purchase_order table after cursor
+func newPurchaseOrderKeyset(driverName, order, cursor string) (*keyset, error) {
+	return newKeyset(driverName, order, cursor, purchaseOrderColumns, purchaseOrderCursorKinds, purchaseOrderPrimaryKeys, purchaseOrderNullableColumns)
+}
+
+// purchaseOrderValue return the value of a column of record
+func purchaseOrderValue(record *model.PurchaseOrder, column string) interface{} {
+	switch column {
+	case "id":
+		return record.ID
+	case "payment_id":
+		return record.PaymentID
+	case "full_name":
+		return record.FullName
+	}
+	return nil
+}
+
+// purchaseOrderPatch apply a JSON merge patch of a PurchaseOrder keyed by json name to record and return the columns
+// and values set, null sets a column to NULL. Unknown fields, primary key and audit fields and null values of columns
+// that are not nullable are rejected.
+func purchaseOrderPatch(patch map[string]json.RawMessage, record *model.PurchaseOrder) (columns []string, values []interface{}, err error) {
+	for _, name := range patchNames(patch) {
+		raw := patch[name]
+		switch name {
+		case "id":
+			return nil, nil, fmt.Errorf("patch: primary key %s is read only", name)
+		case "payment_id":
+			if isJSONNull(raw) {
+				return nil, nil, fmt.Errorf("patch: %s is not nullable", name)
+			}
+			if err = json.Unmarshal(raw, &record.PaymentID); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "payment_id"), append(values, record.PaymentID)
+		case "full_name":
+			if isJSONNull(raw) {
+				return nil, nil, fmt.Errorf("patch: %s is not nullable", name)
+			}
+			if err = json.Unmarshal(raw, &record.FullName); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "full_name"), append(values, record.FullName)
+		default:
+			return nil, nil, fmt.Errorf("patch: unknown field %s", name)
+		}
+	}
+	return columns, values, nil
+}
+
+// TracksFilter filters the records of the tracks table returned by GetAll, nil column filters are ignored
+type TracksFilter struct {
+	TrackID      *Filter
+	Name         *Filter
+	AlbumID      *Filter
+	MediaTypeID  *Filter
+	GenreID      *Filter
+	Composer     *Filter
+	Milliseconds *Filter
+	Bytes        *Filter
+	UnitPrice    *Filter
+}
+
+// Set parse values and set the condition op on a column of the filter, columns are named by their json name
+func (f *TracksFilter) Set(column, op string, values ...string) error {
+	switch column {
+	case "track_id":
+		if f.TrackID == nil {
+			f.TrackID = &Filter{}
+		}
+		return f.TrackID.set(column, "int", op, values)
+	case "name":
+		if f.Name == nil {
+			f.Name = &Filter{}
+		}
+		return f.Name.set(column, "string", op, values)
+	case "album_id":
+		if f.AlbumID == nil {
+			f.AlbumID = &Filter{}
+		}
+		return f.AlbumID.set(column, "int", op, values)
+	case "media_type_id":
+		if f.MediaTypeID == nil {
+			f.MediaTypeID = &Filter{}
+		}
+		return f.MediaTypeID.set(column, "int", op, values)
+	case "genre_id":
+		if f.GenreID == nil {
+			f.GenreID = &Filter{}
+		}
+		return f.GenreID.set(column, "int", op, values)
+	case "composer":
+		if f.Composer == nil {
+			f.Composer = &Filter{}
+		}
+		return f.Composer.set(column, "string", op, values)
+	case "milliseconds":
+		if f.Milliseconds == nil {
+			f.Milliseconds = &Filter{}
+		}
+		return f.Milliseconds.set(column, "int", op, values)
+	case "bytes":
+		if f.Bytes == nil {
+			f.Bytes = &Filter{}
+		}
+		return f.Bytes.set(column, "int", op, values)
+	case "unit_price":
+		if f.UnitPrice == nil {
+			f.UnitPrice = &Filter{}
+		}
+		return f.UnitPrice.set(column, "float", op, values)
+	}
+	return fmt.Errorf("filter: unknown column %s", column)
+}
+
+// columns return the column filters
+func (f *TracksFilter) columns() []filterColumn {
+	return []filterColumn{
+		{"TrackId", f.TrackID},
+		{"Name", f.Name},
+		{"AlbumId", f.AlbumID},
+		{"MediaTypeId", f.MediaTypeID},
+		{"GenreId", f.GenreID},
+		{"Composer", f.Composer},
+		{"Milliseconds", f.Milliseconds},
+		{"Bytes", f.Bytes},
+		{"UnitPrice", f.UnitPrice},
+	}
+}
+
+// where return the where clause and its args, an empty where clause if f is nil
+func (f *TracksFilter) where(driverName string) (string, []interface{}) {
+	if f == nil {
+		return "", nil
+	}
+
+	return filterWhere(driverName, f.columns())
+}
+
+// matches return true if record holds the column filters, true if f is nil. It evaluates the filter in memory, for
+// the fake repositories.
+func (f *TracksFilter) matches(record *model.Tracks) bool {
+	if f == nil {
+		return true
+	}
+
+	return filterMatches(f.columns(), func(column string) interface{} {
+		return tracksValue(record, column)
+	})
+}
+
+var (
+	// tracksColumns the columns of the tracks table keyed by json name
+	tracksColumns = map[string]string{
+		"track_id":      "TrackId",
+		"name":          "Name",
+		"album_id":      "AlbumId",
+		"media_type_id": "MediaTypeId",
+		"genre_id":      "GenreId",
+		"composer":      "Composer",
+		"milliseconds":  "Milliseconds",
+		"bytes":         "Bytes",
+		"unit_price":    "UnitPrice",
+	}
+
+	// tracksCursorKinds the kinds of the values of the columns of the tracks table in a keyset cursor
+	tracksCursorKinds = map[string]string{
+		"TrackId":      "int",
+		"Name":         "string",
+		"AlbumId":      "int",
+		"MediaTypeId":  "int",
+		"GenreId":      "int",
+		"Composer":     "string",
+		"Milliseconds": "int",
+		"Bytes":        "int",
+		"UnitPrice":    "float",
+	}
+
+	// tracksPrimaryKeys the primary key columns of the tracks table
+	tracksPrimaryKeys = []string{"TrackId"}
+
+	// tracksNullableColumns the nullable columns of the tracks table
+	tracksNullableColumns = []string{"AlbumId", "GenreId", "Composer", "Bytes"}
+)
+
+// TracksOrderBy return the order by columns of the tracks table for order, see orderTerms
+func TracksOrderBy(driverName, order string) (string, error) {
+	terms, err := orderTerms(order, tracksColumns, tracksPrimaryKeys)
+	if err != nil {
+		return "", err
+	}
+	return orderBy(driverName, terms), nil
+}
+
+// newTracksKeyset return the keyset of the page of the tracks table after cursor
+func newTracksKeyset(driverName, order, cursor string) (*keyset, error) {
+	return newKeyset(driverName, order, cursor, tracksColumns, tracksCursorKinds, tracksPrimaryKeys, tracksNullableColumns)
+}
+
+// tracksValue return the value of a column of record
+func tracksValue(record *model.Tracks, column string) interface{} {
+	switch column {
+	case "TrackId":
+		return record.TrackID
+	case "Name":
+		return record.Name
+	case "AlbumId":
+		return record.AlbumID
+	case "MediaTypeId":
+		return record.MediaTypeID
+	case "GenreId":
+		return record.GenreID
+	case "Composer":
+		return record.Composer
+	case "Milliseconds":
+		return record.Milliseconds
+	case "Bytes":
+		return record.Bytes
+	case "UnitPrice":
+		return record.UnitPrice
+	}
+	return nil
+}
+
+// tracksPatch apply a JSON merge patch of a Tracks keyed by json name to record and return the columns
+// and values set, null sets a column to NULL. Unknown fields, primary key and audit fields and null values of columns
+// that are not nullable are rejected.
+func tracksPatch(patch map[string]json.RawMessage, record *model.Tracks) (columns []string, values []interface{}, err error) {
+	empty := &model.Tracks{}
+	for _, name := range patchNames(patch) {
+		raw := patch[name]
+		switch name {
+		case "track_id":
+			return nil, nil, fmt.Errorf("patch: primary key %s is read only", name)
+		case "name":
+			if isJSONNull(raw) {
+				return nil, nil, fmt.Errorf("patch: %s is not nullable", name)
+			}
+			if err = json.Unmarshal(raw, &record.Name); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "Name"), append(values, record.Name)
+		case "album_id":
+			if isJSONNull(raw) {
+				record.AlbumID = empty.AlbumID
+				columns, values = append(columns, "AlbumId"), append(values, nil)
+				continue
+			}
+			var value int32
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.AlbumID = sql.NullInt32{Int32: value, Valid: true}
+			columns, values = append(columns, "AlbumId"), append(values, record.AlbumID)
+		case "media_type_id":
+			if isJSONNull(raw) {
+				return nil, nil, fmt.Errorf("patch: %s is not nullable", name)
+			}
+			if err = json.Unmarshal(raw, &record.MediaTypeID); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "MediaTypeId"), append(values, record.MediaTypeID)
+		case "genre_id":
+			if isJSONNull(raw) {
+				record.GenreID = empty.GenreID
+				columns, values = append(columns, "GenreId"), append(values, nil)
+				continue
+			}
+			var value int32
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.GenreID = sql.NullInt32{Int32: value, Valid: true}
+			columns, values = append(columns, "GenreId"), append(values, record.GenreID)
+		case "composer":
+			if isJSONNull(raw) {
+				record.Composer = empty.Composer
+				columns, values = append(columns, "Composer"), append(values, nil)
+				continue
+			}
+			var value string
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.Composer = sql.NullString{String: value, Valid: true}
+			columns, values = append(columns, "Composer"), append(values, record.Composer)
+		case "milliseconds":
+			if isJSONNull(raw) {
+				return nil, nil, fmt.Errorf("patch: %s is not nullable", name)
+			}
+			if err = json.Unmarshal(raw, &record.Milliseconds); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "Milliseconds"), append(values, record.Milliseconds)
+		case "bytes":
+			if isJSONNull(raw) {
+				record.Bytes = empty.Bytes
+				columns, values = append(columns, "Bytes"), append(values, nil)
+				continue
+			}
+			var value int32
+			if err = json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			record.Bytes = sql.NullInt32{Int32: value, Valid: true}
+			columns, values = append(columns, "Bytes"), append(values, record.Bytes)
+		case "unit_price":
+			if isJSONNull(raw) {
+				return nil, nil, fmt.Errorf("patch: %s is not nullable", name)
+			}
+			if err = json.Unmarshal(raw, &record.UnitPrice); err != nil {
+				return nil, nil, fmt.Errorf("patch: %s: %v", name, err)
+			}
+			columns, values = append(columns, "UnitPrice"), append(values, record.UnitPrice)
+		default:
+			return nil, nil, fmt.Errorf("patch: unknown field %s", name)
+		}
+	}
+	return columns, values, nil
+}
@@ -0,0 +1,346 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"example.com/rest/example/model"
+
+	"github.com/google/uuid"
+)
+
+var (
+	_ = time.Second
+
+	_ = uuid.UUID{}
+)
+
+/*
+
+
+DB Table Details
+-------------------------------------
+CREATE TABLE "playlist_track"
+(
+    [PlaylistId] INTEGER  NOT NULL,
+    [TrackId] INTEGER  NOT NULL,
+    CONSTRAINT [PK_PlaylistTrack] PRIMARY KEY  ([PlaylistId], [TrackId]),
+    FOREIGN KEY ([PlaylistId]) REFERENCES "playlists" ([PlaylistId])
+		ON DELETE NO ACTION ON UPDATE NO ACTION,
+    FOREIGN KEY ([TrackId]) REFERENCES "tracks" ([TrackId])
+		ON DELETE NO ACTION ON UPDATE NO ACTION
+)
+
+
+PrimaryKeyNamesList    : [PlaylistId]
+PrimaryKeysJoined      : PlaylistId
+NonPrimaryKeyNamesList : [TrackId]
+NonPrimaryKeysJoined   : TrackId
+
+
+*/
+
+// GetAllPlaylistTrack is a function to get a slice of record(s) from playlist_track table in the main database
+// params - page     - page requested (defaults to 0)
+// params - pagesize - number of records in a page  (defaults to 20)
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -
+// params - filter   - conditions on the columns, nil for all records
+// error - ErrNotFound, db Find error
+func GetAllPlaylistTrack(ctx context.Context, page, pagesize int64, order string, filter *PlaylistTrackFilter) (results []*model.PlaylistTrack, totalRows int, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	where, args := filter.where(db.DriverName())
+	sql := SelectSQL(dialect, "playlist_track", where)
+
+	orderBy, err := PlaylistTrackOrderBy(db.DriverName(), order)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	if orderBy == "" {
+		orderBy = "(SELECT NULL)"
+	}
+	sql = Rebind(dialect, dialect.Paginate(sql+" ORDER BY "+orderBy, page, pagesize))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	err = db.SelectContext(ctx, &results, sql, args...)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	cnt, err := RowCount(ctx, db, "playlist_track", where, args...)
+	if err != nil {
+		return results, -2, err
+	}
+
+	return results, cnt, err
+}
+
+// GetPagePlaylistTrack is a function to get a page of record(s) from playlist_track table in the main database with keyset pagination
+// params - cursor   - next cursor returned by the previous page, empty for the first page
+// params - pagesize - number of records in a page
+// params - order    - comma separated json names of the sort columns, descending if prefixed by -, must be the order of the previous page
+// params - filter   - conditions on the columns, nil for all records
+// params - count    - CountNone, CountExact or CountEstimate
+// error - cursor or order error, db Find error
+func GetPagePlaylistTrack(ctx context.Context, cursor string, pagesize int64, order string, filter *PlaylistTrackFilter, count CountMode) (results []*model.PlaylistTrack, nextCursor string, totalRows int, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyset, err := newPlaylistTrackKeyset(db.DriverName(), order, cursor)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	filterWhere, filterArgs := filter.where(db.DriverName())
+	where, args := keyset.where(filterWhere, filterArgs)
+	sql := SelectSQL(dialect, "playlist_track", where)
+	sql = Rebind(dialect, dialect.Paginate(sql+" ORDER BY "+keyset.orderBy(), 0, pagesize))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	err = db.SelectContext(ctx, &results, sql, args...)
+	if err != nil {
+		return nil, "", -1, err
+	}
+
+	if pagesize > 0 && int64(len(results)) == pagesize {
+		last := results[len(results)-1]
+		nextCursor, err = keyset.cursor(func(column string) interface{} { return playlistTrackValue(last, column) })
+		if err != nil {
+			return nil, "", -1, err
+		}
+	}
+
+	totalRows = -1
+	switch count {
+	case CountExact:
+		totalRows, err = RowCount(ctx, db, "playlist_track", filterWhere, filterArgs...)
+	case CountEstimate:
+		totalRows, err = EstimateRowCount(ctx, db, "playlist_track")
+	}
+	if err != nil {
+		return results, nextCursor, -2, err
+	}
+
+	return results, nextCursor, totalRows, nil
+}
+
+// GetPlaylistTrack is a function to get a single record from the playlist_track table in the main database
+// error - ErrNotFound, db Find error
+func GetPlaylistTrack(ctx context.Context, argPlaylistID int32) (record *model.PlaylistTrack, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := []string{"PlaylistId"}
+	sql := Rebind(dialect, SelectSQL(dialect, "playlist_track", KeyWhere(dialect, keyColumns)))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	record = &model.PlaylistTrack{}
+	err = db.GetContext(ctx, record, sql, argPlaylistID)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// AddPlaylistTrack is a function to add a single record to playlist_track table in the main database
+// error - ErrInsertFailed, db save call failed
+func AddPlaylistTrack(ctx context.Context, record *model.PlaylistTrack) (result *model.PlaylistTrack, RowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	columns := []string{"PlaylistId", "TrackId"}
+	args := []interface{}{record.PlaylistID, record.TrackID}
+
+	sql := Rebind(dialect, InsertSQL(dialect, "playlist_track", columns, nil))
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbResult.RowsAffected()
+	return record, rows, err
+}
+
+// UpsertPlaylistTrack is a function to add a single record to playlist_track table in the main database, or update the record with the same conflict columns
+// params - conflict - json names of the columns of a unique constraint, the primary key if empty
+// error - unknown conflict column, db upsert error
+func UpsertPlaylistTrack(ctx context.Context, record *model.PlaylistTrack, conflict ...string) (result *model.PlaylistTrack, RowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := playlistTrackPrimaryKeys
+	if len(conflict) > 0 {
+		if keyColumns, err = conflictColumns(conflict, playlistTrackColumns); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// auto increment columns are only written when they identify the record
+	columns := []string{"PlaylistId", "TrackId"}
+
+	args := make([]interface{}, len(columns))
+	for i, column := range columns {
+		args[i] = playlistTrackValue(record, column)
+	}
+
+	sql := Rebind(dialect, dialect.Upsert("playlist_track", columns, keyColumns, exceptColumns(columns, keyColumns)))
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbResult.RowsAffected()
+	return record, rows, err
+}
+
+// BulkAddPlaylistTrack is a function to add records to playlist_track table in the main database with multi row inserts of batchSize records
+// params - batchSize - number of records inserted by each statement, DefaultBatchSize if not positive
+// The generated keys are set on the records on databases returning the inserted rows. Use WithTx to add all or none of the records.
+// error - db insert error
+func BulkAddPlaylistTrack(ctx context.Context, records []*model.PlaylistTrack, batchSize int) (results []*model.PlaylistTrack, RowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	columns := []string{"PlaylistId", "TrackId"}
+	for start := 0; start < len(records); start += batchSize {
+		end := start + batchSize
+		if end > len(records) {
+			end = len(records)
+		}
+		batch := records[start:end]
+
+		args := make([]interface{}, 0, len(batch)*len(columns))
+		for _, record := range batch {
+			args = append(args, record.PlaylistID, record.TrackID)
+		}
+
+		sql := Rebind(dialect, BulkInsertSQL(dialect, "playlist_track", columns, len(batch), nil))
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		dbResult, err := db.ExecContext(ctx, sql, args...)
+		if err != nil {
+			return nil, RowsAffected, err
+		}
+
+		rows, err := dbResult.RowsAffected()
+		if err != nil {
+			return nil, RowsAffected, err
+		}
+		RowsAffected += rows
+	}
+
+	return records, RowsAffected, nil
+}
+
+// UpdatePlaylistTrack is a function to update a single record from playlist_track table in the main database
+// error - ErrNotFound, db record for id not found
+// error - ErrUpdateFailed, db meta data copy failed or db.Save call failed
+func UpdatePlaylistTrack(ctx context.Context, argPlaylistID int32, updated *model.PlaylistTrack) (result *model.PlaylistTrack, RowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	columns := []string{"TrackId"}
+	keyColumns := []string{"PlaylistId"}
+	sql := Rebind(dialect, UpdateSQL(dialect, "playlist_track", columns, keyColumns))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	dbResult, err := db.ExecContext(ctx, sql, updated.TrackID, argPlaylistID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := dbResult.RowsAffected()
+	updated.PlaylistID = argPlaylistID
+
+	return updated, rows, err
+}
+
+// PatchPlaylistTrack is a function to update the columns of a JSON merge patch, keyed by json name, of a single record from playlist_track table in the main database
+// Zero values are written and null sets a column to NULL.
+// error - unknown, read only or not nullable patch field, db update error, db Find error
+func PatchPlaylistTrack(ctx context.Context, argPlaylistID int32, patch map[string]json.RawMessage) (result *model.PlaylistTrack, RowsAffected int64, err error) {
+	columns, args, err := playlistTrackPatch(patch, &model.PlaylistTrack{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(columns) > 0 {
+		db := Conn(ctx, DB)
+		dialect := DialectFor(db.DriverName())
+
+		keyColumns := []string{"PlaylistId"}
+		sql := Rebind(dialect, UpdateSQL(dialect, "playlist_track", columns, keyColumns))
+
+		if Logger != nil {
+			Logger(ctx, sql)
+		}
+
+		args = append(args, argPlaylistID)
+		dbResult, err := db.ExecContext(ctx, sql, args...)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if RowsAffected, err = dbResult.RowsAffected(); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	result, err = GetPlaylistTrack(ctx, argPlaylistID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, RowsAffected, nil
+}
+
+// DeletePlaylistTrack is a function to delete a single record from playlist_track table in the main database
+// error - ErrNotFound, db Find error
+// error - ErrDeleteFailed, db Delete failed error
+func DeletePlaylistTrack(ctx context.Context, argPlaylistID int32) (rowsAffected int64, err error) {
+	db := Conn(ctx, DB)
+	dialect := DialectFor(db.DriverName())
+
+	keyColumns := []string{"PlaylistId"}
+	sql := Rebind(dialect, DeleteSQL(dialect, "playlist_track", keyColumns))
+
+	if Logger != nil {
+		Logger(ctx, sql)
+	}
+
+	result, err := db.ExecContext(ctx, sql, argPlaylistID)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,1986 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"example.com/rest/example/dao"
+	"example.com/rest/example/model"
+
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/yaml.v2"
+)
+
+// UserMetadataKey key of the gRPC metadata holding the name of the user of a call
+const UserMetadataKey = "x-api-user"
+
+// Server server instance
+type Server struct {
+}
+
+// GetAllAlbums is a RPC method to get a slice of record(s) from albums table in the main database
+func (s *Server) GetAllAlbums(context context.Context, request *model.GetAllAlbumsRequest) (*model.GetAllAlbumsResponse, error) {
+
+	filter := &dao.AlbumsFilter{}
+	for _, condition := range request.Filters {
+		if err := filter.Set(condition.Column, condition.Op, condition.Values...); err != nil {
+			response := &model.GetAllAlbumsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+	}
+
+	if request.Keyset {
+		count := dao.CountMode(request.Count)
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			response := &model.GetAllAlbumsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("count: invalid mode %s", request.Count)}}
+			return response, nil
+		}
+
+		result, nextCursor, totalRows, err := dao.GetPageAlbums(context, request.Cursor, request.PageSize, request.Order, filter, count)
+		if err != nil {
+			response := &model.GetAllAlbumsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+
+		response := &model.GetAllAlbumsResponse{Result: &model.Result{Result: model.Result_Success}, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows), NextCursor: nextCursor}
+		return response, nil
+	}
+
+	result, totalRows, err := dao.GetAllAlbums(context, request.Page, request.PageSize, request.Order, filter)
+	if err != nil {
+		response := &model.GetAllAlbumsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetAllAlbumsResponse{Result: &model.Result{Result: model.Result_Success}, Page: request.Page, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows)}
+	return response, nil
+}
+
+// GetAlbums is a RPC method to get a single record from the albums table in the main database
+func (s *Server) GetAlbums(context context.Context, request *model.GetAlbumsRequest) (*model.GetAlbumsResponse, error) {
+
+	result, err := dao.GetAlbums(context, request.AlbumID)
+	if err != nil {
+		response := &model.GetAlbumsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetAlbumsResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	return response, nil
+}
+
+// AddAlbums is a RPC method to add a single record to albums table in the main database
+func (s *Server) AddAlbums(context context.Context, request *model.AddAlbumsRequest) (*model.AddAlbumsResponse, error) {
+
+	result, rowsAffected, err := dao.AddAlbums(context, request.Data)
+	if err != nil {
+		response := &model.AddAlbumsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.AddAlbumsResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// UpsertAlbums is a RPC method to add or update records of albums table in the main database in one transaction
+func (s *Server) UpsertAlbums(context context.Context, request *model.UpsertAlbumsRequest) (*model.UpsertAlbumsResponse, error) {
+
+	result, rowsAffected, err := s.upsertAlbums(context, request.Data, request.On)
+	if err != nil {
+		response := &model.UpsertAlbumsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpsertAlbumsResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// upsertAlbums upsert records in a transaction
+func (s *Server) upsertAlbums(ctx context.Context, records []*model.Albums, conflict []string) ([]*model.Albums, int64, error) {
+	rowsAffected := int64(0)
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, rows, err := dao.UpsertAlbums(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+			rowsAffected += rows
+		}
+		return nil
+	})
+	return records, rowsAffected, err
+}
+
+// BulkAddAlbums is a RPC method to add records to albums table in the main database in one transaction
+func (s *Server) BulkAddAlbums(context context.Context, request *model.BulkAddAlbumsRequest) (*model.BulkAddAlbumsResponse, error) {
+
+	result, rowsAffected, err := s.bulkAddAlbums(context, request.Data, int(request.BatchSize))
+	if err != nil {
+		response := &model.BulkAddAlbumsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.BulkAddAlbumsResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// bulkAddAlbums add records in a transaction
+func (s *Server) bulkAddAlbums(ctx context.Context, records []*model.Albums, batchSize int) (results []*model.Albums, rowsAffected int64, err error) {
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		results, rowsAffected, err = dao.BulkAddAlbums(ctx, records, batchSize)
+		return err
+	})
+	return results, rowsAffected, err
+}
+
+// UpdateAlbums is a RPC method to Update a single record from albums table in the main database
+func (s *Server) UpdateAlbums(context context.Context, request *model.UpdateAlbumsRequest) (*model.UpdateAlbumsResponse, error) {
+
+	result, rowsAffected, err := dao.UpdateAlbums(context,
+		request.Data.AlbumID,
+		request.Data)
+	if err != nil {
+		response := &model.UpdateAlbumsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpdateAlbumsResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// DeleteAlbums is a RPC method to delete a single record from albums table in the main database
+func (s *Server) DeleteAlbums(context context.Context, request *model.DeleteAlbumsRequest) (*model.DeleteAlbumsResponse, error) {
+
+	rowsAffected, err := dao.DeleteAlbums(context, request.AlbumID)
+	if err != nil {
+		response := &model.DeleteAlbumsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.DeleteAlbumsResponse{RowsAffected: rowsAffected, Result: &model.Result{Result: model.Result_Success}}
+	return response, nil
+}
+
+// GetAllArtists is a RPC method to get a slice of record(s) from artists table in the main database
+func (s *Server) GetAllArtists(context context.Context, request *model.GetAllArtistsRequest) (*model.GetAllArtistsResponse, error) {
+
+	filter := &dao.ArtistsFilter{}
+	for _, condition := range request.Filters {
+		if err := filter.Set(condition.Column, condition.Op, condition.Values...); err != nil {
+			response := &model.GetAllArtistsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+	}
+
+	if request.Keyset {
+		count := dao.CountMode(request.Count)
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			response := &model.GetAllArtistsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("count: invalid mode %s", request.Count)}}
+			return response, nil
+		}
+
+		result, nextCursor, totalRows, err := dao.GetPageArtists(context, request.Cursor, request.PageSize, request.Order, filter, count)
+		if err != nil {
+			response := &model.GetAllArtistsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+
+		response := &model.GetAllArtistsResponse{Result: &model.Result{Result: model.Result_Success}, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows), NextCursor: nextCursor}
+		return response, nil
+	}
+
+	result, totalRows, err := dao.GetAllArtists(context, request.Page, request.PageSize, request.Order, filter)
+	if err != nil {
+		response := &model.GetAllArtistsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetAllArtistsResponse{Result: &model.Result{Result: model.Result_Success}, Page: request.Page, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows)}
+	return response, nil
+}
+
+// GetArtists is a RPC method to get a single record from the artists table in the main database
+func (s *Server) GetArtists(context context.Context, request *model.GetArtistsRequest) (*model.GetArtistsResponse, error) {
+
+	result, err := dao.GetArtists(context, request.ArtistID)
+	if err != nil {
+		response := &model.GetArtistsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetArtistsResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	return response, nil
+}
+
+// AddArtists is a RPC method to add a single record to artists table in the main database
+func (s *Server) AddArtists(context context.Context, request *model.AddArtistsRequest) (*model.AddArtistsResponse, error) {
+
+	result, rowsAffected, err := dao.AddArtists(context, request.Data)
+	if err != nil {
+		response := &model.AddArtistsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.AddArtistsResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// UpsertArtists is a RPC method to add or update records of artists table in the main database in one transaction
+func (s *Server) UpsertArtists(context context.Context, request *model.UpsertArtistsRequest) (*model.UpsertArtistsResponse, error) {
+
+	result, rowsAffected, err := s.upsertArtists(context, request.Data, request.On)
+	if err != nil {
+		response := &model.UpsertArtistsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpsertArtistsResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// upsertArtists upsert records in a transaction
+func (s *Server) upsertArtists(ctx context.Context, records []*model.Artists, conflict []string) ([]*model.Artists, int64, error) {
+	rowsAffected := int64(0)
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, rows, err := dao.UpsertArtists(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+			rowsAffected += rows
+		}
+		return nil
+	})
+	return records, rowsAffected, err
+}
+
+// BulkAddArtists is a RPC method to add records to artists table in the main database in one transaction
+func (s *Server) BulkAddArtists(context context.Context, request *model.BulkAddArtistsRequest) (*model.BulkAddArtistsResponse, error) {
+
+	result, rowsAffected, err := s.bulkAddArtists(context, request.Data, int(request.BatchSize))
+	if err != nil {
+		response := &model.BulkAddArtistsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.BulkAddArtistsResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// bulkAddArtists add records in a transaction
+func (s *Server) bulkAddArtists(ctx context.Context, records []*model.Artists, batchSize int) (results []*model.Artists, rowsAffected int64, err error) {
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		results, rowsAffected, err = dao.BulkAddArtists(ctx, records, batchSize)
+		return err
+	})
+	return results, rowsAffected, err
+}
+
+// UpdateArtists is a RPC method to Update a single record from artists table in the main database
+func (s *Server) UpdateArtists(context context.Context, request *model.UpdateArtistsRequest) (*model.UpdateArtistsResponse, error) {
+
+	result, rowsAffected, err := dao.UpdateArtists(context,
+		request.Data.ArtistID,
+		request.Data)
+	if err != nil {
+		response := &model.UpdateArtistsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpdateArtistsResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// DeleteArtists is a RPC method to delete a single record from artists table in the main database
+func (s *Server) DeleteArtists(context context.Context, request *model.DeleteArtistsRequest) (*model.DeleteArtistsResponse, error) {
+
+	rowsAffected, err := dao.DeleteArtists(context, request.ArtistID)
+	if err != nil {
+		response := &model.DeleteArtistsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.DeleteArtistsResponse{RowsAffected: rowsAffected, Result: &model.Result{Result: model.Result_Success}}
+	return response, nil
+}
+
+// GetAllCustomers is a RPC method to get a slice of record(s) from customers table in the main database
+func (s *Server) GetAllCustomers(context context.Context, request *model.GetAllCustomersRequest) (*model.GetAllCustomersResponse, error) {
+
+	filter := &dao.CustomersFilter{}
+	for _, condition := range request.Filters {
+		if err := filter.Set(condition.Column, condition.Op, condition.Values...); err != nil {
+			response := &model.GetAllCustomersResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+	}
+
+	if request.Keyset {
+		count := dao.CountMode(request.Count)
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			response := &model.GetAllCustomersResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("count: invalid mode %s", request.Count)}}
+			return response, nil
+		}
+
+		result, nextCursor, totalRows, err := dao.GetPageCustomers(context, request.Cursor, request.PageSize, request.Order, filter, count)
+		if err != nil {
+			response := &model.GetAllCustomersResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+
+		response := &model.GetAllCustomersResponse{Result: &model.Result{Result: model.Result_Success}, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows), NextCursor: nextCursor}
+		return response, nil
+	}
+
+	result, totalRows, err := dao.GetAllCustomers(context, request.Page, request.PageSize, request.Order, filter)
+	if err != nil {
+		response := &model.GetAllCustomersResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetAllCustomersResponse{Result: &model.Result{Result: model.Result_Success}, Page: request.Page, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows)}
+	return response, nil
+}
+
+// GetCustomers is a RPC method to get a single record from the customers table in the main database
+func (s *Server) GetCustomers(context context.Context, request *model.GetCustomersRequest) (*model.GetCustomersResponse, error) {
+
+	result, err := dao.GetCustomers(context, request.CustomerID)
+	if err != nil {
+		response := &model.GetCustomersResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetCustomersResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	return response, nil
+}
+
+// AddCustomers is a RPC method to add a single record to customers table in the main database
+func (s *Server) AddCustomers(context context.Context, request *model.AddCustomersRequest) (*model.AddCustomersResponse, error) {
+
+	result, rowsAffected, err := dao.AddCustomers(context, request.Data)
+	if err != nil {
+		response := &model.AddCustomersResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.AddCustomersResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// UpsertCustomers is a RPC method to add or update records of customers table in the main database in one transaction
+func (s *Server) UpsertCustomers(context context.Context, request *model.UpsertCustomersRequest) (*model.UpsertCustomersResponse, error) {
+
+	result, rowsAffected, err := s.upsertCustomers(context, request.Data, request.On)
+	if err != nil {
+		response := &model.UpsertCustomersResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpsertCustomersResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// upsertCustomers upsert records in a transaction
+func (s *Server) upsertCustomers(ctx context.Context, records []*model.Customers, conflict []string) ([]*model.Customers, int64, error) {
+	rowsAffected := int64(0)
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, rows, err := dao.UpsertCustomers(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+			rowsAffected += rows
+		}
+		return nil
+	})
+	return records, rowsAffected, err
+}
+
+// BulkAddCustomers is a RPC method to add records to customers table in the main database in one transaction
+func (s *Server) BulkAddCustomers(context context.Context, request *model.BulkAddCustomersRequest) (*model.BulkAddCustomersResponse, error) {
+
+	result, rowsAffected, err := s.bulkAddCustomers(context, request.Data, int(request.BatchSize))
+	if err != nil {
+		response := &model.BulkAddCustomersResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.BulkAddCustomersResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// bulkAddCustomers add records in a transaction
+func (s *Server) bulkAddCustomers(ctx context.Context, records []*model.Customers, batchSize int) (results []*model.Customers, rowsAffected int64, err error) {
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		results, rowsAffected, err = dao.BulkAddCustomers(ctx, records, batchSize)
+		return err
+	})
+	return results, rowsAffected, err
+}
+
+// UpdateCustomers is a RPC method to Update a single record from customers table in the main database
+func (s *Server) UpdateCustomers(context context.Context, request *model.UpdateCustomersRequest) (*model.UpdateCustomersResponse, error) {
+
+	result, rowsAffected, err := dao.UpdateCustomers(context,
+		request.Data.CustomerID,
+		request.Data)
+	if err != nil {
+		response := &model.UpdateCustomersResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpdateCustomersResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// DeleteCustomers is a RPC method to delete a single record from customers table in the main database
+func (s *Server) DeleteCustomers(context context.Context, request *model.DeleteCustomersRequest) (*model.DeleteCustomersResponse, error) {
+
+	rowsAffected, err := dao.DeleteCustomers(context, request.CustomerID)
+	if err != nil {
+		response := &model.DeleteCustomersResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.DeleteCustomersResponse{RowsAffected: rowsAffected, Result: &model.Result{Result: model.Result_Success}}
+	return response, nil
+}
+
+// GetAllEmployees is a RPC method to get a slice of record(s) from employees table in the main database
+func (s *Server) GetAllEmployees(context context.Context, request *model.GetAllEmployeesRequest) (*model.GetAllEmployeesResponse, error) {
+
+	filter := &dao.EmployeesFilter{}
+	for _, condition := range request.Filters {
+		if err := filter.Set(condition.Column, condition.Op, condition.Values...); err != nil {
+			response := &model.GetAllEmployeesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+	}
+
+	if request.Keyset {
+		count := dao.CountMode(request.Count)
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			response := &model.GetAllEmployeesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("count: invalid mode %s", request.Count)}}
+			return response, nil
+		}
+
+		result, nextCursor, totalRows, err := dao.GetPageEmployees(context, request.Cursor, request.PageSize, request.Order, filter, count)
+		if err != nil {
+			response := &model.GetAllEmployeesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+
+		response := &model.GetAllEmployeesResponse{Result: &model.Result{Result: model.Result_Success}, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows), NextCursor: nextCursor}
+		return response, nil
+	}
+
+	result, totalRows, err := dao.GetAllEmployees(context, request.Page, request.PageSize, request.Order, filter)
+	if err != nil {
+		response := &model.GetAllEmployeesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetAllEmployeesResponse{Result: &model.Result{Result: model.Result_Success}, Page: request.Page, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows)}
+	return response, nil
+}
+
+// GetEmployees is a RPC method to get a single record from the employees table in the main database
+func (s *Server) GetEmployees(context context.Context, request *model.GetEmployeesRequest) (*model.GetEmployeesResponse, error) {
+
+	result, err := dao.GetEmployees(context, request.EmployeeID)
+	if err != nil {
+		response := &model.GetEmployeesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetEmployeesResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	return response, nil
+}
+
+// AddEmployees is a RPC method to add a single record to employees table in the main database
+func (s *Server) AddEmployees(context context.Context, request *model.AddEmployeesRequest) (*model.AddEmployeesResponse, error) {
+
+	result, rowsAffected, err := dao.AddEmployees(context, request.Data)
+	if err != nil {
+		response := &model.AddEmployeesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.AddEmployeesResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// UpsertEmployees is a RPC method to add or update records of employees table in the main database in one transaction
+func (s *Server) UpsertEmployees(context context.Context, request *model.UpsertEmployeesRequest) (*model.UpsertEmployeesResponse, error) {
+
+	result, rowsAffected, err := s.upsertEmployees(context, request.Data, request.On)
+	if err != nil {
+		response := &model.UpsertEmployeesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpsertEmployeesResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// upsertEmployees upsert records in a transaction
+func (s *Server) upsertEmployees(ctx context.Context, records []*model.Employees, conflict []string) ([]*model.Employees, int64, error) {
+	rowsAffected := int64(0)
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, rows, err := dao.UpsertEmployees(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+			rowsAffected += rows
+		}
+		return nil
+	})
+	return records, rowsAffected, err
+}
+
+// BulkAddEmployees is a RPC method to add records to employees table in the main database in one transaction
+func (s *Server) BulkAddEmployees(context context.Context, request *model.BulkAddEmployeesRequest) (*model.BulkAddEmployeesResponse, error) {
+
+	result, rowsAffected, err := s.bulkAddEmployees(context, request.Data, int(request.BatchSize))
+	if err != nil {
+		response := &model.BulkAddEmployeesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.BulkAddEmployeesResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// bulkAddEmployees add records in a transaction
+func (s *Server) bulkAddEmployees(ctx context.Context, records []*model.Employees, batchSize int) (results []*model.Employees, rowsAffected int64, err error) {
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		results, rowsAffected, err = dao.BulkAddEmployees(ctx, records, batchSize)
+		return err
+	})
+	return results, rowsAffected, err
+}
+
+// UpdateEmployees is a RPC method to Update a single record from employees table in the main database
+func (s *Server) UpdateEmployees(context context.Context, request *model.UpdateEmployeesRequest) (*model.UpdateEmployeesResponse, error) {
+
+	result, rowsAffected, err := dao.UpdateEmployees(context,
+		request.Data.EmployeeID,
+		request.Data)
+	if err != nil {
+		response := &model.UpdateEmployeesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpdateEmployeesResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// DeleteEmployees is a RPC method to delete a single record from employees table in the main database
+func (s *Server) DeleteEmployees(context context.Context, request *model.DeleteEmployeesRequest) (*model.DeleteEmployeesResponse, error) {
+
+	rowsAffected, err := dao.DeleteEmployees(context, request.EmployeeID)
+	if err != nil {
+		response := &model.DeleteEmployeesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.DeleteEmployeesResponse{RowsAffected: rowsAffected, Result: &model.Result{Result: model.Result_Success}}
+	return response, nil
+}
+
+// GetAllGenres is a RPC method to get a slice of record(s) from genres table in the main database
+func (s *Server) GetAllGenres(context context.Context, request *model.GetAllGenresRequest) (*model.GetAllGenresResponse, error) {
+
+	filter := &dao.GenresFilter{}
+	for _, condition := range request.Filters {
+		if err := filter.Set(condition.Column, condition.Op, condition.Values...); err != nil {
+			response := &model.GetAllGenresResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+	}
+
+	if request.Keyset {
+		count := dao.CountMode(request.Count)
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			response := &model.GetAllGenresResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("count: invalid mode %s", request.Count)}}
+			return response, nil
+		}
+
+		result, nextCursor, totalRows, err := dao.GetPageGenres(context, request.Cursor, request.PageSize, request.Order, filter, count)
+		if err != nil {
+			response := &model.GetAllGenresResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+
+		response := &model.GetAllGenresResponse{Result: &model.Result{Result: model.Result_Success}, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows), NextCursor: nextCursor}
+		return response, nil
+	}
+
+	result, totalRows, err := dao.GetAllGenres(context, request.Page, request.PageSize, request.Order, filter)
+	if err != nil {
+		response := &model.GetAllGenresResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetAllGenresResponse{Result: &model.Result{Result: model.Result_Success}, Page: request.Page, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows)}
+	return response, nil
+}
+
+// GetGenres is a RPC method to get a single record from the genres table in the main database
+func (s *Server) GetGenres(context context.Context, request *model.GetGenresRequest) (*model.GetGenresResponse, error) {
+
+	result, err := dao.GetGenres(context, request.GenreID)
+	if err != nil {
+		response := &model.GetGenresResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetGenresResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	return response, nil
+}
+
+// AddGenres is a RPC method to add a single record to genres table in the main database
+func (s *Server) AddGenres(context context.Context, request *model.AddGenresRequest) (*model.AddGenresResponse, error) {
+
+	result, rowsAffected, err := dao.AddGenres(context, request.Data)
+	if err != nil {
+		response := &model.AddGenresResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.AddGenresResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// UpsertGenres is a RPC method to add or update records of genres table in the main database in one transaction
+func (s *Server) UpsertGenres(context context.Context, request *model.UpsertGenresRequest) (*model.UpsertGenresResponse, error) {
+
+	result, rowsAffected, err := s.upsertGenres(context, request.Data, request.On)
+	if err != nil {
+		response := &model.UpsertGenresResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpsertGenresResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// upsertGenres upsert records in a transaction
+func (s *Server) upsertGenres(ctx context.Context, records []*model.Genres, conflict []string) ([]*model.Genres, int64, error) {
+	rowsAffected := int64(0)
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, rows, err := dao.UpsertGenres(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+			rowsAffected += rows
+		}
+		return nil
+	})
+	return records, rowsAffected, err
+}
+
+// BulkAddGenres is a RPC method to add records to genres table in the main database in one transaction
+func (s *Server) BulkAddGenres(context context.Context, request *model.BulkAddGenresRequest) (*model.BulkAddGenresResponse, error) {
+
+	result, rowsAffected, err := s.bulkAddGenres(context, request.Data, int(request.BatchSize))
+	if err != nil {
+		response := &model.BulkAddGenresResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.BulkAddGenresResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// bulkAddGenres add records in a transaction
+func (s *Server) bulkAddGenres(ctx context.Context, records []*model.Genres, batchSize int) (results []*model.Genres, rowsAffected int64, err error) {
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		results, rowsAffected, err = dao.BulkAddGenres(ctx, records, batchSize)
+		return err
+	})
+	return results, rowsAffected, err
+}
+
+// UpdateGenres is a RPC method to Update a single record from genres table in the main database
+func (s *Server) UpdateGenres(context context.Context, request *model.UpdateGenresRequest) (*model.UpdateGenresResponse, error) {
+
+	result, rowsAffected, err := dao.UpdateGenres(context,
+		request.Data.GenreID,
+		request.Data)
+	if err != nil {
+		response := &model.UpdateGenresResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpdateGenresResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// DeleteGenres is a RPC method to delete a single record from genres table in the main database
+func (s *Server) DeleteGenres(context context.Context, request *model.DeleteGenresRequest) (*model.DeleteGenresResponse, error) {
+
+	rowsAffected, err := dao.DeleteGenres(context, request.GenreID)
+	if err != nil {
+		response := &model.DeleteGenresResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.DeleteGenresResponse{RowsAffected: rowsAffected, Result: &model.Result{Result: model.Result_Success}}
+	return response, nil
+}
+
+// GetAllInvoiceItems is a RPC method to get a slice of record(s) from invoice_items table in the main database
+func (s *Server) GetAllInvoiceItems(context context.Context, request *model.GetAllInvoiceItemsRequest) (*model.GetAllInvoiceItemsResponse, error) {
+
+	filter := &dao.InvoiceItemsFilter{}
+	for _, condition := range request.Filters {
+		if err := filter.Set(condition.Column, condition.Op, condition.Values...); err != nil {
+			response := &model.GetAllInvoiceItemsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+	}
+
+	if request.Keyset {
+		count := dao.CountMode(request.Count)
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			response := &model.GetAllInvoiceItemsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("count: invalid mode %s", request.Count)}}
+			return response, nil
+		}
+
+		result, nextCursor, totalRows, err := dao.GetPageInvoiceItems(context, request.Cursor, request.PageSize, request.Order, filter, count)
+		if err != nil {
+			response := &model.GetAllInvoiceItemsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+
+		response := &model.GetAllInvoiceItemsResponse{Result: &model.Result{Result: model.Result_Success}, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows), NextCursor: nextCursor}
+		return response, nil
+	}
+
+	result, totalRows, err := dao.GetAllInvoiceItems(context, request.Page, request.PageSize, request.Order, filter)
+	if err != nil {
+		response := &model.GetAllInvoiceItemsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetAllInvoiceItemsResponse{Result: &model.Result{Result: model.Result_Success}, Page: request.Page, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows)}
+	return response, nil
+}
+
+// GetInvoiceItems is a RPC method to get a single record from the invoice_items table in the main database
+func (s *Server) GetInvoiceItems(context context.Context, request *model.GetInvoiceItemsRequest) (*model.GetInvoiceItemsResponse, error) {
+
+	result, err := dao.GetInvoiceItems(context, request.InvoiceLineID)
+	if err != nil {
+		response := &model.GetInvoiceItemsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetInvoiceItemsResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	return response, nil
+}
+
+// AddInvoiceItems is a RPC method to add a single record to invoice_items table in the main database
+func (s *Server) AddInvoiceItems(context context.Context, request *model.AddInvoiceItemsRequest) (*model.AddInvoiceItemsResponse, error) {
+
+	result, rowsAffected, err := dao.AddInvoiceItems(context, request.Data)
+	if err != nil {
+		response := &model.AddInvoiceItemsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.AddInvoiceItemsResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// UpsertInvoiceItems is a RPC method to add or update records of invoice_items table in the main database in one transaction
+func (s *Server) UpsertInvoiceItems(context context.Context, request *model.UpsertInvoiceItemsRequest) (*model.UpsertInvoiceItemsResponse, error) {
+
+	result, rowsAffected, err := s.upsertInvoiceItems(context, request.Data, request.On)
+	if err != nil {
+		response := &model.UpsertInvoiceItemsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpsertInvoiceItemsResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// upsertInvoiceItems upsert records in a transaction
+func (s *Server) upsertInvoiceItems(ctx context.Context, records []*model.InvoiceItems, conflict []string) ([]*model.InvoiceItems, int64, error) {
+	rowsAffected := int64(0)
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, rows, err := dao.UpsertInvoiceItems(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+			rowsAffected += rows
+		}
+		return nil
+	})
+	return records, rowsAffected, err
+}
+
+// BulkAddInvoiceItems is a RPC method to add records to invoice_items table in the main database in one transaction
+func (s *Server) BulkAddInvoiceItems(context context.Context, request *model.BulkAddInvoiceItemsRequest) (*model.BulkAddInvoiceItemsResponse, error) {
+
+	result, rowsAffected, err := s.bulkAddInvoiceItems(context, request.Data, int(request.BatchSize))
+	if err != nil {
+		response := &model.BulkAddInvoiceItemsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.BulkAddInvoiceItemsResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// bulkAddInvoiceItems add records in a transaction
+func (s *Server) bulkAddInvoiceItems(ctx context.Context, records []*model.InvoiceItems, batchSize int) (results []*model.InvoiceItems, rowsAffected int64, err error) {
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		results, rowsAffected, err = dao.BulkAddInvoiceItems(ctx, records, batchSize)
+		return err
+	})
+	return results, rowsAffected, err
+}
+
+// UpdateInvoiceItems is a RPC method to Update a single record from invoice_items table in the main database
+func (s *Server) UpdateInvoiceItems(context context.Context, request *model.UpdateInvoiceItemsRequest) (*model.UpdateInvoiceItemsResponse, error) {
+
+	result, rowsAffected, err := dao.UpdateInvoiceItems(context,
+		request.Data.InvoiceLineID,
+		request.Data)
+	if err != nil {
+		response := &model.UpdateInvoiceItemsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpdateInvoiceItemsResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// DeleteInvoiceItems is a RPC method to delete a single record from invoice_items table in the main database
+func (s *Server) DeleteInvoiceItems(context context.Context, request *model.DeleteInvoiceItemsRequest) (*model.DeleteInvoiceItemsResponse, error) {
+
+	rowsAffected, err := dao.DeleteInvoiceItems(context, request.InvoiceLineID)
+	if err != nil {
+		response := &model.DeleteInvoiceItemsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.DeleteInvoiceItemsResponse{RowsAffected: rowsAffected, Result: &model.Result{Result: model.Result_Success}}
+	return response, nil
+}
+
+// GetAllInvoices is a RPC method to get a slice of record(s) from invoices table in the main database
+func (s *Server) GetAllInvoices(context context.Context, request *model.GetAllInvoicesRequest) (*model.GetAllInvoicesResponse, error) {
+
+	filter := &dao.InvoicesFilter{}
+	for _, condition := range request.Filters {
+		if err := filter.Set(condition.Column, condition.Op, condition.Values...); err != nil {
+			response := &model.GetAllInvoicesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+	}
+
+	if request.Keyset {
+		count := dao.CountMode(request.Count)
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			response := &model.GetAllInvoicesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("count: invalid mode %s", request.Count)}}
+			return response, nil
+		}
+
+		result, nextCursor, totalRows, err := dao.GetPageInvoices(context, request.Cursor, request.PageSize, request.Order, filter, count)
+		if err != nil {
+			response := &model.GetAllInvoicesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+
+		response := &model.GetAllInvoicesResponse{Result: &model.Result{Result: model.Result_Success}, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows), NextCursor: nextCursor}
+		return response, nil
+	}
+
+	result, totalRows, err := dao.GetAllInvoices(context, request.Page, request.PageSize, request.Order, filter)
+	if err != nil {
+		response := &model.GetAllInvoicesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetAllInvoicesResponse{Result: &model.Result{Result: model.Result_Success}, Page: request.Page, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows)}
+	return response, nil
+}
+
+// GetInvoices is a RPC method to get a single record from the invoices table in the main database
+func (s *Server) GetInvoices(context context.Context, request *model.GetInvoicesRequest) (*model.GetInvoicesResponse, error) {
+
+	result, err := dao.GetInvoices(context, request.InvoiceID)
+	if err != nil {
+		response := &model.GetInvoicesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetInvoicesResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	return response, nil
+}
+
+// AddInvoices is a RPC method to add a single record to invoices table in the main database
+func (s *Server) AddInvoices(context context.Context, request *model.AddInvoicesRequest) (*model.AddInvoicesResponse, error) {
+
+	result, rowsAffected, err := dao.AddInvoices(context, request.Data)
+	if err != nil {
+		response := &model.AddInvoicesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.AddInvoicesResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// UpsertInvoices is a RPC method to add or update records of invoices table in the main database in one transaction
+func (s *Server) UpsertInvoices(context context.Context, request *model.UpsertInvoicesRequest) (*model.UpsertInvoicesResponse, error) {
+
+	result, rowsAffected, err := s.upsertInvoices(context, request.Data, request.On)
+	if err != nil {
+		response := &model.UpsertInvoicesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpsertInvoicesResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// upsertInvoices upsert records in a transaction
+func (s *Server) upsertInvoices(ctx context.Context, records []*model.Invoices, conflict []string) ([]*model.Invoices, int64, error) {
+	rowsAffected := int64(0)
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, rows, err := dao.UpsertInvoices(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+			rowsAffected += rows
+		}
+		return nil
+	})
+	return records, rowsAffected, err
+}
+
+// BulkAddInvoices is a RPC method to add records to invoices table in the main database in one transaction
+func (s *Server) BulkAddInvoices(context context.Context, request *model.BulkAddInvoicesRequest) (*model.BulkAddInvoicesResponse, error) {
+
+	result, rowsAffected, err := s.bulkAddInvoices(context, request.Data, int(request.BatchSize))
+	if err != nil {
+		response := &model.BulkAddInvoicesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.BulkAddInvoicesResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// bulkAddInvoices add records in a transaction
+func (s *Server) bulkAddInvoices(ctx context.Context, records []*model.Invoices, batchSize int) (results []*model.Invoices, rowsAffected int64, err error) {
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		results, rowsAffected, err = dao.BulkAddInvoices(ctx, records, batchSize)
+		return err
+	})
+	return results, rowsAffected, err
+}
+
+// UpdateInvoices is a RPC method to Update a single record from invoices table in the main database
+func (s *Server) UpdateInvoices(context context.Context, request *model.UpdateInvoicesRequest) (*model.UpdateInvoicesResponse, error) {
+
+	result, rowsAffected, err := dao.UpdateInvoices(context,
+		request.Data.InvoiceID,
+		request.Data)
+	if err != nil {
+		response := &model.UpdateInvoicesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpdateInvoicesResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// DeleteInvoices is a RPC method to delete a single record from invoices table in the main database
+func (s *Server) DeleteInvoices(context context.Context, request *model.DeleteInvoicesRequest) (*model.DeleteInvoicesResponse, error) {
+
+	rowsAffected, err := dao.DeleteInvoices(context, request.InvoiceID)
+	if err != nil {
+		response := &model.DeleteInvoicesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.DeleteInvoicesResponse{RowsAffected: rowsAffected, Result: &model.Result{Result: model.Result_Success}}
+	return response, nil
+}
+
+// GetAllMediaTypes is a RPC method to get a slice of record(s) from media_types table in the main database
+func (s *Server) GetAllMediaTypes(context context.Context, request *model.GetAllMediaTypesRequest) (*model.GetAllMediaTypesResponse, error) {
+
+	filter := &dao.MediaTypesFilter{}
+	for _, condition := range request.Filters {
+		if err := filter.Set(condition.Column, condition.Op, condition.Values...); err != nil {
+			response := &model.GetAllMediaTypesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+	}
+
+	if request.Keyset {
+		count := dao.CountMode(request.Count)
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			response := &model.GetAllMediaTypesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("count: invalid mode %s", request.Count)}}
+			return response, nil
+		}
+
+		result, nextCursor, totalRows, err := dao.GetPageMediaTypes(context, request.Cursor, request.PageSize, request.Order, filter, count)
+		if err != nil {
+			response := &model.GetAllMediaTypesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+
+		response := &model.GetAllMediaTypesResponse{Result: &model.Result{Result: model.Result_Success}, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows), NextCursor: nextCursor}
+		return response, nil
+	}
+
+	result, totalRows, err := dao.GetAllMediaTypes(context, request.Page, request.PageSize, request.Order, filter)
+	if err != nil {
+		response := &model.GetAllMediaTypesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetAllMediaTypesResponse{Result: &model.Result{Result: model.Result_Success}, Page: request.Page, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows)}
+	return response, nil
+}
+
+// GetMediaTypes is a RPC method to get a single record from the media_types table in the main database
+func (s *Server) GetMediaTypes(context context.Context, request *model.GetMediaTypesRequest) (*model.GetMediaTypesResponse, error) {
+
+	result, err := dao.GetMediaTypes(context, request.MediaTypeID)
+	if err != nil {
+		response := &model.GetMediaTypesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetMediaTypesResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	return response, nil
+}
+
+// AddMediaTypes is a RPC method to add a single record to media_types table in the main database
+func (s *Server) AddMediaTypes(context context.Context, request *model.AddMediaTypesRequest) (*model.AddMediaTypesResponse, error) {
+
+	result, rowsAffected, err := dao.AddMediaTypes(context, request.Data)
+	if err != nil {
+		response := &model.AddMediaTypesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.AddMediaTypesResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// UpsertMediaTypes is a RPC method to add or update records of media_types table in the main database in one transaction
+func (s *Server) UpsertMediaTypes(context context.Context, request *model.UpsertMediaTypesRequest) (*model.UpsertMediaTypesResponse, error) {
+
+	result, rowsAffected, err := s.upsertMediaTypes(context, request.Data, request.On)
+	if err != nil {
+		response := &model.UpsertMediaTypesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpsertMediaTypesResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// upsertMediaTypes upsert records in a transaction
+func (s *Server) upsertMediaTypes(ctx context.Context, records []*model.MediaTypes, conflict []string) ([]*model.MediaTypes, int64, error) {
+	rowsAffected := int64(0)
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, rows, err := dao.UpsertMediaTypes(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+			rowsAffected += rows
+		}
+		return nil
+	})
+	return records, rowsAffected, err
+}
+
+// BulkAddMediaTypes is a RPC method to add records to media_types table in the main database in one transaction
+func (s *Server) BulkAddMediaTypes(context context.Context, request *model.BulkAddMediaTypesRequest) (*model.BulkAddMediaTypesResponse, error) {
+
+	result, rowsAffected, err := s.bulkAddMediaTypes(context, request.Data, int(request.BatchSize))
+	if err != nil {
+		response := &model.BulkAddMediaTypesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.BulkAddMediaTypesResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// bulkAddMediaTypes add records in a transaction
+func (s *Server) bulkAddMediaTypes(ctx context.Context, records []*model.MediaTypes, batchSize int) (results []*model.MediaTypes, rowsAffected int64, err error) {
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		results, rowsAffected, err = dao.BulkAddMediaTypes(ctx, records, batchSize)
+		return err
+	})
+	return results, rowsAffected, err
+}
+
+// UpdateMediaTypes is a RPC method to Update a single record from media_types table in the main database
+func (s *Server) UpdateMediaTypes(context context.Context, request *model.UpdateMediaTypesRequest) (*model.UpdateMediaTypesResponse, error) {
+
+	result, rowsAffected, err := dao.UpdateMediaTypes(context,
+		request.Data.MediaTypeID,
+		request.Data)
+	if err != nil {
+		response := &model.UpdateMediaTypesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpdateMediaTypesResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// DeleteMediaTypes is a RPC method to delete a single record from media_types table in the main database
+func (s *Server) DeleteMediaTypes(context context.Context, request *model.DeleteMediaTypesRequest) (*model.DeleteMediaTypesResponse, error) {
+
+	rowsAffected, err := dao.DeleteMediaTypes(context, request.MediaTypeID)
+	if err != nil {
+		response := &model.DeleteMediaTypesResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.DeleteMediaTypesResponse{RowsAffected: rowsAffected, Result: &model.Result{Result: model.Result_Success}}
+	return response, nil
+}
+
+// GetAllPlaylistTrack is a RPC method to get a slice of record(s) from playlist_track table in the main database
+func (s *Server) GetAllPlaylistTrack(context context.Context, request *model.GetAllPlaylistTrackRequest) (*model.GetAllPlaylistTrackResponse, error) {
+
+	filter := &dao.PlaylistTrackFilter{}
+	for _, condition := range request.Filters {
+		if err := filter.Set(condition.Column, condition.Op, condition.Values...); err != nil {
+			response := &model.GetAllPlaylistTrackResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+	}
+
+	if request.Keyset {
+		count := dao.CountMode(request.Count)
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			response := &model.GetAllPlaylistTrackResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("count: invalid mode %s", request.Count)}}
+			return response, nil
+		}
+
+		result, nextCursor, totalRows, err := dao.GetPagePlaylistTrack(context, request.Cursor, request.PageSize, request.Order, filter, count)
+		if err != nil {
+			response := &model.GetAllPlaylistTrackResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+
+		response := &model.GetAllPlaylistTrackResponse{Result: &model.Result{Result: model.Result_Success}, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows), NextCursor: nextCursor}
+		return response, nil
+	}
+
+	result, totalRows, err := dao.GetAllPlaylistTrack(context, request.Page, request.PageSize, request.Order, filter)
+	if err != nil {
+		response := &model.GetAllPlaylistTrackResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetAllPlaylistTrackResponse{Result: &model.Result{Result: model.Result_Success}, Page: request.Page, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows)}
+	return response, nil
+}
+
+// GetPlaylistTrack is a RPC method to get a single record from the playlist_track table in the main database
+func (s *Server) GetPlaylistTrack(context context.Context, request *model.GetPlaylistTrackRequest) (*model.GetPlaylistTrackResponse, error) {
+
+	result, err := dao.GetPlaylistTrack(context, request.PlaylistID)
+	if err != nil {
+		response := &model.GetPlaylistTrackResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetPlaylistTrackResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	return response, nil
+}
+
+// AddPlaylistTrack is a RPC method to add a single record to playlist_track table in the main database
+func (s *Server) AddPlaylistTrack(context context.Context, request *model.AddPlaylistTrackRequest) (*model.AddPlaylistTrackResponse, error) {
+
+	result, rowsAffected, err := dao.AddPlaylistTrack(context, request.Data)
+	if err != nil {
+		response := &model.AddPlaylistTrackResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.AddPlaylistTrackResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// UpsertPlaylistTrack is a RPC method to add or update records of playlist_track table in the main database in one transaction
+func (s *Server) UpsertPlaylistTrack(context context.Context, request *model.UpsertPlaylistTrackRequest) (*model.UpsertPlaylistTrackResponse, error) {
+
+	result, rowsAffected, err := s.upsertPlaylistTrack(context, request.Data, request.On)
+	if err != nil {
+		response := &model.UpsertPlaylistTrackResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpsertPlaylistTrackResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// upsertPlaylistTrack upsert records in a transaction
+func (s *Server) upsertPlaylistTrack(ctx context.Context, records []*model.PlaylistTrack, conflict []string) ([]*model.PlaylistTrack, int64, error) {
+	rowsAffected := int64(0)
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, rows, err := dao.UpsertPlaylistTrack(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+			rowsAffected += rows
+		}
+		return nil
+	})
+	return records, rowsAffected, err
+}
+
+// BulkAddPlaylistTrack is a RPC method to add records to playlist_track table in the main database in one transaction
+func (s *Server) BulkAddPlaylistTrack(context context.Context, request *model.BulkAddPlaylistTrackRequest) (*model.BulkAddPlaylistTrackResponse, error) {
+
+	result, rowsAffected, err := s.bulkAddPlaylistTrack(context, request.Data, int(request.BatchSize))
+	if err != nil {
+		response := &model.BulkAddPlaylistTrackResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.BulkAddPlaylistTrackResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// bulkAddPlaylistTrack add records in a transaction
+func (s *Server) bulkAddPlaylistTrack(ctx context.Context, records []*model.PlaylistTrack, batchSize int) (results []*model.PlaylistTrack, rowsAffected int64, err error) {
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		results, rowsAffected, err = dao.BulkAddPlaylistTrack(ctx, records, batchSize)
+		return err
+	})
+	return results, rowsAffected, err
+}
+
+// UpdatePlaylistTrack is a RPC method to Update a single record from playlist_track table in the main database
+func (s *Server) UpdatePlaylistTrack(context context.Context, request *model.UpdatePlaylistTrackRequest) (*model.UpdatePlaylistTrackResponse, error) {
+
+	result, rowsAffected, err := dao.UpdatePlaylistTrack(context,
+		request.Data.PlaylistID,
+		request.Data)
+	if err != nil {
+		response := &model.UpdatePlaylistTrackResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpdatePlaylistTrackResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// DeletePlaylistTrack is a RPC method to delete a single record from playlist_track table in the main database
+func (s *Server) DeletePlaylistTrack(context context.Context, request *model.DeletePlaylistTrackRequest) (*model.DeletePlaylistTrackResponse, error) {
+
+	rowsAffected, err := dao.DeletePlaylistTrack(context, request.PlaylistID)
+	if err != nil {
+		response := &model.DeletePlaylistTrackResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.DeletePlaylistTrackResponse{RowsAffected: rowsAffected, Result: &model.Result{Result: model.Result_Success}}
+	return response, nil
+}
+
+// GetAllPlaylists is a RPC method to get a slice of record(s) from playlists table in the main database
+func (s *Server) GetAllPlaylists(context context.Context, request *model.GetAllPlaylistsRequest) (*model.GetAllPlaylistsResponse, error) {
+
+	filter := &dao.PlaylistsFilter{}
+	for _, condition := range request.Filters {
+		if err := filter.Set(condition.Column, condition.Op, condition.Values...); err != nil {
+			response := &model.GetAllPlaylistsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+	}
+
+	if request.Keyset {
+		count := dao.CountMode(request.Count)
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			response := &model.GetAllPlaylistsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("count: invalid mode %s", request.Count)}}
+			return response, nil
+		}
+
+		result, nextCursor, totalRows, err := dao.GetPagePlaylists(context, request.Cursor, request.PageSize, request.Order, filter, count)
+		if err != nil {
+			response := &model.GetAllPlaylistsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+
+		response := &model.GetAllPlaylistsResponse{Result: &model.Result{Result: model.Result_Success}, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows), NextCursor: nextCursor}
+		return response, nil
+	}
+
+	result, totalRows, err := dao.GetAllPlaylists(context, request.Page, request.PageSize, request.Order, filter)
+	if err != nil {
+		response := &model.GetAllPlaylistsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetAllPlaylistsResponse{Result: &model.Result{Result: model.Result_Success}, Page: request.Page, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows)}
+	return response, nil
+}
+
+// GetPlaylists is a RPC method to get a single record from the playlists table in the main database
+func (s *Server) GetPlaylists(context context.Context, request *model.GetPlaylistsRequest) (*model.GetPlaylistsResponse, error) {
+
+	result, err := dao.GetPlaylists(context, request.PlaylistID)
+	if err != nil {
+		response := &model.GetPlaylistsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetPlaylistsResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	return response, nil
+}
+
+// AddPlaylists is a RPC method to add a single record to playlists table in the main database
+func (s *Server) AddPlaylists(context context.Context, request *model.AddPlaylistsRequest) (*model.AddPlaylistsResponse, error) {
+
+	result, rowsAffected, err := dao.AddPlaylists(context, request.Data)
+	if err != nil {
+		response := &model.AddPlaylistsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.AddPlaylistsResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// UpsertPlaylists is a RPC method to add or update records of playlists table in the main database in one transaction
+func (s *Server) UpsertPlaylists(context context.Context, request *model.UpsertPlaylistsRequest) (*model.UpsertPlaylistsResponse, error) {
+
+	result, rowsAffected, err := s.upsertPlaylists(context, request.Data, request.On)
+	if err != nil {
+		response := &model.UpsertPlaylistsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpsertPlaylistsResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// upsertPlaylists upsert records in a transaction
+func (s *Server) upsertPlaylists(ctx context.Context, records []*model.Playlists, conflict []string) ([]*model.Playlists, int64, error) {
+	rowsAffected := int64(0)
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, rows, err := dao.UpsertPlaylists(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+			rowsAffected += rows
+		}
+		return nil
+	})
+	return records, rowsAffected, err
+}
+
+// BulkAddPlaylists is a RPC method to add records to playlists table in the main database in one transaction
+func (s *Server) BulkAddPlaylists(context context.Context, request *model.BulkAddPlaylistsRequest) (*model.BulkAddPlaylistsResponse, error) {
+
+	result, rowsAffected, err := s.bulkAddPlaylists(context, request.Data, int(request.BatchSize))
+	if err != nil {
+		response := &model.BulkAddPlaylistsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.BulkAddPlaylistsResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// bulkAddPlaylists add records in a transaction
+func (s *Server) bulkAddPlaylists(ctx context.Context, records []*model.Playlists, batchSize int) (results []*model.Playlists, rowsAffected int64, err error) {
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		results, rowsAffected, err = dao.BulkAddPlaylists(ctx, records, batchSize)
+		return err
+	})
+	return results, rowsAffected, err
+}
+
+// UpdatePlaylists is a RPC method to Update a single record from playlists table in the main database
+func (s *Server) UpdatePlaylists(context context.Context, request *model.UpdatePlaylistsRequest) (*model.UpdatePlaylistsResponse, error) {
+
+	result, rowsAffected, err := dao.UpdatePlaylists(context,
+		request.Data.PlaylistID,
+		request.Data)
+	if err != nil {
+		response := &model.UpdatePlaylistsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpdatePlaylistsResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// DeletePlaylists is a RPC method to delete a single record from playlists table in the main database
+func (s *Server) DeletePlaylists(context context.Context, request *model.DeletePlaylistsRequest) (*model.DeletePlaylistsResponse, error) {
+
+	rowsAffected, err := dao.DeletePlaylists(context, request.PlaylistID)
+	if err != nil {
+		response := &model.DeletePlaylistsResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.DeletePlaylistsResponse{RowsAffected: rowsAffected, Result: &model.Result{Result: model.Result_Success}}
+	return response, nil
+}
+
+// GetAllPurchaseOrder is a RPC method to get a slice of record(s) from purchase_order table in the main database
+func (s *Server) GetAllPurchaseOrder(context context.Context, request *model.GetAllPurchaseOrderRequest) (*model.GetAllPurchaseOrderResponse, error) {
+
+	filter := &dao.PurchaseOrderFilter{}
+	for _, condition := range request.Filters {
+		if err := filter.Set(condition.Column, condition.Op, condition.Values...); err != nil {
+			response := &model.GetAllPurchaseOrderResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+	}
+
+	if request.Keyset {
+		count := dao.CountMode(request.Count)
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			response := &model.GetAllPurchaseOrderResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("count: invalid mode %s", request.Count)}}
+			return response, nil
+		}
+
+		result, nextCursor, totalRows, err := dao.GetPagePurchaseOrder(context, request.Cursor, request.PageSize, request.Order, filter, count)
+		if err != nil {
+			response := &model.GetAllPurchaseOrderResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+
+		response := &model.GetAllPurchaseOrderResponse{Result: &model.Result{Result: model.Result_Success}, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows), NextCursor: nextCursor}
+		return response, nil
+	}
+
+	result, totalRows, err := dao.GetAllPurchaseOrder(context, request.Page, request.PageSize, request.Order, filter)
+	if err != nil {
+		response := &model.GetAllPurchaseOrderResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetAllPurchaseOrderResponse{Result: &model.Result{Result: model.Result_Success}, Page: request.Page, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows)}
+	return response, nil
+}
+
+// GetPurchaseOrder is a RPC method to get a single record from the purchase_order table in the main database
+func (s *Server) GetPurchaseOrder(context context.Context, request *model.GetPurchaseOrderRequest) (*model.GetPurchaseOrderResponse, error) {
+
+	result, err := dao.GetPurchaseOrder(context, request.ID)
+	if err != nil {
+		response := &model.GetPurchaseOrderResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetPurchaseOrderResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	return response, nil
+}
+
+// AddPurchaseOrder is a RPC method to add a single record to purchase_order table in the main database
+func (s *Server) AddPurchaseOrder(context context.Context, request *model.AddPurchaseOrderRequest) (*model.AddPurchaseOrderResponse, error) {
+
+	result, rowsAffected, err := dao.AddPurchaseOrder(context, request.Data)
+	if err != nil {
+		response := &model.AddPurchaseOrderResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.AddPurchaseOrderResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// UpsertPurchaseOrder is a RPC method to add or update records of purchase_order table in the main database in one transaction
+func (s *Server) UpsertPurchaseOrder(context context.Context, request *model.UpsertPurchaseOrderRequest) (*model.UpsertPurchaseOrderResponse, error) {
+
+	result, rowsAffected, err := s.upsertPurchaseOrder(context, request.Data, request.On)
+	if err != nil {
+		response := &model.UpsertPurchaseOrderResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpsertPurchaseOrderResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// upsertPurchaseOrder upsert records in a transaction
+func (s *Server) upsertPurchaseOrder(ctx context.Context, records []*model.PurchaseOrder, conflict []string) ([]*model.PurchaseOrder, int64, error) {
+	rowsAffected := int64(0)
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, rows, err := dao.UpsertPurchaseOrder(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+			rowsAffected += rows
+		}
+		return nil
+	})
+	return records, rowsAffected, err
+}
+
+// BulkAddPurchaseOrder is a RPC method to add records to purchase_order table in the main database in one transaction
+func (s *Server) BulkAddPurchaseOrder(context context.Context, request *model.BulkAddPurchaseOrderRequest) (*model.BulkAddPurchaseOrderResponse, error) {
+
+	result, rowsAffected, err := s.bulkAddPurchaseOrder(context, request.Data, int(request.BatchSize))
+	if err != nil {
+		response := &model.BulkAddPurchaseOrderResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.BulkAddPurchaseOrderResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// bulkAddPurchaseOrder add records in a transaction
+func (s *Server) bulkAddPurchaseOrder(ctx context.Context, records []*model.PurchaseOrder, batchSize int) (results []*model.PurchaseOrder, rowsAffected int64, err error) {
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		results, rowsAffected, err = dao.BulkAddPurchaseOrder(ctx, records, batchSize)
+		return err
+	})
+	return results, rowsAffected, err
+}
+
+// UpdatePurchaseOrder is a RPC method to Update a single record from purchase_order table in the main database
+func (s *Server) UpdatePurchaseOrder(context context.Context, request *model.UpdatePurchaseOrderRequest) (*model.UpdatePurchaseOrderResponse, error) {
+
+	result, rowsAffected, err := dao.UpdatePurchaseOrder(context,
+		request.Data.ID,
+		request.Data)
+	if err != nil {
+		response := &model.UpdatePurchaseOrderResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpdatePurchaseOrderResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// DeletePurchaseOrder is a RPC method to delete a single record from purchase_order table in the main database
+func (s *Server) DeletePurchaseOrder(context context.Context, request *model.DeletePurchaseOrderRequest) (*model.DeletePurchaseOrderResponse, error) {
+
+	rowsAffected, err := dao.DeletePurchaseOrder(context, request.ID)
+	if err != nil {
+		response := &model.DeletePurchaseOrderResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.DeletePurchaseOrderResponse{RowsAffected: rowsAffected, Result: &model.Result{Result: model.Result_Success}}
+	return response, nil
+}
+
+// GetAllTracks is a RPC method to get a slice of record(s) from tracks table in the main database
+func (s *Server) GetAllTracks(context context.Context, request *model.GetAllTracksRequest) (*model.GetAllTracksResponse, error) {
+
+	filter := &dao.TracksFilter{}
+	for _, condition := range request.Filters {
+		if err := filter.Set(condition.Column, condition.Op, condition.Values...); err != nil {
+			response := &model.GetAllTracksResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+	}
+
+	if request.Keyset {
+		count := dao.CountMode(request.Count)
+		if count != dao.CountNone && count != dao.CountExact && count != dao.CountEstimate {
+			response := &model.GetAllTracksResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("count: invalid mode %s", request.Count)}}
+			return response, nil
+		}
+
+		result, nextCursor, totalRows, err := dao.GetPageTracks(context, request.Cursor, request.PageSize, request.Order, filter, count)
+		if err != nil {
+			response := &model.GetAllTracksResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+			return response, nil
+		}
+
+		response := &model.GetAllTracksResponse{Result: &model.Result{Result: model.Result_Success}, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows), NextCursor: nextCursor}
+		return response, nil
+	}
+
+	result, totalRows, err := dao.GetAllTracks(context, request.Page, request.PageSize, request.Order, filter)
+	if err != nil {
+		response := &model.GetAllTracksResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetAllTracksResponse{Result: &model.Result{Result: model.Result_Success}, Page: request.Page, PageSize: request.PageSize, Data: result, TotalRecords: int64(totalRows)}
+	return response, nil
+}
+
+// GetTracks is a RPC method to get a single record from the tracks table in the main database
+func (s *Server) GetTracks(context context.Context, request *model.GetTracksRequest) (*model.GetTracksResponse, error) {
+
+	result, err := dao.GetTracks(context, request.TrackID)
+	if err != nil {
+		response := &model.GetTracksResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.GetTracksResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	return response, nil
+}
+
+// AddTracks is a RPC method to add a single record to tracks table in the main database
+func (s *Server) AddTracks(context context.Context, request *model.AddTracksRequest) (*model.AddTracksResponse, error) {
+
+	result, rowsAffected, err := dao.AddTracks(context, request.Data)
+	if err != nil {
+		response := &model.AddTracksResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.AddTracksResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// UpsertTracks is a RPC method to add or update records of tracks table in the main database in one transaction
+func (s *Server) UpsertTracks(context context.Context, request *model.UpsertTracksRequest) (*model.UpsertTracksResponse, error) {
+
+	result, rowsAffected, err := s.upsertTracks(context, request.Data, request.On)
+	if err != nil {
+		response := &model.UpsertTracksResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpsertTracksResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// upsertTracks upsert records in a transaction
+func (s *Server) upsertTracks(ctx context.Context, records []*model.Tracks, conflict []string) ([]*model.Tracks, int64, error) {
+	rowsAffected := int64(0)
+	err := dao.WithTx(ctx, func(ctx context.Context) error {
+		for i, record := range records {
+			result, rows, err := dao.UpsertTracks(ctx, record, conflict...)
+			if err != nil {
+				return err
+			}
+			records[i] = result
+			rowsAffected += rows
+		}
+		return nil
+	})
+	return records, rowsAffected, err
+}
+
+// BulkAddTracks is a RPC method to add records to tracks table in the main database in one transaction
+func (s *Server) BulkAddTracks(context context.Context, request *model.BulkAddTracksRequest) (*model.BulkAddTracksResponse, error) {
+
+	result, rowsAffected, err := s.bulkAddTracks(context, request.Data, int(request.BatchSize))
+	if err != nil {
+		response := &model.BulkAddTracksResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.BulkAddTracksResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// bulkAddTracks add records in a transaction
+func (s *Server) bulkAddTracks(ctx context.Context, records []*model.Tracks, batchSize int) (results []*model.Tracks, rowsAffected int64, err error) {
+	err = dao.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		results, rowsAffected, err = dao.BulkAddTracks(ctx, records, batchSize)
+		return err
+	})
+	return results, rowsAffected, err
+}
+
+// UpdateTracks is a RPC method to Update a single record from tracks table in the main database
+func (s *Server) UpdateTracks(context context.Context, request *model.UpdateTracksRequest) (*model.UpdateTracksResponse, error) {
+
+	result, rowsAffected, err := dao.UpdateTracks(context,
+		request.Data.TrackID,
+		request.Data)
+	if err != nil {
+		response := &model.UpdateTracksResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.UpdateTracksResponse{}
+	response.Data = result
+	response.Result = &model.Result{Result: model.Result_Success}
+	response.RowsAffected = rowsAffected
+	return response, nil
+}
+
+// DeleteTracks is a RPC method to delete a single record from tracks table in the main database
+func (s *Server) DeleteTracks(context context.Context, request *model.DeleteTracksRequest) (*model.DeleteTracksResponse, error) {
+
+	rowsAffected, err := dao.DeleteTracks(context, request.TrackID)
+	if err != nil {
+		response := &model.DeleteTracksResponse{Result: &model.Result{Result: model.Result_Error, Message_: fmt.Sprintf("%v", err)}}
+		return response, nil
+	}
+
+	response := &model.DeleteTracksResponse{RowsAffected: rowsAffected, Result: &model.Result{Result: model.Result_Success}}
+	return response, nil
+}
+
+// ServerConfig server configuration
+type ServerConfig struct {
+	ListenAddr          string `yaml:"listen_on"`
+	DatabaseURI         string `yaml:"db_uri"`
+	MaxMessageSizeBytes int    `yaml:"max_message_size_bytes"`
+	ServerCert          string `yaml:"server_cert"`
+	ServerKey           string `yaml:"server_key"`
+	CACert              string `yaml:"ca_cert"`
+}
+
+// LoadConfig load server configuration from file pointed to from BACKEND_CONFIG env variable
+func LoadConfig() ServerConfig {
+	configPath := os.Getenv("BACKEND_CONFIG")
+	content, err := ioutil.ReadFile(configPath)
+	if err != nil {
+		log.Fatalf(
+			"Error loading config from BACKEND_CONFIG=%s: %v",
+			configPath,
+			err,
+		)
+	}
+
+	config := ServerConfig{}
+	err = yaml.Unmarshal([]byte(content), &config)
+	if err != nil {
+		log.Fatalf("Error parsing config: %v", err)
+	}
+	return config
+}
+
+// NewServerFromConfig create a server from a ServerConfig
+func NewServerFromConfig(cfg ServerConfig) *Server {
+	dao.Actor = UserName
+
+	return &Server{}
+}
+
+// UserName retrieve the name of the user of a call from the UserMetadataKey gRPC metadata of ctx if available, the
+// actor of the dao audit columns
+func UserName(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	values := md.Get(UserMetadataKey)
+	if len(values) == 0 || values[0] == "" {
+		return "", false
+	}
+	return values[0], true
+}
+
+// Cleanup server resources
+func (s *Server) Cleanup() {
+}
@@ -0,0 +1,301 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	// gen:begin custom-imports
+	// gen:end
+)
+
+var (
+	_ = datatypes.JSON{}
+)
+
+/*
+DB Table Details
+-------------------------------------
+
+
+CREATE TABLE "invoices"
+(
+    [InvoiceId] INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    [CustomerId] INTEGER  NOT NULL,
+    [InvoiceDate] DATETIME  NOT NULL,
+    [BillingAddress] NVARCHAR(70),
+    [BillingCity] NVARCHAR(40),
+    [BillingState] NVARCHAR(40),
+    [BillingCountry] NVARCHAR(40),
+    [BillingPostalCode] NVARCHAR(10),
+    [Total] NUMERIC(10,2)  NOT NULL,
+    FOREIGN KEY ([CustomerId]) REFERENCES "customers" ([CustomerId])
+		ON DELETE NO ACTION ON UPDATE NO ACTION
+)
+
+JSON Sample
+-------------------------------------
+{    "invoice_id": 33,    "customer_id": 66,    "invoice_date": "2020-01-23T05:24:50Z",    "billing_address": "voQxveuavXlKMDAmGUCDmDDUt",    "billing_city": "uuwIfeIplkkgqRrBSuOHQGCkK",    "billing_state": "YPDMZBqQDEPQSwvdDgpLbXuEf",    "billing_country": "NuBsUvxGnZSwSAMmiYbNMGTLS",    "billing_postal_code": "NvXQvHJkDJjxVWWMlbCTcwiZA",    "total": 0.4922718791101031}
+
+
+
+*/
+
+// Invoices struct is a row record of the invoices table in the main database
+/*
+type Invoices struct {
+    //[ 0] InvoiceId                                      integer              null: false  primary: true   isArray: false  auto: true   col: integer         len: -1      default: []
+    InvoiceID int32 `gorm:"primary_key;AUTO_INCREMENT;column:InvoiceId;type:integer;" json:"invoice_id" xml:"invoice_id" db:"InvoiceId" protobuf:"int32,0,opt,name=invoice_id"`
+    //[ 1] CustomerId                                     integer              null: false  primary: false  isArray: false  auto: false  col: integer         len: -1      default: []
+    CustomerID int32 `gorm:"column:CustomerId;type:integer;" json:"customer_id" xml:"customer_id" db:"CustomerId" protobuf:"int32,1,opt,name=customer_id"`
+    //[ 2] InvoiceDate                                    datetime             null: false  primary: false  isArray: false  auto: false  col: datetime        len: -1      default: []
+    InvoiceDate time.Time `gorm:"column:InvoiceDate;type:datetime;" json:"invoice_date" xml:"invoice_date" db:"InvoiceDate" protobuf:"google.protobuf.Timestamp,2,opt,name=invoice_date"`
+    //[ 3] BillingAddress                                 nvarchar(70)         null: true   primary: false  isArray: false  auto: false  col: nvarchar        len: 70      default: []
+    BillingAddress sql.NullString `gorm:"column:BillingAddress;type:nvarchar;size:70;" json:"billing_address" xml:"billing_address" db:"BillingAddress" protobuf:"string,3,opt,name=billing_address"`
+    //[ 4] BillingCity                                    nvarchar(40)         null: true   primary: false  isArray: false  auto: false  col: nvarchar        len: 40      default: []
+    BillingCity sql.NullString `gorm:"column:BillingCity;type:nvarchar;size:40;" json:"billing_city" xml:"billing_city" db:"BillingCity" protobuf:"string,4,opt,name=billing_city"`
+    //[ 5] BillingState                                   nvarchar(40)         null: true   primary: false  isArray: false  auto: false  col: nvarchar        len: 40      default: []
+    BillingState sql.NullString `gorm:"column:BillingState;type:nvarchar;size:40;" json:"billing_state" xml:"billing_state" db:"BillingState" protobuf:"string,5,opt,name=billing_state"`
+    //[ 6] BillingCountry                                 nvarchar(40)         null: true   primary: false  isArray: false  auto: false  col: nvarchar        len: 40      default: []
+    BillingCountry sql.NullString `gorm:"column:BillingCountry;type:nvarchar;size:40;" json:"billing_country" xml:"billing_country" db:"BillingCountry" protobuf:"string,6,opt,name=billing_country"`
+    //[ 7] BillingPostalCode                              nvarchar(10)         null: true   primary: false  isArray: false  auto: false  col: nvarchar        len: 10      default: []
+    BillingPostalCode sql.NullString `gorm:"column:BillingPostalCode;type:nvarchar;size:10;" json:"billing_postal_code" xml:"billing_postal_code" db:"BillingPostalCode" protobuf:"string,7,opt,name=billing_postal_code"`
+    //[ 8] Total                                          numeric              null: false  primary: false  isArray: false  auto: false  col: numeric         len: -1      default: []
+    Total float64 `gorm:"column:Total;type:numeric;" json:"total" xml:"total" db:"Total" protobuf:"float,8,opt,name=total"`
+
+}
+*/
+
+var invoicesTableInfo = &TableInfo{
+	Name: "invoices",
+	Columns: []*ColumnInfo{
+
+		{
+			Index:              0,
+			Name:               "InvoiceId",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "integer",
+			DatabaseTypePretty: "integer",
+			IsPrimaryKey:       true,
+			IsAutoIncrement:    true,
+			IsArray:            false,
+			ColumnType:         "integer",
+			ColumnLength:       -1,
+			GoFieldName:        "InvoiceID",
+			GoFieldType:        "int32",
+			JSONFieldName:      "invoice_id",
+			ProtobufFieldName:  "invoice_id",
+			ProtobufType:       "int32",
+			ProtobufPos:        1,
+		},
+
+		{
+			Index:              1,
+			Name:               "CustomerId",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "integer",
+			DatabaseTypePretty: "integer",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "integer",
+			ColumnLength:       -1,
+			GoFieldName:        "CustomerID",
+			GoFieldType:        "int32",
+			JSONFieldName:      "customer_id",
+			ProtobufFieldName:  "customer_id",
+			ProtobufType:       "int32",
+			ProtobufPos:        2,
+		},
+
+		{
+			Index:              2,
+			Name:               "InvoiceDate",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "datetime",
+			DatabaseTypePretty: "datetime",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "datetime",
+			ColumnLength:       -1,
+			GoFieldName:        "InvoiceDate",
+			GoFieldType:        "time.Time",
+			JSONFieldName:      "invoice_date",
+			ProtobufFieldName:  "invoice_date",
+			ProtobufType:       "google.protobuf.Timestamp",
+			ProtobufPos:        3,
+		},
+
+		{
+			Index:              3,
+			Name:               "BillingAddress",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "nvarchar",
+			DatabaseTypePretty: "nvarchar(70)",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "nvarchar",
+			ColumnLength:       70,
+			GoFieldName:        "BillingAddress",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "billing_address",
+			ProtobufFieldName:  "billing_address",
+			ProtobufType:       "string",
+			ProtobufPos:        4,
+		},
+
+		{
+			Index:              4,
+			Name:               "BillingCity",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "nvarchar",
+			DatabaseTypePretty: "nvarchar(40)",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "nvarchar",
+			ColumnLength:       40,
+			GoFieldName:        "BillingCity",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "billing_city",
+			ProtobufFieldName:  "billing_city",
+			ProtobufType:       "string",
+			ProtobufPos:        5,
+		},
+
+		{
+			Index:              5,
+			Name:               "BillingState",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "nvarchar",
+			DatabaseTypePretty: "nvarchar(40)",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "nvarchar",
+			ColumnLength:       40,
+			GoFieldName:        "BillingState",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "billing_state",
+			ProtobufFieldName:  "billing_state",
+			ProtobufType:       "string",
+			ProtobufPos:        6,
+		},
+
+		{
+			Index:              6,
+			Name:               "BillingCountry",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "nvarchar",
+			DatabaseTypePretty: "nvarchar(40)",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "nvarchar",
+			ColumnLength:       40,
+			GoFieldName:        "BillingCountry",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "billing_country",
+			ProtobufFieldName:  "billing_country",
+			ProtobufType:       "string",
+			ProtobufPos:        7,
+		},
+
+		{
+			Index:              7,
+			Name:               "BillingPostalCode",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "nvarchar",
+			DatabaseTypePretty: "nvarchar(10)",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "nvarchar",
+			ColumnLength:       10,
+			GoFieldName:        "BillingPostalCode",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "billing_postal_code",
+			ProtobufFieldName:  "billing_postal_code",
+			ProtobufType:       "string",
+			ProtobufPos:        8,
+		},
+
+		{
+			Index:              8,
+			Name:               "Total",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "numeric",
+			DatabaseTypePretty: "numeric",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "numeric",
+			ColumnLength:       -1,
+			GoFieldName:        "Total",
+			GoFieldType:        "float64",
+			JSONFieldName:      "total",
+			ProtobufFieldName:  "total",
+			ProtobufType:       "float",
+			ProtobufPos:        9,
+		},
+	},
+}
+
+// TableName sets the insert table name for this struct type
+func (i *Invoices) TableName() string {
+	return "invoices"
+}
+
+// BeforeSave invoked before saving, return an error if field is not populated.
+func (i *Invoices) BeforeSave(tx *gorm.DB) error {
+	// gen:begin custom-before-save
+	// gen:end
+	return nil
+}
+
+// Prepare invoked before saving, can be used to populate fields etc.
+// The audit fields are read only in request bodies, they are cleared and set by the dao functions.
+func (i *Invoices) Prepare() {
+	i.InvoiceDate = time.Time{}
+	i.BillingCity = sql.NullString{}
+	// gen:begin custom-prepare
+	// gen:end
+}
+
+// Validate invoked before performing action, return an error if field is not populated.
+func (i *Invoices) Validate(action Action) error {
+	// gen:begin custom-validate
+	// gen:end
+	return nil
+}
+
+// TableInfo return table meta data
+func (i *Invoices) TableInfo() *TableInfo {
+	return invoicesTableInfo
+}
+
+// gen:begin custom-code
+// gen:end
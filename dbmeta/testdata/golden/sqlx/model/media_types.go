@@ -0,0 +1,128 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	// gen:begin custom-imports
+	// gen:end
+)
+
+var (
+	_ = datatypes.JSON{}
+)
+
+/*
+DB Table Details
+-------------------------------------
+
+
+CREATE TABLE "media_types"
+(
+    [MediaTypeId] INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    [Name] NVARCHAR(120)
+)
+
+JSON Sample
+-------------------------------------
+{    "media_type_id": 94,    "name": "LJUlwBVLqEKdRaZuvogKeYXeN"}
+
+
+
+*/
+
+// MediaTypes struct is a row record of the media_types table in the main database
+/*
+type MediaTypes struct {
+    //[ 0] MediaTypeId                                    integer              null: false  primary: true   isArray: false  auto: true   col: integer         len: -1      default: []
+    MediaTypeID int32 `gorm:"primary_key;AUTO_INCREMENT;column:MediaTypeId;type:integer;" json:"media_type_id" xml:"media_type_id" db:"MediaTypeId" protobuf:"int32,0,opt,name=media_type_id"`
+    //[ 1] Name                                           nvarchar(120)        null: true   primary: false  isArray: false  auto: false  col: nvarchar        len: 120     default: []
+    Name sql.NullString `gorm:"column:Name;type:nvarchar;size:120;" json:"name" xml:"name" db:"Name" protobuf:"string,1,opt,name=name"`
+
+}
+*/
+
+var media_typesTableInfo = &TableInfo{
+	Name: "media_types",
+	Columns: []*ColumnInfo{
+
+		{
+			Index:              0,
+			Name:               "MediaTypeId",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           false,
+			DatabaseTypeName:   "integer",
+			DatabaseTypePretty: "integer",
+			IsPrimaryKey:       true,
+			IsAutoIncrement:    true,
+			IsArray:            false,
+			ColumnType:         "integer",
+			ColumnLength:       -1,
+			GoFieldName:        "MediaTypeID",
+			GoFieldType:        "int32",
+			JSONFieldName:      "media_type_id",
+			ProtobufFieldName:  "media_type_id",
+			ProtobufType:       "int32",
+			ProtobufPos:        1,
+		},
+
+		{
+			Index:              1,
+			Name:               "Name",
+			Comment:            ``,
+			Notes:              ``,
+			Nullable:           true,
+			DatabaseTypeName:   "nvarchar",
+			DatabaseTypePretty: "nvarchar(120)",
+			IsPrimaryKey:       false,
+			IsAutoIncrement:    false,
+			IsArray:            false,
+			ColumnType:         "nvarchar",
+			ColumnLength:       120,
+			GoFieldName:        "Name",
+			GoFieldType:        "sql.NullString",
+			JSONFieldName:      "name",
+			ProtobufFieldName:  "name",
+			ProtobufType:       "string",
+			ProtobufPos:        2,
+		},
+	},
+}
+
+// TableName sets the insert table name for this struct type
+func (m *MediaTypes) TableName() string {
+	return "media_types"
+}
+
+// BeforeSave invoked before saving, return an error if field is not populated.
+func (m *MediaTypes) BeforeSave(tx *gorm.DB) error {
+	// gen:begin custom-before-save
+	// gen:end
+	return nil
+}
+
+// Prepare invoked before saving, can be used to populate fields etc.
+func (m *MediaTypes) Prepare() {
+	// gen:begin custom-prepare
+	// gen:end
+}
+
+// Validate invoked before performing action, return an error if field is not populated.
+func (m *MediaTypes) Validate(action Action) error {
+	// gen:begin custom-validate
+	// gen:end
+	return nil
+}
+
+// TableInfo return table meta data
+func (m *MediaTypes) TableInfo() *TableInfo {
+	return media_typesTableInfo
+}
+
+// gen:begin custom-code
+// gen:end
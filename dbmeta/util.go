@@ -48,6 +48,30 @@ var commonInitialisms = map[string]bool{
 	"ACL":   true,
 }
 
+// defaultInitialisms the built in initialisms, UpdateInitialisms starts from them
+var defaultInitialisms = copyInitialisms(commonInitialisms)
+
+func copyInitialisms(initialisms map[string]bool) map[string]bool {
+	result := make(map[string]bool, len(initialisms))
+	for word, ok := range initialisms {
+		result[word] = ok
+	}
+	return result
+}
+
+// UpdateInitialisms set the initialisms FmtFieldName writes in upper case to the built in initialisms with add added
+// and remove removed
+func UpdateInitialisms(add, remove []string) {
+	initialisms := copyInitialisms(defaultInitialisms)
+	for _, word := range add {
+		initialisms[strings.ToUpper(word)] = true
+	}
+	for _, word := range remove {
+		delete(initialisms, strings.ToUpper(word))
+	}
+	commonInitialisms = initialisms
+}
+
 var intToWordMap = []string{
 	"zero",
 	"one",
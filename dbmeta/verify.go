@@ -0,0 +1,58 @@
+package dbmeta
+
+import (
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var buildIssueExp = regexp.MustCompile(`^(?:vet: )?([^\s:][^:]*\.go):(\d+)(?::(\d+))?: (.*)$`)
+
+// BuildIssue an error reported by go build or go vet for a generated file
+type BuildIssue struct {
+	File    string
+	Line    int
+	Column  int
+	Message string
+	// Template the template that produced the file, empty if the file was not generated in this run
+	Template string
+	Table    string
+}
+
+// ParseBuildOutput parse the output of go build or go vet run in the output dir, mapping every reported file back to
+// the template and table it was generated from. Lines that do not reference a file are returned in other.
+func (c *Config) ParseBuildOutput(output string) (issues []*BuildIssue, other []string) {
+	outputs := make(map[string]*OutputFile, len(c.Outputs))
+	for _, output := range c.Outputs {
+		outputs[filepath.Clean(output.Path)] = output
+	}
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if line == "" || strings.HasPrefix(line, "# ") {
+			continue
+		}
+
+		match := buildIssueExp.FindStringSubmatch(line)
+		if match == nil {
+			other = append(other, line)
+			continue
+		}
+
+		issue := &BuildIssue{File: filepath.ToSlash(filepath.Clean(match[1])), Message: match[4]}
+		issue.Line, _ = strconv.Atoi(match[2])
+		issue.Column, _ = strconv.Atoi(match[3])
+
+		path := filepath.FromSlash(match[1])
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(c.OutDir, path)
+		}
+		if generated, ok := outputs[filepath.Clean(path)]; ok {
+			issue.Template = generated.Template
+			issue.Table = generated.Table
+		}
+		issues = append(issues, issue)
+	}
+	return issues, other
+}
@@ -0,0 +1,42 @@
+package dbmeta
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func Test_ParseBuildOutput(t *testing.T) {
+	conf := NewConfig(nil)
+	conf.OutDir = filepath.Join("example", "out")
+	conf.Outputs = []*OutputFile{
+		{Path: filepath.Join(conf.OutDir, "model", "albums.go"), Template: "internal://model.go.tmpl", Table: "albums"},
+		{Path: filepath.Join(conf.OutDir, "api", "router.go"), Template: "internal://router.go.tmpl"},
+	}
+
+	output := `# example.com/example/model
+model/albums.go:12:5: undefined: foo
+vet: ./api/router.go:40:2: unreachable code
+app/server/main.go:7: imported and not used: "os"
+go: some other failure
+`
+
+	issues, other := conf.ParseBuildOutput(output)
+	if len(issues) != 3 {
+		t.Fatalf("expected 3 issues, got %d", len(issues))
+	}
+
+	expected := []BuildIssue{
+		{File: "model/albums.go", Line: 12, Column: 5, Message: "undefined: foo", Template: "internal://model.go.tmpl", Table: "albums"},
+		{File: "api/router.go", Line: 40, Column: 2, Message: "unreachable code", Template: "internal://router.go.tmpl"},
+		{File: "app/server/main.go", Line: 7, Message: `imported and not used: "os"`},
+	}
+	for i, issue := range issues {
+		if *issue != expected[i] {
+			t.Errorf("unexpected issue %d: %+v", i, issue)
+		}
+	}
+
+	if len(other) != 1 || other[0] != "go: some other failure" {
+		t.Errorf("unexpected other lines: %v", other)
+	}
+}
@@ -11,7 +11,9 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	_ "github.com/denisenkom/go-mssqldb"
 	"github.com/droundy/goopt"
@@ -40,6 +42,14 @@ var (
 	modelNamingTemplate = goopt.String([]string{"--model_naming"}, "{{FmtFieldName .}}", "model naming template to name structs")
 	fieldNamingTemplate = goopt.String([]string{"--field_naming"}, "{{FmtFieldName (stringifyFirstChar .) }}", "field naming template to name structs")
 	fileNamingTemplate  = goopt.String([]string{"--file_naming"}, "{{.}}", "file_naming template to name files")
+	initialisms         = goopt.String([]string{"--initialisms"}, "", "comma separated words to write in upper case in struct and field names, e.g. SKU,OAUTH")
+	ignoredInitialisms  = goopt.String([]string{"--ignore-initialisms"}, "", "comma separated built in initialisms to format as regular words, e.g. ACL")
+	tablePrefixes       = goopt.String([]string{"--strip-table-prefix"}, "", "comma separated prefixes to strip from table names before naming structs, files and routes")
+	tableSuffixes       = goopt.String([]string{"--strip-table-suffix"}, "", "comma separated suffixes to strip from table names before naming structs, files and routes")
+	columnPrefixes      = goopt.String([]string{"--strip-column-prefix"}, "", "comma separated prefixes to strip from column names before naming fields")
+	columnSuffixes      = goopt.String([]string{"--strip-column-suffix"}, "", "comma separated suffixes to strip from column names before naming fields")
+	singularStructs     = goopt.Flag([]string{"--singular"}, []string{}, "name structs after the singular of the table name, routes keep the plural", "")
+	wordMappings        = goopt.String([]string{"--word-mapping"}, "", "comma separated word=replacement mappings applied to the words of table and column names, e.g. qty=quantity")
 
 	daoPackageName  = goopt.String([]string{"--dao"}, "dao", "name to set for dao package")
 	apiPackageName  = goopt.String([]string{"--api"}, "api", "name to set for api package")
@@ -47,12 +57,18 @@ var (
 	outDir          = goopt.String([]string{"--out"}, ".", "output dir")
 	module          = goopt.String([]string{"--module"}, "example.com/example", "module path")
 	overwrite       = goopt.Flag([]string{"--overwrite"}, []string{"--no-overwrite"}, "Overwrite existing files (default)", "disable overwriting files")
+	dryRun          = goopt.Flag([]string{"--dry-run"}, []string{}, "render all templates without writing, report files that would be created or changed with a diff", "")
+	checkOutput     = goopt.Flag([]string{"--check"}, []string{}, "dry run that exits with a non zero status if any file would be created or changed", "")
+	pruneStale      = goopt.Flag([]string{"--prune"}, []string{}, "remove previously generated files that are no longer produced", "")
+	forcePrune      = goopt.Flag([]string{"--force-prune"}, []string{}, "remove stale files even if they were edited since generation", "")
 	windows         = goopt.Flag([]string{"--windows"}, []string{}, "use windows line endings in generated files", "")
 	noColorOutput   = goopt.Flag([]string{"--no-color"}, []string{}, "disable color output", "")
 
 	contextFileName  = goopt.String([]string{"--context"}, "", "context file (json) to populate context with")
 	mappingFileName  = goopt.String([]string{"--mapping"}, "", "mapping file (json) to map sql types to golang/protobuf etc")
 	execCustomScript = goopt.String([]string{"--exec"}, "", "execute script for custom code generation")
+	hooksFileName    = goopt.String([]string{"--hooks"}, "", "hooks file (json) with commands or scripts to run before loading the schema, after each file and after generation")
+	datasourcesFile  = goopt.String([]string{"--datasources"}, "", "datasources file (json) with named databases, each generated into its own model, dao and api packages")
 
 	addJSONAnnotation = goopt.Flag([]string{"--json"}, []string{"--no-json"}, "Add json annotations (default)", "Disable json annotations")
 	jsonNameFormat    = goopt.String([]string{"--json-fmt"}, "snake", "json name format [snake | camel | lower_camel | none]")
@@ -73,9 +89,27 @@ var (
 	makefileGenerate = goopt.Flag([]string{"--makefile"}, []string{}, "Generate Makefile in output dir", "")
 	serverGenerate   = goopt.Flag([]string{"--server"}, []string{}, "Generate server app output dir", "")
 	daoGenerate      = goopt.Flag([]string{"--generate-dao"}, []string{}, "Generate dao functions", "")
+	repository       = goopt.Flag([]string{"--repository"}, []string{}, "Generate per table repository interfaces, implementations holding their own db handle and in memory fakes, injected into the api and grpc handlers", "")
 	projectGenerate  = goopt.Flag([]string{"--generate-proj"}, []string{}, "Generate project readme and gitignore", "")
 	restAPIGenerate  = goopt.Flag([]string{"--rest"}, []string{}, "Enable generating RESTful api", "")
+	batchGenerate    = goopt.Flag([]string{"--batch"}, []string{}, "Generate a RESTful batch endpoint executing a list of create, update and delete operations in one transaction", "")
+	softDelete       = goopt.String([]string{"--soft-delete"}, "", "comma separated names or patterns of soft delete columns, deletes set the column and reads skip the rows where it is set, e.g. deleted_at")
+	softDeleteTables = goopt.String([]string{"--soft-delete-table"}, "", "comma separated table=column soft delete column overrides, an empty column disables soft delete for the table")
+	auditCreatedAt   = goopt.String([]string{"--audit-created-at"}, "created_at", "comma separated names or patterns of the time columns set on insert, empty to disable")
+	auditUpdatedAt   = goopt.String([]string{"--audit-updated-at"}, "updated_at", "comma separated names or patterns of the time columns set on insert and update, empty to disable")
+	auditCreatedBy   = goopt.String([]string{"--audit-created-by"}, "created_by", "comma separated names or patterns of the columns set to the request user on insert, empty to disable")
+	auditUpdatedBy   = goopt.String([]string{"--audit-updated-by"}, "updated_by", "comma separated names or patterns of the columns set to the request user on insert and update, empty to disable")
 	runGoFmt         = goopt.Flag([]string{"--run-gofmt"}, []string{}, "run gofmt on output dir", "")
+	verifyOutput     = goopt.Flag([]string{"--verify"}, []string{}, "run go build and go vet on the generated module, reporting errors against the templates that produced them", "")
+	forceRegenerate  = goopt.Flag([]string{"--force"}, []string{}, "regenerate the files of all tables, not only of the tables that changed since the last run", "")
+	watchMode        = goopt.Flag([]string{"--watch"}, []string{}, "keep running, regenerating the files of tables whose schema changed and all files when the templates change", "")
+	watchInterval    = goopt.Int([]string{"--watch-interval"}, 2, "seconds between polls of the schema and template dirs in watch mode")
+	strictMode       = goopt.Flag([]string{"--strict"}, []string{}, "fail if a table or column is skipped or a sql type is not mapped", "")
+	reportFile       = goopt.String([]string{"--report"}, "", "write a json report of the schema warnings and errors to file, - for stdout")
+	inspectFormat    = goopt.String([]string{"--inspect"}, "", "print the loaded schema and type mappings as json, yaml or table instead of generating code")
+	lintFormat       = goopt.String([]string{"--lint"}, "", "check the schema for design problems and print the findings as text or json instead of generating code")
+	lintSeverities   = goopt.String([]string{"--lint-severity"}, "", "comma separated rule=severity settings for --lint, severity is error, warning or off")
+	loadWorkers      = goopt.Int([]string{"--workers"}, dbmeta.DefaultWorkers, "number of tables whose schema is loaded concurrently")
 
 	serverListen        = goopt.String([]string{"--listen"}, "", "listen address e.g. :8080")
 	serverScheme        = goopt.String([]string{"--scheme"}, "http", "scheme for server url")
@@ -90,7 +124,8 @@ var (
 
 	verbose = goopt.Flag([]string{"-v", "--verbose"}, []string{}, "Enable verbose output", "")
 
-	nameTest = goopt.String([]string{"--name_test"}, "", "perform name test using the --model_naming or --file_naming options")
+	nameTest      = goopt.String([]string{"--name_test"}, "", "perform name test using the --model_naming or --file_naming options")
+	namingPreview = goopt.Flag([]string{"--naming-preview"}, []string{}, "print the struct, file, field, json, xml and protobuf names, routes and dao functions of every table instead of generating code", "")
 
 	baseTemplates *packr.Box
 	tableInfos    map[string]*dbmeta.ModelInfo
@@ -178,7 +213,7 @@ func main() {
 		fmt.Printf("model: %s\n", result)
 
 		fmt.Printf("fileNamingTemplate: %s\n", *fileNamingTemplate)
-		result = dbmeta.Replace(*modelNamingTemplate, *nameTest)
+		result = dbmeta.GoSrcFileName(*fileNamingTemplate, *nameTest)
 		fmt.Printf("file: %s\n", result)
 
 		fmt.Printf("fieldNamingTemplate: %s\n", *fieldNamingTemplate)
@@ -192,19 +227,56 @@ func main() {
 	// fmt.Printf("fileNamingTemplate: %s\n", *fileNamingTemplate)
 	// fmt.Printf("modelNamingTemplate: %s\n", *modelNamingTemplate)
 
+	if *datasourcesFile != "" && (*watchMode || *lintFormat != "" || *inspectFormat != "" || *namingPreview || *execCustomScript != "" || *addProtobufAnnotation) {
+		fmt.Print(au.Red("--datasources can not be combined with --watch, --lint, --inspect, --naming-preview, --exec or --protobuf\n\n"))
+		os.Exit(1)
+		return
+	}
+
 	// Username is required
-	if sqlConnStr == nil || *sqlConnStr == "" || *sqlConnStr == "nil" {
+	if *datasourcesFile == "" && (sqlConnStr == nil || *sqlConnStr == "" || *sqlConnStr == "nil") {
 		fmt.Print(au.Red("sql connection string is required! Add it with --connstr=s\n\n"))
 		fmt.Println(goopt.Usage())
 		return
 	}
 
-	if sqlDatabase == nil || *sqlDatabase == "" || *sqlDatabase == "nil" {
+	if *datasourcesFile == "" && (sqlDatabase == nil || *sqlDatabase == "" || *sqlDatabase == "nil") {
 		fmt.Print(au.Red("Database can not be null\n\n"))
 		fmt.Println(goopt.Usage())
 		return
 	}
 
+	if *watchMode && (*dryRun || *checkOutput) {
+		fmt.Print(au.Red("--watch can not be combined with --dry-run or --check\n\n"))
+		os.Exit(1)
+		return
+	}
+
+	if *inspectFormat != "" {
+		if err := dbmeta.CheckInspectFormat(*inspectFormat); err != nil {
+			fmt.Print(au.Red(fmt.Sprintf("%v\n\n", err)))
+			os.Exit(1)
+			return
+		}
+	}
+
+	var lintRules map[string]string
+	if *lintFormat != "" {
+		err := dbmeta.CheckLintFormat(*lintFormat)
+		if err == nil {
+			lintRules, err = dbmeta.ParseLintSeverities(*lintSeverities)
+		}
+		if err != nil {
+			fmt.Print(au.Red(fmt.Sprintf("%v\n\n", err)))
+			os.Exit(1)
+			return
+		}
+	}
+
+	if *datasourcesFile != "" {
+		generateDatasources(setupConfig())
+	}
+
 	db, err := initializeDB()
 	if err != nil {
 		fmt.Print(au.Red(fmt.Sprintf("Error in initializing db %v\n", err)))
@@ -230,25 +302,265 @@ func main() {
 		}
 	}
 
-	if strings.HasPrefix(*modelNamingTemplate, "'") && strings.HasSuffix(*modelNamingTemplate, "'") {
-		*modelNamingTemplate = strings.TrimSuffix(*modelNamingTemplate, "'")
-		*modelNamingTemplate = strings.TrimPrefix(*modelNamingTemplate, "'")
-	}
-
 	var excludeDbTables []string
 
 	if *excludeSQLTables != "" {
 		excludeDbTables = strings.Split(*excludeSQLTables, ",")
 	}
 
+	conf := setupConfig()
+
+	if *lintFormat != "" {
+		result := conf.Lint(db, dbTables, excludeDbTables, lintRules)
+		err = result.Write(os.Stdout, *lintFormat)
+		if err != nil {
+			fmt.Fprint(os.Stderr, au.Red(fmt.Sprintf("Error writing lint findings %v\n", err)))
+			os.Exit(1)
+		}
+		if result.Errors > 0 {
+			os.Exit(1)
+		}
+		os.Exit(0)
+		return
+	}
+
+	tableInfos = dbmeta.LoadTableInfo(db, dbTables, excludeDbTables, conf)
+
+	if len(tableInfos) == 0 {
+		fmt.Print(au.Red(fmt.Sprintf("No tables loaded\n")))
+		exitWithReport(conf, 1)
+	}
+
+	if *namingPreview {
+		conf.TableInfos = tableInfos
+		preview := conf.NamingPreview()
+		err = preview.Write(os.Stdout)
+		if err != nil {
+			fmt.Print(au.Red(fmt.Sprintf("Error writing naming preview %v\n", err)))
+			exitWithReport(conf, 1)
+		}
+		exitWithReport(conf, 0)
+	}
+
+	if *inspectFormat != "" {
+		conf.TableInfos = tableInfos
+		err = conf.Inspect().Write(os.Stdout, *inspectFormat)
+		if err != nil {
+			fmt.Fprint(os.Stderr, au.Red(fmt.Sprintf("Error writing inspection %v\n", err)))
+			exitWithReport(conf, 1)
+		}
+		exitWithReport(conf, 0)
+	}
+
+	if schemaErrors, _ := conf.Report.Counts(); *strictMode && schemaErrors > 0 {
+		fmt.Print(au.Red(fmt.Sprintf("--strict: %d schema error(s), not generating code\n", schemaErrors)))
+		exitWithReport(conf, 1)
+	}
+
+	fmt.Printf("Generating code for the following tables (%d)\n", len(tableInfos))
+	for i, tableName := range dbmeta.SortedTableNames(tableInfos) {
+		fmt.Printf("[%d] %s\n", i, tableName)
+	}
+
+	conf.TableInfos = tableInfos
+	conf.ContextMap["tableInfos"] = tableInfos
+
+	if *execCustomScript != "" {
+		err = executeCustomScript(conf)
+		if err != nil {
+			fmt.Print(au.Red(fmt.Sprintf("Error in executing custom script %v\n", err)))
+			os.Exit(1)
+		}
+		os.Exit(0)
+		return
+	}
+
+	if *verbose {
+		listTemplates()
+	}
+
+	conf.TemplatesHash, err = templatesHash()
+	if err != nil {
+		fmt.Print(au.Red(fmt.Sprintf("Error in hashing templates %v\n", err)))
+		os.Exit(1)
+	}
+
+	tables := dbmeta.SortedTableNames(tableInfos)
+	regenerate := true
+	if !*forceRegenerate && !*checkOutput {
+		tables, regenerate, err = changedTables(conf)
+		if err != nil {
+			conf.Report.Error(dbmeta.StageGenerate, "", "", "error in loading manifest %v", err)
+			exitWithReport(conf, 1)
+		}
+	}
+
+	stale := 0
+	switch {
+	case !regenerate:
+		fmt.Printf("generated code is up to date, use --force to regenerate all files\n")
+		if *verifyOutput && !conf.DryRun {
+			err = verifyGeneratedCode(conf)
+			if err != nil {
+				conf.Report.Error(dbmeta.StageGenerate, "", "", "error in verifying generated code %v", err)
+				exitWithReport(conf, 1)
+			}
+		}
+
+	default:
+		if len(tables) == 0 {
+			fmt.Printf("no table changed since the last run, regenerating the files shared by all tables\n")
+		} else if len(tables) < len(tableInfos) {
+			fmt.Printf("regenerating %d of %d table(s) that changed since the last run, use --force to regenerate all\n", len(tables), len(tableInfos))
+		}
+
+		stale, err = generateCycle(conf, tables, retainTables(tables))
+		if err != nil {
+			exitWithReport(conf, 1)
+		}
+	}
+
+	reportPendingChanges(conf, stale)
+
+	if *watchMode {
+		watch(db, conf, excludeDbTables)
+	}
+
+	exitWithReport(conf, 0)
+}
+
+// reportPendingChanges print the files a dry run would create or change, exiting with an error with --check if the
+// generated code is out of date
+func reportPendingChanges(conf *dbmeta.Config, stale int) {
+	if !conf.DryRun {
+		return
+	}
+
+	created, changed, unchanged := conf.PendingChanges()
+	fmt.Printf("dry run: %d file(s) to create, %d to change, %d unchanged, %d stale\n", created, changed, unchanged, stale)
+	if *checkOutput && created+changed+stale > 0 {
+		fmt.Print(au.Red("generated code is out of date\n"))
+		exitWithReport(conf, 1)
+	}
+}
+
+// generateDatasources load the tables of every datasource of the --datasources file and generate their model, dao and
+// api packages and the files shared by all datasources such as the server. All files are always regenerated. Never
+// returns.
+func generateDatasources(conf *dbmeta.Config) {
+	datasources, err := dbmeta.LoadDatasources(*datasourcesFile)
+	if err != nil {
+		fmt.Print(au.Red(fmt.Sprintf("Error loading datasources file %s error: %v\n", *datasourcesFile, err)))
+		os.Exit(1)
+	}
+
+	for _, ds := range datasources {
+		ds.Apply(conf, *modelPackageName, *daoPackageName, *apiPackageName)
+		ds.TableInfos, err = loadDatasource(conf, ds)
+		if err != nil {
+			fmt.Print(au.Red(fmt.Sprintf("Error in loading datasource %s %v\n", ds.Name, err)))
+			exitWithReport(conf, 1)
+		}
+
+		if len(ds.TableInfos) == 0 {
+			fmt.Print(au.Red(fmt.Sprintf("No tables loaded for datasource %s\n", ds.Name)))
+			exitWithReport(conf, 1)
+		}
+	}
+
+	tableInfos = dbmeta.MergeTableInfos(datasources)
+	conf.Datasources = datasources
+
+	if schemaErrors, _ := conf.Report.Counts(); *strictMode && schemaErrors > 0 {
+		fmt.Print(au.Red(fmt.Sprintf("--strict: %d schema error(s), not generating code\n", schemaErrors)))
+		exitWithReport(conf, 1)
+	}
+
+	for _, ds := range datasources {
+		fmt.Printf("Generating code for the following tables of datasource %s (%d)\n", ds.Name, len(ds.TableInfos))
+		for i, tableName := range dbmeta.SortedTableNames(ds.TableInfos) {
+			fmt.Printf("[%d] %s\n", i, tableName)
+		}
+	}
+
+	if *verbose {
+		listTemplates()
+	}
+
+	conf.TemplatesHash, err = templatesHash()
+	if err != nil {
+		fmt.Print(au.Red(fmt.Sprintf("Error in hashing templates %v\n", err)))
+		os.Exit(1)
+	}
+
+	stale, err := generateCycle(conf, nil, nil)
+	if err != nil {
+		exitWithReport(conf, 1)
+	}
+
+	reportPendingChanges(conf, stale)
+	exitWithReport(conf, 0)
+}
+
+// loadDatasource load the tables of a datasource, the datasource must be applied to conf
+func loadDatasource(conf *dbmeta.Config, ds *dbmeta.Datasource) (map[string]*dbmeta.ModelInfo, error) {
+	db, err := sql.Open(ds.SQLType, ds.SQLConnStr)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	err = db.Ping()
+	if err != nil {
+		return nil, err
+	}
+
+	dbTables := ds.Tables
+	if len(dbTables) == 0 {
+		schemaTables, err := schema.TableNames(db)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch tables from the %s information schema: %v", ds.SQLType, err)
+		}
+		for _, st := range schemaTables {
+			dbTables = append(dbTables, st[1])
+		}
+	}
+	return dbmeta.LoadTableInfo(db, dbTables, ds.Exclude, conf), nil
+}
+
+// setupConfig create the code generation config from the command line, loading the mappings, context and hooks files,
+// and run the pre-load hooks. Exits on errors.
+func setupConfig() *dbmeta.Config {
+	if strings.HasPrefix(*modelNamingTemplate, "'") && strings.HasSuffix(*modelNamingTemplate, "'") {
+		*modelNamingTemplate = strings.TrimSuffix(*modelNamingTemplate, "'")
+		*modelNamingTemplate = strings.TrimPrefix(*modelNamingTemplate, "'")
+	}
+
 	conf := dbmeta.NewConfig(LoadTemplate)
 	initialize(conf)
 
+	var err error
+	conf.WordMappings, err = dbmeta.ParseWordMappings(*wordMappings)
+	if err != nil {
+		fmt.Print(au.Red(fmt.Sprintf("%v\n\n", err)))
+		os.Exit(1)
+	}
+
+	conf.SoftDeleteTables, err = dbmeta.ParseSoftDeleteTables(*softDeleteTables)
+	if err != nil {
+		fmt.Print(au.Red(fmt.Sprintf("%v\n\n", err)))
+		os.Exit(1)
+	}
+
+	if *inspectFormat != "" || *lintFormat != "" {
+		// keep stdout for the inspection or lint findings
+		conf.Report.Output = os.Stderr
+	}
+
 	err = loadDefaultDBMappings(conf)
 	if err != nil {
 		fmt.Print(au.Red(fmt.Sprintf("Error processing default mapping file error: %v\n", err)))
 		os.Exit(1)
-		return
 	}
 
 	if *mappingFileName != "" {
@@ -256,7 +568,6 @@ func main() {
 		if err != nil {
 			fmt.Print(au.Red(fmt.Sprintf("Error loading mappings file %s error: %v\n", *mappingFileName, err)))
 			os.Exit(1)
-			return
 		}
 	}
 
@@ -265,48 +576,304 @@ func main() {
 		if err != nil {
 			fmt.Print(au.Red(fmt.Sprintf("Error loading context file %s error: %v\n", *contextFileName, err)))
 			os.Exit(1)
-			return
 		}
 	}
 
-	tableInfos = dbmeta.LoadTableInfo(db, dbTables, excludeDbTables, conf)
+	if *hooksFileName != "" {
+		conf.Hooks, err = dbmeta.LoadHooks(*hooksFileName)
+		if err != nil {
+			fmt.Print(au.Red(fmt.Sprintf("Error loading hooks file %s error: %v\n", *hooksFileName, err)))
+			os.Exit(1)
+		}
+	}
 
-	if len(tableInfos) == 0 {
-		fmt.Print(au.Red(fmt.Sprintf("No tables loaded\n")))
+	err = conf.RunHooks(dbmeta.HookPreLoad, nil)
+	if err != nil {
+		fmt.Print(au.Red(fmt.Sprintf("Error in running hooks %v\n", err)))
 		os.Exit(1)
 	}
+	return conf
+}
 
-	fmt.Printf("Generating code for the following tables (%d)\n", len(tableInfos))
-	i := 0
-	for tableName := range tableInfos {
-		fmt.Printf("[%d] %s\n", i, tableName)
-		i++
+// exitWithReport write the --report file and exit with code
+func exitWithReport(conf *dbmeta.Config, code int) {
+	if *reportFile != "" {
+		err := writeReport(conf, code != 0)
+		if err != nil {
+			fmt.Print(au.Red(fmt.Sprintf("Error writing report %s error: %v\n", *reportFile, err)))
+			code = 1
+		}
 	}
+	os.Exit(code)
+}
 
-	conf.TableInfos = tableInfos
-	conf.ContextMap["tableInfos"] = tableInfos
+// writeReport write the json summary of the schema warnings and errors to --report, - writes to stdout
+func writeReport(conf *dbmeta.Config, failed bool) error {
+	if *reportFile == "-" {
+		return conf.Report.WriteJSON(os.Stdout, len(tableInfos), *strictMode, failed)
+	}
 
-	if *execCustomScript != "" {
-		err = executeCustomScript(conf)
+	f, err := os.Create(*reportFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return conf.Report.WriteJSON(f, len(tableInfos), *strictMode, failed)
+}
+
+// templatesHash return a hash of the built in templates and the templates in --templateDir
+func templatesHash() (string, error) {
+	names := baseTemplates.List()
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	for _, name := range names {
+		content, err := baseTemplates.Find(name)
 		if err != nil {
-			fmt.Print(au.Red(fmt.Sprintf("Error in executing custom script %v\n", err)))
-			os.Exit(1)
+			return "", err
 		}
-		os.Exit(0)
-		return
+		buf.WriteString(name)
+		buf.WriteByte(0)
+		buf.Write(content)
+		buf.WriteByte(0)
 	}
 
-	if *verbose {
-		listTemplates()
+	dirChecksum, err := dbmeta.DirChecksum(*templateDir)
+	if err != nil {
+		return "", err
+	}
+	buf.WriteString(dirChecksum)
+	return dbmeta.HashContent(buf.Bytes()), nil
+}
+
+// changedTables return the tables whose fingerprint changed since the last run, regenerate is false if no table
+// changed and no table was removed, so no file needs to be generated
+func changedTables(conf *dbmeta.Config) (tables []string, regenerate bool, err error) {
+	previous, err := dbmeta.LoadManifest(conf.OutDir)
+	if err != nil {
+		return nil, false, err
+	}
+
+	tables = conf.ChangedTables(previous)
+	if len(tables) > 0 {
+		return tables, true, nil
+	}
+
+	// a removed table changes the global files such as the router
+	if *sqlTable == "" {
+		for tableName := range previous.Tables {
+			if tableInfos[tableName] == nil {
+				return tables, true, nil
+			}
+		}
+	}
+	return nil, false, nil
+}
+
+// retainTables return the manifest retain func for a generation of tables, entries of the other tables are kept if the
+// table is still loaded or if only the tables in --table are generated
+func retainTables(tables []string) func(tableName string) bool {
+	regenerated := make(map[string]bool, len(tables))
+	for _, tableName := range tables {
+		regenerated[tableName] = true
+	}
+
+	return func(tableName string) bool {
+		return !regenerated[tableName] && (tableInfos[tableName] != nil || *sqlTable != "")
+	}
+}
+
+// generateCycle generate the files of tables and the global files, run the post-gen hooks, update the manifest and
+// verify the output. Entries of the previous manifest for tables that were not generated are kept if retain returns
+// true. Returns the number of stale files remaining.
+func generateCycle(conf *dbmeta.Config, tables []string, retain func(tableName string) bool) (int, error) {
+	conf.Outputs = nil
+
+	err := generate(conf, tables)
+	if err != nil {
+		conf.Report.Error(dbmeta.StageGenerate, "", "", "error in executing generate %v", err)
+		return 0, err
 	}
 
-	err = generate(conf)
+	err = conf.RunHooks(dbmeta.HookPostGen, nil)
 	if err != nil {
-		fmt.Print(au.Red(fmt.Sprintf("Error in executing generate %v\n", err)))
+		conf.Report.Error(dbmeta.StageGenerate, "", "", "error in running hooks %v", err)
+		return 0, err
+	}
+
+	stale, err := updateManifest(conf, retain)
+	if err != nil {
+		conf.Report.Error(dbmeta.StageGenerate, "", "", "error in updating manifest %v", err)
+		return stale, err
+	}
+
+	if *verifyOutput && !conf.DryRun {
+		err = verifyGeneratedCode(conf)
+		if err != nil {
+			conf.Report.Error(dbmeta.StageGenerate, "", "", "error in verifying generated code %v", err)
+			return stale, err
+		}
+	}
+	return stale, nil
+}
+
+// watch poll the schema checksums of the database and the template, fragments and mapping files, regenerating the
+// files of tables whose schema changed and all files if the templates changed. Never returns.
+func watch(db *sql.DB, conf *dbmeta.Config, excludeDbTables []string) {
+	var onlyTables []string
+	if *sqlTable != "" {
+		onlyTables = strings.Split(*sqlTable, ",")
+	}
+
+	// watched tables are all tables of the database, or the ones from --table, minus the excluded ones
+	watched := func(tableName string) bool {
+		if _, ok := dbmeta.FindInSlice(excludeDbTables, tableName); ok {
+			return false
+		}
+		if onlyTables == nil {
+			return true
+		}
+		_, ok := dbmeta.FindInSlice(onlyTables, tableName)
+		return ok
+	}
+
+	checksums, err := dbmeta.TableChecksums(db, *sqlType, *sqlDatabase)
+	if err != nil {
+		fmt.Print(au.Red(fmt.Sprintf("Error in watching schema %v\n", err)))
 		os.Exit(1)
 	}
 
-	os.Exit(0)
+	templatesChecksum, err := dbmeta.DirChecksum(*templateDir, *fragmentsDir, *mappingFileName)
+	if err != nil {
+		fmt.Print(au.Red(fmt.Sprintf("Error in watching templates %v\n", err)))
+		os.Exit(1)
+	}
+
+	interval := time.Duration(*watchInterval) * time.Second
+	if interval <= 0 {
+		interval = time.Second
+	}
+	fmt.Printf("watching %s database %s and templates for changes every %v, press ctrl-c to stop\n", *sqlType, *sqlDatabase, interval)
+
+	for {
+		time.Sleep(interval)
+
+		currentChecksums, err := dbmeta.TableChecksums(db, *sqlType, *sqlDatabase)
+		if err != nil {
+			fmt.Print(au.Red(fmt.Sprintf("Error in watching schema %v\n", err)))
+			continue
+		}
+
+		currentTemplatesChecksum, err := dbmeta.DirChecksum(*templateDir, *fragmentsDir, *mappingFileName)
+		if err != nil {
+			fmt.Print(au.Red(fmt.Sprintf("Error in watching templates %v\n", err)))
+			continue
+		}
+
+		var changed, removed []string
+		for tableName, checksum := range currentChecksums {
+			if watched(tableName) && checksums[tableName] != checksum {
+				changed = append(changed, tableName)
+			}
+		}
+		for tableName := range checksums {
+			if _, ok := currentChecksums[tableName]; !ok && watched(tableName) {
+				removed = append(removed, tableName)
+			}
+		}
+		sort.Strings(changed)
+		sort.Strings(removed)
+
+		templatesChanged := currentTemplatesChecksum != templatesChecksum
+		if len(changed) == 0 && len(removed) == 0 && !templatesChanged {
+			continue
+		}
+
+		checksums = currentChecksums
+		templatesChecksum = currentTemplatesChecksum
+
+		var summary []string
+		if len(changed) > 0 {
+			summary = append(summary, fmt.Sprintf("schema changed: %s", strings.Join(changed, ", ")))
+		}
+		if len(removed) > 0 {
+			summary = append(summary, fmt.Sprintf("tables removed: %s", strings.Join(removed, ", ")))
+		}
+
+		for _, tableName := range removed {
+			delete(tableInfos, tableName)
+		}
+
+		tables := changed
+		if templatesChanged {
+			summary = append(summary, "templates changed")
+
+			conf.TemplatesHash, err = templatesHash()
+			if err != nil {
+				fmt.Print(au.Red(fmt.Sprintf("Error in hashing templates %v\n", err)))
+				continue
+			}
+
+			if *fragmentsDir != "" {
+				conf.LoadFragments(*fragmentsDir)
+			}
+			if *mappingFileName != "" {
+				err = dbmeta.LoadMappings(*mappingFileName, *verbose)
+				if err != nil {
+					fmt.Print(au.Red(fmt.Sprintf("Error loading mappings file %s error: %v\n", *mappingFileName, err)))
+					continue
+				}
+			}
+
+			// mappings change the type of fields, so reload every table
+			tables = nil
+			for tableName, checksum := range checksums {
+				if watched(tableName) && checksum != "" {
+					tables = append(tables, tableName)
+				}
+			}
+		}
+		dbmeta.UpdateTableInfo(db, tableInfos, tables, conf)
+
+		fmt.Printf("[%s] %s\n", time.Now().Format("15:04:05"), strings.Join(summary, "; "))
+		stale, err := generateCycle(conf, tables, retainTables(append(append([]string(nil), tables...), removed...)))
+		if err != nil {
+			fmt.Print(au.Red("regeneration failed, waiting for the next change\n"))
+			continue
+		}
+
+		created, updated, unchanged := conf.PendingChanges()
+		fmt.Printf("[%s] regenerated %d table(s): %d file(s) created, %d changed, %d unchanged, %d stale\n",
+			time.Now().Format("15:04:05"), len(tables), created, updated, unchanged, stale)
+	}
+}
+
+// updateManifest write the manifest of generated files to the output dir and handle the files that are no longer
+// generated, returns the number of stale files remaining
+func updateManifest(conf *dbmeta.Config, retain func(tableName string) bool) (int, error) {
+	previous, err := dbmeta.LoadManifest(conf.OutDir)
+	if err != nil {
+		return 0, err
+	}
+
+	manifest, staleFiles := conf.BuildManifest(previous, retain)
+
+	stale := 0
+	for _, staleFile := range staleFiles {
+		if !staleFile.Missing {
+			stale++
+		}
+	}
+
+	err = conf.PruneStaleFiles(manifest, staleFiles, *pruneStale || *forcePrune, *forcePrune)
+	if err != nil {
+		return stale, err
+	}
+
+	if conf.DryRun {
+		return stale, nil
+	}
+	return stale, manifest.Save(conf.OutDir)
 }
 
 func initializeDB() (db *sql.DB, err error) {
@@ -361,7 +928,9 @@ func initialize(conf *dbmeta.Config) {
 	conf.Verbose = *verbose
 	conf.OutDir = *outDir
 	conf.Overwrite = *overwrite
+	conf.DryRun = *dryRun || *checkOutput
 	conf.LineEndingCRLF = *windows
+	conf.Workers = *loadWorkers
 
 	conf.SQLConnStr = *sqlConnStr
 	conf.ServerPort = *serverPort
@@ -383,6 +952,28 @@ func initialize(conf *dbmeta.Config) {
 	conf.GrpcFQPN = *module + "/" + *grpcPackageName
 
 	conf.FileNamingTemplate = *fileNamingTemplate
+	conf.Initialisms = splitList(*initialisms)
+	conf.IgnoredInitialisms = splitList(*ignoredInitialisms)
+	conf.TablePrefixes = splitList(*tablePrefixes)
+	conf.TableSuffixes = splitList(*tableSuffixes)
+	conf.ColumnPrefixes = splitList(*columnPrefixes)
+	conf.ColumnSuffixes = splitList(*columnSuffixes)
+	conf.SingularStructs = *singularStructs
+	conf.Repository = *repository
+	conf.Batch = *batchGenerate
+	conf.GenerateDao = *daoGenerate
+	conf.GenerateRest = *restAPIGenerate
+	conf.GenerateServer = *serverGenerate
+	conf.GenerateMod = *modGenerate
+	conf.GenerateMakefile = *makefileGenerate
+	conf.GenerateProject = *projectGenerate
+	conf.CopyTemplates = *copyTemplates
+	conf.SoftDeleteColumns = splitList(*softDelete)
+	conf.CreatedAtColumns = splitList(*auditCreatedAt)
+	conf.UpdatedAtColumns = splitList(*auditUpdatedAt)
+	conf.CreatedByColumns = splitList(*auditCreatedBy)
+	conf.UpdatedByColumns = splitList(*auditUpdatedBy)
+	dbmeta.UpdateInitialisms(conf.Initialisms, conf.IgnoredInitialisms)
 	conf.ModelNamingTemplate = *modelNamingTemplate
 	conf.FieldNamingTemplate = *fieldNamingTemplate
 
@@ -466,12 +1057,7 @@ func execTemplate(conf *dbmeta.Config, genTemplate *dbmeta.GenTemplate, data map
 	data["outDir"] = *outDir
 	data["Config"] = conf
 
-	tables := make([]string, 0, len(tableInfos))
-	for k := range tableInfos {
-		tables = append(tables, k)
-	}
-
-	data["tables"] = tables
+	data["tables"] = dbmeta.SortedTableNames(tableInfos)
 
 	rt, err := conf.GetTemplate(genTemplate)
 	if err != nil {
@@ -489,49 +1075,81 @@ func execTemplate(conf *dbmeta.Config, genTemplate *dbmeta.GenTemplate, data map
 	return nil
 }
 
-func generate(conf *dbmeta.Config) error {
-	var err error
+func generate(conf *dbmeta.Config, tables []string) error {
+	if len(conf.Datasources) == 0 {
+		err := generatePackages(conf, tables)
+		if err != nil {
+			return err
+		}
+		return generateSharedFiles(conf)
+	}
 
-	*jsonNameFormat = strings.ToLower(*jsonNameFormat)
-	*xmlNameFormat = strings.ToLower(*xmlNameFormat)
-	modelDir := filepath.Join(*outDir, *modelPackageName)
-	apiDir := filepath.Join(*outDir, *apiPackageName)
-	daoDir := filepath.Join(*outDir, *daoPackageName)
+	for _, ds := range conf.Datasources {
+		ds.Apply(conf, *modelPackageName, *daoPackageName, *apiPackageName)
+		conf.ContextMap["tableInfos"] = ds.TableInfos
 
-	err = os.MkdirAll(*outDir, 0777)
-	if err != nil && !*overwrite {
-		fmt.Print(au.Red(fmt.Sprintf("unable to create outDir: %s error: %v\n", *outDir, err)))
-		return err
+		err := generatePackages(conf, dbmeta.SortedTableNames(ds.TableInfos))
+		if err != nil {
+			return err
+		}
 	}
 
-	err = os.MkdirAll(modelDir, 0777)
-	if err != nil && !*overwrite {
-		fmt.Print(au.Red(fmt.Sprintf("unable to create modelDir: %s error: %v\n", modelDir, err)))
-		return err
-	}
+	// the files shared by all datasources use the connection of the first one, the server initializes all of them
+	conf.Datasources[0].Apply(conf, *modelPackageName, *daoPackageName, *apiPackageName)
+	conf.TableInfos = tableInfos
+	conf.ContextMap["tableInfos"] = tableInfos
+	return generateSharedFiles(conf)
+}
 
-	if *daoGenerate {
-		err = os.MkdirAll(daoDir, 0777)
+// generatePackages generate the model, dao and api files of tables and the base files of the packages
+func generatePackages(conf *dbmeta.Config, tables []string) error {
+	var err error
+
+	*jsonNameFormat = strings.ToLower(*jsonNameFormat)
+	*xmlNameFormat = strings.ToLower(*xmlNameFormat)
+	modelDir := filepath.Join(*outDir, conf.ModelPackageName)
+	apiDir := filepath.Join(*outDir, conf.APIPackageName)
+	daoDir := filepath.Join(*outDir, conf.DaoPackageName)
+
+	if !conf.DryRun {
+		err = os.MkdirAll(*outDir, 0777)
 		if err != nil && !*overwrite {
-			fmt.Print(au.Red(fmt.Sprintf("unable to create daoDir: %s error: %v\n", daoDir, err)))
+			fmt.Print(au.Red(fmt.Sprintf("unable to create outDir: %s error: %v\n", *outDir, err)))
 			return err
 		}
-	}
 
-	if *restAPIGenerate {
-		err = os.MkdirAll(apiDir, 0777)
+		err = os.MkdirAll(modelDir, 0777)
 		if err != nil && !*overwrite {
-			fmt.Print(au.Red(fmt.Sprintf("unable to create apiDir: %s error: %v\n", apiDir, err)))
+			fmt.Print(au.Red(fmt.Sprintf("unable to create modelDir: %s error: %v\n", modelDir, err)))
 			return err
 		}
+
+		if conf.GenerateDao {
+			err = os.MkdirAll(daoDir, 0777)
+			if err != nil && !*overwrite {
+				fmt.Print(au.Red(fmt.Sprintf("unable to create daoDir: %s error: %v\n", daoDir, err)))
+				return err
+			}
+		}
+
+		if conf.GenerateRest {
+			err = os.MkdirAll(apiDir, 0777)
+			if err != nil && !*overwrite {
+				fmt.Print(au.Red(fmt.Sprintf("unable to create apiDir: %s error: %v\n", apiDir, err)))
+				return err
+			}
+		}
 	}
+
 	var ModelTmpl *dbmeta.GenTemplate
 	var ModelBaseTmpl *dbmeta.GenTemplate
 	var ControllerTmpl *dbmeta.GenTemplate
 	var DaoTmpl *dbmeta.GenTemplate
+	var DaoFakeTmpl *dbmeta.GenTemplate
+	var DaoFilterTmpl *dbmeta.GenTemplate
+	var DaoDialectTmpl *dbmeta.GenTemplate
 
 	var DaoInitTmpl *dbmeta.GenTemplate
-	var GoModuleTmpl *dbmeta.GenTemplate
 
 	if ControllerTmpl, err = LoadTemplate("api.go.tmpl"); err != nil {
 		fmt.Print(au.Red(fmt.Sprintf("Error loading template %v\n", err)))
@@ -558,7 +1176,17 @@ func generate(conf *dbmeta.Config) error {
 		}
 	}
 
-	if GoModuleTmpl, err = LoadTemplate("gomod.tmpl"); err != nil {
+	if DaoFakeTmpl, err = LoadTemplate("dao_fake.go.tmpl"); err != nil {
+		fmt.Print(au.Red(fmt.Sprintf("Error loading template %v\n", err)))
+		return err
+	}
+
+	if DaoFilterTmpl, err = LoadTemplate("dao_filter.go.tmpl"); err != nil {
+		fmt.Print(au.Red(fmt.Sprintf("Error loading template %v\n", err)))
+		return err
+	}
+
+	if DaoDialectTmpl, err = LoadTemplate("dao_dialect.go.tmpl"); err != nil {
 		fmt.Print(au.Red(fmt.Sprintf("Error loading template %v\n", err)))
 		return err
 	}
@@ -576,7 +1204,11 @@ func generate(conf *dbmeta.Config) error {
 	*xmlNameFormat = strings.ToLower(*xmlNameFormat)
 
 	// generate go files for each table
-	for tableName, tableInfo := range tableInfos {
+	for _, tableName := range tables {
+		tableInfo, ok := conf.TableInfos[tableName]
+		if !ok {
+			continue
+		}
 
 		if len(tableInfo.Fields) == 0 {
 			if *verbose {
@@ -587,66 +1219,102 @@ func generate(conf *dbmeta.Config) error {
 		}
 
 		modelInfo := conf.CreateContextForTableFile(tableInfo)
+		fileBaseName := conf.FileBaseName(tableName, tableInfo.StructName)
 
-		modelFile := filepath.Join(modelDir, CreateGoSrcFileName(tableName))
+		modelFile := filepath.Join(modelDir, CreateGoSrcFileName(fileBaseName))
 		err = conf.WriteTemplate(ModelTmpl, modelInfo, modelFile)
 		if err != nil {
 			fmt.Print(au.Red(fmt.Sprintf("Error writing file: %v\n", err)))
-			os.Exit(1)
+			return err
 		}
 
-		if *restAPIGenerate {
-			restFile := filepath.Join(apiDir, CreateGoSrcFileName(tableName))
+		if conf.GenerateRest {
+			restFile := filepath.Join(apiDir, CreateGoSrcFileName(fileBaseName))
 			err = conf.WriteTemplate(ControllerTmpl, modelInfo, restFile)
 			if err != nil {
 				fmt.Print(au.Red(fmt.Sprintf("Error writing file: %v\n", err)))
-				os.Exit(1)
+				return err
 			}
 
 		}
 
-		if *daoGenerate {
+		if conf.GenerateDao {
 			// write dao
-			outputFile := filepath.Join(daoDir, CreateGoSrcFileName(tableName))
+			outputFile := filepath.Join(daoDir, CreateGoSrcFileName(fileBaseName))
 			err = conf.WriteTemplate(DaoTmpl, modelInfo, outputFile)
 			if err != nil {
 				fmt.Print(au.Red(fmt.Sprintf("Error writing file: %v\n", err)))
-				os.Exit(1)
+				return err
+			}
+
+			if conf.Repository {
+				fakeFile := filepath.Join(daoDir, CreateGoSrcFileName(fileBaseName+"_fake"))
+				err = conf.WriteTemplate(DaoFakeTmpl, modelInfo, fakeFile)
+				if err != nil {
+					fmt.Print(au.Red(fmt.Sprintf("Error writing file: %v\n", err)))
+					return err
+				}
 			}
 		}
 	}
 
 	data := map[string]interface{}{}
 
-	if *restAPIGenerate {
+	if conf.GenerateRest {
 		if err = generateRestBaseFiles(conf, apiDir); err != nil {
 			return err
 		}
 	}
 
-	if *daoGenerate {
+	if conf.GenerateDao {
 		err = conf.WriteTemplate(DaoInitTmpl, data, filepath.Join(daoDir, "dao_base.go"))
 		if err != nil {
 			fmt.Print(au.Red(fmt.Sprintf("Error writing file: %v\n", err)))
-			os.Exit(1)
+			return err
+		}
+
+		err = conf.WriteTemplate(DaoFilterTmpl, data, filepath.Join(daoDir, "dao_filter.go"))
+		if err != nil {
+			fmt.Print(au.Red(fmt.Sprintf("Error writing file: %v\n", err)))
+			return err
+		}
+
+		err = conf.WriteTemplate(DaoDialectTmpl, data, filepath.Join(daoDir, "dao_dialect.go"))
+		if err != nil {
+			fmt.Print(au.Red(fmt.Sprintf("Error writing file: %v\n", err)))
+			return err
 		}
 	}
 
 	err = conf.WriteTemplate(ModelBaseTmpl, data, filepath.Join(modelDir, "model_base.go"))
 	if err != nil {
 		fmt.Print(au.Red(fmt.Sprintf("Error writing file: %v\n", err)))
-		os.Exit(1)
+		return err
 	}
+	return nil
+}
 
-	if *modGenerate {
+// generateSharedFiles generate the files shared by all packages such as go.mod, the Makefile and the server
+func generateSharedFiles(conf *dbmeta.Config) error {
+	var err error
+	var GoModuleTmpl *dbmeta.GenTemplate
+
+	if GoModuleTmpl, err = LoadTemplate("gomod.tmpl"); err != nil {
+		fmt.Print(au.Red(fmt.Sprintf("Error loading template %v\n", err)))
+		return err
+	}
+
+	data := map[string]interface{}{}
+
+	if conf.GenerateMod {
 		err = conf.WriteTemplate(GoModuleTmpl, data, filepath.Join(*outDir, "go.mod"))
 		if err != nil {
 			fmt.Print(au.Red(fmt.Sprintf("Error writing file: %v\n", err)))
-			os.Exit(1)
+			return err
 		}
 	}
 
-	if *makefileGenerate {
+	if conf.GenerateMakefile {
 		if err = generateMakefile(conf); err != nil {
 			return err
 		}
@@ -664,19 +1332,23 @@ func generate(conf *dbmeta.Config) error {
 		"Config":      conf,
 	}
 
-	if *projectGenerate {
+	if conf.GenerateProject {
 		if err = generateProjectFiles(conf, data); err != nil {
 			return err
 		}
 	}
 
-	if *serverGenerate {
+	if conf.GenerateServer {
 		if err = generateServerCode(conf); err != nil {
 			return err
 		}
 	}
 
-	if *copyTemplates {
+	if conf.DryRun {
+		return nil
+	}
+
+	if conf.CopyTemplates {
 		if err = copyTemplatesToTarget(); err != nil {
 			return err
 		}
@@ -706,13 +1378,13 @@ func generateRestBaseFiles(conf *dbmeta.Config, apiDir string) (err error) {
 	err = conf.WriteTemplate(RouterTmpl, data, filepath.Join(apiDir, "router.go"))
 	if err != nil {
 		fmt.Print(au.Red(fmt.Sprintf("Error writing file: %v\n", err)))
-		os.Exit(1)
+		return err
 	}
 
 	err = conf.WriteTemplate(HTTPUtilsTmpl, data, filepath.Join(apiDir, "http_utils.go"))
 	if err != nil {
 		fmt.Print(au.Red(fmt.Sprintf("Error writing file: %v\n", err)))
-		os.Exit(1)
+		return err
 	}
 
 	return nil
@@ -739,7 +1411,7 @@ func generateMakefile(conf *dbmeta.Config) (err error) {
 	err = conf.WriteTemplate(MakefileTmpl, data, filepath.Join(*outDir, "Makefile"))
 	if err != nil {
 		fmt.Print(au.Red(fmt.Sprintf("Error writing file: %v\n", err)))
-		os.Exit(1)
+		return err
 	}
 
 	return nil
@@ -748,10 +1420,12 @@ func generateMakefile(conf *dbmeta.Config) (err error) {
 func generateProtobufDefinitionFile(conf *dbmeta.Config, data map[string]interface{}) (err error) {
 	moduleDir := filepath.Join(*outDir, conf.ModelPackageName)
 	serverDir := filepath.Join(*outDir, conf.GrpcPackageName)
-	err = os.MkdirAll(serverDir, 0777)
-	if err != nil {
-		fmt.Print(au.Red(fmt.Sprintf("unable to create serverDir: %s error: %v\n", serverDir, err)))
-		return
+	if !conf.DryRun {
+		err = os.MkdirAll(serverDir, 0777)
+		if err != nil {
+			fmt.Print(au.Red(fmt.Sprintf("unable to create serverDir: %s error: %v\n", serverDir, err)))
+			return
+		}
 	}
 
 	var ProtobufTmpl *dbmeta.GenTemplate
@@ -765,17 +1439,19 @@ func generateProtobufDefinitionFile(conf *dbmeta.Config, data map[string]interfa
 	err = conf.WriteTemplate(ProtobufTmpl, data, filepath.Join(*outDir, protofile))
 	if err != nil {
 		fmt.Print(au.Red(fmt.Sprintf("Error writing file: %v\n", err)))
-		os.Exit(1)
+		return err
 	}
 
-	compileOutput, err := CompileProtoC(*outDir, moduleDir, filepath.Join(*outDir, protofile))
-	if err != nil {
-		fmt.Print(au.Red(fmt.Sprintf("Error compiling proto file %v\n", err)))
-		return err
+	if !conf.DryRun {
+		compileOutput, err := CompileProtoC(*outDir, moduleDir, filepath.Join(*outDir, protofile))
+		if err != nil {
+			fmt.Print(au.Red(fmt.Sprintf("Error compiling proto file %v\n", err)))
+			return err
+		}
+		fmt.Printf("----------------------------\n")
+		fmt.Printf("protoc: %s\n", compileOutput)
+		fmt.Printf("----------------------------\n")
 	}
-	fmt.Printf("----------------------------\n")
-	fmt.Printf("protoc: %s\n", compileOutput)
-	fmt.Printf("----------------------------\n")
 	// protoc -I./  --go_out=plugins=grpc:./   ./dvdrental.proto
 
 	if ProtobufTmpl, err = LoadTemplate("protomain.go.tmpl"); err != nil {
@@ -786,7 +1462,7 @@ func generateProtobufDefinitionFile(conf *dbmeta.Config, data map[string]interfa
 	err = conf.WriteTemplate(ProtobufTmpl, data, filepath.Join(serverDir, "main.go"))
 	if err != nil {
 		fmt.Print(au.Red(fmt.Sprintf("Error writing file: %v\n", err)))
-		os.Exit(1)
+		return err
 	}
 
 	if ProtobufTmpl, err = LoadTemplate("protoserver.go.tmpl"); err != nil {
@@ -797,7 +1473,7 @@ func generateProtobufDefinitionFile(conf *dbmeta.Config, data map[string]interfa
 	err = conf.WriteTemplate(ProtobufTmpl, data, filepath.Join(serverDir, "protoserver.go"))
 	if err != nil {
 		fmt.Print(au.Red(fmt.Sprintf("Error writing file: %v\n", err)))
-		os.Exit(1)
+		return err
 	}
 
 	return nil
@@ -884,6 +1560,58 @@ func GoFmt(codeDir string) (string, error) {
 	return string(stdoutStderr), nil
 }
 
+// GoVerify exec go build and go vet for a module dir, without network access. Returns the output of the failing command.
+func GoVerify(codeDir string) (string, error) {
+	goFlags := "-mod=mod"
+	if dbmeta.Exists(filepath.Join(codeDir, "vendor")) {
+		goFlags = "-mod=vendor"
+	}
+	env := append(os.Environ(), "GOPROXY=off", "GOSUMDB=off", "GOFLAGS="+goFlags)
+
+	for _, args := range [][]string{{"build", "./..."}, {"vet", "./..."}} {
+		cmd := exec.Command("go", args...)
+		cmd.Dir = codeDir
+		cmd.Env = env
+
+		fmt.Printf("go %s\n", strings.Join(args, " "))
+		stdoutStderr, err := cmd.CombinedOutput()
+		if err != nil {
+			return string(stdoutStderr), fmt.Errorf("go %s failed: %v", strings.Join(args, " "), err)
+		}
+	}
+	return "", nil
+}
+
+// verifyGeneratedCode build and vet the generated code, printing the errors with the template and table of each file
+func verifyGeneratedCode(conf *dbmeta.Config) error {
+	if !dbmeta.Exists(filepath.Join(conf.OutDir, "go.mod")) {
+		return fmt.Errorf("%s does not contain a go.mod, generate one with --mod", conf.OutDir)
+	}
+
+	output, err := GoVerify(conf.OutDir)
+	if err == nil {
+		fmt.Print(au.Green("verify: generated code builds and vets cleanly\n"))
+		return nil
+	}
+
+	issues, other := conf.ParseBuildOutput(output)
+	fmt.Print(au.Red(fmt.Sprintf("verify: %v\n", err)))
+	for _, issue := range issues {
+		fmt.Printf("  %s:%d:%d: %s\n", issue.File, issue.Line, issue.Column, issue.Message)
+		if issue.Template != "" {
+			table := ""
+			if issue.Table != "" {
+				table = fmt.Sprintf("  table: %s", issue.Table)
+			}
+			fmt.Print(au.Yellow(fmt.Sprintf("      template: %s%s\n", issue.Template, table)))
+		}
+	}
+	for _, line := range other {
+		fmt.Printf("  %s\n", line)
+	}
+	return fmt.Errorf("%d issue(s) found in generated code", len(issues)+len(other))
+}
+
 func generateProjectFiles(conf *dbmeta.Config, data map[string]interface{}) (err error) {
 	var GitIgnoreTmpl *dbmeta.GenTemplate
 	if GitIgnoreTmpl, err = LoadTemplate("gitignore.tmpl"); err != nil {
@@ -899,13 +1627,13 @@ func generateProjectFiles(conf *dbmeta.Config, data map[string]interface{}) (err
 	err = conf.WriteTemplate(GitIgnoreTmpl, data, filepath.Join(*outDir, ".gitignore"))
 	if err != nil {
 		fmt.Print(au.Red(fmt.Sprintf("Error writing file: %v\n", err)))
-		os.Exit(1)
+		return err
 	}
 
 	err = conf.WriteTemplate(ReadMeTmpl, data, filepath.Join(*outDir, "README.md"))
 	if err != nil {
 		fmt.Print(au.Red(fmt.Sprintf("Error writing file: %v\n", err)))
-		os.Exit(1)
+		return err
 	}
 
 	return nil
@@ -941,15 +1669,17 @@ func generateServerCode(conf *dbmeta.Config) (err error) {
 	}
 
 	serverDir := filepath.Join(*outDir, "app/server")
-	err = os.MkdirAll(serverDir, 0777)
-	if err != nil {
-		fmt.Print(au.Red(fmt.Sprintf("unable to create serverDir: %s error: %v\n", serverDir, err)))
-		return
+	if !conf.DryRun {
+		err = os.MkdirAll(serverDir, 0777)
+		if err != nil {
+			fmt.Print(au.Red(fmt.Sprintf("unable to create serverDir: %s error: %v\n", serverDir, err)))
+			return
+		}
 	}
 	err = conf.WriteTemplate(MainServerTmpl, data, filepath.Join(serverDir, "main.go"))
 	if err != nil {
 		fmt.Print(au.Red(fmt.Sprintf("Error writing file: %v\n", err)))
-		os.Exit(1)
+		return err
 	}
 
 	return nil
@@ -1031,11 +1761,18 @@ func regenCmdLine() []string {
 		cmdLine = append(cmdLine, fmt.Sprintf(" --context=%s", *contextFileName))
 	}
 
+	if *datasourcesFile != "" {
+		cmdLine = append(cmdLine, fmt.Sprintf(" --datasources=%s", *datasourcesFile))
+	}
+
 	cmdLine = append(cmdLine, fmt.Sprintf(" --host=%s", *serverHost))
 	cmdLine = append(cmdLine, fmt.Sprintf(" --port=%d", *serverPort))
 	if *restAPIGenerate {
 		cmdLine = append(cmdLine, fmt.Sprintf(" --rest"))
 	}
+	if *batchGenerate {
+		cmdLine = append(cmdLine, fmt.Sprintf(" --batch"))
+	}
 
 	cmdLine = append(cmdLine, fmt.Sprintf(" --listen=%s", *serverListen))
 	cmdLine = append(cmdLine, fmt.Sprintf(" --scheme=%s", *serverScheme))
@@ -1043,6 +1780,9 @@ func regenCmdLine() []string {
 	if *daoGenerate {
 		cmdLine = append(cmdLine, fmt.Sprintf(" --generate-dao"))
 	}
+	if *repository {
+		cmdLine = append(cmdLine, fmt.Sprintf(" --repository"))
+	}
 	if *projectGenerate {
 		cmdLine = append(cmdLine, fmt.Sprintf(" --generate-proj"))
 	}
@@ -1122,16 +1862,21 @@ func WriteNewFile(fpath string, in io.Reader) error {
 	return nil
 }
 
+// splitList split a comma separated flag value, ignoring empty entries
+func splitList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
 // CreateGoSrcFileName ensures name doesnt clash with go naming conventions like _test.go
 func CreateGoSrcFileName(tableName string) string {
-	name := dbmeta.Replace(*fileNamingTemplate, tableName)
-	// name := inflection.Singular(tableName)
-
-	if strings.HasSuffix(name, "_test") {
-		name = name[0 : len(name)-5]
-		name = name + "_tst"
-	}
-	return name + ".go"
+	return dbmeta.GoSrcFileName(*fileNamingTemplate, tableName)
 }
 
 // LoadTemplate return template from template dir, falling back to the embedded templates
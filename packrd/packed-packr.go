@@ -11,48 +11,66 @@ import (
 )
 
 var _ = func() error {
-	const gk = "c1531da9dcda94e76d2ee1f948ed2796"
+	const gk = "313f1acd9e86bfc7d9826f5932916e6d"
 	g := packr.New(gk, "")
 	hgr, err := resolver.NewHexGzip(map[string]string{
-		"0df26a2f94d76462650022cc445a4a11": "1f8b08000000000000ffac524d6bdc3010bdfb57bc2ea5ec8257a1507a483125ddcd96521a429b7b90ad912b6a4b41966916a1ff5ef4b18b1bfa71c949d2bc9937f39ec67b415269c24a7073df1b3bde0b1ac811eb0d73e3c3b00aa1bab8c03e05bd67df9c9d3b77c3470a016a02879c75e794d17006b9161c93d2fd40b0d4192b20ad19e13dbbe3ed40a5d6c53b9486fb4e11db73c75b3e9d60519eb139596b2cb6b8b6f6c6b88399b5a8215a1c9416197c9295a73d703550cecc01c814292571ee3feb5a77ee119dd18e1e1ddbe5b3f6de72dd135e4a4583c065832ce7939686ed8ca0438c4f21c07b2859f2d8ad5523b7c7cf74bcb27da447caf81bba043f9a4479777ca0106aef498b10d2816d081bacadf9395d49499d2301a5dddb3775d496f56de0ab0ac0e90f2e1bbcf29e8d46d070cbbb1fbc2f56b327ea7d4865a28d25fb0feca0ece4d699a5c673dab03a4bdd99611ef517729c15171abc5fd5fff2e9374342d8a4a1958468d975da84170db41ae013908d70b3d5d8beae979b94e0509d343791206f45d17c668ed63667fe77e9fd9f26cb455c342a49a2655f175f5847aa2a544551f52b0000ffffd949b82e9c030000",
-		"16f6909b4741ddb9a56b42c8f91e9fd9": "1f8b08000000000000ffb455618fdb4410fd1cff8ac14255825c271cfd80422338684b4150a22414a4aa421b7be2dbb2de7567c7cdddf9fcdfd1ac9d4b93e67a20d17cf2ceceee7b6fdecca66972dc688b10ab4aff55202b63d2c2a55c56266edb683c861f91cf8d699a74c95467fc4295d8b6a03d28d8d43663ed2cb08302191478a33304b701c2cc513ef423d8902ba169d2955a1bec4fb37c83b6c017287b4f14abb5f2bbedbc5f0afc77cbba2c155d090f30dab3dc7ec426e4ad54e14f6e3c419f91ae02d18f88b9503637489f54cb799661c5006fbcb321302797d719be1f51a44a00a8548120bfb735d2957c68cb21b051c6775b0010873cc2b7357ac61c86396e546dd88b8ec928fee04eafaff1be3b6d5dae91f6d2bdc8533da50384b363084739d2016dcfa46d710c91afc13be23e3f73a62e6ddcfb9d65e83d9c4d26d0b8f51bccb895baaa4acf55f6b72afacaa6735560be402f5c1a29f3ecd5eba6494b97a339cc3cf2bb6b8b674a9b9a101edd87f37cb59a3f25727474ecd17f39b6703523c1f8800adc00bb5fdc16a96de15581fc5a722f982b889be6f3d423bd435a661728374ec7e37df0b9f3dcb64da337601176d1b994f4eb49db4ef79912934cb479dbde8dffadb83b9b3cd8f5c8ec6c12c39f0fcf2bfdf0778f34ad3dd297675f4532f3a7c768b80dd4d305faca598f7f9066a40408bee8e3a14913a87c48a4509234748e1f41130d32be84e90cb4d5ac95d1d7f883b38c973ca451047dff2680449245a8f29f2c0f29e986204e60328a067a13123e9b81d5066e6ebaae7d0c13011810724d36d832ccf832816d0294887fb97287fe3d25fa5ee53dbbdba3d1a08da2c1ae48779291cd3891f1b8835118c3c7b3ff9756374c42277de6a87ca94c8dc33844e35174cb643a8397cae85c31f69e74a042fef0718b03871313b540268deff05765af46dfbca7ef236a90e888b078dabf3009b06365166eeb6f8b7a52ffe9d60b305d7becbd09c23f30a089061deebf2439a0f0c408a107074f8e2ca63da87c2ff575bfeee0e58f60bad7b70afabad574afb68d065b19949f97bfbdd8130910a3a88dfab98dfe090000ffff10c1d0a5a9070000",
-		"1ef7a6022c151f089c8f937777998127": "1f8b08000000000000ffbc55df8fdb44107e8eff8a21aa68827cce51fa80824ee27aeda985b68426074808557bf6d8d9d6d95d66d7cd1ddbfddfd1ae9d1fb6e290a2c2bd5c323b33df3733df4caccd30e70261c8147f5ba98c194c0a9998952a87ce459309dc04a3b5c9dc50959ad76c85ce355660a0b9284a04c254520639c915589b2cd86d898dabf19f810b304bf46f4f9961b74c6f9eb3e6abc7fa7e5ead568ceeb7e9c526b10f0f79dac943d053d4297165b814ff15af052b34747a101e2ed314950178a7a5088619c9ac4ab1b1584b4c14080f728e6506d30ba829bc10b94cae6486d7deae9db31678deb82533e2be0b3fe2fd2515355848cd88ad00acedf503e74031b3dcf399fffcf215538a8b2299af5951202dee55703454210c779e57b2ac56e2151a9634b986d6a2c83cb9f02f6a9168ebe15666f7deb89219963396be6745d3c8a4eb5ae33683ea3ed6f31a36624853d41a1e9d9f8395b7ef3035ee348c107ecd785911c2e34e3853bc1dfc7cb1983d2392d4097bfc29616f64659060d262021fc1c897728de49bf8b9a460c1dab37e0d9c3907ae3d39f85d55e60fcf13d3a58487d62ee40ff39f5eef537821b46122453877ee217c84a5310a66370b2f91078946fa80344f97e8294c27939df1b9d4c623f11c04c2c63a9364e0db73e7a63b4f6fdb72fa5f3ab515f7357b8f7ec1c1755a3304f8edec52f1b31b8d34ad34d2d78fbe89f24aa4872fdf681d3a93bc41ada4d0f82b71831403c1578dfdcf0ab58941e9e048411749581b3d061b0d5273e76be2821bce4afe175e4961f0ce8c687cfabd888ed70ece45d1c0dafedec480441ee08053009931d27e1a23a5e3a081de54c37134e079c8f7c505085efa2a0784a622111664949abb18d63150401d6f5fa3812fa475633ceb1e5d78b65f9eb4ffd66d194d2f8090655eed238aa137f9f8bb130bb036c9986cc33f237ac2b266c6adea2200d831e9454f9e602e09e7ec038eba4c20fcfd6b360050d3e8079f112a46381a9f4cf7175672bf1ca39e7b5cafcee7af641bde94b537e50da56601ebd414a4bbffb33f8c4fa7fc29323ea2db18ded6db7671b0c8c367c663f99c27de03f8c77b7068d177d7a0b385fdc51cdaf523530d7d02803d9fba5b6b7f37c3566ebc8f406eaf4414fd1d0000ffff948e6509b20a0000",
-		"26e9ac997e362937a263db5cd36883cc": "1f8b08000000000000ffbc54ef6f1a3910fdccfe1573281fe0040b97cb49274e910e05a2544d2a1ae80fa9aa2ac73bbb38d9b5b7636f49eafa7faf6c36fc88204da4b69f60c7cf6f66de9bb1b509a642223459293e2598a3c13853b129cabce95cd4ebc12804ad8da7862a6e5eb1029daba3c0400b99e508845c510229a902ac8d67ec2ac71a6afc7f1012cc1cfdd9881976c5f4fd71527ffa5cff4faba26074b7a6dfcf1bf023d49c44698492bfaaa419cb343c683f1c0c39c7d2005c6b254360422aa938d6116b89c90ce12015982730388665092f64aae21395e0a98f6be7ac0591d6b07842c20bf012ef86942d93056a46ac00b0762f0e9c839299f90666fafafc8295a590593c5db02c439add950168a84268ae91272aaf0a798186c53557d35a94892f2efcd4e6708e5ac361ff08acbaba466e9c57a65009e613c66f58562b18efd2eb9489bc2284a37e7feb3a2bc5f6e5b3d96c322652b475ed9fe75dbb54954182de5625f00d8c3a570b24dfd9cff2c782b5ddfdc6749d03b72d277c58aeda475feadc981246e3f3f16cec3d398835d217a4299fa3a71ff47aebe099d2c6b3881424c27d74a2c8c0bf7de7066ba48fadf2fd161556d374ca6ed06f14b8076d37e17d77588aee1b8d34a834d25f877f476925f9ee47a6b508dac497a84b2535be2361903a40f0671dff5ca1361d28750052b03c0ebba2db60a30637b7be252185112c175ff1444983b7a645eda7ef67f478ebe05cd4b076bf321d4022cfbf0314724c1869ef45abd49d30017ba99aeda821d2c0f7c7314891fb261b84a6221946bfc5cd6d07161da090b5bd3a8d1abe8fad955e510d8ee12dcb45c20cd6922e692854b3f970363b7bf67de95ffbbf8dd29e5359d420b5d0c334456e30d9102c4e98da95e9e1a478f2271b0a3f347497556b3f3775f4dbbdcb14008047bb5f0396022cfc705f6ea8d0f2e88d6f68472b0ba3ef010000ffffed775f0eb8070000",
-		"28851f11a1e66316d1f1150914d2be3b": "1f8b08000000000000ffbc565f6fe3360c7fae3e05110c8724e8d92f7b0a700f6d831db2ddba00edf6320c38c5a61de16cc993e8ee0243df7dd09fc47f920cedb05d5f22913f923f5234d986675f7889d07549ad72acb6e1fec86bb4963151374a13cc1900c02ce7c477dc606afeac664144a2c6190be752d0bedd2599aa53c3496991962a695b91076cd78902925f0d7e6c3596adb5238bd20b53d956d50cba0e656e2dbb99954ad78950a9fb9db10563e992adefe199ef2a843512179561ef5ff3c7ba0ebecbf30a561f20f1f61b59a8647dff33124fd6eb4f602df31c3dca5fdcc91fde4320c47e7cfae5119e78dd54f8eab0cfca5b0d826ea4212e3384ef9dcf50985efba8088d23f0a0ea1a25bd21c1a9174f3e525fa68c8547908a207950b210657297e75bad48eddae24e4a459c84922e384b53d7154fa4db8c423f80f11710063868f51768cc94ce4115407bdf433e7c04937f23218fba75ec9ea83e3613a34383d72275b1733497250eb3fb4160951b6bbb2eb13682429e3ee3cae0b74c215dfef759b8e73abddd0bd7136e2727f001def5978edd38fd0a6663f8ec96dd3ca8aaada559c1ef7f2cc3d999047257083ea81c8f244fb877978c6f3632c7af2bc732a8fd67e585d6def6b09edd0076e27882c5d65fc1e73132caadfd3cf4e95afd0c1a3f8011b0ad2a97db94e6513e627a7cece7438317594f01e30c86daad46a2c33f3a0890b18b8dd96a51737df8090f67951de846b437e6ae25b59199c650c1a9dd483d35d59a5f08e5c52368503be26759f5aae9933af9279425eda72186ba519c8fcab75f5fff8160ec3e2a7a4a03c118e9a6f1c4eb4834461fa7e3c4e24c7cd9aaa733945cc66e95f17519dc87b5181c4ffbd1de32cb981f74a78f1d0c92f1134b48839ae214934e55280db417e63899dcd462452b3398bbd9b5579a46036c3999688b3ecc7ce19c0859ba99a3915a2dcf860e0b33f81e0ba5f189bf384a2fea0be6b0f32230fc45c8f216a23d97805a2b0da280c215d6cd69b7ad1ad5b41527cc93b7b1ed23cfe92b2cfd7f13ebfb458cd21397a28a5cb71a1baeaf12cdb8841d426b300752275e81ad01a4ec8d0c63bcf902bac8e0375e89dcf99c506850174ad7aee23c735bfaff2bdb91c23c04823bffd397cdf5e059e5fa251455a1ef6a24ee37e5bfe833e76dbe80e568bf45ef57d621b3ecef000000ffff71f6b67fd40a0000",
-		"29cd6e2f5881d9f19ca732c24b7ed7b2": "1f8b08000000000000ff84924d6bdb401086effb2b5e924b0c967a2f6d21d8b49796b46e028510a2b176b45ebada51b52bbb69c87f2f2bc91f710cbe8e9e79e69dd1de9752d7ece3c37b7cf884abdb950db00104c39e5b8aac5159c7681c5360b0b61141bab664588ffc5de4ba7114394cd491eada39d4a26d654b8a563c36d6392c194e429ce2493aac68cd58327b6ca8f5acdf38264a5d5e62b6b89b637e7d83cf9d2f932aa8c2b02f06e336283409aa2d015b21ae1845966d814c931469b98642608d28e835396e578c5234a3249f12965d8852db7facb1b171b51569928fbda37264527be0d87ff35433641898523454fe26c3b952b303ebfea2d6236e2469d6d2b5618a9f3fbefec2f2099a2bea5c04798d2f378b6ffbf1fdc8b48cb475a194da451ead56fcf4e81efb5b54d2aa0cf70b8eade5b5f5062d97d2ea30f81b32d69b87abcb11e0ec3b19d6d962802607bd0c4268b84c7f75941cf60d95be61d6720a417b6aa81c32778d3e6286ca213367c7af99a1b263d20bd9a5eb83630cae8aa230a29e9fb78f14179ae431fc717f1f0d47722e3792c7ba7117c85f5e12feda368c38a339ed18d73f6f20ad4f1bc6e39c37743d785a325eefbc44f7e05bc9ff000000ffff6236e43020040000",
-		"2a100c4d01d80db47aa8c6c74f636d22": "1f8b08000000000000ffac564d73db36103d13bf62c39976c8c4a1da6b521fa20f77dc49a4d4b23d9dde206021c301011a002d691cfdf70e004aa2647ba607fb6093cbddf7deee02bb6e28fb4197084f4f15a7e67b7a9bd21ab75b4264dd18eba12059ce8cf6b8f639c972b4d658179e441d0d168542e67342b27c29fd5dbba898a907f7b591d6e8817b50eb9c94840c06306ca5e2975a18900e5a871cbc018e426a047f87409b464946bd341a16c117a416e60ca80e4ff7c83c3c52d5a203a9bd8147496358726dac61e85c45fca6c11e95f3b6651e9e080100d8a918538fc0c32fe7add44b30025677b8e35d51070d5a616c8d1c84540a8304586ce0e33fc04cdd488520145d92ec8007d0a111920d06f0957a747e64ea5afa37e23a82ec734511d3b65ea07dcbb43ac467547ea62fbfbf2151c283178966ee6d8966ee94e8aad55ed678fb66b5eb01ee99b6249dccaf66397f50205acd0ae6d7d0ddad6a94fe9e817b505d4c49c823b550ec4eeec4daa9f117a6d51ce23d4cfa2c32633968e341846f24eb3bc23988da5793e02f8abc739e1a0ff17b5ea61a4cacbdd174a1f0da7ca3d6dd51f5d77c36edd3dc3ba3a1a11b65280766ac6d1b1f95bd127a42fc5278203fe476d384ea5f50192adb236ea31d0495cac5dc8e1c4f68f862e71f117ae95d6a87d63f8797d1de833f727c0edff99fc28f51e14bea79b4f7e08f1c9fc377fea7f043cabf534b6bd7c75e500e4db236d4b9782023c7c1fb84a017b167e83a301e8245811635c33898a9a70bea9064e3211c7ede87895e8d8749d997a6398cdaa3f0c30027d991d7fbfd633726cd7289365e8838f9fd1df5b0924ac10241ea47f303392c50188b806b64ad0f97d33d289275a1e94a852513d7ccc8341ba0e02cdb0dffb82e2870745eeab45fd21712586340c15df4432b28c3a7ed598cef19caaef04f24e3cedfc2a773e8565f75a9b9b4c87cb133dc862d351301b32c49e62cfb7ffeceb232b45c0a781748aa11d55f38b7451968338bbeb53ae970d5145745ce42ae61170684b85635e5dca273e142e625c9b6092f68a8ae370d1625bc3b8788debdbe02cda588edf410e696034675e808338d44be4316c6820cb9fdf61924fc9170a76d7d2151f1a2fc0cf2c3874820825314913ec99264314fe9fe456b66e24673b46a23f532ca12d5e5aef6459934c6baefc3ab39fa4204946d92d2a5a0a50ab33675f615f0f5716317c6a8c0d021ace1fc3cc0c0cf9ffb968d119bc9434b55b13edb1b03f6be870178268a75591e290f5aa2943fd15f99d5c8b4dabf3cf77de858f8e76b37fda190da9fa59ec40a84cdf029dde77963a5f6a2c8e793af93d135b088fbbe848babd937f8c5e53dbc321e80eeaebc4ba9857a264b1013974ed751a67dec27c9ac5985a7f1b0fabb45bb89eaa3d67e085a1b8fb65955734675f12bd33e11862f3db6aeb81f7f8f191db58c7569922df92f0000ffff2b73faee920a0000",
-		"2a4ec5d806dceaf4df95a14e32bc8fe2": "1f8b08000000000000ff548e3b8b84401084f3f915853a899ca3181e5c72677c9199188c38bbc8eaacf80269fabf2fe30334e907d5fd558938144418b47d1a042fb37e2158743b1b7cff4081590020423f34767ac0937e94268bb79d82f92e16322f217d27ef8ca33113c1d8dae1c4a4abd68048657ad2951ecdbfee0cb32252b993f6157489d5dc4265bf7fef76eeece870177700286494a47509c8284dc6bd7a089ae35f39f8399ffef9da6fa6b7982cc2587c020000ffffe141b80b1d010000",
-		"2c89626a28ddc92ac95f345d6ae2a468": "1f8b08000000000000ff2c8fb16edc301044fbfd8a01d4dc09175e9f32b9200810c08d7f8022f7a405282e412eef2c17fe7643b29bc514b3ef6106fcaeec8d23a60d4ee6ac95d17a295a0d25f559324e4b7b77ab4e72a66118f05761bc96e48d69c02fc9be0a37dcb5a2549dab5f1b7c8edfdf8d46c76ffc753f687431251a5dd33d6e49269ad5b5be120d78e5669876e076c1d425199e62cb4fccbbb2197e041add9ef6f64bb7d20d7a872dbc57823eb8fa9961aae9825638c85d824f69c373e18cde381e44fc17e37fb73f343aed07ecc68573e41c3644a91c4c8f51a7caab3ef830045d57ce8689933e610ac921f5c8103bd38007e7a8f54a3449be9293c8fe4a44f4190000ffffcd9078ef62010000",
-		"346fbc41181bdf0f31a8d024d6ffbf18": "1f8b08000000000000ffb457cd6edbb8165e5f3f052b2081d4ebd2dd74511759a46ed2164dd2dcb8c5bd406e5030d2914c842235e451628f6160de6176b399ed3cd73cc13cc2803fb2e41f65900e264020e9f03bbffcce215db1f48e1540964b3a5132e7057dafabf4d24b2f5809abd560c0cb4a6924f1801042a25449843946e14b2f2a5423146653307ff5f27590e46503e66ac4558d5c846fa18af026a1c1a8c690ae25f2124619dcd64534f0c2e592664c9dfee7f262b58ad6a25265201aa197161c67f52d4d55392a7495be80549985412847857ae12425cf32010f4c43f4749511ab71f63d7a42150597857deada7c8f050da9ba07bdf81e5d64c51e9f86ebba3220b78252aa10400b25982ca8d285b3ffe8e22855193cae3f4a3564209133f11740830c6d30c96030c86b99120d9686275a2b1d97608ce5ad41cd653124a0b5fd573a214b677534220624ea059db00a6b0d27f3142ae44ac67989d459c9e3e8c08cc9c17d3424c1a0b39424ce845005bdd45c621e477ffcfacb6fe4c090df7ffa790f7eb00a21c6863c9f82be079d90e31a67a7b54c3fdf83d63c8338c53909cd639bcd3e8724af8538079ca9cc765bc82721f10e703b3b26847a80ec1bc8ac525ca221e32352b2eada9bb8b9554aacc1ff8a46b72cbd0399d1b7fe399a6860085f0de8684c08415dc3b081af06cd1bcfbda3215177d6c18ed7ebcd046ede7808393cb41a6d001ab0d692a4381f12c945ebc9be6c2fda7a868a564cf2f4ca533eae0897083a67292c57892f49f0d12587dde0691576eed21a2033565520211b9303130d49953847bbfbecd1aa4e2d4dd7e04d581c4d91a577a8590a24d7aaf4418ec9ff6544fe1db63076638b3a649c0446853c3db31b0eba9ea11f6d62928921899a579f5fd4b2ebaa969e5c71c383d4cd6cbb31678a657e82c7de977148bb74010f5eed54ab3260bca2476690830e783a11c0645dc54997021e4da7aea1de4d2fc8b32312459ddd0dadf651728c9bb6131c247e762d6796ef8c1cef9859255b84b37d3f5dc76dbfe83af8b86477e05f83d126094aa937d49c041bc717bd82821b041d881facb5ae8621f590b1e0c68f93f1119180f4cc2acb38c2b48a864d0a5e789c65da7be6b9537976645915ead270e6942113791ce58c0bc8082aeb024186c1e3e687c5861a74d9186a619069848c28e909f97810a1725e97ba472cb849de3c25445792dd081b26f6ed05f1424fb2845cdfb83dec228367d44c1adbb193f6385897bd00fcb2677d83b44fab7801483a074f7fe90b4fbd437f12d2332769ad7fae711c5e95a153cc548dc3f5eaa9d22543043d26121ee260e30bcc71bd92b4e80f4add196fcd96b3819fc13d08b7d6c13ae1b849d0c2ded9f1e2c4c39042bb7862fbcba611b017f0e044b14f30103ddc23c2065ab8dfae6fcd0583faa536fbcdd5ff729c85c1bcf8c06426ec80ee4ceba41b582d995e781eb8f166cf62a53b5ee9d7bd882de729ceed15a6071c6f606c7ca71c447632b7a37a677da232780ff20a7ea8c1e02632e9d4de29854af6f8ed947d5bd1de13fbd4ec0934eca96e8fcae6a6d9c937ec32381c307bfa6eb390f49ccdaf20bd3f37c594ff08a1b3acf4dcdf6bacf4ed02c16c0568215390d9d3156d239bf8f9bec6df2e427b61759bd829c564c6b88c7bc8d456a3cfd21435b0b26b6a0ddfa15817fb8f736c9b677dcef7136d936c7dba9b6cdb655c9f5e1fe5ecdf9a7dcdc1d03bbaf3edb3217ede19c8749fd2d69db702d013d0b83e2650d801cdb2ffbd7af9fa132c2e19d7d64fa0bd87b6df9f60f1f8c9117a4772e13c74db2a651b8efd6f587a052c3be5029cd3c9b185fc4d0f974a09ebc0fe78b653bb91852b5d0ba2c7f63a9bd94f636f759727e7b15ff5484cad996e812fe0e1cbd9343eb445f35bd0c665f578ce538660c6e4fac6615ad9b2a9fcaadd787fbb9b1cfb03ac0d2cf021d918478798fa7bfd6af067000000ffffe07f4bfb70100000",
-		"34e1957655af505ea742ca42555313b7": "1f8b08000000000000ffb4554f6fdcb613bdf3530ce21c62612dfd8e3f186d01c7765d037163785de41004582e399226a6382aff5876547df78294e485db1c0a14ddcb8ad4db37f3de3c723f2bee3ab4e1cb29fcf013bcbb6fc9037990d0a04527036aa8c920f406a547404d013c47a710c8425905ec7a2303fa63f117aa3363a0634d352919882d0c640cec110cfbb081678ed0ca47843da285413a8bfa6f1cc7e2e8e808b6b2eb0dc2f9dd6f1770767b0d353b082dc23896fe7773ffdce334819641ee538bf3ee395bbb0d6e9a84383a82cba74c21ee5b84def1575461567977b9bdafa301d953a6954aa1f7649b7f5ea0cc156e17d69fc9a0cf750e06ae15b3018a6d9064337fcdc6f090aa29d608d16a9c95edca0ae79677a0c9a10aec9e4b51c08d7cc0340f015040f4987a5ff7b200b23e4863b20266e3611fc9e8b45cbbc0a04ab8f63e22ec3af9803b080c9a7c6fe433b4687a5140d950a0c6b29b0b3514605ee61a0daf64a28086cb8ef50ce334f068103c86d86fa097dec3eee464dedd416d6493193c86b09abcf6b5fc54632da309b05b0c2815772f668802ee2ecf2e6e2ecb6e2eb9daee50ea0e4501b2ef2b8fee115dd549b265c319b744e88a2c6cf3db0d0c145af0836c1a4cb6510069352cf9f3998aaa6225484101e5a2ce03746c0cba04d2920fa08bb38f5047ab52dc7d12f648d9fa9cdc395ad9ec2549a248a2d11c08b6c145153c38ec1d7ab4d924098e876c1b4ad51e7218e4dea0c859cbdda50ccfd2210632f40d7d363889ae9dec7060f7b081ab8f7737a0f7199e146f876528877e575734ab98dcc8e75714f0f98aec97776d08bd3fadaa86421bf7793e0dd993862da9f4749c9089b5e1ef82333be7af0cbd62d77d17f895ecb736560dbbee381fb2f74b96c56eb72bf7d2b72205189674a45df1299f3187322048d89395ee391da73dd9438a12d75db4f615d52b4ce6ca97613eb44646abdaece680fbd565b6308e6fcb79f50bfb304de34835588475f7965d80ffff6f9a4e0fc8b4979068f5723d6d67bff32857ef539d48eb75314ff2d53c5eaec1e4dcd2d206528c8de76598e8415a201bd04915e811e7e75a2a4c41c427748a52945a7cc9c323e1000e7d34c197e2d0f656b5d8e1349d56d5bf565d2d2a2bb21a9fca3674661e4aca7174c667713506d5a63ca6c80b318e4eda06e16d0efeafb2c30dbc4d17e7b5ad194e7f8432bf482b3f4da280ffa8f7717ca95ace0736f5027f40e00f3ca04b4840ab6199ee7a475dbdfc215c609064bc18c74eba07cd833d678def0dab077893d3f806de95e76c6b6acaf34e7f208b9f9cec7bd4c789741cab4214557a5c3e7f060000ffffbe479ff4c7070000",
-		"36cca6f17a7dad3fd8ffb948c01678bf": "1f8b08000000000000ffb454db6e1b37107dd67ec554286aa958af54d70f850a01f5adb08bc2162cb509100406458e243a2b7233e45a7668fe7b407ae5cb466b3b41a21749c373e61cce85ce099c4985d06685bc604264739dd96591b7bd4f7a3dd813c2b96c6ca9e4f6942dd17b604280d5f18b81916a9e2310724d31ec5c3661d31c2bb00dbf412ab00b0c6787ccb22933eb6351fd0d527f8dcbe592d14dd004a65ec81919876838c9c24aad7e9cad099b1ba815211eec718e8505b8345ac5c088b428393e8e3062cb3a19a65adc84e0520bcc478c7f60f34a39ab432d9508ed5091da49bb2a19e7680cecf4fbe0f4f412b9f5afcb1ce97f33999784b05ba3b3423e251f4f26a323224d35daeed7d0ce756991a0f7c409dc82d5ffea1592f7f0aed0c6be0f60e40b0d5bce4df43fe3b353b86bde899ae9ec4419cb1447e87bbf05b7b0b0b680d1d978026de77ece0cd215d2982f303818f47a0fc1636dacf7cec91928847574a4c9c21f7def070fc8100b4854c2fb66bf6d78bbbd57c8edff0cd2a03448bfedfc9ecc4ac537ac4d67159d66e7680aad0cbe2169915220f8b58a7f2cd1d8140a1381148b95c501325d70498bdb6b180c412a6925cbe5273cd0cae2b5ed50376935d77430845f5e3511ce27494bce00890289908950fb0ea5d098bdfb6784ff340425f360b245684b52b1e91d6eaf5358a570974130fd54ff88689f89ea86f7d4a4e593240180072b8df2d93ece34e1985d61a76e05e2e79bed00808f369ac547840523ec745f6df77f964bc12c763637e4809059fcfe17b9a757b77ad4e5b5a36afcee52531a76e9f183d94e1b5e950d969f990224aa353a78bb6214e9185f8ae6514ee122a680e1c66b6fd8b928dd3cbcf78578797ebf74de5a850d8e1bb2c63da3e493ea35493e070000ffff51a1b4af72070000",
-		"3adf58574e9c76acb6a937d9d849f6a7": "1f8b08000000000000ffe4594d6fdb3813beeb57ccab368514b8f28b62b107033eb86e13146dd36cd29e8ac265a491424422bd24edd625f4df17fc906da58ea5200eb0c8fa22911ccdf7f350a6e624bd210582d6c994b39c16c9a998a7e76ef68c5458d74110d06ace85822800000853ce14fe54a11be5557347f990f285a2a51f97bcf0775c8681bbd33ac9083ff9ebfcacaec3f554c5332c9b49375bf0f94d9150365c91aa4c96afc2200e82e1102e512c51807417caa4222cc540ade6b85e5362912ad081713dd01a046105c27345ae4a1bd3c0dfbf633987d11892f548828d773884535493b2d47a4b34b9b48a8d06a86ba012085c9c4fa14275cd33501c0a544040963445e039084cb9c82219432e786572fcb971a1aec1ea05ca405d9bfc3f4fde1045ae886cd6333f0cf2054b219270ece28bbb7d8b7c89c05f4d6dcd750002ff5ea054706c0cdab4b76a9d74aabe700a62881ea042ce3993380014828b18b4abb940b928d5001457a4bce03fa4153005b2e9c908df6d68a79d4d12d64127e7a4c0f6e892feda9af9243214b1f585e6d6f6ffc6c06809dace791fadefc6ab170f4d80beb0118feed4e4d6d7627ba5666f4d3207f011a52405ce4690572ab99c0bca541e8547cbd0a633aeebad60d442b0754c0313ab5dacd705f9b7067bb9485394b21e8029e3e856899bd28e7614dba06cb4eeb5cfb6d72c4ee50828537ffe11adfb2f863ab8334f7543135ab7da6e0f2f505694e859c1518283fee168e1b138e1a184f00036f01470170374c35f6bcfff39c532b37c3fe5199e9891ac6bd0daa0ddad26e7825644acdee36a228a86e99b26d2da4b9d72fbb43334d01a5956d7f6022febfa200cf2dfa18f3e91d62d85161230f62dd25e72d18c0f4a325d2430c9b21e2440b2ec371250fc70f0b75e3c02fcf7ebed867fd7f3fbe12ff80f39c9734c1566fbc9c0dae9fd2e60d2fa70a0f68bed2900b567a4f7052adc6df121506d6c6c358f71636bd885e92ff38c28ec016b27b87b7b3f18b61b6f1e01de9daabb11de43c5a140de98eac2f9baf70fb7fb9b62f579056801ef9064d33bcd4f816ffa07fb6428e70d96d88b72322bf8c894d378f30894d3a9ba9b727aa8b883727a534d63e209febfe89dbda7c024f70876ab3746b73ae59e89809ee4b1ffbf85d62fc1ed285b679fee9cb639014ded682188a29c6d1f833662cd61a8b1f3814a856c926502cc0265057c5f91aa1c85a55d9971167eb7920d4d7cb978774b32bb9a2d04f5621fc94f5fcd4bfa0b5faf144aa04c35b215f939ab7cb525fd85b32b23e19ff56ea250b72cb8c866290ad5127d8fabdb6e7bd11b5c79c9e9c42a34bfb6644a1a858e6c3f7092f914959c643bd3e91835a725c29c5366185d7137f97a327dfff6eccd6cfae9ece4dd29205bc292086a1acc51e9467f14b72be24ae10c9d13756d7a974bf34f18d9320adb9ac3b89156c836a721ee9c3db940929dd012a38db6fde450f22239218a9479b49eb3c7ed169a361326674eddae48c747720406a9adc737e6dbf328c46622de06aeb7301ab772e3f772e3fa18ecc1ff17561121af49197dfd669a27f2998807f0c2e9e81db08f714e84dcc4e882b1b4e3dddbc2a313f10d73863f9cab278257be94a940ffeeefbac7268cb44272ddb0e3e128cd8b9664dcecbd9bb763ebc50b37abbd7fce9b6989842de68d6181922f448af2f77ddc4b46b1ff20323cdefa247283ab013c5f92726129340147485a836365088f9ebd7cf5ff656845cd5ed55afc7af4f91b1c3d33cb4e87bf98cdca1097fd9a02c7c3e09f000000ffffae97e9f8671a0000",
-		"3b1c75b8e4d28f4b29acb1e48a50e464": "1f8b08000000000000ffac535d6b1431147d9f5f715c44ba324d11c4076591baed8a88a5d8fa5c32939b3198c95d3219ed12f2df2533695deb072bf894c9fd3839e7dc3b312ad2c611164af24dc7bebf19b74a06121d8bd06fed22a5eae4049fa6608ce22af8b10d17b2a794600648e8d1b5c1b04360ccbd90188ceb2cc153cb5e417bee11a3b8968da5d21bf2378c43f84c397726836ce4709756e59a1f27efd9e318e7de5f70d8f0e8540dd5dca3b38751701ca073ee41cb4c7d238da5b9ada720277cb4bcdd414f19b0876ac495fc4a68a5b5255c6575bf577fd4865bb4ec02dd06b19ecf1a317ae93ac2636dc82abc5c6156fdce69166b56b4c9f12125c408a34b9db8f4a6977ef79e76a7becbf8982afe94dd4fbee509f27ab7a594ea18c9a994a603c729cd1351781aa3e85991bd94ed17d9159bc5034d4b1c791a461b0eacaff191bf0da75a539b1f312ebc785e67f3e7012c11ab0a40c15ce1c941a831e526d564f7cede888df14328bceaffe9efe2dec335dbb1771f284851ec5de1f5a2fedb007e717a99491b3da95fe55d3acf16bc9aee8f5670c622e692c98f307a9743358e9fd5fb8b9d2b52f513d49ab7bb3bf9e50f53cb4371f7b7ff07b66a668a79dd0bf401f4fffd9d5278475e35627f5fea8c50a5aa38597d0f0000ffff3e92b9ce8d040000",
-		"4dc5d0bcc754ea1d422df93cf8b3abc0": "1f8b08000000000000ff8c94d96ee3361486eff514ba6c51889bf6c7a1a8639a09c54373f18c3198772f28a7a863c7cedc11c2ff7f67d7866bb650fffa45aeafdfbfab4a63cd09efaa2ac0299b00f55f555dd7b5b29857a211b505a270a31aeb3323ed48ba9ad2dab8d5045069d76a938e79d955d2824a47dc64a409366f65826263843582f1998d7c625c0c7dd71c16de2fd33ccbb1e7f794159c89e0def7b0cd16e3c9aecbff1cc15827262644dbb2860d7260cb200fcba2ee39dab846a333aabcea332703110f1a6cd08393ded0e84195287c26e3ab226f2d3fa4feb0ccdf58e2c9366b30670874bbc4932df974e4a1768d1aa90f9870c987a269bfd258e9f4275547d8cbf8c1ab0614c64b4cb0a7b37fd9ccba5af82103148620ecc19703e84c5db6b63eb725ca3fc629dcbc4c66b1702f7fdbd00474349eeccf27c4b76c0db8a88e9b59133da6e403e604e15aea83fc3d501f20a54be9b2785da4350bf5a727a04d1a1b3205192f6f115dc18d84bfc26d3225f7313993a0adcf8230225e3620ca84c1508d2467b33e69413421fb088e5ad421c7ebf81e16b3ac96467a3016e2e71b621d9b7ace866668d9308e6aed15ef9ed8cb09eccf6b87bfcae62abc6e723991feaad8778c60d0f42755e1e213de66c105ebf9c486be6f60545d3b4c0798d7f5b19fb71807e9ee8ab968b9e8e606d834483633351dbe61c44bbc63303eb43de70d9ffb71597a18567e78cd4888f69ed215bb104ddb4f3d1b58cb051fbea0ecffc31b98f41e9c360e3e7af70796727945cde7ff8681fe5d13e3e8456e969c45593441a6eaefaaaaaa7f030000ffff71a6e24fb4050000",
-		"5a6731c012006171905bc006b699e6a0": "1f8b08000000000000ffc45bff6fdcb8b1fff9f4574cd739d40e76a524875714beba85633b89517f496de7d0832fc872a5592dcf12299394ed3ddbef6f7f98212969fd25cde1f5f51d9064490e87c399e1cc6748dd79aeeb1a95fbbc097ff92bac9f2da405694140890a8d7058c05c56084d85c22260211d58dd9a1c412a48338775530987762379c06abbaaa0d6859ccb5c38a9155ccbaa821942a5ad1bc352b7b0105708334405d7c2282c1ef1d848d6d648922439ffc3f981cc5159fcbcbe70aeb19b5926eb32b50b89556153a9b399284acc02d564bb11f902bf7ff3ea87f4d56456b598daab72a39fac1b547e23a9366556f96936f3f3263fa4af36e0fc0fe7eff5aecefb59a52e74ce134ae916ed2ccd759dd95a549542ebb212d5dfac13aeb569a3e26adf346d03683567c495b4fd72be3dc925cf5d9940dbf9dbcc08952fb66a611d9a8d6f9a17760527d868e360479862b83dc3ddb93005cbe875faacd05f99e99bcf4f4d92b305926dc1695d416374d1e6489eb773f26917d6770c0a876330288a31b44d211c8250051458a1c30d38d93b3d03d1489afa2be60ea22bc2dce89a3c585ea18242383113165358598f9c31c9b5523c53835b201433083de4aed619a94a104a54cbdfd013045e2c473c203c92eb0281860ad08a7be695282dc976250b2cd22479bbe4bd10cf029d9095f582ae329ee9d6058e555bb3146dee5a8363963d2eca275443aeeb463839ab3010825b36985c4bb76026062f5b69b088dc94a8d18e7939a6b463de8a504a3b3ea4364d927d07b66dc87a16ce4b6dea81957b6bfe2ad56f8b96ec5e6f80a3bd1227593715d201b660758dd05a41c2d5e816bab029bcefa24a2f034895576d817155986b03aaad2a9eea25b7706e2fabf4a8adaa7ffa5943d7ab842ad9d39b8b328bbaccec6595add18cb75a571ba00d9c97adc1b265e6e9633efde63c5d46741b09ed8b9489370de624fa4c5899c3ac9595a320586acf294d9269896a4af1d3bb420652d9860d305b328f6b6d2e40cfe114dd024e1758a9d6b93f5a382f666fbc059f942752bec93aba0d6fbaddd99b536ff9b86af6dc9a3b0b59a3113bba40f3470ba5fed56a058dc82f448964696a3fb9fc7066e6e936d22449283af3690d66955a257d06b1829c015c30a372422a2c809c53fa33926678c34499a74d8b199c5e56d2e10f8373fbc9d299f1aae51cb272f2e6baaaf4355178bda4c9743ab59755b273b2b77db60767db6f0ff66024aa595bdb51b29e00009c6f5373bff80cb07f74b6f77eef043e9eec1f6e9ffc0c7fdffb19b63f9d1def1fed9cec1dee1d9dc1d1f1191c7d3a3818fba967d255f8997e1efdb47db2f361fb64fdf59f5e6d3c24db364e5a474bc4155609de1d9fecedbf3fe2f5d67b6a8a6beff64ef68e76f64e6124b8db8e562892efbe3b3e82ddbd83bdb33d383a86ed9db3fde323383e824f1f77b7877dc9062923595b5b5b833323949d6b53d379739a064a9d90e3022b230690dbe4bb2c3b87579f21e808bef13fa91c9668563be9046dc25c5416011a236b61969be04c8b00205aa7bb46aeabcd473c2a549b3079ed1b05ce455bb94d38ff9c7ce785dba51930a520b4390aecbf5ce0f247b2e097ce843ffa20b21976f423ed7a3398e5c711903f6f7a0ff9228b1114b3cd51201d5104777ad6ce374752b91fde8c5f8d75e3c61448b7ba1953afb3d79f819de35b3506a0ae84c917c2780ffaaace42dbeb2c34586791c78ace5effe9d5639d79e1425e0b4a0b9ae121af9715affed1cadf70f3f59f5e756a7244e97574e67ff61af2acc7af7b1579eaa09f379f217af1bfdfa79ed5cfeff0292fdc8a5345cf09723fe33a3cdafb4e207eec3c6f06ced3cd9926f77c4c29bcc6d8460084d2a0e0144f80c7075a83d67580475a68b4b59cfe39e5ef6e1f436eda02e6ad621063c740e11c164215151a3b865a5c2001fa710ccf16cd151a1006415c09c94937859d05e617405884d3b89e73ac3dcf0722621158d8cfeb6b5d5e9f9cfabe2e3fbc954a9825ec2beb4455f91c319d4edf6e9f7ea061e9fb7b60b66e17baad0a2a14c2181604d0fe3b2b753693ca6f016c6bb0eb234d484525858146b8459abca0ac5ca283490bcf415016afd0d7aad2a2e8d4c1e9a7cb3ec90bb826364f24c5155e9911d79987e18f735a12aa189fb41e43c5076bc27a3470ac98be9e3ca1906683768c0a26137b59918f6ed990477fa16cf3dd6442c8d63a03a3b4176cd48d766bd7422ae8ba1921c4061d88bee191546cb25fc606b94d2f5decad75d15684a2fceabe46b0ee29baee7770ce6e2838ef8a7c9379edb6ac22a7e8840bba9e14423fee24edf6b25ea1b936d2211b89305d1145f4965af77d8dd1395aeb4d129d96b0937903720e4abbde5dc91879d1ab2079e17d363679257f2cfa9a38e71325487333a9baa96b6b70bd4005956855bea0c0ddbbbf5b085f2b9cfee3801c8855d32329693bb6c165aca8d95b40586e7b2148da06d2344b07ae014087a8df020b7ddca0020133a3af2d1a3a95b7b72f526fa4d37c8135dedf6f6659dff9415b777f7f7b4b1a4288bd1f09e4fff9d5fdfd664f497d4489aab8bfcfecb5284b34995405dea40b5757bcfe278b7c1cb3bc355546675222493147972fe04a72b4ac0916575261e2439fafc04913d5425bb7f9e7577f7e95793898109fe7283cf44a383487d54589d4ccb5b2bac2e4f6367d8fea0356cdbd8fe01cefc85da42ab9bc8d07391c6042c59d0f5378476197dee97ca959f006282c087b61d3e4254cd9dc0bac9a294ca092d6f5611a9c30253adb913127a25b41c8c1d5a62905aa69471c2c4be4a6f5fe4111243a7a884df12c9c5e56ec63c56ce8549d7f58682a91fbf5fcad8a5d194f8edd823cc60b0c78431b210d147815374bdadb0be443e5851a7a5052047fef825670ef69b7dc94bc1c73a7cd328577b1ca8e0ca787c10653c885a2e3d75a9f6456f4360c035c55a4336117c9ca5166ab878b85901457827730fc834a85034bab0ad2c8b372272f21ca9900bc2419e76dd5f5b1f642c8219e943c6def4b510a74790afbd6b6e8cd3ea56d16d2369558b25b51926f5a47cba5a574b254daf8054be9c03779ad5247a6c94b28755aebc2936908a1dda26b9b3134c25a98c6803fe59b10e660d1391675608b3035e030980ed343544af2124ef6b6770ff7d2da2ff9314c36288a1a9397209a26f37124a30496969ae93c1681f752c1298f8e3d4e0ae105a492ae3b75a8f8248946667ff1352be1b4bf465e7cddc4e08a6c6a7445782a790985d04fd21316eb6058f061da3cc33891f319235344a0f1925481d593bc7c856fc16063d0a2622d0a30fa3a44917cd11f069ecf116b6d6d70df127059f212cedf1f9f1cb27c2ccbbb28e4e7f53423cffc5208fd85927d5a171b447ffa8f837ffe2b7a7b59dd44fa0f147799f643c09d1d29c5da487648fbed4678f771e863c0cdb08b733292d4aa238c989a69fde1ebae02035e689dace46fe8931c597f6e448dd7da5c8c81375fcc989c4c7f7a1dbcb33751748f42e72db98507ada438f9f4fd4829d5a4d44ae6f4cbab8c58e8278999bbe67f98f4fdb7dcb011a1471b4f0b400a998b4a674cb411b0f7a9bf50c302768377d864a7350695ab96fde83899c0a13052ecbea55fcbd37f1c2413f8a8ad2b0dfac6a1cc8db67aee28f686c3944c42204e928f9550044f03cb6402c746e401eaac4a0167cb066d921c6aeb8697805480d84e22b6c8e1d25e56e328881dc7b84f663b3ca5561aae855a8b3eb7360d1990612b074986480f82bc288a8ed25fdcc1db658c4063f619aa198d125547c7cc18508982609aea10211629eccfe31a114e3a5440e55b51b0f38a2aeeb228fcc55c6b6324a448e957d9c21b67444ad24f57900ce886b8a4dedbbb5819a68509bd883e3daf940add330d25989481d434d40be20ab706049c20a833dc422bc7b7b9a102ecf9384d79ab9f96266fb1d2d7feea8fac48256c9835d4e218ecc267c195116d601a2e32a629832eda15df94dd26be561fd9cbea0ba971b409a3403b1ac7c1527763fe1aa21f8a11a323982d1ddac154ae65ba51be1d3e7dc0a3d45fe29574bf4eb8910eb44c7adfe3c5ede24aa81c0b369af7d3f8d4e1f14c4c0fa2926e490aadc4926a27ae63f5b5ea92e45c9b5a38369bb1fed5ec4df00906891f3d47367d35b051f70e309d4c62efae345bd3301926e016d23eb855f5e8a4f3cf074c5338ebf893d3a3646437e3fb717fccf95983379c1026f77ee4bd79e0749066f572e03f70d689d2b91fd633e413d3ef893cc58b8474b654c90f3b5cfcc335df1cd80673395f7e75e35c53c5bb164ea641d76942fa14d6df394f267883f9565f18b11503345e39a12c375f24f0b243729b1bd9789c41dc5a87209d7f8e0a430b61079080cf198300a9bce549629aae59d5d4cb20cb87335674d09e208469095463a51b7216a7216fadd3b50c6f58830b263df7d8167ed62de4acbb4aeb06dcc2e8b6f49ec315071d631228bcf1a82b7d81c934628b331a7ac7705a1b80ae9fbb0273a1405456438386b604249c7f7002dbe60b2a48eb8b429a31e4ba598ec1e9365f8ca1b92e520f656265302c6e289cd8f8dad7bdfed116bcef9ad6e7845efe3439081b6c5707c670814b8a5dbdf20981f17eaf44d5726465c0b2afe63a057e9df48ec371b73f2e7e724f9a2453eea28685cd2d5af7dc47a8cf2f3bb269f2843ed707139f9c35f6ab1d891ac79d0268a6e21e8fec776345113b8880a6849be071082fc73c0833adab8da92f7b6f6fe90f18a14a8417dd5a300e0dda4e3a10f2fe9e62e0eded0b492352e57d0f6b73730b9cfed434680283f4ace339e948e7610340f48d91cacd6174b8fcdea6a51e81e744d49e1c1ea90d5e0c657ab4108cfc81484b9dbabaa946307268dd08fa859d69f1fefef67602a80adac3b42ff09ff5c4878e98262b2761fddf689f71873330da6b60aee1aadd66eb6275b37dff28dc96fbbf9fdf2cd7bd6258a00ed6e4c3eb7967a2c29b8c7f71efe82b3cf9988733b4c2cf0f3cc98fdba9bb715f631c2b5cc2fcfce2fea4d4cdf5d7ac4b91289e6f6d7a16ded0e12318c293e15a63b808cf8df93d7cbdc0d13a247f984ce2ab378518ca33bed50847d0d3a6f031fc6264dc814d90042c0b2a6585ea5ece1b833962b84482c88b739223b3f178812ac7140edbcac986b34b2f409862238ef5395412b87dc7511615736744e18f17fb537781d90bd855a49e0a82dfc6c365bb29d669d3df4d36867242afc350aa0b1f8723fc14dd5b07df34b5f3b9bc79884d016f1c2a4b80f949e19f169b5270cf22bef97721214ea0ce1589e20c3aac4d1370b747ddbc9297315d09a8ec1da334cdbe84c3e8ffa198d68f6633915fa02ac2f0a0d97b4b2a0b14770188d895a152a7b6ad47407dd1d2e9cbdfb3489cf5549fbfff5995e5e508987b779c92d5d4af9bb6eabf97510e6f9cafc966cb8798950b215f1dce968c7506d55298bbf597aeccfb6bc4751e55f5e55fb45a2cfd9e97810161238c5fa803bb3e2670241d7ce3c06fe79128c9323858814d3d54e2ecc5cf688f308591ba65345bdb55ef269ae4f6360de583f51737a4d82c7b9cecfeff30c2509cfff304e72bdffe0695313001deee2e3c94bbaded8e2681ed41361e20990b5c8ee185c77504613a98d2c18defd7266ffeeb6ac4a4707fff6070f2c3ab33f87e8dc63d93f00f61860819923ea5840f7494a8e3bb809849aef9f81e9c41ba45378c3f7c6fb1e2bd7c053b2c586cc715f90263784af8cad55fc162f5c52fbc757b3bbabd4defef47f7f75396227088cb10a7e28123a6f053f4d4ee2e73789d1282772fb7f6df7c2efb4019f07d1f0dc844342a4cc9d76b2428517230dc6a2d9a29f1cdb5fa3526d061213bdc13571cd3c9840e3b75e1944a07b892780dd7f175cc9386e270469e70d75d6202c01df061020f0eef42be4aee269349f727b923d5b6157f6571e7cb70a29ddede760323eea6003b05b84bee9aaa35a2a2b2ebe1947ee4e11c173e1f59a5f7bd8f68f581bec6c702c5fec7f41e793fa6f7fdcff0df1135563bc23e21d643826756fc1a8707048f399c2a7181cf4ceec71ecc4b92270e5e447636e9edbd09064b699d8feb5f3ca623ffe0f0492434f11d87275a76709dee59139364e026533e64a3fbfb94ff1eb1407007b7b7a327561a7164a1699edd3393bbc16f6501bd533ecb6c40f3cd6c1f5bfc79f68f697fc7320fdde26bcb3ca4fd1dcb0c1ce86b2b0cc8fe43aae200fce53fa4b0dfbdd8ef57dbff62897530e8df9653f82530fa6504bf8c7e196dd0790f1c9e5eb89ffc1511e2d80846cc127c08595b8323edd0fa2791cb6a0c8776e53dc23f1ff96f77f84e56f48f70353ac1f0953f4de077de98d02f5b34cbf0bdb9ff86377cdcce39b1759a0a33c31f518fe3e76d7c3b45a4dd67d10c800813f09d9e36f5e023ee3499f89c66fdb24138a5d5c43aa10a618a15c6eb47c747dd6772e1cb2403fe567b63a5eef240c13f0a17fec57ef72d1ca213fe6de740f307eec91d75736a5d369c548fa2dc70071fc3d27fc72535b769cbfb71cbd4b3e33f563f400577dd6bc74f84b0ee20e7ff2d008aa25acdd04ffd49eec2975500774b1667f9f0af3b58dec132b96ba24dff05a94aeeeaa5bdacbe81656de11b08fd57683fa1a17a153e482a8a9704ff4f90ff8f9bd0c380726d0d76b47246ce5aa70d39e6b9a8f0e6d737af9f7e158c831b3001103093040e85ba60c01907d98916d2fa676d624d202f494e11a1d606fb7e6dec269c0f9b4fbf6fae7c17571ad12c6c369cb591fc4f000000ffff908988f975340000",
-		"5ae4f8ef0e9b1de7530664f3fba1d023": "1f8b08000000000000ffec544d4f1b3d103e935f31efea45daad1643a5aa07a41c523eaab4b4a209c74ac859cf6ead3a76623b90c8f27faffc912d0a14384655b998cce733cfccb3ce316cb944281855b76629d6b79431d22962e70b51783f383e861163ce91a9d5abc67ea573f41eb8010aed4a36962b095601650c28182e3b81a0b1519a05b373e486ce04e6341bfe072ec1fec0e03ba796cea8d9ba59fe199aa2d64ac3115c683d9606b5bda45c20ab81cdc0d03b84860a016d340e02922760968d5d43a3a4c5b52567e9adb7e8de3847e68aa1b8a6cd4fda650c64a74405a546b312f695f1354cd4bd19b52d3616197069dfbfabc33069a00adce080b770fe819c6b7e873a6495150c87502c94b19d46538498038d76a565e075a7c3750e2ba1b1ebed34d5e0c0030a83cfe73eca19f84120fbcf5df672d3cf90b2971b0700304b01a743289c233c4e395d0aef8bde370c3731c11997ac344b510da287b770a5ba0e35fc3704c9452e16fe92bd8c0b8d09c1e8539a56f726748b60cab7d5832eeddc92e9427369dbb23834906e85cb0e0e4d112bd511e4b5e673aa379f71633e292e91795fa43a6c3649fc9c46ccdf56a83713759fd9ee01d5e09ca6b243f8bfe52858084f273296ad22678ae165b01befc1b930a95436c79233255673f9052d2563335a5935968dc6394a0bdee77d12e772f447152be57d388792791f1f38f21e12ecb091610f9e4c1b2acb3d4198979d659b72ebb8c478487f8b48ff89f309719ee462dbc34ce892b42ed6d8ecbbac781b013f62201fb3e4a2869374c60f38e0ac9fb357e4153536ddd69895554f55bdabdc871b2d337bafe624519207fcfd8d1be92e8cf9220b30845dc7cd66116e9bb3cab9f85d85e2bb2c3251fdf3a2c2b771bf020000ffffaa617d0192090000",
-		"5d0f233f1bccaa0723de47b5a2ceb285": "1f8b08000000000000ff8c945f6fd33014c59f934f71895448509ac1847898d4876d5d61b07f6a2721046873939b60e1d8ad7dc356ac7c77e4c4ddbaf247ed4b1dfbdedf39b64f626d8125970851c1d48d598afb9b0a890991552aa37a21a2b60df7f6e01dd2a110d66633d24d4e17acc6b6056e8041d9c89cb892400a2a24606004cf1154091a73a58bd824506a5583b5d9359b0bf4dde4c6c025d077746b63466ccecc7ab9f08f4e7ec134ab0d0c61c12a04f7f3438dcb060d61017181256b0419e7e355f24797e1bf1086209b7a8efad19c710698e73e61ec6f41942e50f7d2c51c8cd2e4a772259a5aba5ad45a6918c289d6178a26aa9145ea8a275c16fd62e84eebefa719e7740fb99284f7941df7ff69672c7ddc0097f4f64dea850d692eab04628da673fde5db4b6bb35a1528ae58fe8355fe2cb32da9144811135375e7b64fa9f3d6fb4bc08681590a381841646d6650604ee78d203e5b8ab68dc230e0a5d77f368228720d6eaa37633ae78c4b73285771579642f4e26b1475e420e83b5d6318046d18b49bc0d103f0a1ccdaec4af39ae9d5475c990f8a4b2c9c8d75e3f8281b6bfe13b5db599c7488da98a5e8396e2b23286bca660bcd25957134305e6dbe828181cbc96476720d8302a6979f663039b93e7e0f93d3e9ec71eef2e2ec739482590a7ff2dbd79284410b280cc23f1c2d94a14aa3d9c9d4edc0dc6ed83a3b3d3f75831d1dec2ab0c6a6bba0438f1c1f65539c7359c4662992fe06ce5455f561905c74f2fd8ccb73c74dfacb7219eb08b32e533ee17dd5739fe0753d2fbb486e303552a3a57b4c61f8ba4b6c8fcd25411fe083917bb1a6eaee5835d283a3a7df9ce87fec070fc3fd0dfef662eedf97b00d436b51166d1bfe0e0000ffff9fb98df146050000",
-		"6363cd73a54815cbc46f48f9b0c72cf4": "1f8b08000000000000ffc4576d6fe3b811fe2cfd8a390177900a594ed27dbb6c5d60b1d8971cee768d24872eb05d2c68696cb1914995a49db839fff76286a45f125f924f2deeb2b2a4e1ccc3679e99a17a515f8919c2ed6d257a39f6779fc41cd7eb3495f35e1b07799a649395439ba54986aad68d54b3e1bfac56f4603a777451182fc3d6b99e7e5b67a49ad92c2dd27438848f9797e34fba16758b6fb572a81c5ccbae038b0e5c8bd0a268d058986a03753070ab1e41745acde05aba1694067220d5ac4aa70b551f709a5f0301a8ced1f65a59fc87910e4db971e95115709b26d7d5478e9917d505ba3c0b1e0697ab1eb3cd8ae28021c51b90b9d15d5642a6f4803194a0f4c03a6d303bb06c6cc46c2e76ed0f59bdbbe9a5414b66475991aeef92f7cbc5e74f8799d30a84e2dd43dc3db329c0e18de38c3dc62cdee795e21d2695493c948112b24dc4cd162e50355bec06ddc22870e20a41c052740b04a11ab068a4e8e47f10a403a78141d38bb880f64b3b34014a001cbdff59fa0dfc253cfff702ada3ec360852b99282d30f345351e3edfab034fe87197f54989edcbe1352bd86ba15c6a21b2ddc74f0ea90f72f83dde583cfbd935a598fc92a399dfa454c545849dc100eaefa12d018381d712aaadf84b1ade8f2a5e88a3491537ef9c30894ec88b7e48c8854a2bb40b344f3ce186d480f86bd146992f83ca6c93a4d93ef257c871184e039878b7239e0684f394291476d4824248aba9348d9b4a8a83fc1f3a3a360c099deb3f2929fa14223ebd011bc8c0ec27fa2a288098ef87f5750c2c036e4677157545d4b0c28b392388a74bf691aefd27e14aae930901dea732e7ad0d3c0d4d05fb82a1736166a6c4657b8e237a1a4ed4e870225e6b8f3924a186b2797d8ad4219df83918bcd1342f1d5c7fee62f25b43e37c1b8d8bba32c04b1ec3e7ebf50754ec19e9858ca669a24d448af705506eca7233042cd1076f091feefa666bb84c44fa24fda8ad5458d934ba34893754c83eccfd9eb0004b1bda8ddc220b856386875d77836ad13c6318fa81aca8b0858f414644f880c5a8b36e5261f5d7a7704d2af57e8aab3719a900f887701858a28ea16eb2b9f5e0449916672896a270c48cbe524d506069bf87c064fb989304a90fd9bb0d2c72c60a235378fe19040d6f3de4f276a0750eb792f8cb45a71b7e11651bde587986f5c95602ade55017f1fc111fcf4d34396a89a02fe06479cb03857cc02a929c5fba9e82c121d4b61a03772291cf2062c8ce0ebb7b09bdb34211f1cfa94f733a66e7c36ceb3e3a38affcb8a324d88e4d3c0f2aec5c9f3e7f18fedd6e5430e8faa17cf1e7379541d9fbc7cbacf9727d5f18b477cbe3ca9fe7afc74973f9f3cbaf160f25477c72f5e3dee908c9e8ef15575fca8cf57d5f1cf775d86d964c75e11178b894277a04e5c2b2d1509fd4b8511140496178459b3ef257fb030e62beeb5b5a0864b47bd6e4567b91a8dc2c6cf33d92f9f8170732e14d9bf6548a7a36dc15597fa595ebcdebcdb19db54f40e0d41d4342144d7815e185fd036b4bfefd41b378d6fbf28b8f70d8781880718e0c5644c206377d8e90a8577b55f97d439f9ef40810e87f001ddd93892b73bb7c24991fb38374c0f2f1e98098283565898202ae88dbe91d8501af1c619513bb8a6cecb3dd74fce0976129768c96682bb478a6d470ca36c17547e6fa684197a9b26b2274eb32c8d1cb75b8ebf8641779b7d19bcd7e65a98061bfa4593fecbe01c453738ebb33573b6e9fbb43e7c7c55177d275d6ec250aa3ea0cbdba284aca463020b4b98ba85a9d1733072d6f22cef70ea60a19cece01a61868ef2b79874b28e7bf46b792e31df818b3813bca7094eb541d61151bbaa828e2401ec50e51bc4050ce0f83548eedeaf410e065e05b287ed562e8d9c5ff4a2c6edbaaff25b1184178e18b5f0df38422ab0644ca9d2253be9c1b5da122047501283a23b1b1398ddca977d11e4f98337a8ceec874e4f44f7bb92b5b02e2fe08f3fee55afb78dea1d0e61229ac84709334dbc2abc71fc9a104ab5557614b5eca3cc491725840332c1e3447ed4d68db5a1849ee35c3b247d15e9cef275ca15f15e9bb970416dfea42b1c5a10b696120cf6062d2a27e85b209c20bcadd7eedef287c43b1cc25b835cd71e42384c27343663e14515b3f99ba661a9c497f1d5c2749489e99cb66aa472d33cfb7109fc7f4653fb3774ad6ee8d7efe7bfd2656cb4d3bc79ef6904a2ef5135b98927f285a18f949da0adb6eea1057bd189ecd3189d6e0aefec57ad4949462f666d6c25be78e97c5b6ebacba68a63f5b136ee1779bb7b7c7c22b61f9701590859145be1d0808afdd78280f1e78bcb92a408bdb60e1ae1048f8848298c46909151e60f44be124802b9ff60fb3344d93f55f6b085a9c84df54ed137584e20d78cef7cfb31bfe9ced61f79590c7b62b2d52f5aaabb61d7e97f030000ffff0ee76ae3bc120000",
-		"77f02d048f6eed1a3e480f2220639f05": "1f8b08000000000000ffecdbcb6ee23c1400e03d4f61655db1a0a8aabaac0a129b2efef65f55157282f178e44bea0b3314f1eea3a4dc621c83a033c3f874d305c776fc1d274e64bb8b0e4299c065c92435d91d7ae92084d0a2fe8b5066def8d8ce4b92dda12c6736bb5a07a8dafeae14df06be1b2537a17ba538c1721b2db5b22a77d396cad46942dd26281de7dd916c5eb6fa11e79c6c4a9937de7d749cdf379a323f30a544372f540797571163b33b69222d937326c383c9a4bdeeb54047d2124a7404ead53e4b3a6ab6e5532bc041290ca51198f306d5b0f72ad24bdf2ec8843901639c99b47d18cc1e0c66b3c7c94a7346230fe84dff1ce86eed73a1bb6d05a037fda306f51604b4f886c3b7aeb19a49da227d263f6d84e9d70d399ffc3211aa5fd6b7aeae77104ba41360b033ac410dae8406e64a52dbe87ae2e08f6f445064585826e7a0c0ab192bfc959c2298490267742b03286c0e462b417d684cb02541ac6582749f9920a779a9529493eefaf7ba2563b128e329685e30948040bf7cfbb6c851fcaa78789a869503c029f8e2ef75ebd372e08e5818f96b60fb0e855c6f407c3dead984144ce0f006e2942bdcbe0438aca291044c9bf110d66b22f6821ffa7df1cdebce1e162b97f3f090a709164a923920af26a0eee7ba607893ad0eb5eeca5c22f8bab7011f10a7078e7bc37b34fff42ddd2ece99c43a3c67bdbce6737be23bb9aa695abd7ecb21ab5fc627aee2078734e72abc8490068f2b4913277eacd0278e9c619dfca3289d209a15e94dafed934f096a4dcf9a1929ac82031e83da59aa9e720c46fbffd3e0bff1c360387a1c3c7c3a3a3e293369899ee2822c967fd6ec1c9b80196030831a3bd2edce3b4de857ffcd87cf8ec0ee9f774e591b3fe19c1c170c34722ad65fe33fc17a61c7451dbca571076e6ddc415b4bac5f448668b67b63effdef4de18c55a22a5f2a63a96e7c405ce899ffb3a7b796acf461672567b42531b70012d341e8b5b3ecfc0a0000ffff781348b5733a0000",
-		"7b7749291dddd94b5eb4d0ee2c75d8bc": "1f8b08000000000000ffec564f6fdb3614bfeb533ca80dd214a995a4dd0e1174c8d22d08860c469b9d8ac0a0a527958b44aa2495c525f8dd0792b224c7992c67c34ef3c5e2e3fbf37bff29574c91474820ac0557fc7d1807414dd27b5220683dfb4814591289bf910a8d89035ad55c28080bce8b122327b36cf248d10aa522553d73a470c049d5d766394b791515bce0bd883db983fbeae48280d78a72066f3a8659cdeba6240a17a42c8f2001251a8cd77c055fac0127106a3dbbe42ca7c5ec866758cefd8d871fc6cfe8c66f0d299f553c602ab85482b262932fa8504a6bf713caa654a0030000644d05c251166a55634bb6bf9f85e0021238893bd2e7264d514a48e0d4134de0fe860a529e59df4ee1cb0052da48c52b462ab4800e2f798687c743cc1517a84821ddf51f92b3f3d02a0ae1b12ad79fd9b2fd3abcf3d6bd9bb0762c81b331a3379e6da7dd565d6bba3b59ebeb830560823ea436a5b811524f711159a8f1a082d620082b105e2bb22c5dfa8fdbef6b9673384f60d69d2418d38946d1fc5e693d609e7d56a24995d501c640025a0f2eaf59868fc6c45abf036459aba94de226649ad9787a94cb95420919510412786f5d97281e688af093956119e8e0654e883a852b54176539e2c59b9d1c9ff05b83521d8140d50826618a88ac399378140f81ec40b12f84fdec5f64d998fdf1eb6dfbbbf8b7ecff5e6744e118849d1cdb2826886c01f988258e03d9c9b10d64824807a4ef0f13bca0b2a3081ce1bceb3e3fd2bb7931d6b27e563c37d5ddffa26102535e30fa1d333bb572524a8c770adde36a0f7e49bf634ad2af38901804821ec3eb9c629959e7079ed8b9fe8ba5770d1e4570c9cba6622e164e66e60937a888759db2a2e5b551f11cf376eddeae6ab421d9ba70467c50dd8c83a70c732e8d19db06bdce2b3ed036b21db4069429a9b117bce2375ce02d29a4318777f146d974c99e3abddacc53a67efc00b55f68ed9aed692e33fd646e372017198af56cdec7b0aff8d672fb30f0cbbc372eb046a230db5c25bd2263d6abe1ec295a0fe9790f3e0c2f1457a45cd8c216997d5efc103f0de1a4f875499d73694b53a282133066cfcad51a680edbd57a2de7825644ac7ec59567dbb04659fa2f5472a772b47ec3bfa9dfb0ab420770508f536339a524a654423fed262daed6e04ecda71b253e6dc7fd7367fa4a15fc4f7991e798da8e78da7153f7e3cb9c9dbc4aff337fa7aee1ff5b73526b4e7ea28c27b84fdc82f499732d69d3e0cdda5ff476b0d3ef71750caf1f48d9a07bd2b41b5c6ba805652a87f0e0d5bbb39387d0b1da97cac6e59783db3b387865afbd8ef6cfe5c2fb1c04f0360afe0a0000ffff5a6f5d1ec40f0000",
-		"7d8a0ff5810f52aa5335bb5f06c75056": "1f8b08000000000000ff8c91c16edb3c1084cfd9a75810ffe16f8050f700b918428bb489eb22f5d960c4b54a942215912a1210fbee0529db111c15304f5ecf37330b6daf9adfaa254c496ae537d3b4561d310398aef743c4ff01115134de457a8d629af6ddf1578883716d384cd1742400ae444ab2f39aece71f9b35b38022a764f628b781be8c03b523338ad6c45fe3b36c7c57b5e5cfca8dd60a4c899c6686ab391154f483a95a2fc7d168019f00fea8e1b0e10eef30d7cb276abcd3ff28cc546e902bef6de253cf312107cbedf6be4e9ce3a1ba8694f03fad2ddedea1fca99e2dddbbbd97f5ea91a29275fd80cc508a0ac50cf50a0b873545656c809b4b1ea494034ad80d4e5b016c06d3a9e1ed1bbde5b3840713625ef5369f6c41639e39c2576f1c69c405c7416386b5774b25855fd6ce4def3d1f4def3d9aecd38bc5f3572c93c60c63af55a40f5c814e1a3318176888cbdc49638640969af8dd9d45166eae9dd0c7d146338767e8512b87b9ae0020a5485d6f5524145af95d78b1afbb96a2b256b65ec6aeb7026539e9327801a5b4be809a3ece05a0264be720c0df000000ffffc49575c109040000",
-		"7e1bb7a6fa257d6a01306f3b3fbeb3a6": "1f8b08000000000000ffc4556d6fe34410fe1cff8ac1ba0f09729c72dc07145489aabd700777bdd084170910dad86367aff6ae991d370d8bff3bda8df3aa841451c127b7f3f2cc33f3cc6cac4d31930a211495fc2d478e731d73591561d30483017c8d6c6d3c61aa13be1525360d480302b25a252cb502d69023830023555e2010269a52c84897c073046be3a99815d826b3fb1ba45afb6e048b99306b77dafeeb4a7f35a9cb52d0d271d887f51887b4664bb09684ca115e64128b148697b0aafd56653abed6298e9cdd348db520b3362c1e937465bec5e515e52d98b527bde0dda85207e33f9eec54e4e69053f06c845c85b7376768595b91540ce12f2adc906b3fe0106ed024242bafdbff29ec559260c5001f8d56de30269dd609b696679dda589028e19ca095e0f94ecce4bb77ef45554995c79385c873a4e9b2f2814c3542b88dbcd6455daaf7c8226eb1c2b3324cea244163e0e5c505583dfb8809bb958a4b9d623116c9bdc8dbb1c5870be5d24742163521bc3a481795dc4f7e339d8e5f13693a487bf5c434085f13dd6a1ee95aa511a4b3cd0e68029982d20c99f3411f08b92665601d0e0ec5d7da46a1030d3d993b5d33120cf6fa833f81f53bbd4072f37aae1db0606dffb4f8fda68143917ece917f753ce7cc9553fb456c901e9026c91c1de87030d81adf68c32e5766a010d6d6b126862f2e9a66b88d74b64d95ffa4f7cd9e8ec43dba9384c38d0ce1a7fe5525fbdf1ba4616d903e7bf979e01e81232f4477e12712dfa1a9b432f82349468a80e0d3d6fe7b8d8623a88c0f24af72ec4fd0f4c0069d841f5d3f524996a2907fe0b5568c8fdca5ded3cf3ef8fbbea16982a063ede9b9446e155d812341bec85890714a742b1379fd4f4285bda023338ff7c9252859b82e3bab6bf047d44df831824504e4abf636dea0e31ad9fb1dd9400d2fe10751c85430b6335dc19067b3fbee86d18997e30e99243ee00785bd2f77f8fd137a416775f03bf38a53a1f70b1d591387f96435e1ac9ac764da6ab93b4377cdff4a90a0b3703bfdcde4c3ed36cecfa0176ce40afe0a0000ffffb634365635090000",
-		"813f7763788b1b28819a407ac9207289": "1f8b08000000000000ffbc924f6fd33018c6cfcda7788838b428f338200e937a18db8a10689ada71439a9cf84db070ecd671582bcbdf1d394eabaa400512e2e4e4fdebe7f7d87b41b5d4845c70f3d46dd4f6a95f0bee883586b976adf210b2cb4b7c1e82deb395b37de5ee794b214076e0a87b5d3969349c41ea05472775a308962a63056a6b5a78cf1e79a968ec75f11b52c37da598bbe58e97bcdba7c5f81b9793b5c6e20277d6de1bb730bd16054479986e2ca480360e75cc9db4a4ab2fb85494da5a727c988fcaac77a8870c8c8528d98a7f27545ca9319c4575bf563faddc1695d18eb68edda4b380f796eb86f0b296a404aee648aa3fe8dab01b236811e35d08f01eb21eebd883952db7bb8fb4bbb64d9c8fa1e277d9e3e47b338c7cdcad2984c27bd22284e1c04508c9118157deb3d608520fbcfac69b11333bd134c3d452d72bf787f50596e6b9bbae6baae212a9dddb3745849f0c98c167936ea32286dc7b962eb3daa810f29498e3f61d5b5229b598761b35cbb289acf1c9340d59bc98434b15674c5224222f30d44d42964d44b91c6e9b565e0dc3eeb6548d6e1ccaffde96f898ce59336265a72e8c584e5df86fcfe2a7cdb30168c47344d392ebad8ebf055e0ff0124f6b9ebb03cb3d5c766cf1749601f8a772cea3c4fcac5eefd7566a87fc8bce47d58723dbeb1c371438e8cb42b62ffa110000ffff9414cba602050000",
-		"89aa623ced15e1535b6ae80b0f4baabc": "1f8b08000000000000ffac575f4fdcb8167f8e3fc5b911bd4dae42d2f6e90a2dd252a01469cab00cedee43a5d6939c64dc3a76b01d0636ca775fd9cecc6486592dd29287093efe9dbf39ff6868fe9356083565821056375219884810e652187c302109c2b2762f2e2bfb92daff669a5582727bd08f3aa79c878400007c83b06266d1ced35cd6d90f26fe5cb45925559d158c72cc8dceea477dc7c3e7a2f51d67069f0d6fa4369542fd6c865a3b6b483046564c1c5652b0dcfe156edf8da4ecdce825ad2a99958ca30ec13f59068e8c0ab09e6301ee762f9f553a6043cb373a43cd8a82e3922adc662d946c45f198555236c679d175692d8b9663df675d97d2865dfbaf7c456bec7b1f975d5441e51ed4b76d5c2173bd9fbd9605f21d013121f75441448628bc6f192fcea84128ec8f368a890a6409cb050a98db5b58520d0daa52aada478a63014cc0fc110eff805cd60de30825a7150936f200066984045906136a509b5359d7ccbc90ae2d91635dce88abb69ea37a49b706894f5499a9b8bc7e41455e1eec5534d52fab68aa7715ddb4c2b01abfbc58ec4602b7354df5ccb52bf05d0b5a8d0518097ad19a422e0509d608fbe40b2a40ead4936c2667195c303143758f0a386d45beb0e509da5148d98a7c03886288502940a5a48a3b12b48ac3d1b16598f9724e3fdf4ca2b0eb0e522f60962fd056cd5166abc9d33e4a6dfabeeb5809026185bcb61dfaff6ffafe68c36d691689a2e8fb6ce818b65ad31f5a8a30b6d577bb40b06634920963a36c249c5c5f42812513cc30290809946c0daac1d4f40c4bda7213c5ab8bf4e2fc360ad70afe47c563988cbdfa5dd1e623150547150da80fb6dba50331b126c43121c19ec6949e4a51b2ea82891ba72df24a37da6f5a616336383d61daa0e8fb3026012b6dace13fc72018878e04019755fa811acacb283cb79f01b4a1ca39eed913300bf45f089886d7afee5f87893dc724e86d28d0b44a909e10f7f17f35cc7084ae4b075f2f4529d35b4bec7b07b847a59914bb902f9e3c800ad4b9628dd9033cdb5c0d6083aad6d3d2e614cb9fea9ecefadedb668735cd4d2a68fd0476eaef7c88b7d0361bf6833fdf4c76b05853f677e8737bb73285b31c85466fca4943f305c2bbf4cdd69d55bc30a639cab2e5729952874aa5aab201a1b3c9e5e9f9d5ecfcf05dfa265d989a7be10ba90dfcdbfa7092de538dd7d42c765d5ad1fbde97b4dd8ba2183a37c3d62de2186afa13a3ed2e91c0dbd8379b335b5208ab8460c2f62eea4a2c70637a9514700c656dd259a398306544be9f340d67b9830edd6fcc0c7edaf85ee99e2378a5d76431cc8c35f98219db1fedc81aa38726b9b66f24c48014309d6dd0df93cdd84ec6c32ed99ab2c9a895279bf911affcbda64a632418b7112ae6aece5c9791aa4ea70d0e757dc76f1f1bbb3b24309c4fa51033a39e53e517d20ce5ec86472e85c0dccd7f3aa71affa9de8b793a91d527596064548bb16b51bb5b517af61e8ea198fb4db7ebe060bcf8588f9e6c42d0f74ef8496be42756296ad06f445e82a2a242383074ce1d3c81835c166893d1897317f6a4fb1efebbabb1efd3ae5b33a433a3da55a9777d3252e3321f86c6fbc4ab89742be631d89c8f72f300c3faef4a1c1f4c02fa8e0f03357691b7797bedd3369cfd36b1c9f255840e3704b492e381e86c9948d97c900a3d65d55a47549b7ec3846e94cc516b3bc15d256ef15a13c6169c0b836e7560c24d33042e65f35584d6632f30bd9286958fd1aa881318fe6d496797179757b75be7dbf39b4f5b84cfb39bb73109bec131fc72b81241768c7860e6890da03047768fc5ba7b78ab7af257000000ffff62848d307b0d0000",
-		"a3c72a98c9645c632a78c4b287b49ab2": "1f8b08000000000000ffac554d73db36103d13bf62c31399b874cf4d7d882d7b469d54f2d4b1a7d31b082c642420402f405b1a45ffbd03909228d99ec9413e58e4e2ed7bfb41ecb65cfce00b84f5ba92dcddf66f33dee066c3986e5a47010a96e5c2d980cb90b32c4722473e3ea926190895411172c6b27ce1a8a9b43b8fbf392b193b3f87cb4e1b39b5ca81f6d07994101c4854da22844704deb6460b1eb4b350472c68abdc19701b9fbea308f0cc4d871eb40d0e9e354f6e3db42527d0fb8a85558b23291fa81301d68c01006ca398f08020e33f1f48db0538052f8fb8d57de11e5a24e5a841094a1b833104a857f0dbbf205cd36a83a00c5fb06ccf0730b031969d9fc3571ed0872bd7343a9c48eb8072ac958298754d8d74cab406c65752616ea7b72714eaf9e04da1b93fadd0dc1f0bfdd3d9a01b7c3859ed46843ba50debbfccaf6e71f76440755614222c61b850d555ff7b06fec90c3e2563cf9ca0d87eb9d74433176e5c6725a4cbd7c747281c49b02e808a672c1b03e1025413aaeb8857453e80672e403acfcbbe06d744f796d706bfb9bf39f9476efeba9bcfc632dfbdb3d0f295715c8270445d1b5264efb81e09bfe51ec5f7b9ddb7b1fa375cc7ca8e84bb6407c5b5f129b703e0918cacb7f8c4304a6f6a3d52784daf937d447f007c4d3fe08fe92768f0ade865b28fe80f80afe907fc31fd2597b79c78e3c7dc3597d0f6d6967b9f3ec8a4b1471f098c3c760a4307269740a890d00a4c8399075e738f2c9b5cc2feef639aec93cb3eb22f6dbb1fb507eefb01ceb203d4c7dde33026dd6281942e449afce1910778d1c6408da0edb3fb81126a548e107089a20bf172fa27c3b2c1b5bf5271c9a43573e5da1570f024b6c33fad0b0e127dd0b6df2ffd098baac9a1903ee1901417b8de9c25ff91a11c0abf6699f4e101feb88061df55532b35a108c5d6f010b7d45c45ceb2649927f16b784fa28c2dd70a3e4491ea8adb2f52525146d98c307464fb387c35c397221731d7b80b23435aab964b49e87dbc9079c9b24dcf1763a8bead5a2c4af87001897d787d875a6a95da1920ce2d0f82dbd811e15a8d72cbac1c818eb9fdfe1934fcd9f3cebae646a39145f919f4a74f494045500aa23fd225cb529edaff87e4e6eade4a24b3d27691c252d5745bfba2ec634c75dfb95777180a1559367d28430a569b386bfbcebe43be3c6c6ced9c890a03c3122e2e220dfcfcb96bd904b1bd7eeab82996673b63e4def53012cf55b12ccb83c8d986fd1f0000ffffaa465b865b090000",
-		"b0787b42c5582ac977ebdf8da2845709": "1f8b08000000000000ff84924d6bdb401086effb2b5ee24b0c967a2f6d21d8b49796b46e028510a2b176b45ebada11da954d1af2dfcb4af2471c83afa3679e7967b40fa5d435fbf8f8119fbee0fa6e6d036c00c1b0e796226b54d6311ac71418ac6d4490ae2d19d623ff10b96e1c450e5375a2ba710eb5685bd992a2158fad750e2b8693106778960e6bda3056cc1e5b6a3deb778ea9529309e6cbfb051637b7f8daf932a9822a0cfb6230ee824293a0da11b015e29a5164d90ec8344991966b2804d688825e93e36ecd2845334af22961d98528b5fdc71a5b1bd73b9126f9dc3b2a4726b5078efd374f35438681294543e55f329c2b353fb21e2e6a3de2569266235d1b66f8fdebfb1fac9ea1b9a2ce4590d7f876bbfc7118df8f4ccb485b174aa97de4d16ac5cf4eee71b84525adcaf0b0e4d85ade586fd07229ad0e83bf2163bd79bc9e8c00673fc9b0ce9603343dea651042c365faaba3e4b86fa8f40df39653083a5043e598b96ff40933548e99053b7ecb0c953d935ec83e5d1f1c637055148511f5f2b27ba4b8d2244fe9844f862339971bc963ddb82be4afaf097f6b1b465cd09c778ceb5f3690d6e70de3712e1bba1e3c2f19af7759a27bf0bde47f000000ffffa7724c4920040000",
-		"b33731a3f7f61e6e31ce19e294a05aa4": "1f8b08000000000000ff8c525d6fd33c14be8e7fc5592faa644abdedd52b2e4045827d70032b02ee109adcf824583876b14fc48495ff8e4e9c75a54c88ded4f179befcd829696c8d435868e5ef3a1ffabb0e49592b3b2fa9dfd9c5388ab3337883f4cada94e4470a4343b7aac771041341413bb8868c77401e3a245010ad69107c0b011b1f74192b6883ef2125f9496d2dce6ce2351807f4157976a5486d557c18ebf993ed772aa83ec20a76aa43e0dfbc0cf87dc048a8a1d4d8aac152e41ce7d51fac687e22acc00dfd16c363b8c801d4acfb9bc67f47223e680cd95a6f21fa40f356e3edd03bc662083ec00aae43b8f574e307a76b06df18a7f350705b4fb75936740f8d7784f7242ff37f3d05ab1f0f601cd5b36da4605c574119304e993f7f394d49f65ea37daf9a6faa9b9b944746359027653ff81f7c787af67fcdd972be0a92104556dc841e9eafe1eab57cc79ae5f29fd4d358096e69af212ffde0a85cee4d2b210ad3e6ca5fc239245114be6d2312db95d3fe0a2e2a38dd9f5b140799d607da9b8957667a25df9ade50f9c0aa443102da8893c5d302c70c8e3ee680b9e693352c167f11d830aa9cb0ec97a9dce721881f40b99c2faa92d75cf58b0975b206672cebb373e61d3c9fc99686e01855c3ea62baabec330f66d5835bad192c4691123a3d8ee257000000ffff91ae515de5030000",
-		"b799be4656319aafe2b18e1fce84b51b": "1f8b08000000000000ffcc58716fe23816ff1b7f8aa7942eb0bb4e3a8c34d2217137b4a5536e29a042f7766fba874ce2046f1d3be338ed7428dffd640792408bae733aa9178d98e4e7f77ecfcfeff9f9b9f46b229586c9f5f8eff3496f76d95daddc580619a7eb35429bd1f3deac0f9d2ed49be992720e01d1147e3afedd3d8edde3001f5f1e5fb5b6b2c3deac3f9dcdcfc6575783595529621ab88c00e34451ad1fbba15431d19dc6f1b20158c0bb82e2f4ba373abbdcd75d2822fc253c458a2680efc1117283394f60c1db1f9ffc4c030ea0010dc061bb64bc190c67f3f1683e9854693f43bddecc0489690bba306422fb0a7fc00f3fc052a6dac080193c3d959f25e160343f1f5c775d6fc1c4169cf4ce7eb96ecffbbff5cf6e66bdd3e1ce9af9328e8908cccc13e2dfa93640fbafe005f4de1319e705f3f41fbd4fff99227d20d10182eb9bd16c70d59fffdabfde594209f754a54c8a167ab62ce369772b97e57e93166221fd02cd7a330f47ebe7162a031393545385a8085858d0e5319f9f8d9ec55dd17bcc59aae1b2df3b872778f0017378829406d0483df0bca8013bd13a9f8f6eae4e372eac72c36b5c5f9536d615b393c1b03f1f9e5f0c7b9fa65d9c02fe0d9c9830e19e668c07e744d36e7d65b278edc02d82834fa137249aa6fa4cc631d3ddfa6a27a95fcb616d8fb2784155b7beaa3af53d0c7a2c06938dfe2685bf537d9c56d5c753ab5eb34f45f83a139ac5f4573bd94a0ead1d8416c6933913a1ec80bfa4fedd3c5154d12f707404d64bd04b0abe149a304115aa7da4fe5242a351bce1fff62929aa7b387feacd0ad67a41723c7d2e399e56246d61db79ea4d83554476eb592eb283550de7fb63876eb37b4aa1ca2e29844a6ccf8d621b54dcd862bb9cd52db0e5ac62156953e7f7bd36d88ec87e2933227b58457ebf6a19ca3dac225d2d51db0954b0d6ff346f1a08a1a3377890fd074bca1308a5024afc256892de0186a5d649daf1bc98a89872b2707d197b0b2e23af7df2ee8377d2f6da7ff148a6250ea49fc554681ae098dcd19071ea2e75cc73f637f0ca9d5c8e47bf77ac5f0899df8e2903b3254b2de6a2da47f270078dd3fea7c108561753e882d3717ffcdbd111386bf0fef599e06f3dfccf39fee3a70decc12a514ce8109cdb93f7ef3fbfff101fe3f727a9fd3889e138bd15cecf50afbf333fed7503eacdabde2ffd0b9bdf83e9ac85907bdebfe8dd0c67f34fe3ded09c1af904df34f6796d94212c98208ad1f4cda24638ef00fd4ae2845330479b89992deb056a3a0b95093b9a22b49d72a956686c878009f30e015308f98a124de701531d54fb18df054c014e4cc9ca5ba51642d5a3a303a50262a10868f8bc7f42b548424435e0ccb411cb2cdf28915c646148b8f46c27e5ddb7f397f6a61fa9d59b0f440926227042998960d371392db435b5579b0e18325d5624ed7f9e1f07f6e5a00d33682c6c8f4b92243b4ed66a9bbe2f5f442c2b6be3e5afa3de55bf059800e641c8499442e3853a0ef5666f32b1ddba31b7094f07ca53fa79706dc81eab01db944767572ea5ea9e2a07d56c83c904d380b109a50b8071440555840f8c09d72349e2e5f29eed2022896aa646c1d695ee96753bdd6ebd595c345a157d2856ec85d6a1f1c69bf88c5322b2e4ff621b23df4c665eeecdfc7bbbce26ee1601c2f9cbdbb4caf0d829155e481015030e0f24e996a748be8268831c4a85aa791358536dac76597acc5eb4250adf433561100ae352368c35480189927f525fa3da51242db69362aeeb1a3a839b5b4100f8c15c3c5c8402e95b2a4e3273ab8c64207dcb676e141f4e3e9c183d83617356773b1642014d52ab16b034e1e4110c604ff7621eab956bc0f57a7353753c0736f7d33d7f36a873eb1a89d4187eca04fb821067a274d47c98999916bbb012490bbbd643946aa2996fcb6ba156c19e6957c7308b8454149ce2964934b8762c1f693966bdee6939237b957c694e167611d252f27c9d9848b5c9c5802654045468b06376c97c19985387f0c794a5074b7d24bd44496d4afe6b64f2975749fe994a912c5e4fea9bfa87cdc0ab74ccd7b3c92ca51034747de945d2b34b71904b0552dc7192a51e13340c499ab2481c900ebfb124f122e93ffa7c7776921311b95245de57cfa48c9767ce779fac082145232af224a0f949a0296c5f021b4e9bfed766f42c0e864cd83f22ad562c8425492f18e501b8e04cec6a6e249cf51ae5cbbb4f1d2a19836bc74cdb6bb97754d76bb45a5111acd7e8df010000ffff8e23a27dc7120000",
-		"bd6cbab1e1b42444feb752d9cb2f8163": "1f8b08000000000000ffbc914f6bdc3010c5cfd6a7780da524c551ee2d7b68936c28851068ee416b8d5d515b0ae359c832e8bb17d95a08db167aca49b6defcf9bd27554f7d888433efd2d390787a1a48ec90ac4ccfe359cee6ea0a7724aaf687f0be937b3751ce08331cfa7dec24a408491848e03087388c04a62eb147cf6982fc24a8da47b71ba9364bf9468847edc689dbb9f928fbfa5b561373625ce296f93ec936eda36fe177d886e857d1148abf209e77f2822e45a117b1d7ebd9aab28b03e17d1f68f4f8b4c10af62df6c95e274fdb723fe70c5584bed6d9070e93e3c3773a7ce1a18cc752f12ff5b5789796918f8767cab955a5e8735e0e5ce67c81f39ad547553b254fe383eb7eb9a166614f4cb5c5f3eafb026a9adabcc187ff6ad76c9ad02f3336b8f96ab78167a9042dde2a9c3f53b0b7c5d1e705ecdd06318cc51c808afaeaf54dd330c99e238ed8c46c9a6ccce97d0ca3c9a6ee32bf030000ffff4118a0fde9020000",
-		"bf7f87a723eaffa58cf271518912327b": "1f8b08000000000000ffcc5a6d6fdb3812fe2cfd8a59a1b7900a454eda5c5078d7c0a54dd3e6b0dbcde565ef8020c8322265732b933a8a7292eafcdf0f4352b26c2ba9d39760fdc51239339c6738331c922a48fa918c19d475420a7e6cdf3e90299bcf7d9f4f0ba93484be17a4526876ab03df0b984825e5623cf8b39462a961c26ef13d9b1a3a2e075c569ae7f822981e4cb42ef0b9d42a9562868f9a4f19fe57a224193e5d4130e67a525d27a99c0e4aa2a5e283b14caa8ad3c0f7bda0ae134ae4e1bf8e3fcce7817d9f4acaf2a6c5f7ba02c65c6c8da5e0293e05cb7d7f563967a24c27534ead724a569aa9c08f7c7f4614a4aaa26f052d2417ba8429292e4aadb8185f3e7fa32aba7f7ce4fb8301b867a0ac4c15bf662528f6df8a95ba0432233c27d739834c2ad0f8540217a0270c28d1e49a94ccd777056b85945a55a986daf7700ea0f3b343771aea1a923752647c9cfcf3f4b70f67640c81205316c07cee7b6f14239a9d9ffcf218f6d4305d552ab7424e98569ccdd86fc24ada4888724c5752f488fa95883b94f538515322ee16b2ce0bfa786c95615a083960397bb4106a9816420e994e270707bf346236129221d315a5f942ce19bac691c8a4637bde78f55240260bb23eb9c6bfaeb8c8a4113af78d7b1e9331a327acac728d9e69ffd11d0b326625bc637a3fcf9b8ec47ae312cfc225b1b945c685dedb7d00258ab7e090ed947f621bb35d95fc93e33d209a7487642a2329abe7fdb34334316c487c2635c94f582a15c598d30f4e8946e22b65a91beb0d06f0feececf8ad525201bb25d32277d1ba685e18e78da4cc29897f7f60721c062b831d72965313d9412aa9c11834a287c1eef676f087effdcaca122ded9ce9b392a6963e5892556aa2ab12ae096dd251f047e31256cc894976909a974a31c00c98bc2782e64c814d857e568974893e8c96e96adfb3a4301cc12287261fd84d18f95e5d2b22c60c9e19e7447d637886d08d170f4790e8c6a7cbf9dcea52d72d45726aec6bdddf296007887c3b974c50f41337eabbb7676130a0341f0c891a1f1d04313af801cda37592b6af4df148c474a544837ec95eefb85833d9988b1e4bb5944e57244b8e4c5319a1c5bec42aefb8d8d030de06567923c58ca9f7ed7c9dc9775c84ce56fdc67a886561c2d6861de3adb3c18d2245d75dac3b8196c656ceb90e2b91b676ed193b5b9710adf21b07b5938a92c2149e23c51b5bce98d9f070ad2f8822d3b22bf0d8b4f89e7795338193923311a6ae39f23d8f6760ba4623d836723c27640482e7bee7cd81e5255be90ac3e7173bf0f3cfb0b37db93a5a14da3a28399626d7853f36e35d6c5f46517431c4012f51b4ef7b5e16a6c9bf15d74cc590262736cc630725f23dbb06580b72c1352739ffc41cf450c17313c98e2f8230d5b7e0eabc2503f10cdceb512b45c10f06a641878ca31e9a10bdb163054ba71ae1a1d1b1999e54df1a7d8dbabf939ce37aed94eb532d861504b1adb05cde8c81a49a4b0175fdac6f2ddd37bd113093c72d4a27c80d2e97303a2d574950b318941bbb19d4006b91a137cc7dbb74acf21b1fb59ef97d21da92760d619f3e4ed5f5f95c28bb99f3d8317b7ca75fb4df7aab62841e89351f75beddc29fd9722282d0fcc716a9f1d90223562587524d7f2779c542c31999692e306483a03badb3d8c66c67d6dc2e0503b064a84c11c3ce760c7bbb11cea6d1f306a30f97e3fed9bb01a77d594851b2265667dd4ac6688b954b0c57a833aef6c9af4495139287b3c8f76e92f78c505c799353a6c3c048177aebecae60410c01298a9ca704a7daecc87e8274822aeb51a5b3ad57419f08924ed8160a5212537b20e4568a6d96d8e819a24e2b484fe44db99f652cd58c86f780531d9a667a1e40d825ffcb8045f733b3bae67f2b53d7268feb2a33de87f8eca637396184eee779a892d792de59cf438af59cc2945af23c63a07331752632b267d122395a3253803ecaefd6d0a03a8b907125e370b4ed7be50dd7e9c410d4be9792d29c1050229733cc5ba53e487d282b4187bed748b08560726ade5e13eac67b58ceb9c0cc76269d63a0f9bf5aa4d9f21d129eb3af56ef48944ce96f23cb6e3dbf8dacd784da0a6113419465a4caf526a473dfb3357dbbd74187c54dced06e749121f6bd66b732445f49ac4f46b175e753266c18dd981592e1c24f193e444d75f881dd2cefaf8c8337adc6bbbbf55aec06c6285c75dfaf54178b13b3b30a1d71aba68bba02f3cc3917fa5558f4148a317c64776e658a20ac90b0bb20717aaa8d864599bcbec3090c3f329316689b39d6961cc364979d570f6510335ac8691437c9e49e9ef90a969dbd4dc1ecec7d4b343b7b9f83b3b3772f9e6ed72aa0972f3605f4f2c5b704f4f2c5e700bd7c712fa06ed72aa0bddd4d012d17405f0b08cb9c8701ededde0ba8dbd50564caa8cfa369a2fb49a06cedac41e086bf5fff8da2ff4983bf1fc03d417fb469cc3f71c8f783b82fd28f360df4278ef37e14f785f7d1a6d1fdcd83fbef8f8e08baaefea9516f13fd9bfdda0600daf18cae76e7dc4988e747074f306067c4d7779a95df67c809bb4d0e582a696349c3d0d41c581dd9c335e02510b3ed369b7d2d61ccb43b08e02293e6349fb40de676a9ae930377c1646bc5c57dd36000ff38ada653a2ee70802f1604d777608e138dc433322ea1bd9f304d47079dfe03733b561804df0dd67e9ab24283d940998663256995b26e8b3940b08a4141f4c41cd86b553108380d9c75d2949525bcd8de865a5effc9523defbb204d9a6b4064c272be520c763fc7d456a82b6cbb1bb241d0d975c540afc1de5b187b710a426ac8b00fb6dc4eb18486dc94c766ac059571580bdc9d6f9ba3e2da98680e1763a62fb117030082ba7e96944ccd983a4d270c351b0e068bc6f7b2d4f3795df30c0483a6f5582a0dafb6e7f3e18212db90d21c559b116fef3e05f09fadfd826f9d974c0dab92a99d172f6d305a9fb9f7d061ed9ca827604d50e28e02f7e8ebc7a191ef7bd62d964236b047e6d8eb12e670d4773c69b638813d2aefbfbd6b2e0ba39ffaf36ebbe589a1d930a9a8ed6d4e0870aa63901f518da50bea0ba3e8a551f307f9f121c1d954dbbd4f160695d97763f8655c501b6e43f85b19c436482258d561e9d0cb8a345ab9ccd5e6adc5d5796fa413c879a9416640690eac257ee8d27cf3b4567e0ff93d496e3daf3d3dec0dd2de17a5b44e3a78ba34f0b914d09af77be782bf40b4af47da52c42f0e2c114268312d7db3328229f9c8c29e4f5710e18c28d0d302dacf59dcf578cfe5647b1fb9723b692ee951c6087e74521022720d8d97b442e6f320f6bdc577294308064dffca8d26fc0fb4fc45de30e59896bf43f9124ef7d9c96358dbaf4c1ec3d47e55f218a6ce5724c86656df35cba13fe869b1f80624862b18dd77f5f38ee996305c9d8768c54d2e56092e61848ee12fae9231b3ff3f0000ffffa4089a332b260000",
-		"ca8905e3fae93dc0ee5a57d9aeed3259": "1f8b08000000000000ff8ccec14a04310c06e0b3798ad0935eba4fe04544f1b2acc89e873a8db5d84e86d95484907797ee785061618e493efe3f73183f422254f531f0619df6a1921940ae332f82d78088e8469e84bec4ad93e44a0ee0caa9faca91cac3f3616fe6e07c56cd6fe88f277a6c0ba566862e65796faf7ee4ba4be7e56e6aa53854a5299aada9bfd029082f7997d8b796a3831b80cfb0fc3c33e02df60ffc0b8d3cc50b9d5df5127fc75cd4fe54f55b0ff6c7e3d3bd5a8f075015aa730942e862e021f1528744124af189bdd4b938f46617e1061562dca0da1c83d00618a9d07f08df010000ffff21c02427d6010000",
-		"cdfebd95d7762d8a6701d2476c4cd066": "1f8b08000000000000ff8c90416fdb300c85cfd6afe0724a0647c18061870d39644906ec320c6def012dd2ae50592a64ba0d20e8bf178a1da0087ae8c926f91ef53da644dc5acfb0200ca72ec4fe8444ba0b5afa67b7c8596d36b0234a49df4b1c8dfcc39e73063b00423b7a233678900048040883f59d63886c42a4d24e493f60e378b649f907eb411eb9cc0e28d8e0701dd35c964739c610610dc718fffa81a3fc41eb986aa006067c6130e81cb497a62a241f602e8d9cc1042f7c16bd9fbef595ee6b4aba0fc4ee3f9a27ec66067db36205cbc8c3e8e493fa1aeec2ebb06b5b36c204d6cb8fef750933055a4152005042fcdcc2e1b7de4746e1e5c4b452956d2fe22d50a38fc5f1eb527fd982b70e92aa8a3bb28cd1974e0deb6ff5ed8d549595aa66d1b4b99c4dbf27ab8b5b6595127bca59bd050000ffff846b263d09020000",
-		"d5d1125410fabba3c14b9f97a7018f2f": "1f8b08000000000000ffac576d6fe336f27f2d7e8aa9f0df7fa58522a5bd3787e07268364f1b5c2e49e3ecb6c076d1d2d2c86643910a4939f119faee87a1245b76dcbb0297bc88ade18f33f31bcd936b9e3ff21942c585624c54b5360e221684b9560e5f5cc882b0acfc87d433fa50e8b2b973357dd7b6fb9f5931535cd2835dda9c4b1932168433e1e6cd34cd7595fd5e6961b4caec937c095930136af2cc67333430465992e96c26d481ed8ec36d35af0159ff792124da1d34c1665a899cbeed9c154637aa586633ad6b17320600f0eb9633052a61513d92227d5059fb248b69b80738d307f6491e14462cd064d5d23ec97d3029a659fdb4efa4e2cea95e8d70f897903116fc0ae16a9556ba6824b66d56e8dcd35bad525e8b8b1fef6edab67f2eb8de7aae74817290b098b15c2bebe09345f30f5cc231848d45134296ad658fb884c66201a536609d3642cdfc2158679adc8150d06704630b6e20f234b20c3e34421667dc2114f4cf3a7f5597f03c4705533a85676ea146536a539109212516a471ba84839f21d7552d244229f98c051b7d00bd36c6822c836beed0ba535d55c2bd91ad2d95635bde899ba69aa2794b5abdc657a6dcadbaba7b43439d3ed86be8d6beada15bbb6be8be514e54f8f9cd623752b86de9d64e7cdf81aefd7419ec34d879e30afdac58b046d05f3ee70ab44d3b1115465f014392d355a70d8250a51ea7bc5bd63846ae5870c3ab811a6b192b1b9543d4c07b42c530f107513c905fb1c0a06b8c8226a59b7425cbe052a8099a051a90bc51f91c664281f5924ee31a10c510a13180c66813af58d0180947c7b069a4e9a7fbeb285cadfe2fed144cf23956d8b64759b65af5b28fdabab65dad44090a6140de51cfffeb61db1e6d6e938c90a88ab61d7a2c35a1f477ab551853e93fcc11c88d5a0be588a5d3707277050596420927b4622c30ba71687a57d3332c79235d140f07e9e5f94314ae0dbce76a192663563f195e7fe4aa9068a271ab4f7b61422ec4316341d719efba8146416edbf454ab52cc2e85baf7d6a2ce68cc028ae531f43edc378a22d753bf16d6a16adb3066812829e2f0cd312821e92d0652cfd20beeb82ca3f09c5e0658c78da7df5d4fc0cdb17b4f202c7cfb6ef16d98d073cc82960d794009e053e007279c4458add29ef1952a75fa40c2b6f580051a2bb4da857ceec43da8409b1b51bb3dc0b3cd510f76682a7b5b526689fcb5eddb49dba5e70f94ff3c77a9a264df819d76675da0b7d09413fbc19feeaf77b05871f147e8733a1b5c91224765b173e5a4e6f91ce1fbf470eb8c0cd36a729465cfcfcf29f7a8549b59d6236c767d757a7e33393ff83e3d4ce7ae929df2b9b60efed72af19a3e708b77dccd77290df2b6ed0a9bf6ad2886951fa3eb2e750c157fc468bb5125f05ddcf5bb332a2c842121a84b998afb420bfc1e3324051c4359b974521ba15c19b1df4eea5a8adc43fb063cbe0cddc0ebdab5ff3b8277762d56fdd85a8b2f85a3164d53738ceefbf4dabf9112075ac1ed6483fe2dd96c0ec978de265b833e194d936433c2e281ef1d371623252445a898fa3aa35e436b667a5b635fd74ff2615963db8609f4cfa75aa989337fa6ca2fb5ebcbd9cfaf5c2b85b95f41f8945bfc6ff5de2d68db6de9ec031c4331dd7f78adfd5e7c0c942851ee5e8631e4eb025f5c42f4fac9127b77699f4b403f12751a401746573d9814c42c208efad183034a8dbb2e33c22fef165f61f2e335bd945f549840a7ca3e49bad4024a8baf6f8d2f0cd035db7d4d983cb9a299c0a5f8d7869c81f754afe93d3e35685d0cfbe8124516040baa848ea2493f222fd07c097f3e38a9c501510ebf6e9314254854d182cb18fe0e879d3068e8faff137e45ce1dc182cb2f875f5b7f48b649f910b978241d7cfa49b8f9672e1b244f93617f4ea0e9c0c60376424fb1f1c121a7bcba4da2ed35eab1fdbe90bb973f8e6c1fb7cf5c8a823bfd9f936627d809383e95c30e9300cf87e1e17f3f6c1b3af187719fe67f3ee5be195e47cf8692c84fcd320a1bf5a8f4b3f21917ae698fd38c4e8ee0dd02789ea3b53463dfd9de51928f12d6731948c49bf02921bbf0cdf47899f26df75aebfa421bec242d5bef83232e60d019810b04de2d80a5d1150c87a204bee04292f1aeafef09c5eb7c8efc9698c0546be90bb8192299bb97b44baf3eb3e2b403c79b0592b0fdfe3862400db65f836ba3fb70753e6df12473e3189f2b877e4515ca6f6d0852ebfa17155247ed14a637da8972190d632a81fe477e3ab9babcba79d87a7e38bfffe796e0d3e4febb987ece1ec3df0e06156cc78917e15ef9000673140b2cd6f3d17bd5b27f070000ffff49b647a4b4100000",
-		"db7217d16e2b0c79d27600b28e1ce9c6": "1f8b08000000000000ff8494414fdc301085eff9154f70018964db2b6aaa5670e052a942704288ccc6b38e55c7b6ec09ab16f1df2b2759d88565d9e3f8bd6fc7e337b96b7ddfb393fb737cfb8e939bce2498048266c791841556c63282654a0c564690fc105b8671a816c27db0249c4e8b37a89fd6a2f7caac4c4b62bcc3da588b25c3fa2467f8eb0774f4c858323bac293a56ef18a745717c8c8bebdb4b5c89045c915396632a1acdae99889b46d16545372b6056908ed19465e4244dbed4905855b8e918ad578c965c6ea71d92f8defc6385b5916ee3a2606a0aa6c1ca9286782496f1cc51cff0139d8241a0f60f69ae8aa2c4dd354b34fc689c46e4d647952668206d9cbe3f399e055cfe26cdaabc9e44a75b5e0621056ef3e066c8b66faa8c868bc8f9def4aa9a2adb9adba0de68a6cab6e6922def6aa6ca8b667bdca414d29ab4e688f9b1521e4f1ec71c8c97e89c41729ec4fb43c35e796bfdda385d154dd32c2975055096f39f3c3c724cc6bbfa6bf5051fff362dcdea1d4220e9eac501f30e6199739e2d3b0cf1a9fe8cf0ca109f3044bb4368bd136ae521e7a7fec59f1066f598b6bd9821da3a27fe7cb1e8b96a7dbf108e7daa3ae9ed7bcc47cd704fc6d63dff98189f3433aaf32315e35ebe0476cc32e62ce773ed8ba7a7cda7014714cc8366216b2bed2be9833d42f5fc3c91b64153e03e26ecb7cf7b70d04c4aed37cf0b72d03c8c9afdfe79790efad5a879efff1f0000ffff767e09f27d050000",
-		"e4bd0d6c65071597f7e774df0618541d": "1f8b08000000000000ff94565d6fdb36147dae7ec585b0015291395bdbed21401eba182db2b5a9d1a47d09028316af6cce122990943343d07f1ff825d1f1c75c3d4997e71cdd7b79f8d190624d96085d37a905c56ae6beef488d7d9f24ac6e84d49096b54e93e4f212de179a090e375fbf4d81d87795e86d83618071fdf64d926c88842c0100b8bc841b8944a387c3f30a39482c84a4c0141476905aac075e7bb1ecd73c091a5f514b861bfcc277854824253d864229450d7461c9317350fe6d5ff933e1db0339662a0722f17fc42d79507f33aa7f6be8f1da5b3b4881f1a0e7e183d2db51698a151e55a2763056f2f041e9dda8f40175b19a4e3fed689526c8f81228ad80f152ecd4395006bddfbd9e268b0a15d4a479545a32be7c7afd6042b7bc14499e2449d9f20218673acb01ba98730d35596376909a5b60d781247c89f093a518575ef8770382ab6b980c5f0afa3e927f4cbb6ea4f57dfa04d7b01b1a13753ffb0590d3be4f7a6bf57b9b13505485640b04bd0add773565ccf722f7d02c0757872f533d335dac80f9cf8228f416bfb201f348d4ade490ba783a0223dbeea3a3c1031463c6e31c331a919ce3f6e12e1e019da1f6812e1e018357f6a161c4812996a4adf41eacacf5e4be918ceb324b5bbee6e299fbc65fc1cf34bd309b4cc6726791305b9fcdee65465096a440a851af0455500a099468b230a9292ddb422b58224769b71dbb77bde4baf97a084619e6d586ffc45248bc271b13472985b4e199c48648cc5c56df49c54cff32bfc4825146fce0bd2c8768c5b86286efc17d0a88f3b559e2c689a12657c14870254297bc32a95b1b3a4ffaa7eb60722378c99693bfeebfdc3d9025a49cd4989ad5f3ea46546dcd153c3ebd76af56f310a770484b73494784386b077c99b62bc6251f11c7ec6f39c57f61e7615c1fab8019b42be1a3f8c0b0a2a1faa80387884b312f0d7c3ef6c00b3c98d47e40c094e204ccd88b1c4e08fca304dfcb612685168bb68c644e48341e7d5426aae57c99b1a0203313ea8cb97821d30815bc55d7e86867f4b5706847bd131ad5ae194e50b9417b62e482338843e3eedaaab2ab6d7c164254c7dca7e6dc131c7dea5d6efaee5338f1dbb0266cc7a3d98b556612b5de9eafd258bcd3b95533c96a22b77fe3f6ac621a079faf715078df6a71cb0b89610a4f2b90568b390bf841444ab285b37b4a0c3cde97e22579da3b061d19d8d13f215fead568e03fde1dfeb5a75716eea7c29d55df49d59eb190fcc936df1878b4437e443d6ed4e14219eddde6acf2dbbcbb62c484cc18c3ff35876c3c332e6c1b737f6a6d487501626dae46e12264884f4974c27a4cd227ff050000fffff0e408ccff0b0000",
-		"f960b840e03e232aace40533d640c30a": "1f8b08000000000000ffbc51416b1b3d143c5bbf623ef351ecb251ee051fdaa436a5250d4dee415ebddd8a6aa558fb0c360ffdf7a25d1942d3424fdd8b5633d268669e88a5ce05c2d29af8341efce9a927d67dd43c3cfb65ceeafa1a3b6211fdc0e9d8f29d192867b81106dd31b4ec620047f4c430185de83d21511b934597e200fe4e10d18f66efa95ee6f20f172edcad61b337e385b6755b9ea69462c2153ea67417791b8fc136b07b6c5db033a98a8bdf585cb57c421b03d389f5cdbc3622c9849ef07fe7c85bbcdb6036f6297451df444bdb828f394304aeabe7f47d728349e7cf747e9ffa228fe9c49fd897e42e4e928fe767cab911a160739e165ce5bcc6aa76f556440fd192bf37ed0fd3d72ef42fa19a9279cebd86a8c578f025c452448fe4a9e5af811e0e3ee7a50280426f70fb417fa3bd0b76351efc5a4d8cebf025f63d25fcb741701e32c1e59bf1525f83e94201b3528bea7483377fe555b25a14b793811d719dc12c3c6b4d0f34f85753795dffa58be2f3551189f8984201a7da6b0f2f984b88e0bccaaa8aab9f010000ffffdeb87d7253030000",
-		"fd7390ee6c4256229f5f3d526262546f": "1f8b08000000000000ffd453c16edc20103d9baf18a11e365583ef917a58251b376a946e9bdd7345cc98a5c560b1d02aa2fc7bb5f626952bec28b7cdc9e6f19879337aafe3f54f2e116264bc53ebe174c75b4c8910d576d6795890821af4e5cefb8e1252d018596b05eaebafebbb94280100388082db278814542abf0b0facb66d29953997d6a8faf0474911a36a806df758058732a40423760f9626684d21463422a571bd1f412b34fb7ad72a31e872367874949c11d20453436d4da3648cecdebb50fb61a26f3d693170e1fdbf776cb83983488a2352ad360b5a8e0ac01ff0f6d6fe469712fd0015faa5d6ffb5387b2eb0fe72ff5285a51093cf5fe81fa3e34622bc6b146a01171f816df883c61bd3587669055e1ff0fd8108aa39d2d8daa996bbc7cff8b87472e8585ec4387d7bdc7eff81f3a7b1a767de9ea8e86d27b8c749dd57abdbd566759ad2af5063467a1a19bd5266d6eb521976d343fbd799fcd29a5fe83e3d2765632b651679ebbfcafbf9c29944bc81484c2e696643a79a94fc2cf9fcbc8d00e527cac7aacf558c1edb4e738f4079a7be4bf45c6b262df36da729b094729c790217629e10fa15cf73442f7accf91b0000ffffc6c2724fc2070000",
-		"ff54a046f5cf5bab67d837a8a7b21fd2": "1f8b08000000000000ffbc50cd6adc30183cdb4f315d7a5883a3f4507a08e49066b3a5b48492e41eb4d62757a095b29f65ba8bd0bb17494e59fa73ed49f6cc683433312ad2c611564afae7e9608fcf8a2c0512a31761ff625729b59797d8143046f118781ec2bddc534a301324f4ec8660bc43f0a877213119375a02d3e05941b3df2346f124779696bb217fc33884ef94b98d0c7227a7575a2dbff97162f68c0bdc31dffbb0f5b3533dd40e5be354257f53d5b45b692c556505a00bb25cc9b9ffde6b3d842306ef021d83b8ad671f234b3712de6a4356e1ea1ab5ce67a7bdb8f58ab6199f52428c307ad1896f6cf6924f5fe874c363b64751fc8b3d273ff962f9747aa194fa18c9a994ca818b943aacd9ff986eb4a621908271e1c3fb3e77abfd3ac4b6990e36275dc52814d9c7834d6955d16b6c3e8a07da19a7d6d3c1766ddb188daf7e1c89f1e61aced86cd054242fd2a3e89ad4b60dd334db505fbb2a5677471a96a97e897bfcafd1fe58075de993f39d95610a333bbc2bc15f9b14a816120f678baebb36b58b6ffb330000ffff56140cec28030000",
+		"0d7aa2fadb0a277d30bf439ed5a151fc": "1f8b08000000000000ffb4557f6fdb3610fddbfa1437a358ed4191b3b6d80f170196b90dd2ade93cdbdd06144571914e325b89d48e549c94e5771f48298915c45db6657f593ede3ddebdf7485a9b512e24c1106bf1ae4693ae934225a6aacba173d16402731fb336c91b992e9b3c17e7ce4153676808cc9a201754661a540e083f2d7f790515714110a0daa816b228099852c519e4ac2ab03659e16949afb022e7c0f86f1032005a9b3c4383a7a82f97b3eeaf6fe787655355c8176d5f80f212d75707983e76a879463a65511ba124bcbe63eba3c5d10cbe7dfcfd37e37b9e22868fc40aceb06c480332c186853124016506b2294bd06434604b2d18158261901516da432f0d37a9d99af0304da93600efb59221306795352975116b196541f0a0859c1e40cbd10b99ab64a6323af271ed9cb520f22e2d99b3f054ff4c17875cb49b056864ac00acdd9907ce7916d75b39cb5f5f9e605d0b5924cb0d1605f1eaa20e89861b82e175e64c954d254fc860d2610dad2599f9e6c24fd46ba2c7059caaec02d4e97b4a4d077c9b273b817a95ad8d869dc7d294b48647fbfb605b34e769af5446e51cd30f5874aa26376042f9118ab261822737cab116fde2e3d56afe9c59f18db227ffa46ca11a430c136b93f0d936767f8a5bb0766f6702ec3907ae2f10bc09a7ffad9f8ad2b58287d6ae541062ab8917521b9429c1be730fe113ac8da9617eb89a1d7b373c4834f119f1325d939f673a995c078f95367e37918324b88cce151bf86edfb9e975a68f5df5f5ff517465de23fc40fedc83bbc1c910e08fbdc35aecbdd6c4d346137ffde871e42fd54ee205a567cedd76db8e36819b6441ba5652d3ef2c0c710c0c5f75f13f1bd226865a8744f62ee0249c103d061b0d5273ee1d20a430024bf191664a1a3a37231e47f7c40238170dacddb5ec5c0cc4ece16f490a44cf9135b173a35ac730fc1cd4701c0d441ef0be3800294a3fe380c9342cc3b918a5e63c864d0c1c761d5fad460317f575890667c8ddcd5061fd461b16b278eb6fcd64819b13d21a0bbada6f7a004c98792f8f38862f43e1f8e91d7bb136c950f50ff373e61f31ebc4ea35babde96f580aff6e755ab70372e069fb0d1ac63baea9f6d51b3ffdb7a44583de45079fc0a8976ae3f58ae1dd96b67ec005d56a8795fd1e77761cfcade5e0733689fb4ab722ff27eb4403ff545350ff326f272fe3c845d692cc9c8bfe1a00d70d88c267090000",
+		"14a29ee9bf4f478b5b5acb1be338d9b9": "1f8b08000000000000ff94565d6fdb36147d8e7ec585b00152e0d95bdbed21401e5a1b2db2b589d1a47d09028396ae64ce122990941343d07f1ff82189893f870489449e7378efe5e1a52a92ac498ed034e392a758ccedfb2d29b16d83809615170aa2000020cc4a1506e631e7a28450ff1d533ed1ffc3200e82c9043e268a7206d3ef3f6640ccb30cd4b6c26e8232f5fe5d106c8870aa93094c0512850e0ecf2b642030e122052a213193a9c13ae0b5138b7e8f834ee33b2a41718377ecb510f1a484c3a490095e42ba34649fd92bffb1abfc8db0ed9e18231903117842dc907bf57783fa8f2a3d9c7b6d2653a0acd373f05ee9fda034c3020f2aa566d25772f05ee9c3a0f41955b29acdbebed2caf4206539a469019465fc559e3da5d7fbd3e929b22c504249aa47a90465f9d3e5831eba6119d79e09b29a25401955510cd0f89c6b28c91aa3bdd4d8009b06046139c22f66196ddb917bd6fa70750de3fe4d42db7af28f61d30cb4b60d9fe01a5e0f0d816a5ad3fc06c8d2b60d5a63f57b930ea428134197086ad555dfe61451578bd841a3186c1e2e4df94c55b202ea5e1322d19d852b33a07f05aa5a3008ed7838003b67ddb13d686f720f459bf13047cf7a24ebb85db81df780d650bb403bee013bafec42bb190b4e312375a1766059a9c6f795a04c655158b335e3cfcc15fe0a7e4dc31150a6221a5b8b74bbf54db7373d8322230942896ac55309191790124596baa852893a5112726428f4d1b3bdeb2dd7eed74367947e5fcdf027ccb8c07bb2c148bdc0a56e8ee3d9a71850082e0c622eb02202231be04f52505dcac89db6ce3303beb761148377786c5efd7b6f4409c45a5c9f766dca2e3d9bcc40b0d942135c68bbeb959c3d5dbd9b06c653ce329a8fffbebfbb7d2039848c9418ea837431e5455d32098f4f97f65107b597939869696836688fe0476d816fc3b6c9d8e03de210fd0d4bf1c5c5ec7e28538732a01a6d62b9f8c23f532cd22e7baf02fb88395f641abe186ae0041eb6d5ff12d0a958015dd737311c11f85772b613c35c70c59775e6c91c91a81cfaa08c97cbf93243429dcc9c4ba7726c2fdec854dc9ae462cacb122d0d4e079358b4a5de7285fdd227a94ca31dd173c119c4be70b775516883f62c8025e7c521f7c9057304bbecccb95cd7dd99e0c8b2dd993015f776cf57990b546a7bbe4a65f0369a1b3917b42462fb0f6ecf4aa6b2f0c51a7b858fb5e2372c11d86de17105522bbea01dbe1711826ce1ec9a120df7fb927f248f95c2b61ccfc096fe1559ae568e4e99faeb031ca31706eeb6c25e5b3f4951e3e9f5dd25b7d868b8d721bfa01a1a75f76de9f56e7d6db9366fbf367c42a48de1568d211aee8c912963ec2eb00d2946c0d7fa2ba9fb26d2c4a7c0bb6c37a418015f076df0df007c5699292b0c0000",
+		"17da0259671026bbcd5800914e3fe3c1": "1f8b08000000000000ffcc96516fdb3610c79fa54f7113b6c12e14f6dd8307a4c906041d16235dfb5214052b1e6d2e32c99ea8da06c3ef3e9c6c15d662bbc916047d4a4c1deffef7d3ff28c6a8501b8b50486f3e7e92a15a88b91361e9eb22a5188d0671e1ac3673f18a1fa694bf7c095d5c8c42b7b67ad36a6dd62901aeb16a0382848a50062ca1f54a060447a0b046fe4f838418c55ff2538d7fca25a60484952305c68284ae44ce59394a7a7383d597940e951b55610d95b301d78115f2df12085e2c42f0e2063fb7d884129c87175dd66b8f248371760c236303929615c6540212391a43ccb318c59b406d155819dc41707fb815524a3099c2cf318aa55358cf64752be73bf562b027a598f2cc68705e5c7b984ea1d8a228e0ee6e6f71cba5e09a1c8d445ce1efc659f1d62e25350b598f1c77c1684a382a6cfc0bcb871fa6604ddda5cb08434b967f77fb947443c1bf11bd926a26492e9b3ccb529ea5fc8866ce77b4b498117a49381ae7832e8e6f78276bc37d8f0e93bce8aa3e4547033d7dd59d23d836255009c5d0864509ff431612f595099bb60e257c2cf78828e96ed0bb94ce953ae0e3536f38ff5aa9cfdc15e32ebf4802df001b9e5c1b90440f413b0253c22d6ed85824ed1ce1fd872690b1f308319eedd67ed4066bc5315b1657563b71e114feceeb0d1f0060f42e4cccc82c256d5ee3e69ce68c0d522a623cf6740715ade23c68159ca504a977bd815fa750a365a35f5d8effe3bbce32dfc014a4f768d5c837e53d1cf1356e268ca28477b26e71c24ebfba7c6f3e30dc94c7f8542cf2ec148c3d3fdc0bea70cf24353cd45d17a7b98ef3dee17b8edc67b7f3c83e7dd6d7ac4ca816bb518f7956c906bf1e4793671df7b75e7d7bae9e7fdc1f24ebc1e3becd7668e21fec3af8a6ede09455caa1051e7dd0f41ed97ec08bc993c2beec923e02b65b35e75a6315501de4bd4df8ddf086b321d77fcbe7e3271f34ab9781bf678ef4a868edad752bbbbd6c81ebef2ff0d3e782ef35e2da8ff37b23fecf00d4d1651ad0090000",
+		"1821410450bb2a624bac209e4f13c39b": "1f8b08000000000000ffd455416fe336133d4bbfe27dc687c26e15650b143da4108ac0d9146993344d765ba041b0a0c591c346220d925ac725f8df0b92f256c93a4db0ed650f86cc2139f366e6cda3739c1a210913ced4bba5d2ddbb15b3f56db954a5ed56edc4fb7c7f1f17c1e65cd9f4b2beea9b46dc7b0f61c0102c562809abd0af38b3047b4ba855db77d2403560f8f1eae77374a49784e8bcc01d6d8863b1c11f464948d651918e1a21972d4153ad3447a35507e7ca376cd1d239ebc87bd8f01f42c630ce9547ccb20533db6d3e2c03eadf492bbc676d4f064c13d65a584b124c72c8be6d61c8861c12d890c1f9dbd3d332776e0fa2410a7b221b555ea9c61e514b96523dc21a3c1af800368590ca0e65e0050c112ec958a5e951ed520c923cb923ad95c61e7a7927d55a16d0c438946c37503afa0c70039a544034825a5ee0b5d6e7ca1eab5ea6c5db18f9988996781e3a138ac799baa4fabdf7bb9a38aded3d6a252ddddb729ebe059cd34c2e09ff8f7170508d6b31579c8e83dd780fe742a5d2b9f2428b8ee9cd4fb439d4cbd00fc4134fed8e377f50d1e59bcd8abc2f9c8ba5891fec799f92eed8eada582de4f226d0a6bc64eb3332862d6986a926d3b7165f3a57768a537bc1ea3bb61c58513a575e59ddd736ad0b5caab5396c1aaa2d710869bffda600691d7e4acfe0f26c60703110286d1f5470ceaa53b5263d671db57366080f7cc72a4f079e7ff12238cecff24c3431c2ff2a48d106009926db6b199605f6be8e00f2cce77936e45abdd8fd738ccef82234399065e17d9e4935ecf1df6e4953d8fba557964e38492b1a417aca17e591602dd556e932c499ce0a4c9cdb19a29cc7629e9165f1acf79319bec204275771e426114105be2863c0e92300b3f2586863872617ff253d271f28f8114654f87e5238f7d4f51d449dc54a536b3eaeea67964248eb032963675e87d1f8ee39928e242991553468494e87719aa1aaf06a7c7328085e15c149bab3bf0f868ead06293551ebff1cab394c9c1fac55df72983bb1cab3ede1830a1dbba3e9482f84b4a41b5693f3c50338b33c6b948628b68fc04185d499e144843a78be4eb61b54038e6b719367bba6ebb0e7c296db1ee679c68261be9594b8fa35ba7889a4446f41a90bec1cbb97ca408186b5869ece798cf289c447d047d9470ae5cfc9cc30e5db81380bc2380cc4eca9c14f4f9a990e481e0cd83ffadb7df15fd0fac1e39af93c7bf086c411195b3e49a7ff46f6b9a9c6a7c9c36311181730e981cf9d23c9bdcfff1a00b6d58423ab0a0000",
+		"18492817ebd8d94fbe3e73201b0bb5d1": "1f8b08000000000000ffc454414fdb30183d27bfe25bb4432205b3c3b403120744e9348da1ad709b26e4c49f336bae0d9f5d6865f9bf4f8e0b94325476603b5471dff7eabef7fc9c10044a65102ac1eda5bbd6cb4b811a3db2c1323fbfd2558c65087ba024b00bde69fc64a465e756fac9c88bb1dcdf87bc0e81c985e9cf1752aa658ca01c70488857d680b7e0acf490f7070e4e99412310f6960448b2730821ffc9199f638ce0d31a9401ff13d36cc23defb8bb1b8bf5d7a4a05b8143ef9519ee37d952ca8ead5ecccd17f49ce50d5a70883043e72d6d8b1f4da3767f67f015bc8d3a8cc83290c812ecc109d199f553bb30a205d1c1541991875bacac7bca95c6cccc00c81159ff24f94ea9096e67d8dfc4f847b775ef97d05be371e9d9717eb621103703c25ba9500b3838dc6cc9b115384db88b1142481dca3cf695d49cd3ea33ae8e684881c0c8786eba39fc68c72d2f565718631bc298cdf880bd181ba8c9deba2329b1f7284019ffe17d9b7ca68fa5064259882e094d86bb18cb4228aeb1f7099be4e5d4522d3a362175839404d44d5396c52f5ce50eb9c4fdfec379526608908ee88531ec4ca1ba37faa4ae550bdb7661e7e52cdcb54e7a66d82923eab5d7161e48e7df4e1fe0ea714bab0d64e775aa5a7848680c4c4938b5c380046f0ec1289dc22f3292dad482bbd64d59c4b22c08dd42fb7c520787203a76b2c47e5db37b720b6ba5ecccded64d0bffaa7f4f8a06cde61be2b9945f94f0eb87f6df534ae0e80ce9912d42bf2003ef460b779e46285b63b38dcb5c37652c434023622c7f0f0078f5bb7fb9060000",
+		"1955a1c25f24dd61fc8a4aa10db6b9de": "1f8b08000000000000ff94545d6bdc30107cf7afd89a526cea535a287d48394ac847094dd23497d0c7205b2b47d49682bcd7e610fbdf8ba4cbf5927ea5e0c3d2eedeecccece210146a63114a25dd75effc78ed7122e751f44ed0783b94cc450833301ac4a56c073cb6da8985d37480031232173b3b7091ff1482d04bdb2d965a9b3b66301348881132ce023958838384c9d87e40f0d839af60729a40254005dabb1142c8edcee488cc40f10cc602dd60cc1d4892ad9ceed36a7d8d5cda1574034a6f6cbf4179445aecbb6139da532429324203de7d9ff6b4c68e5045e2afa264bad950341358470f98c66ee8bdf3308343efaf6e95243c926640d5806a210740a748ae2ca21b919692ee02bb6fccbfb7aeeae80e3a6709ef48ece777138297b64778ae0d0e0a76e7db23d9770a8f627c62861022fb5c27cebd19a55f7dc4d59eefa35c48157fca6e273fb80479b9ba45e62604b48a39bd60c65c43f5d0354b6fdf345168fc395f432800205ab13b4fa25be6fbc81c542b4e9dc2a17a118218e3e95c765f65bf1eaa08412cc82f3bcaf7c0f5930df8a77ef1e5063d56e546e92f1b0173785f367ff5a9de3684592469f1c9e09f978ef058a125a30dfa4ab5e2c0c8013b723e35a9ea06ca27af6859bf2ce17801679f2ee1eceae4a4ac7f36cc9b56fd075803d60c7502303a8e2b0fe430ceed5dba3f9bc792f508e3e39196dec2ec75f378db530917c556956ac5c5d66ea47645fe9424cf8a10d02ae6e2c7006ed6867983040000",
+		"1ba04236fba67eae85d6b04d6693b76a": "1f8b08000000000000ff84944d4fe4381086eff91525b88044d2bb57b459ed0a0ecc61a41103278448755c712cfc25bb426ba6d5ff7de4c469f507ddf4d179ea7175f9b55f5a670c597ebd857ffe85aba75e4550111024590ac824a0539ac06bc248404231443784964059a8164cc66b648ad7c581ea7fadc138a13ad5222b6761a5b486258176916fe0971ba0c70f8225918515064be2c8715d14979770f7f87c0f0fcc1e1ed00a4d21168d24db4cc6b951e813d167025407dc13346519287293fed4104954f0d413b44e10b468533bed10d919f59b04ac14f773157a55a3570d741a25b083483c7eb36808dc6447afc063fb8e92aaa228e1e5913828fa505642a0d6051127a947a9ac7cbdbacc00953f5092281f27e87aa79600217a6ad3e0b264b76e5a190bee0221277c4b4d2bbbccb31707ccb4b2cbdc93a67d665ad932bbe3462120ae504a0a900f2ba6f1a471e4606ca373039cf2c4ce9d1b76e7b4762b656555344db3c4d8170065993779fba01095b3f5dfd55f70fa37b794e93d8347eeebc54c7e6558a69ca7923d07bb58cfe4d70e761186a0f70cadb38c2dbfa5fcd4df692e3961c83424fa53cd10749d127fbb5818aa5a67164cc1c4aa67a38f35a79a21834ad786fe9b1c7317f9776819e97448c5782fb7811db30c39cbe9bb74c57a3d3f0d70815ebd4962d4ba92ae62e3f505549b4d22f74553e04e1b3e2fcff7e06c310ab15f7c080c3e52e0f3cc72d0ef47a2b98b7cd3ce76318cccf94d3c72db7fbe45bea867b71023735cbf5e97e94dac9e70a9e99bed5cf5d3753c19379b7c0c915df8c29f5e53174e6c40566c36c59f010006121f8754060000",
+		"23c996435a95e1fd132776e3b21ec020": "1f8b08000000000000ffcc3c7f73dbb8b17f4b9f62c3b9b8e4856192f6e6669e2fce4d924b52b73e5f9ae4fa669e272f8545c8464c910a01c9d6e8f4dddfec6201823f64c969a6f33abd580281c56277b1bfa9b9985c890b09eb75968beaadfd762a6672b3198fd56c5ed506e2f1289a54a59137261a8fa25c18712eb47ca4bf14ddef8ff25a2d658dc3b29c54b92a2f1ee1b31f7f680d7dd6558903d31941ace585bc99e3275dd534a24d3da9ca257f54e585c68f46cd64341e8fa2f53a9b55b92c5effe3ede9668343ebb59a42f6bb966f16b5bc586c36105d2873b938cf26d5ecd1050d3e2a174511c17a2dcb7cb31927e3f152d478ba4f7004fa4b919d2e8ae2bda95579b1de0c81c47908237b5155c57a13427af4085eabc2c81a2655992ba3aa524355828049552c662554531060c47921614a1353104511ced6d2c06ca10d5c56453e36abb97420b5a9171303ebf1e8d517c0ffa9d2c87a2a2612f13c2e7108ce3eb646df98fecc3746f6c64e06e69d0ccd53573868b9311e1ddbeffeab46dac1f7e755558c37440e7bca97f6f49e0ca2cc4119cd34b0a76ccd6cce5a8a59b8a39d05df5b9af026efe7853276e4ada8c50c340e80802f0b59af608e63124948c054692a30973244a69acb5a200352786a879f3142c43ff965210a655620ca1c4fe5e67cfaf4b49a3f836b652ea19a43554ae4b02a53b830f89f4ca130f81f7e505732055520c5704b14a1f174514e7ae8c784a63d7102b1dd2bc50ddcd87a3c5253507078c4433a3b11da1c97b9bca1d529449f3e45c94fa0e0193c463a8ef4b532934bc0a767eac19f0f3fd2e844680991fc12a510a912ffbd30f65f897f0afa52f01775457fe90cf8018f101d8e47a3512dcda22e2df043f5310db6198f469b31fe3f9893d296cc3d94f8b9a8b584a528165203b3e74a953992b3c32a9c6e87f8ce4035b7748ca74e2e12d0d278c221a0807ca9dbe7eca3a3a7acebaa66aa5673383a8228c2af23fc6271c513a82914b28cedf204a73d863ffe80b89ac3bd23a21f1c1cb4a63c8327c42d77f8e9cc64af70b3691cb128dfd7705f1f822a97a2502816b37359e3b919cbfb7994f2f9f11069083f41c28e1d6bab396e6539ba858bc82c35258a30ca8670f603d3a2129d21d2b7b00ed8bcfd145559acf008b25613e2162e66ec35e8c59c4c492dca0ba95bc74aac9858e49d7c5941ebe26c9976278cec921e1ebccf162cbc742b526b29c8bae61b8756297b8b328b3680b971f6f823ae57539a78ef084a55ec89e4fd650b0dda8a71194db363da1f8ee0c062d28853a98a71f70a1f8e47b99c8a45610e77c8dd212ccaabb2ba2e1bf507f77b6c4101a3eb99e3e167e24ac62d23d315c8695583e23b862b88d94e98911c686e914274e91ac544f2d6a824551a223eef7d864a055775e87f5c1a4bfe149e3c4ee1c71f120fc18af21e305ee34407258480766c1f008d10346b51f0b7acc54776614c1fdfbd7ef997bffce5bf986608226060b31c8eec04968b6f2c675bf488fc42879866afbec011302e8f3fbac756de50464bffd83dbb30fcec8d195a7ac1ea089fcba109855b7f32b8dea9337c3ebcde298f6976824697c9a783298d829966c7fd4981d1526d87e6bf2f652d811fa235baa6814921165aa6b0d0aabc809f615e888944474ed63af55e8fa82f34e035092c1b3b1bd690057bc4d6953e6d3c02c740b45fa1cf9440ec26b4ee27d99f5c89424e0cdec75fecc7d7551dc04ec6742dbd4d6d8ca37d4028b7c08e4722278d8018c78d317638f085cfb2ac8bcb28d8e508c47c2ecbbc01a0d3060b144ddad94fc36f0e769665ac9e90969f1c5d1a9de3e88477638ac3762063af9354f5148e820b84f18d2af98a8d4723f4627021932ffbc7a23292fd8a0c1fb2c2a7eb11de4491e7e4873d88e0087e8e529ae1343abb11786912ef9e854b8e4f21fe397ae05cbb77722e8589a39420f9a50f9f240fa284601f9796180e3cdea96df83c637cde98ce02b975853bc21b235b6b4eb66ef294579cb43739d9bec953b7c9497713bc96f7bc4716ae3939fefb2bb74a5db5971d0fad737ca4872d3ea66e491b08d9db16ca6a0adffb0734e231820710c1f17b38fdfde4244238a30dc842cb6dd37efb104eedfac98efb7fab54d9ba1e113c3ffd05a22405bc0d2db5f4ab30934ba9bd62aa171294f5a0f93e52005acb4955e7294f93399caf80cd1f2aabb67bad3b3e386baa0c95e1b101890b8541b7fdd205711a54093339abea55ea15dd545c49a8e5bcd2ca54b5923a0b951d631e0feb36bef24ed7b810917cf7967a418b0debbd34829ac23d96008b4236631c682fdec6def2a46d5f45a165975da65e48e6c56c980b0496c27abd85c22e258052e119861e354104163ab4594860fda5e8c73dad330cd3a6abf5c20390da5b7a57d71a88ec17eb8ebc7571f4cbaa5ccadac83ae34fff445cbd07d4f74c780b26dc663c74bb0e0e828b75ef08e22523990c82188f2c259d052217b6ccc323a720261339372c36b3393eb47468a881a8b8b5a129e0fd88adc4ead164364fa1ba427b30a96673514b3ab68e97a983903488565718445904e2c96c9eb873df23bc633408e90066b0767283c314626e12f8e38ff168e457be31bb573e1b5e28f75839b8e7c91e7b3e1d5eb8c79e4f79cf614e0f1acc69b528ad03c2d791efbc63a6bff4682cbdeede87893fe18483838601b498373ce28b321a8dce6b29ae02dd8da274cfcedaaa2ec63da3767000f7f04a3b0d68bdd51496a93d5972db0dba15ceb10784286f81d3575f2dbab86fa4b1ac3a689912a4f4b9b324aabc80874f52780c550d4f3224a3d2762f344352994b5983d264f6708eb54db829c39c8812cacac0b9741be76c26dadc12299cb7b59b932ebaa228d474de9baf5465a2a7c6fef8036e4205c1747bcc5c225aaebe72b7f3a1dd563b76f3e1da0d5e829b2cc62c6ae22337559a1f7f386cc2eb15ce5a05b33ad3dc164ce777522f0a13dfc05358a57003cf6095a44ef4692585d7b7ade509f14d628104df5bd05cc2859f7f139cdd5eb80d22df7cbfeb298629e0704641e3fcd4cae9955516e3b05523ebad80efdde09525d0a80206805372e0839ac9811dfcb35ddbdc642fe4b4aa658c1bdc64cfa746d62d32b8dd9ae0f17c6564c8851e03fa53bb4eeb4bcb91185311efe7b52acd348e28b77493a4d01fed20b419f724bea59b2ca79d0179f804b54b21b54e813e5ed452608a0b95d363a850eb5c2b2d5b8a84c96357b905c437d422286c7c7c77a1706690517bf884c7796df0e849eb00ac5503d53cec182a0d82e967bd38fc805a577f619f6f2e8c91759902df022d4bad8c5aca628560a6e8ba2baa20d99386d680d7f206ce9f66354526103df522ef78474b5183e6459e224b9441021048462018480a8dd92a1e0d240a5cc1225e268379ca46bbc99b39a9d228fe5927ff1b9186240c510ce9e11144f1cfca3edc789fbf6e2cbf3b7450fea81b29fed3fd3f214e16d6832388b2ef23ffec53f759d449cbb927b66a693303bf4a23380913d70947929bf188d829f3143e116e760571c6d6196304f620fa2e4a41275e727815cb0f6d709ccbd2a8a992357cc1efbe9858d52ed6c178c5c75cae28cb8c4e414be9124056463a60839490ade130bb12fe0b6b8fde701e895324b83461ccab3a97f50759cf50be31fd6f31b555bfe66153f2e39338a9cba59e00405054b454f3d7a8894de7b59caa1b1bd03ec43b816b6589d5660e954cb363c270e2f070e89164b82a34bbd1c3081e80c918f1d06d72639da336c1dfa50c0f4da11ecd4aa9283c9b09d0120b9446e650286d700216c6dd9190923a0d0e82c70ace89db3ecc3cf89998dbe89260d062574f0b0166f0e152c2bc563351afe04aae3c00f4be5469a904a2969c79933908aba050de7053a32439c0b2660fad397c4c1f99aa2e0ad73013f3333bc6b9c5d461f077b90acb71f1d9470f8c5caaaa4e9c3632b8010413c6236c1708aa13c13628335c92f8c4f2ec95833355547d8d9927511ad14e36f3d754573fd46af67e2e264eb2c91ee3c7a056d8cb1e22cfc212ed5f857e4b8c7325da871143f202e7f6c53f674fb8788a4ac9e5ec3972c16ffa0c677de4b8a3e30494aa485b85003a6053f1616120e3eb8ec4218c25e7999df171e068a351678a8f892c737cb696bea6cdad58db0587bcbb95ea43fa77737b2a37941397bc19c2f36e086c1a15edaeab5dd0a4fb69e98b555fd7d04d16eea29caf7cee9f1473be4d050757e5c52a509c2c24695fbe43ede4b66e0a716e19668569695082c3ef0d09f19be6fc3b41b125a5db0c002e6105975072f3f9fb9768075149e2a3466cbf1ee42faf2ccc161b3ab94f9c8d89cf14226754d01b7b4769ccae37852cb2094eb8beacb4d77b1c610a5fb721f5c93649c33979c9c4326ee338c7c72c1018b006612a8a1e4c55ad4d06c78621dc21e7d9601ff7f89d0205b7774d756e63f64d6ac3281187a44fe1bcf5fdb6f41642483a5ae606b37429acf00faeb08569f22363f4f0c3efb418c1c351c7f926281805dd234029dcf323341028255c7ecfe76298e531279d124c817889ec0a53183f04780dc94d98e0c0fbbd74c90a7b6dc3532d87b9c169f4af4a032c138f33aee7f0ffe080f1b1dff9202fab45697ead720997d535c9da1b69de620f1f626ab3ca139c13dc076d5dae66297b58e349556a6af073804ff10ae415a5627a5052309511c5bbea5a23791e3e198f9a450d70b48be306e4ab1b311900d60e755c652e58d1022871a805551b35134682741f104cd343e376e1043b77ded5d58cbe4e8411457501da08a3b451139d82ba28ab7a181fb7451b251e8db8ffef4aae5c8752d7ef13eee15c5ca8923c3edba086716abb4683df0aa10d1fc00dcd6bb954d542c35c5c48cb4d06d978cf8db675fc65abc8fd815ed1b0b0ea81be410eb617b5aeea5f84414fb534b224e754f0b8dd3e98d3a0f092cfeccc136e00f02f74490fa349f4aff188938bed6ddd8c65f42f96f2525ea36b287da86f2efd891d4950e605663518176077f07ce51c6d5c44cada4e466d62672a0d7236372bf2869bf482d2414d86772be552d654bc21e51e5668907bcecab369a0c776cbaa2646a6a02b96cda2202964c1a06d9bac67283329bcaaeb1722a7ba0bbbec8cb9dbba96dcf700e762721576ceb9032817196a8afe1abe120bd81e793ab76c35938fb7749e86876677b9dda94ffd79bb329140fcbda56de8e1b3b965f5d98d2882cd833d7ac9d32072233758d6759820d86629f931db5c3fa17b00574908cc270601fc899eee72c21d48b7e87e2bf1edd544200b8770ff3a68dd09e5a25d3d1e8f284238b020d60d3b0fa1eb86e943fbc7161398cb4d38c367b8b2de3039e7e79e3328eb3ffe90bd13d7bfbf3b79c50dddd92f7252e592a36a0b70277342f2d825dca6645be0705bbc5c74a846d9601f734ebb113aa837b253796d11a83907a371e89d14cd487c9e24895f898de2a7642ae206cd23704f2db018774f7efa378fc0152c8495b12091d3d2b8ed98f2f753fec9eda3ed19fbec9b5752932e21bb8a0a8023148af4e863e22fc32d3102e64e02643156703e96cf9adc1a6fde0d2127bc7b6265e913b4d4052a2d26bd74165ccf8f6967559f9d3b8fe1bbe702b88ec1fee665ac968fc2fdc69d9b14da569ac1cdcf82dd3c2b7e79e3a5fcedfd6fa7a1e10db53c8e6323bd4388e65e0a1401c0120116d9ce5529ea5510bda0c112f6f139552b85a6be34ee4364b7a1e11c3bed360144cb5409680f57deee716abdc305869442c7598e836fa1f677f965d73abaece697ed3da72bcb351122c251d3b81c32938e9bbde6d20f330a65a8f47acc4e39c65a599cfc14badd2da9601d888cde0abc9b001fec6a6dba4219cc965ed05351569ca44ffc62cca2ebd66a56c3ef4d3eac833d805640b4dc91e068521a6cf25aae102752af80cd77e2b31961a682c1b9475759687dae32bc45dac5f2d7b73553829698ad761eb973e8adc767bc4784b67176ae4a99dbb7317a60e802b13fdf3b01cd8cc3d64e27b976ec79affdf1d66e4b944baf0b1a71e21306db84f0914314d8c49327f00c964f12f8ed1d7d3982e513eaf99afc191ffc991e64599662a1b3aac3dc30738c9b36117429b042d4f85de311768dc1a1cf91b5d05f6f42946c53e1a04e6616fa86eea099a9d9893caecfb8d7e39fe0333c05f5137c7ef0c0a714dd0a8f4b3396f6b248832274f6f9232be3845b2df1ba0c368d3a8e9c7da6367d2436bede811526ea891ccc6bd9f73fa89d91afffbf8776603d9207d57c5b83abc75511ae2d46365383d114a2387ad0499bf55b06b15f943d121279644d146371a3b5b40319c52da26c5dc265b8e0a2341d9616a203185e265c49028c0f685aa88e68a0d5ccc8768e9f37977c20e6bb63b68f86fb1acc828ff76c32f437bfb15c5b5cd4c188a37575bc11eaa6e35c5db87649b965c6c159465e431d14febb6f54208fb8898fa1738d371ef07c1c1ba2882325174d60052370011bc10b47d3b64f98b8451ce0b7d7d8c1149649379e20c3feaba8f5a52868ee6d7183c77433ee18c24e3c421fe4878ab13b4f9ce17bf408e6e88ee2a5ec95ea640e53258b9cea459cb821bf6a266b5b549e5c5a116a60c4f4310c86e944efc4f5af526b712113af80dd4b8d4359fcc736914fc05c22bf5db5a247be42151098eb845ccbd9d8aa18f34553c129282dd357be6c4ae3e130b1d9cd88d6e21a137cde076dde586cd6c438a97756970d6580fd2a9a2b928beb84dea38b1076c4188989a9eadfa6215f161aab91d60d50254ccc0d6533e0394eb538f1aa78626e80df53c6f654fc1b5c59df19a6a676f180818ea2a0cdca0dd26404eebc96b232bfc84262aac9753d23a574353590d303a78b586bd598303597c2905642c73a989bd35bc8014c6e6b4ddc2b8d484e9b7f4661401679f21ed8196bdb3b7be85e13db8c3771325eafade07c47b94f14f8943f1f97d30a6165fe9bde6cc6ebf543f8cec99ca94eaa6b59bf143359bc4407b65989a2b598187e49fcd12358afb73c64fc432275d2b2ada51f1c9e9b0d3737840afc8d34cfb1f2802c63f23ac04855cade4a7e6f7a17463e618967662ad99b7f78149ef46595cbd7388ef419add77652f6a6a2414695d944b0ec9be5f8494d5b24aba6cc5f0484fdf3e5a458e4d2f15cd9af8db4840282a7d7d2d01558af07a1b252c636948c1933ea6c81f21fe0c8fd14dd3770b7bd67db7d97bdc991bbdc946503ca0fd95b9b80f4bd086c79a7f0fd0ede24f03e7881b7ffea6e9665bd777739e262eceec8551b6179dea27a0bb81b71b439cdb6703f0c1ab74ff237d5350cf305deb6220bdf626e90b340feae908398d4993bb224016703d515e6332cb786fb009cf3c14e18f3b357f976eaee0ecc64d98893ce7b1d818d683fb823f746eb863abd3b10a55b09bc49db14db8cc7f47b0f83b70b1e6e36bbc3d5f025bfb47d81c900e0f59037742773589485d4baa706821ebebdc87b3dfca6e0ae18b5f516c814c573c74e6b4e74b9ab8ecdf7ecb26a4ea1de9b669dd3041d078db7c20329b4981eadd783941fe469632b37494bda9bc0232089d74f28dec4f3427f154745696b46ed39d89b88be12ba97ff59be31d65114e4e9fb89861625029e796ad00db8e5e5258eb1c2b797425d90fa898e0cd9b697c33a7d12b8e760abc4f68bb8d9a40357a69053e352a3135114b2e61f66c9eec0113e7dccc7c505f637665abf89936d85d3b8be3bdfb2e281f6eb6f016bd25d81680876bd269f6db3a1108bd16f34fa68838c763f74639d353bdf05770d4bf7f5caaee40afd8955d369381ef5c01ef5eb837755edb71865b855ebb7557be7d814a6a309d5bd8aa9f331aae9575045954d999febe4a3c15dbf2965faa76fd1c66fbb8b2c6f9baa2af71bf41b44f7a2c3783408f3c847c16bb8c359ad2ae81df55837b0379bdba891ba1f69a23f68c8a17bf453aec98617a253a7bdfbd9bb40bfedf91df4af387b3f3679bec895c95ed2cb0cf9a64b1d1e0f892370c1dd28836e0d8610aad4b236f4d32ce3d1b63dee40ab0eee7b50232043f8e35d5bf5fa6ffb5749769000533adccc80098ca6c5c15aa95d18b40c79d8663d9896dcd54ed1d0def96343d7f68eb9b86a005342c4a7dfb8b567eb59f7eaf9d98fdc616790ef75d9b1f1ce2698e1de1546778f5e9a5b091f18896176a4b04dbfb8d0cd3f6f373a3ad3c6c5641fc55b6fc1cb5fdf91b8bb1fe45c8f6d7ecbb78cd5fb973c78a1c81e604bf8d736859e8124a3db34a447a345687ae653263dd5c86474dee9a2aeb1990e6bbf4ea4f99729f06b2336147af022ca3f4235c56d29fd89ab265665365be18f05a8a91f579a1c4efbd3337cbcd0a74184f1914dd358bfa7230774b2a1a46a1a22ea54cfbf212f8c32facffe47ef9a62a2cffcf422226654556fb508cf4dffd1eff3dc3e42de97d5f5f65fc6c94eabeb38ccacecdef0c56aeb862f56b821e5aa5d6127485c77b7a152f6b65d2cee01b76d3cc0f2de967438c2dff6244ce862435456d7d166d3c4e69ec0fd181d7f68b37fc9f0f7476c30bfe4b2ce96bd31fcd8eb5c2d9edcf920ff8173dc81392f565fc59cff9175b5e12e1557dddb9b122448440bae54ff3f63aca5c9fe446868f01524f84f4b84b31e9d4d7bf915af58df62aa01312a56030546340462bb3738107d63ee83d5efb0b647cb814f981ae4bd608483664b370e81a9e8e71932f89d53c464389b965bdc9ae0582b679ffa5f3475d0ab69b86dabeae5a32a4c85d6f2b39c1899673bca25f0a1535ba37d31d7e420a4a00c7ec7a802ae6b658c2c91409cbc4504df496daa5a66014bdac68e78b2b396fbf5666e6fc39636f57c67e3bef37473bf79b2d9dcc96f62022321063c27073cc6c2e4edb1766227c5c146f69eff55685e9a0ccc39d60d43fd340cc99b93d9c22687aa9bae7251d38608a81f6c1e161b83efca877508da357e0f14da83da3eb7df8a6b9c4223fef54b7666f12bacefc49251d797edd79ddcc16fe508810afcd7b4dfc84a281fb62ef17d7a7fa7962227c7d1bf09ead3e343fe6fc3e3fd370d34c55d371d109afdf7edeb8b5dbb5bd86adae97008fcccdbd230dc4ec3b68267762d1649edb68064d4bc4b79bbd91a40639bf1c29fd01a8fc29778dba7dd8b949670a1fa6ed3ae6471686eaa45f0fd3f4efc3b73af1bee61269af083f57a60e687d59c91e3c4037708fd5eceb847a816d7291c10885b7a9a761c895bba51047c2ff76833de8389dd0716619ba81a380f9ffcd0fdcade3f45a1f243abecfab277cb816fc5eb9b13a2c5d96f2d96b71f2590aa108bf01738f6399a2b73bbdbcf87ecb544770ee71255ebb52cf3cd66fc7f03007777357220610000",
+		"2412da4bd4dc5faec21cc8fca40df65a": "1f8b08000000000000ffec5bdf6fdb38f27f5efd15b3465b48fb55e5e28bc33df8e087346d8aa2db3497b4770f459165244ae145167d2495c6abd5ff7e18fe9025c7b6e4c4d96b826b03d81287c399e17c3e43d1e29cc45724a35055d1212f529645efc43c3e31778fc98cd6b5e7796c36e74281ef01008c625e287aa346e62a9db96f8c8f192f15cbed75ce33fb8dcb9167be555594107ef4f793e3bab68d5515cd78427377d3dccd38cf721a653c27451671918d33318fc733aa484214b17d333ebfca22568c17649647d7ff3ff202cf1b8fe18ba4e2a315fd4017704517c053509714b2d39343706ae092e7092b32dd5290197552a5a402bf1388499e7b312fa4baa5750aa39b9764ce5ea2f4480f7c46c5351520cd072ba422454c3db598d3a64d893256507955f512580a2ef0a774ce25535c2ceadafb693c86e606a31244fba29434818b85b65acc6374e79227d2fba9d3e51713ecce642e4761546a0b6891d4b5577b55b5c996f1188ee9776b7d2c2851148873b1942e7cacf8178d154d3aa67a6959c4cbeebed8c9c0007eb1c356de4f82aa5214f0c2dca9da7293ce98c61be397e779550582141985678a5ce47a98d07e7f5fa41c2653889a2b093ae1c7637847d5419e57554b343ad353871aa0ae81492060b3e99227a038645461687216eb4c1234e622f16500a9e03304d96767425d83d60bacd0d35855cfa23744910b225d7b622f4d0c7de98211f4dbe65b8c82fdc41c43cc8620e8bf4b2a15c6fe99c15d37fcbdaa4f8d8200fc7ba890735e481a0215828b002ac30e29cb151538212f50f5add4a8aab51aebfa4877ac6aa3850b380fd1f38429c60bd46712c0fa1e1971099596c73f96a22928696c88cea8f21b0dd121cfcb59d1d2197d9ab7affe41f292ca288a82bf693d3f4fa160794b3ffe09eb75e3df7d42579d5259e66ab25193696fc4b64a9dbfc56908e123959264f47c02e94c456773c10a95faa3e7d7233d53415daf38a401e9fc0ad1e746c088d68ee4dafef054bda13955d4eab3217f5fc4799950d394c0b499ae6e438bb43c3b754ef0035d48aa5a618f7959288cf7da743ac4d68f3ca1be53a0ef044d77965a0d3f6fd370cc0b0a2f5e0c117d7b43623554562a3643aa7d2259a45d9e002bae49ce12c06484e772144237f8bbe558f355680b4328e88d3a2c8544431457243fe5df65e8c0ad6bdcb375454e76eace66a641849e908c5615cd25adebb5f3d7c86c50628b931faf9072e42c77d738d219fb9d2eef7c120915a1850c52d06ac2fe8f7e2cfd788fc0f1b3328ea99475086ea6276be61e9705781f3586f05967b55e58488493faeb5ffc26d383108e1b044c5a6880daeb8d9b8d991be8367cf6841f1de9edf0d122bba2072336183b81b70d304f0a2c0326fc7100a40b8efd81c682636d9c6af73050552d87b7aefe5991e5d4aefdcdc2df2cf0f7b7f8df320ff75af9df77d9bfb5fffa35bf9ba1bd56e83e7a19cc2d55651f1d5346f304d9203ae4093dc22b59d7505558734d6b7422d88c88c507ba38109975bbc9ccaab252efb8ee8ded751dda01f507bcacebbdd0d29f0ed3ff1a270df1d481db28d40fd9fae1022def36196fa67b652e6fa3b786590e926400b39024b9c52c8aef8f53b4150fc029dbf5f6734a5fffed9c22f8777990a67a576a9f0ca3addac230ba7d20c334048179797ff40f0b5893c38f18fd033ddd15fd30dd4f5056f0efc66865244c3b09ea6d0c8c218a2f7349c590550872051750ce13dc3d3064217137f28e6c8122b8c5a1042924897143ec368138eb1e80437a55f7d3c80015776012199543bd6ebcd409b8bcfa54dc1ff2839d6b32f711a37eb8b34f06f82ec95a7ed6b5bddbc09b1540b6237440aeaa9b75e834fcf1f5db26706d51891b54459ab358c1d76f5209566401f87754a59fda5a00d5616b877532b54f76af0ca8762df89f6fb656f57f3275a9454cfd563721200dae0b5b60ac6c0139e502988b66fb1709bc6eff12b1ca3afb5cb738f06c71d289f4ad5ed07b63fc728ef1f78f8e1f9b586d8510a8109da62563e07f1ba2afec5b17beee5f1b4af07fa6a67ab755d9b11aca09bab0d363ace1798bc1d7657e357ca56ed581e20f5a731ba37acbcfee45b75f777fd51da2e34e65f762b0e3cb4a8b810e911f9a1f7b5e13155fe2665170ff1a3cdcd5a68a3ce222bc83b74fa60a3739d772b4ae3b70efafc14312f7018af085cb74ccff007c13fe3bab6b87ad5595bb95196ffc20b5f79a88a57d2d20e820ac2399bd14db06245bdc6a6486975bd99ace6015d5ae92d6c14a3a6ff0d4e6f617fda838a0b819c1f57bdc77ac74b741e2ace925f7ddab5aafea218f92bd2aee50d3f6906fceb02de9e644fab2cd86b3c9aefded8263120cd90aef14ac65d77d3c2c0f9cbea6ce3ce23a3ddcd92753a6cddb4303a82cd1820f4c65ce9a07a0b25ed5fd543640c5062a7b200a73066da13027b241c52a853dc2dfef06cf4a03b147cc503b38dbcab9c94a06ee180818484ab5b7d1cddef71a71c7fe944ac5c510321246f2161b499e2a48ec0b91fba5a6c6b807e0a67eddfde43444c79fcb4e8d455be8a99179bafc347c62aa1d71f92312d42edefe800c65df936e7d5d1e52315870e73870139765a5207ae7a2755ec589b9532b38e2af4c2a5a1c2489006cc01320bfe1099cc928d72de7bc18fda643edf8e8cbe9fb15c9e4e2bc14cc8a7d24377662719be2f54251893b0b4e76466ece6776e225fb9d9e5fa084ed6bcda442ad8c603c3b8fa9501d513c10b45ef48a2eace4e1815608b02a1913a7d03c3bffca496243947392ac0da75955a62ca730e7ac403257dcdc7c7d70f8e1edf19bf3c34fc747efdf012daee19a0886a966387ba9df0fba3362a6c20c7442d425a63197f87a132daefd5157f32870d28a16cbd7adcc79ade89492e488e5d45f6adb4e1439cfa223a2489efacd3dfc3fd228d591c0e81a75eb3c9d3e971340d076ba2f87efdea7422c6f046d0cdb1126d34e6cecf30c72ff1470dea22fc58c08794972ffeb374c1edf462208e185d131d861ebe39c08b9f4d138837d9d792d649a016cc23467a18e049fd9a95c3d54a503463a2ead1ca45a76f6e334eb48b60f4da111eb0e591dc48a636cf0441b528b5bceac2b906d4f9af1fd755a8fe9f7762d5d2bf3e67510e8d14c15754cdc39da6523b8642dbb4d658d456304a3d7b4e7d4def2ddcbd5837bdd33803c05dc476429906bc272c45e88ea704ca20365d52784032913864b223c1024cda4b820aedf8df40d7f8470c179ee7647674908fc0a11e8ac887046df17319fb122b39d516193963ff32bdbb915b1d1288494e492da90e98f6b7d1a492b4f900efc15f71b95392d7c231cc0740aafe08f3fc05c7f7df50def8c46fd43b69a9abe212851523b6d873925453977b92da8e4a588a9bcbd26b5927e0095577bff19004dd74cf19b3a0000",
+		"2f8dd756d3400dbd1c54d1156dfabdc0": "1f8b08000000000000ffdc55dd6e1b3713bdd63ec57c422eac0fd2ca4d53a05021a0861d37fd89a36a95b6405114347776cd6445b2c3d9c82ec1772f48ade5b56ab96d9adcf4caebe19c3333e79023ef4bac9446180aab7e25746c08f3dae4bcb6cd3084ccfb09a80af295b86cf06b5d99bc30159f61838c2164d3292cb720eff3aad5b268ab4a5d87701b06014ee9ba412094864a70a6622813be848acc1abcdfb25f883586001cbf4169e02b8c676782c5a570b7c765f76f2cfd65d1aed7826efac5faf45d4953edd548d8337492946565f4476f162e6f40362848e97a47b1a7667e6a9a76ad5f228bbcd7e64ad42e420aa65672efe0444ab40cf0c6199d020b32652bb18b784f42d7084f2a854d09b379dfc35353e2798cbb10bc8f066fd3f205a928e8b7787342f5b658a21624d600de1fcc8310c00abeeae514df7ff75258ab749d171b51d748ab1b9b12995a84e15de6dee410c2d07bd4656c2efde9cc96129d83a7c7c7e0cde51b941ca2326b5362b310f2ada83bddf387f43a17aa6909e1d91e5c58751ffc62b55a3c2732b4077bf63eb0cffe59b5a5691909a6dee7e9b3b3a07b981fce530ff1611f4a80490810ee5b003f5be3f897a8c915b385c5ab62153d7c923ba4774885bcc2d8eb6c3abd0bbe308e2383aa4023dc461786183e3f0e6176971963bb5a1f7ffcddd53b176f313e5a087bf30ee1a7c9895593d70e69d63aa44f9e7e9ac50dd7b9b844f92e848797dfd12669942fd159a31dfe488a91c640f0ff2efe5b8b8ec7605d4aa46835e5e995b911f86c20f93adaabb462251af53b9e1acd78cd4734ca3e900c104236f0fed071086340a248ff4052527a21c821857064dd18868f510d47d9405589ef7f73d0aa89330e08b9259d2eff91e4eb316cc640a9ea68779a0d4276df981dd36c0e3f88469582b11374cb42a999fe9a1e8e0fec89d736a2475fbc6f67d980ccc69d54154ac6b227585e0ab3446b0ede90c8fab78d84bf74121e537fdc1730beed7fe54684f6a786f91c8e1f63d8aa715ff8e7441786cf4dabcb3f299a7eb41fd4f22be4ff8e8ed96013d7c237c5ab8bbbbc34fb288b777e02c9b4cc7bd46508d91f03008eea3310ab090000",
+		"2ff3a0cb930dc87373247454c196e588": "1f8b08000000000000ffbc54416b1b3d103daf7ec57ccb77d8858d9243e921e043b09b529a96d6cead94a05d8d36a2b2144b726a23e6bf17edda8e9db4a4a71e8ce437e3d17b6f1e4e49a2d216a194c2dd8595d9dc790cd179e4bde371f9604a2296d2196805fc56b4063f58e5f8c2a93843831189d8f939ccc71fa5c4d5da768bb5527a43043a80808c44ed2c4407bbe1202068db1b048f9df312825311e4305082f26e09298dcf7d164b248298efa02dc47bccb59988a215615f96bbaf994bbb85cea0f0daf68729cf48f3a933eba5fd8451f0714203defd0c574a61175166e2175972bc3f50d401ac8b274c599696df90c2cdb17b24fabd0f551737d0391b7113f9743c9b94bcb03dc2ff4aa391703939f677ea245e673c10414a99cad8c7bf78bd147efb11b757becfdc61e8f853f5b8f8de0d236fb70f48d4a48456120d079c11d5509d5a60e3db370da0f7f9e37c0d8915b2cd44b3e2968815520b835dccd86cbc5e3b5fc996cfbc7e449f095475cd58f103b7a3e521f77efb1e625e4f829cacbfb4e15517ca83d017db2d1b782e1788156165f283736cb595d54e4cb30ff3e2ebcd13569ee6b13c425ecd56d9c093fec10eade0c6f53d7af86f02569b6c6d3122392b0d8495a959418c151ec3dac4710f9713902d7fb7c16e17a2437303ff2a4e2f7203352bb41af81d89f118d7dec2c5407caf648046417c7e94b5aa66e39fccb02296125a49c47e0d0071855d479d040000",
+		"385f58a2c0f21f2941409b7e1ff4c361": "1f8b08000000000000ffc4585f6fe3b8117f963ec5ac7108a43dad72058a3e649b0297cd0648bb75b6896f5bf48d1247362f32a990542c57e7ef5e0c49c9b293ecdeb5417b0f178b1cfe66387f7e33dc8695f76c89d0f73967eab3ff9ab335ee76712cd68dd21692389a954a5aecec2c8e669c59563083a7e6a1a66fd45a6943bfaab513d058d558da591c47b3a5d2eb5ca853fa3b8bd3383e3d858b56d4fc5a560a8481d62007ab80632524825d21b0a6a945c9ac50120a9205212b9501931c84fc194b0b8fac6ed1809056c1a360ee98176db42ad1983cb6db0627aa8cd56d69a18f630080c18a4b661138fdcf582de41254059b150e7a37cc4083ba527a8d1c2a51d7482640b18577ff8052ad1b512354355bc6d11e0f20a0c571747a0a9f9845633fa8f55ad857d2750039d5e58c98b7eb02f56b5e2b203e51656fe4f5e75754e4f1e0594537e67515dd986345b7adb4628d5f5ecd7713c051d32ef699f9492def1e6aa85a5926a5ed201458fec1ffcdc03cd4e14c1ac78f4c433264ee47ade7ca5ea9567270c5e7535663a93407a92c54b41747534138876a6df38f245f25b3203c5716dcfe2cf53ef8a8f54f9215352ed45f99362b56fff9ee663e55f3b351121ab6ad15e3502aaddbc63acb5e387aa4f8b9e3b374ac4a026928ce574cd47870bfd6ad43c5446de2e858f0480d2f06798730b9deb534a8ed5378e1d627f007824fe183fc31fc25d6f89cf5dcad4fe00f049fc207f963f80bc63f33cdd6668a5d300e8d5f6d98214215328e0ea48f144c4e8c1a42042e2f4063851a65898e9803ddc7d1e505ecff7b4b8c9e5f5e78bffed8347baa3d38be27f0383a907a3bfe0c34a9964bd4ae201cf3db15b3b011750d0582908fea1e391458298d801d96ad25be360f751c85a3bea48241a55513308db6d5920e50a7680d6a60a5031012d8507c196cb4b01625dd9b044b8dcc92daad6b3e3ea3fc67cb858552d5ed5a9acca97407fc02308d50d6c834c5a20261a9d5495183d2c1160315ab0dc6d1ded2e77820858439014f0519a87b2894aa53eaa5ae9b7e50cd1618185d0e3dce7545061c8d15d2b751bf13933fdc81841b2787ba6225f6bbcc9d9f2ca421bffa38e2c67e81b373086d3dbf965c682c6d322c7ca1667c5311669ac691d1e5af9337ba4c29b345056f4849fe81c91f39d7490a7d1c45de4fde0e93cf7193cc4aba2bb57cd2482e6d25e35ca3314459b3348e761e8f6cc817db069314de9c83430f9f2f407351b9acb540f46ca064d2516941416d04f201bd521a04ddef87f720e08f1e7bdeaeaf04d63c49df83f8fe7b677f4542ce10bf25d23822dbde08f34fd4eaa6fa4972d4f556c8a533adcaaf07ff27a97781f3fd783cbf439b5484b2f3a6846b4851c7bb38f6d17d01bc9b463b752904fd88d0c1f9b94bcf5f7e19c37689d87c7c68599d74d9b848d8631c09f8a64aba343db07c6c71b6fb0b6e43eaf53b97acb60b79ed2636ab993454874a5297e50518ab5cc948b71f6a81eaedb695d772d10dc003ca38d545bcd8535264bbfdc72e54891c58e09baaa90c8b2dfc5dd8d5a2a38a0dca33e00555b35da176d92795c4a1a6a47cbe8d4fec4ac75f643001b9723e3b87d276be2a12e7b37e97e6c9dbf196e97b123b39019bf38222c58b6981d8dc76940fc3372fc29d3f1a2bd6cce2adda7c50adb4d3fb4b3fd1a90ab4da187201034b4504184e71a8b45a3b6795ccb25a2dc1586685b1a274076867780b6424477ec68e95f6185d1c0ac38a91ef0ee0b493f016d0d42df191385ab27afb2fe4dec9c7f74926becdbcf5f47219a6264884b47ff87de6cbdc15148d51a5b4345be5f3b6aeaf49c085e2a145bda582bd148c92fb4ae9841779f8523a27e4244df36323eefef6898ade9f7f730eb319292248d49a007991dfb24de2042656a6f9adda24697e5732999c94d2a6efc950622b2a44c21802faee77ee0e43dd1376291d8d0a0e2727eec3dd04fe740e3f4c5363dcc9a8bae9b0f7815596d5b72e34742e8ef6b62ec88dc9c44c973ac9c97824f543c4986ee38637d2a7dea2bb6da5440dba9550d170056c5a7319d4e21ec712f33939bc452f2f7c998f202f0fc995fc4ae70c71777f9c5541dd0b360d39bd3723038338520f290a16ff97c64c9837801360e63456320d3e0c5b2fb990cce5450e0b57596a9c75a875b977ea46d8d5018d86d9d02a826b0d1e1361463edaac44b922722bdd839578c04ddfd5409f7e8ea171482ba7a760e5fd131916ba2bd167c3a4284d0e3f8244438064205dcb902142925a06863d62a384b4d9b3c06e70cea095351a33004d8cf73317176e12183b08b103ddbf12cbdcc54eb51618299c9091e4356680f932f73eabd83d82c6461961951668282adedecd0031f55beee96912caafb681df982882c23cf4e7496d57aee184a124ac0d6d88f4a5f9626f6242162693b6385130050cc690a77c3f7279199a520627634fea797106bcc8c0766760bb1d0d7e3bcadcbe1715d0bdc9e5b78313b7bb90d313afba004ddd3c14a0231f3384b0eff3cb102be2e0dd6e0c9d678903c8711ee8fb77a0995c227c37725906df958aa37b7d9c9d43ee36e8cbec7671d4f7e36e7ee76082ba973646c55ba70d25a74bd24be0db1c73947dc3f2d41b71b4e8460a0c9430c7cdc175fdfbe45b8e6ccdf0f03956cb0b9fba47b8d3c69ac2db03957bea3a99aeff271e7fd1e56730c7cdb7dd9ef022cd26be8fa24577f66265fdc6b21beb6c52d6545604e346f08c2a6f0ccb15bbc7a93fc2d39192788d6ba5b75f095006f4a668a5b040ffac67b290408e1e9f659c316ac76a93ff5bb8c8925f11b2ff75c0a4a88f23d6f7ef0025dfede27f0f003786dc7efd160000",
+		"3bb4d245f7af16bd3b593ed2a35bb098": "1f8b08000000000000ffb494cf6edb3810c6cfe253cc1a8b85b450985da0e821800f869d1406dca2b1732b8a8012470a519a4c482a7640f0dd0bea8fe3c44e9a1c7210240d479c9fbe6f86de73ac84421871a6afed9ddc5e33ce69ada95bdfca5108e4f414269c7b4fab4695aba6aac43604101618c488135a81d3c038070656a85a22182cb5e131ec3dbd6285c46f6c8d21808bcf2014b81b04efe98c3956303b2cf3fe95787f02a282eedbb9aa349d345c387a215072db515ddd20b0188552cb66ad2ce86aa8cc0c82459783c558c7e985dea099b235ca29b30874e54c53baae6cb7755b1315ef364763b48113383766ae2c1a77c184449e032fc0b27b84924909551b245187f8379ce92596f7211c0a96966e0ba5560eb78e4ebb7b3ed0feeb3d5d6b8ef23b2b7fb1ba57837aff043383d4a06da47b637e0e4bbdb193aac2d22107a1dce74f39a031f1d226034f125ec0d9b8452f422009174c62e9626cd63d5e6893f282ce8cb84713b9d22cfbb33bc99b248f9ae4f06cf741941c9c6930db73852483cf6763f8f1d33a2354ed212618a66a84bfab583fc2ef914d35c781cbfb88adb4eb53e9b4ddf02b3a46e776d2383d57a5c1352a07218cbc3f4cebf0473978df52b537380901024998a97b38a11c9a8a95e8c30712764ad11de717ddfee960ff11c477a3bc0583904454d0f70e5d30ebba9199cfd20cc663d88f2cd135460955c7ee4bec9d8c044b2c84e269bf430e5df2ea72f1188a66ec9f24a37c98fafcb1175e732c64194922e742d7351af86b0c4ac89622e9425d3bda3b191363bb257156c6b1412f1b340f4bbde90777979943b49c529ad155c954facfab8ef400689e5637ad24f13d87ffda016debef168679f83f8f3924098f33d13b4cc8fb947c4948256454e9a848871a458978b16c8fa4163b02f0829e6fb17c5928724482630a7c60aff23dda0e7fbf6979fa0ec6e455c3610ccf17ae1e6e318454f0ec988b466fec21dcfe311ee19ef5c5ee231288f7a87808e4f700909fad32d7070000",
+		"3ddd46698e7b6260725e8b77ccc102e8": "1f8b08000000000000ffc458fb6fdbc811fe99fc2be608dc812c28d276f33aa72a100479f8709708b60f0d9006c18a1c8a5b93bbecee52b6abd3ff5ecc3ef4b055db3ff5903812b9b333df7ef3cdcc3a03abaed80261b52ad8c067eee913eb71bd8e63de0f521948e32899df1ad4491c25282a5973b128ffa5a5a0174d6fe84360f8285b6306faae8de262a193388be3b2848f9797b34fb262558b6fa530280c5cf3ae038d064c8bd022ab516968a482ca1b98db018175522ce09a9b16840472c0c5a2889b5154079ca6d740008a73d483141affa1b841956f5c3a5419ace2e8baf86863a6597181264dbc87c9e5ed80c9664776c090e24dc85cc92ec921117242b830072127da4885c9816d33c5163ddbb53f64f5ee66e00a35991d2559bcbe4bde2f179f3f1d664e0a60c29e1ec2e92d9b0c0cde189bb1c798c5fbbc52bcc3a45a120f65208764137173840b14f516bb42332a01865d213058b26e4460a2068d8ab38eff07811b30122c685a081b48297442e5a178c0c1fbff4abf82bff8f7ff1e511bca6e8dc085c929387d41d5b00a57ebc3d2f83f66fc51613a72878e71f11aaa96298d663a9a66f2ea90f72f93dded93cf83e152d860426ac19bc66db244f99dc44d16c791adfa1c5029389dda5414bf31a55bd6a54bd66571c41bbbf8c31404ef88b7e88c8814acbb40b544f54e29a9480fca7ac9e22872798ca3751c47df73f80e53f0c1531b2ec8e580a33de530411ea522915011541d47caa64641fd099e1f1d79033acdbe956b260b14a878e53b8293d141f84f5414316123fee90a8a2ce00df949381555d7123dca24278e02dd6feadab9d41f99a83bf464fbfaecd900b2f14c95eec396eba843a186667485b776c597b4dee95020588f3b8b54c25819bec4eed697f13d1829dbbc819e0d5f5dec6fee2387d6e5c61b677b4f94052f96ddd7ef4751a514ec8989a56cc651448df40a6f738ffd740a8a8905c20e3ed2ffddd46cb790f849f4515b587551e3b4a591c5d13aa4810fe7d6eb0418b13d56665408a665065ad9d58e4d6d983296471435e585792cb2013e1022855aa38eedf80c2e9d3b22c5ed17688ab3591c910f084f1e850828aa16ab2b975e044e91167c8962270c706dcb898b0d0c6be2f2e93da52a9c2c073ebcf13b5dcc0ce652dae6519674e6aa1fdc74a2760095ec07a6b896c2761b7aa78bb7f625a61b5739a8c29e2a83bf4fe1087efae9214b1475067f8323586dfa1118352235a5f0dcb04e232565c9140c8a2f99417b000d53f8facd9f661547e4c3863eb51ccea81b9fcdd2e4f8a8b07f922c8f2322f914e09ec5c9f3e7e1c7daadf3871c1e152f9e3de6f2a8383e79f9749f2f4f8ae3178ff87c7952fcf5f8e92e7f3e79f4e0dee4a9ee8e5fbc7adc21193d1de3abe2f8519faf8ae39fefbaf4b349cf9c222ec6b94073a04e4ccb351509fd4b85e11504da6ef0b366dfcb56a3870aa3bfb5bdb662d470e9aad7ddd25dae4225b0b605087c583e03667a5b287c786b219d4eb705575cca6769f67ab3b633b6cb12a8f91144491382751dc851b982d6befd7da7deb8697cfb454154134ed7301e60c07a2463de6cbbc34e57a0761b4577ea923aa7fd3950a065091fd09ccdfcfebdb9e56f8ab68fdb86e9e0850b334130d0320d7344018392371c6b4a23de18c52a03d7d4796dcfb517029863c771899a6ce6b87ba5d876443fca7641a5f7668a9fa1ab38e2035dae92240e1cb75b8ebffa41b74abe4cde4b75cd548d357da30bc197c939b26e7236246bcb990f8f9af6fb5fbe8a8ba1e326557e28151fd0a46d964392d3358112d63355b5d028d983e28bd65eba3b6c0c8cc2f00eae111668287fe3bce35538a3db6be792e5db73e157bda7393652a1d511517b5b781d7102d8a148bd39ea0c2670fc1ab8eddeaf814f26f644c4cdf628978af71703ab70bbef2bff9679e1f92b46c504d585615c8026634a95ccad93014c2b350132042552c8bab31981d9ad7c3e645e9e3f3883e24c7fe8e49c75bf0b5e316dd20cfef8e35ef53adba0deb28439ab035b392c24f12af0c690ef881072b1557610351f82cc491739f80b32c1b389fc28b5994945093dc75e1a24d1679b9ae0034d2cea50efa5ea99f16a73375d665003d315e7a07050a8511846bf0bf81b84b375dadddbfe9078cb12de2aa4b6e121f8cb74446333145e50b1357f53d7562a61312c8daaa34c343d1d5571619a34f97109f66f42f3fd3734adace9dbefe7bfd2c74c4923ede19da729b0614051a7fe450ea3eab2bda0add4e6a10d7bd189ecd3109d1e32e7ec572949494a8e8b36b41257bc74bfcdc3ab6d1587ea83d5b691ee1479b0a7d5a762fb71e991f99059b6154e59c259e8bf1a18cc3e5f5ce6244518a4365033c3ec880894c2740a09192516a0727392249066f14388927f8ae4610b55909be21dfda711a604726df19dfbe6bea30346508318f6c4a48b5f241777c3aee3ff0e000ee76ae3bc120000",
+		"48dd2150bbb76163de11ee370747203b": "1f8b08000000000000ffbc54514fdb30107eae7fc5b7689a9a11ccfba43e20ba2244570165dac3342127be040bd706c745ad2cfff7c9490a0c98e065935a25f9becbdd7d9fef1282a45a19422685bd6aeff4e6aa21cf1bcbfdea566731b283031c930f81d76b532dd775ad363142b510488857d6c05b34e421d02ad36882a3ca3a89dad915fc3521047e294a4d0bb1a218e1d33d94d97153e14529da1d2d874716c23e548dfedd13535bbeb4b59f92264f7d67adad3d6407c8a16a0be108c67a38f26b67487679c8c8fe1572ce3aece3ab730beb67766d64015962a68cec499674a5bea4b01754ddc7f8d28071e537a8acf1b4f1fca8bf162138611ac2c75a9196f83279dafa9195344b781b234248c2fa387ee6d44ab8ed296d0f5d933c4017f137f629796cbb9497db5b8ab108a153d95db01f638ef170129f43e02b2b499f89ea463483d33c04bef46e5df9feb948fad3dfba1c818d6499342423ca18d9482aa1a9f2099bf6b733ebc6b2e453a7eec9a51ce33c676c7443db23abd72bd3a6d89fbf5aef946902d239bcd3a1370dca1e3ce84b7d232f78e262cc0a3c7702f1ad611ab5773af57341a532723c682db0a42474793e7f84b23fc7392b704adb1fd7e4e831e6d1827c2fc3e1628a6c6f20f9f9da7a1a3f6479d6cb2b7a528a932516dfe7f32ccf3b25a4db7fdbf5ae4e1a28c60024f3e6b669c8e1c3044669840e4ebf1e4f3b51a0bdd3794744c646c3f84df0e95d0318221ba5119c4096fc98fcb0587de23e5757a0c0ff5ab5973bb5f382dc2b46f49f9c0476bb34f8f084d989304ab3c84220236364bf0700f05c996086050000",
+		"48e427ead6a7a4c1f4c20cfcfce353b3": "1f8b08000000000000ffbc54db6ee336107db6be622aec435cc872badd87c2458006c9a6bb6d37ebdaee05688b8296460a7725921d8e7229c17f2f482bbe35b7a2699f2ccf0ccf1c9e331ce74aaca442488591bfd7c879ad736e4d937a9f8cc7f035b27379d5a962de5595bcf61ea4050121c2522b600d353208b052d50d0261a1a9848a740b7c81e05cbe10cb06cf458bde03876f90ea36772a582c85bd4d97fddfd0faab79d7b6826e02875dd888e15c3e67ea0aee8f2e6fc03912aa467851496c4a981cc1aaf75b55e9fc44977816e2d67be740567d593e2519da7c8b37c754f760cedd9b859846550698f813c92e446df73925cf462874787bfa082de70c49c590feaa52ef7739424038455b9034d1b795b13b0afe6fc61e17051a06f860b58a8129e9b22bb08f3cab6a5341a28547940323f862ab66fefd77ef843152d5f9fc4ad435d2e2c6048981a94348379527bae95af50e59e43d56faa80df3ae28d05a787978084e2f3f60c161a4f25697d84c45f151d4bd6cf9fe4005b1ce846c3a4278b5775c18b97bf8cd62317d4da469efd8ab271e83f435d1b9e633dda9328372b99e014d204b509aa10a3918012177a42cdc96434089bd365518b8a491cc4c778c0463e7f2f8b9baeef319efc0b9d1bd0530f21ef69df9a546fe2d90bb6036c1e217b945ba449a171718d84dc6e34df08db61cceca0a14c26d74aa89e18b43ef279bca105b77f9ef2ebc9ec833f111c35605bf77c3147e1e1d1b39fac1224d3a8bf4d9cbcf93b0c7fb29986171e9fddf17fec15594249fa1355a59fc8924236540f0691fffa343cb19181b0b29184a797c7876082e19147c1dcc944ab2148dfc134fb462bce6031a3e7d45260f6b00de27c9c0b9fbf2de67804481c71d45b1c9549045f2fec0d80cd287a0d261329055c4fbe408946cc22d07ab37109fce41c1d7195c6540b1eb709d4d063ed9b52559434d8ee047d1c85230f69aae6028b2d9deb66976cfbe982193c44b7caf70f8e516bf7f422f19ac9ef9965e7929f40c8dbe733c02d6935d84475d848784cfb6b50bcff85f19910caec22c7f337f7fbea98b771f263e710e55e97df2d70001da3a6623090000",
+		"4a4527aba86ea6c4cad7d61f978f884c": "1f8b08000000000000ffa4945f6fdb460cc09fad4fc10ac36a17aa9c757d18520458d63f68f7d01ab1db0d308ce272a2ec6ba53b854725f1b4fbee034f8a1367e990ac2f86451ec91fff765d81a5b108a96acce7b6f1489caf5dce7553a52124d3297c8cc2aecbcbd6ea795b96e632045045018ea06d0ac50884da51e1c195d075f9429d56f85ed51802b0fc0763813728ba578ad5a9f257ea62f89417ce223029eb9566e3acc4fe75ded6b5a22d1c3f245cb47c855e9369c45384e5cd9e9d822f5e34446a0becfe2776d617c0d8f59eff0bc39b28f0aa46d0ce9695d10cda556d6d7dc45ba8b517c773a65673ef362a8eb5c68621e245c18c5cd16abc2951a46a007016ce5aa42d7826412855e51152edea5a81c74691622ca21d5855634c5cb00610f954d05a73d68acc7a26652c6711bd21132bff15b7604ac0bae16d7a23fc2d763875c51696abaecb6b57603553faab5a0ff5ca6f3f666a11d27eb26e57211d1aaf357a0fcf0e0ea08b5d0a702fdfd1fa8d32554b08cfc5da9d7e41cdd15c3566dff8ed62317b4de4e896d9f387989db8969160da7579fcdb834cfb6d8265e33cafc43fea8d83c7cbae5bb8dfe71fde433fb9ef6ce9f277d6b3b21ae12084d563f81b36cc0dcc3ecc179076dd0fb9473a479aeb0d8aebc3e9f45af8d6790ea1eb4c0916e14a3a73c4f0cb410887d72f45262fd11621dc4d9bc29f4f8f1bf3f4a3473a6c3dd24fcf7e4e64f187329ca03e0fe1ce9330be88d0f909fac6598f7f9061a40c089e0cf2b3163d67d0f8f890a45494c769f213e89291e64b383c02630d1b5599bff0a5b38c973ca649323a57b4dbafe5eac9fd662119c9e812895b425548d9c794c18f83a7c98ba87d7404d654823022e4966ceced58f3650617195026d917caed077b4df49b2a06fe9d693292b0a523f89c0dc031bab2ebeb03d14792fff98c6451713c4992d11e6dd47e5295919337be3bdf97848af1df597c4f1abb3c462119ed97f08a666865ef993248f7af679ac1c370bf418b44939db6679129d8ddd3e5aa3f7c11d15921a4fc8da3fa93aa5a1ca7cea6931772251f1d419ac6f6ee6c8f86a3e9f37953191e3b9b419aa5933ecc906f5fae850c77c4924590a4e558ca60e6c3804e242147318274defc77e74784bead389309d98b74828dfbd6764580de4bb62b419ee752a1ab16dd9c80a16c42269f417e0688a55981ec834024bd6a786c4d958cc224b9c3e1fd7b74218b1f376df76ed8b624245d87b60821f96700e513ab5679080000",
+		"4aa5e97f288ebb6ade4b557804160d53": "1f8b08000000000000ffd457416fe336133d9bbf62207cf8602f1ce91ea0876c36eb2c5aa46e229f0b5a1cc94c645225471b0704ff7b41c98e9d4412b43db4de93ede1d3cc7bc3d19ba4e2d9132f109c8b792597edaf3bbe45ef1993db4a1b82299b449956843b8ad8244295692155913c5aad22e6dc05c81ce26bad7259c49f39651befd924cab7d49ea2124d4021251ba22a24b164a42a6cc4d824722ede6a81e5d73f9677de470c00200405d787109b4485a44dbd8e33bd4d0aa92e0aad6416be456ce25c20b0b2b8a80d16b5f7f006dd041355976504ce1dd89c201eeb52a2b2d9662b454bd1e89ad0446cc6987327eaeeb1d25692362fdeb324096d7b205367d4b6ec962b51a2814df36981360806ffaad192059d37bf9d8b53be2ef74d060adfe159d2a63995ea11334201e6b512a3970afb2ad926068e4d8ed4025670fde632e377cf1fd18c4d9204d21d985abd25fc6c24495580c5ef68780906336d8405a9800319ae2ccf486a75502638f135b7b8971fc2276526e9ae9358babbaf9542c33c0b1dbdc3e71ea999414e08bcaf15b50d648f7d03ae0448b2a7542dd06e0e16f1c84ca28dd31dcb6b95f5179f9a7fd8dd39d0b0ec197cea2e19eed420d546c1ffbb11ee58e6f244f71cd2dd25d0ce33cf0ee3dea8cb9a37f45daefb66d2a7edc0c3a7e3f0c7ed49eff8cff7d76cfa04ec6bcf1a214dae7871934ea3c4b93677dbab68be379ffd63de2f90aecad2b938b07ea8f35ceebc9fbd2659fefe3026cb95103f9622a92b8b863e665a35f11f4cb6aecba78fa93ed7e5d310b1ae063967b82a10fe974b2c055cfe02ad817c53b98eafb5c0af216e033018710b8b97466eb979f9155fae4cb1e774e95cffe9feba9a0fb8e8b997fe26acce98f8aa129cb09ffb557a7d7bbeec9761a3f692ff72f3db4d7a73beecbf60891dbd3ffcd57024f5a0736ac1debfaaeb7eb70c5ad206cf46e3fd814f87c8a63f61b79d58f042aa41172ea48abf3582edbf61bfd75a7d4773fbeafca95e48351d65cab3e19b1a9bbcc312479bf5a802dd163ed2c34755e871f6d960f3ffbb091ea56981432d5bfd747abaf7c0f92e8251a23ad7c319ef8751a2bab7c6cfbe364649ef5926efb6897384dbaa0cff1045bc927f1648bc2ce342c7b4adca0862efbb30c3002ec430a035dd614c30cd1189c29b388ca9c2600f43443326c398fdad0f83d61f6bfd3d004a7579261a110000",
+		"4b0f3d2bdba0d625b051a8845d627f59": "1f8b08000000000000ffc47d7d7bdbb895efdfc34f71aa4c5b3b0f4539996d6f57339e5ec7f164bccddbc44e5f9e348f098990c49a221482b2a3dabe9ffd3ebf83031094e5cc74efeedeedce8c450207070707e71de087a9592e75dd7e1cd377dfd3def9a2b4545a5234d7b56e54ab0b9a9595a655a595d5a48bb2256bd6cd54535953366af57255a956dbfd640bd45155d1d214e5ac9caab634355d975545134d95b16d4a1bb3a685bad234d1baa66bd5d4bab807633f79f4089824c9875f7d78594e756df5c7bd45dbaeec78342a97f3cc2e4a5d15362bcd68a28ab91e49abe1d14a4d17fad74f0fbec90e86936aad337b35dfef3a9b95aedd4432d3cc4795eb6647aedff09bec609f3efceac30bf3dc4cbb5e73539829779897ed623dc9a66639b24b5555b5b6ed68aeeb3fda56b56b9bad6a3fda2feab64f18ad6dd45569bbe1dcefe1b4e4bebd0e98ce1f278daaa78bc3a5b2ad6ef67f513f9915bdd32bd3b474ac9aa2eb37370d3f9eaaa6e0a9399a3e88f4177aba9f0f774d92f385c6da526b4c45abc614eba906e71dbf7bff9cf68e1bad5a9d52a35591d27a55a85693aa0b2a74a55bbd4fef4ecece49ad4a74fd879eb6e45991668d598283cb2b5d53a15a35515667d41b0fcc984c4d5d734f43ed42533121790276b56d53d67352b5aa36ffd4ae81c0623cfc06e137535368c23005999a9fcc2a35b7c0edaa2c749125c9b30dcf05300bddaab2b20ed13ee08959b702b15a2f198bf5b45d373a655af94141a7b9a1a959ae545b4e2a2d0da9ddac74725db60b06d2e84febb2d1858756aba5b629cf835bda94a7a2eadab4bc496d9624a72dd9f50aab67e9c3dc34cb6895bbd5fc4759ff73b11ee1fd3eb5982b2095cb55a5b1812d59b3d4b4b60ac82d75bb3085cde885e05f443850594fab75a1fda834330dd5ebaae2ae0e734b1feca72a7bbdaeaabf3acc63d6ab543d674e5f5dce479e9623fba91a3d428f67c654fb641afa305f377abe66e0d97d38dde45cbb11daed27981788a93faff4140271a26c39a5c9baac5a08c1b97190b224c9e7bace213f814041232a6bbbe205986c7841ae4d7349664667ba5dd0d94257f5ba6d7f6be9433179ea5670273ebee5d35168b7ef96eef9e4e919ff0ea38e1e1af378512e75a38e4da19bdf5a9a9b7f5853d34a4d2fd55c63a5f17be7f071cf916bb79f254902e9ccbb5596b53475d26910abc00cd4ca32d6ad2a6b5d1098b3747b241be9cfdc68e4da66c584ce3e5565abbf095b22a3f7167bc69116db36a8268631335565aed1c2d12f4bf23cb79faae4f8ddc9d1f9099d1f3d7b794203554dd64b3b48f61222a20f47f8795a7c243a7d7d7ef2e2e41dbd7d77faeae8dddfe84f277fa3a3f7e76f4e5f1fbf3b7975f2fa9c5ebf39a7d7ef5fbe4c5dd7f3b2adf447fcf9facf47ef8e7f3c7ab7f7e4f707fbdbcd8e9ab6b42d86f023f41bfcf0e6ddc9e98bd73cde5ed71a72ed87937727af8f4fce68a0f8b11df45a245f7df5e6353d3f7979727e42afdfd0d1f1f9e99bd7f4e635bd7ffbfc287e96ec8318c9a3478f1ed179a36a3b33cdd25259b7062fe62601e31213c30b909be4abd1e8031d7c24a111fdc2ff2beb56cf75e37fbaff61078d69a62aab89564db954cd664c6db3d644a4d6ad093fa6a61adf8351e97a4cc327fc37157aa6d6553ba60f1f93af1c72cfd1837208a1f140c05f5ceacdb758c18bb084df3a213296197d8b598f6559be1d10f879ec38e4a22c06544cc603693a80046fcd643d1b0fcabafde6697a909a559b42901e861eb9a3d9938fc4cce1b1fff9ffabaf54335da8c671d0176926bf1dcde407d3ccc3f0dd99664f7e7f709f660e39d16b4234a10cbf7274e971f5b7b6fca71e3ff9fd4120538b968e46dc29a690039d3ee948e45a0b7d9e7e24cfc51ed9ff3a9e7a903eff024f39e47a4ce53947f07e8075f86dc73bd2f83ef33c8d9827f4c9933becc60482d9cb36182050838a553c0c1e27681b6ddb60f0949656c65a56ff90aaf4fce80d4d9b7541b3753d65759e12c4392d545d54bab1292dd5a586419f7af16c7573a51b528d2675a5ca0ad23aa3e3859e5e126c1156e366c6b2f6c33442511702c27edc7b14f4faf0cc3d0bfae15959ab6643a7b56d5555f1cc30db6747673f427d94ee796798edd9855957051c0579a70b6a0dfd9fd1dc8c2665eda64076dde8f00cfaa6ace15234b452ed224bbea6b9a1b96e69b8a6874c5046af30d77565949f0b59563f41fb245fd335c0ec508a3d58a3465d8f9c197e5fa725e2c530d576988a5b63d29e5f60ef317d5979525136fb98b1ae6938b49f2af0e8a1153dfa77a8a3af864358b6b66d689075880dc2db30f652953585c760eff00302a3fbc11652f8c97ce97f806d3aecfcd3a529d615ac287e2c3e826d77b50b7f0b738657c2bce13df01bce96eda1add56507c2d37a582873ff21a81b9e9a2bdd5c3765ab799160d3151e45b7527beed9aa31536dad73623dd3c2766a9e5239a3dab41dbb6231a6454782e46bc7b3fe278fe4b645e7134f794729506e52d6a1eba34774bdd035556a5d4f1730733af66f17caf90a673fbd04d3625f0b1fc3922a6d002b2c63d592b98594e5df0e0960bba22c1b65116b106113755360f2bc59e99a144d1a736d75835d7973f375e616e96cbad04b7d77371e8dba873f1adbdedddddc80429afcd3b7703cff70707737ee5ae2195aeabab8bb1bd96b359feb6654d685fe9c2dda65c5e3bfb79ab7e368ba6eaa11f664a981c54cb7d3055d952c2d97308babb2d6091a9078e0a044b530b61dffe1e00f072356dd36019c875ab07cb609845522a3abb9c6cfa9a9ada974727393bdd0f58fba5add39098e56cfc02e653d67f7d66f64d9c0b08abd00662f472bbb6147a2c0ca02714c001ea4b297364b1e538ef6b4d0d52aa72155a56d3b314dad6ae6bab5a11943423bcfebd12a539e41e8e5a1b1ac2c9a376bc71f10c29ed14536492b98e5cc63c52466aac01f9656959a8225b584876cef7df2a65d80631cc2a43f6322a040a1affc6441bd13691e134f7ce8c8a5107e0f424bd83b0fc3e5e0723d6d4db3c9e807ef657b80f92b59839ca6aac6f65bc35b6b4d9f6e028bc5007b15d944d945d2dbcabceae78b5829fa51786bcbc26f792a8048ebbad0cd17f04e1e93c733217a4c6bab67eb2a3c63fe119103e64154c376bce4b1d0ed34a3536bd7da2d7b8efd52947655a90db31594fc6add82d7b279d996f3da346ec079d992fbc963cd8d079a3ca6b9c996a670cd0c8968b7ba5daf525a296b29f7023fa759a5e60cc1eab6056ef15a4857b1c3281742b07af044491ed3bb93a3e7af4eb2a51bf2ad74463c65a993c7a456ab9193232328b06c6eb89db345e84559d319bf4dd9fb24112f54d6651b761d82160caa1c7de77c5618f9df7b581c6e62e30a6bda980af654f2980a6576b6872d16cc30e1614c9ecd38356565d29ac0c3c9635051573b61390fdf52a3578db6ba662a2a6accb54891e9a2b31eb83f4bac478fa2788bd865c963faf0e2cdbb576c2b322e3f78243fee652370e645a1cc05947db62cf6d1feeca7977ffdb9f6f653f5d9b7ff11729761ff287667680a59eb9bbdc27cc31b9ebd7ff556ec667aae6758a4d2d4a1a1b7a9b9ad8b228650a0d80bebb6acca7f6aa7e4b0fab3462d35e22029f1e48b0937c7d29f5d0b77764be4d9a330d3356259ce6805e1caddf19179590fe7a62ea7a379593b920184d9d998a11b5671dcf4c52f89b0a1a1b33676369d83203355991137da17dbfbcc05d47441cf853b6c72bc6e1a5db7d5a67b9b26437aa59a523d7f86bf36673fbd4c86f4d6d876de68f7e355396d8c35b396ce7e7a299b29198ae191246f2b55c33c1590c990de346a2aa64e1887b1a073c4ca92e495b16d1c04544d0800ea22e51579b5b19faad42362536fe760d95e9de1572661a1b5e5c8f152ad56584098852e6dc126d296905745115af2d8193ddb784f30659e81cfd8d4aa0aed18181b54aa809956078b5017199dcebc22f1e664ab6b041c555130f3aacacfb240f7c986d6d64b42484a37caa1fedc362a03f679cf9221b30294cc717b9095d24d3a74283a53b2e72a84340d140c426a9a29077f415de9c3a8012b088bc40c08015907c1e83dc00e4e6b288fbb65c9335d996b60a0085c0717567a09a2248ee7c2c5eb7a6f4c43b90432720452f31cb382914e37fc6fa281fd545d808c83310da4ed20f52fe726bc736188ee959718a1c164d36a1b75655f26bc457c213bdb823137173e241d1afa88b4b465706208c296392aae543dd5052f1aa89d875407720236a8075595ed0664afd406be13fbb1e6ba0e4a12613bd5f2b235d665cd9e0a4fb091f8d64164cbac8ad628e401f2e1d03f7d5e3687b974a621b508d7f6a3aa90b2117f6e01cde8dcffc94caf4bb6ec26706f649b735a83279cc026c73a7b6e8e988eb2d17213c0e6199d075402fbe9e544f38e09ed58303894908b04fbd3391c24e413af397260577a5ace365f9c38fb543263f09ff2b4ce12d053599e7e3e1ceacf7a7ad839469894378d7b3b9449c88104bccae3e676da942b676700dabad554b698aef6af16ca46260100b012a7b2762b0f8cd1dd30a9f1948d2cd0c5f03ef6d45388855b2af495aecc4a37bc4da76bdb9a6529392c3f69b73bb1d619fdcdac69cab4ab8c5951bb68cc7aee3248ec71601b0321c9f1d457e65227b9b72dcef1ea0736a74d43149ef32301ae6a529535b4d20da644408ee765c9aea70b38a4cbcba26c529a9ad526a5d6aca78b9456d7c89c258f3acf20766e204e2489a60283f214b078eda2593b9dd0e19f252f6582ebfe8b942ef506b2ab233e2c309eef95aad61aefd86039ad672623ce4e6214c5422dda2eae73d73449727e847e96c68718f78393501f1f876679b2839e7b51c79dbd52b72cafd552a78100203b704fc5b27fee3d0aff000dd04522c1a9889737dc9a264896019d3c4f6e6ef00f35aa9e6bfa3a8c45a9fcc074b208c9bb3bc8c09b9baf4bbc29eb69f70418e1616bdeaf56ba1100d97980390c4d67320142fb5553d6ed8c06af36bfb6d9dc0ce8ebdab776cde91ed9e8eb18a77b03d1c06d886c6eb276b9aa063468b56d07d40d8c9404821143d2758139e49d83ff20276e3362d6ad28b0dafb2f5c9f34d819daaf57b45cf1a861b2cba23fd9eef940a2e5eedf0f4f16de23a9d8418dc6e4cdeb608f54a53f8ff82f7e3af8024cdee6b2877af0dc8b9df0187ed67e6ebf04d87bb8b0f9b14d7763bdbafed2ea4212f9fd6d9a0e845b682982813d29618d7810eeebf5bb542fb0b416e54fc3a1cf7a43c440cfb85f2bd5c2f4b419bd95bf38341f8c4d44554c53e806e9df90395f357aaab50491c8c3629dd462d9f87da1eba9cee8d5ba6a4bcc304640ba586fc73a1d5ac2b8051b596c0440678bc26d2fe6a710c0ec100c1ea96b45c2b77e73898a9f80bd4dd3c526570d7442474371d515a153303f55c875e0a75dcf66e5e7f0523618e9cfadae2d0ce69dc8ef465bd968ef92cff90791e03b60a01e46be0736eb6a257637f775047238663d81cadc31c8b2d1856c46f71fc8b4eeed68a2a697ba2ee475f4b3e396ac2cb4ba1543c4f65ecd4d66d7cb01e1995fe9ecf1bf3288efb5eb998bfff471793c20861eb653d257fd66b5ae7c6c8dfd8acfadf3c9269b4046b159d91172dee164c3b68e57aff970287d0fbf0b6edef7deae8319a423f7cfaf9a77fd1ec6810dc2956a9c1ef7d87050f2117391d73392bf9a1bdf28198dc85b15ce6cea4c25d65e10ebf76d8aa6346bcbaab773ae421b8496c57db02e7003c28e46f795ddff3f1b2146e7bf5dc139cfb78ba0b20d5cda28162eeeeeda86ad09633bcc384b224be6526f52fadad9753061829912cc8d5f3f1a3efdddd5809bd2ddddd6cbe13707e7f4eb4778ef80c87f6033789341120750298866b9caa146cf61f326888b918f13c006f772f752af90480a964521462e7641a5a63cf59626babd46d5a4a27c3442dbf144cfcb9abec39a7e9fb3f4eadee9bac869a99a4bdd64c95fc0e7a2d44a4414bb914041c46d03e9f3b4db27750bb2b25c7793f0729b43f9718864aa9aa644721369e5b2760e4d229d6469381105643d945a5f0b73b82dccd1c0b0789dcb8cb64244ca9d09332c97f03b6d9e8627133d338d662f334f13ff74d5e8956a74d4ee4a5525ea0a1dc5fc53ac479e92fb1570c0c46a2af4b4823e6e17ba6cc85cd74eb0cf4d02654f7b8a1e73e588dda73f0bf03dc54e3e1df17ff649378d695c994db4745b18255f953352195758d0e1210d06e8f155a3db7553d36cd966270033db1b708505e8ee6bfd06fbc9577701baae8bc477abcb4a4a0d9223a1a20b9095966a4395a911f5944acc503e161681c710179f79a56cad678e942cd659b5c884729a928365b6255b561c6e1451fabcd950b3ae93c8c52e9acdb059d739351a29114bfa4a379d4a008b2cf5d270b6b45db0308575e83582639bc8f8a21fbc0522af1c67b6892b49b548a83a47ba9588c144533e8547a70be68e05c44491238257e9594bf9baf6cf5211422e8aa7685db3994445399b418e9134e41d66b304fa0ab514b9777d6d948975b410030cd4f4268fa9e99fba31e46a89916056f546549ac75810a66e4c6cd7d29b70c95aacc5e35368be2bddb89808e2a166b984e8e96a5891772cd8c79dbaf070646321afab1847bf222812cdf39cb3610f951df40a0e2491d3659707341c8684c5120932292f904a82ed1a02216227525ff4abd397aa2e67dab6c909b30ef2992cc12ce588c10cfd7b0995b66627e7a49c5f055fb19ceb8823b68450243026d304ca27295bba56b6db3aec21781bd22ed4d3dffd1ee233da3019bdf192ef730b2e4845d2f30a41c40097ce3cf633a0c9ba658fa036c9d686056b363e3349133d5550864acc0ae05734864d54ec0f67b51529030bfb5a59f01c2a80f2e170d5ac6b8d6db934578c995ea64984e5b56edc890094029535bca185deb8c71df1009fd5dabaae90f4ca87c39969a6da8347151307b332facbbda89c5d4fac86ea49c4aaf24a8a7fe69e68a2565c7cccdb5f4c24707b6dcb42c3d57053731cf467de1018a543159b003bd66d961ccb62515de8d2aa840a894c74c51c5baaf58fca7a2743919ab5ba0933f2f6a9b7d7019fcc6c860062ea8ab6f3176fdebe7bf3d7bf1d9ad92c67a15ae815c4623d2db5a5e51a1c10556a79f670115149a64ed514c8a07a2cbfd275619a28af9c06dfc649c7a4c396a1fb8cb5c2c461e4e7b467b596846ebe9f91ec31cf324e9f6115613be9e24b1b85554412b6091a99d94cdc332c65ea4bebbf2810c59b72cb34a6fe02d14c95952ec60cc14bd02709497ed5557e6473337ef274fcbb31a7e167284c1ed3cc1809f77bcc5d452a3241e3d188fbfba811b9098d492a498264fad1984b9b1cfb258ee5a6847b83938de5578ce2bce3e18e5962d747d102702349940f87fce890ffed045b9624b7740670744befc0bcb7c9ed7038e47f92db7cd5e86165549113d12d392b2916f25d82ebfec904ee6f6c3b040a39fa3be6de252a4b1b8cdbd00d516aeabaa9aa92bdab7ad2e2b6cbfdb8bccf200c3a18d307591fa21b1ac07e1c8c69303762010e521ac8deea3da7e1357dfde2e4f5c50fa72f4fd068a9dae9024d1e7348918d7ffa9846e3cd75fdd070921dde1a0c4f5d3d81af3463ef6b4765023aaa8969da81d43edfa5bb86599ae9e5fd19054a39c93378a0b393e7e8ed580ecfb21173cac8bd9368a04cbd330b73198e4f318041c36eb60b5d550f49ba14e2a6ac292fca2687bd62b5a4380461d89adee10e125fa025babe2a1b5323d78e4c408eb53a3b3f7a71026b0c3fdebc3fbf787efaceff7cf5e6f9fb97e1e5d94f2f2fcefff636fc7e7e747ef4ece8ec4424353af02180b33ca5c04c6e3f251c994070acbcd20ef60fa71de4f393576f5f1e9d77bf0126028bb61767e747e7efcf7249e8a865175888b681b22e2a21de9010f9b7d0b6056a5a3dd3c936f718c51918a9928df699cde88872b7c2bc5e925eda0e665525d235881ae9a94f475989afc581904eab60113248b23c43d9503b5dc00c402c0065285b4484790992c2b0369643980b9cdb42b12ff27826e9730c2a78dbe942bc39097866603e486e483b80edb91b5e656d315359af91ba11c3020db8a7d5ada5bcb7c9f28c8e6a9cae6ab87626cc20e10e66e65d62bf5e930d02a95b3443c47225f6bbe4f45afe753f7593a7ddc3901acb11f743cd59556509a8db192961a745fe90182a7f81b062e58535bcc6af9c2eb55e590803d81035a6e4a431b099958d6d2352798ded7b0f59a75da92a27aba7065aaa8499b45cad916055c4b6b65d2fbdc7ef6cafdf22afe8ab71762888a96a5565e6b487d33765ab2f5c3974ce56a97b94521ea5342f9cd6710d3af3cd03b4eee495a845c76065bd95d1059d87c359a3e6101e164f827dd31556e4bcb830b9ab4d042d64ff84711d3e89f7e0b036517c24250583bbdb360e885d28396b850deab3893ea989768d59636980568e52294c2e9b1bb064d8a33268345e708301d107b2cd2cd8f091957bcf7a775694b3db0b48e3ce982f2d8b66d6b2bfc0790b89ef62f2b32e9bc79cabaea3301209df25599625fc17f8d58fd587d97333237fda53e3a94d09357096a66d530da7903dd895c987833f8cffeddfc74fffeda337666419838516b58856959e381ed8b3fb633a603ed9b3fbdeb94ee9a98793d2ef298401523a80d9e60b408fc4e6ec960fea6e5b8061b75e2bf8809ee1d9f77320b3b03d21206af65c2610cdcb4928fcef054b4cd3c51644529cd6d346631fa8a02910ad3e8fdd47aff04c5360abcf4ab89012a69ec52c07ddc484ecf1bda285b20bbf29bb958a76aa5479da04b63ea9d90c152c91f0163feb9e034cc6efcedea68c310cd4378dbced6006633259966c337bcf3634711afa5fdbb529e590bc55d8b441baf8a2a2a4138af746e4ac02681abc709901aca56b65fd1685241001d09a3e65530ac22df5d552364dbccac606d93e36c4078f1f1424a7b310a20be4accdb6ddfe3f2a205830048481d91370d893a7616b4adc4a8817620d54292bc113843a24b00099d04d9f5455f15c121f78c8a3b7b6af0e3a66c8a88bdca9ea5a6d10b959227e6c6303b8dfc76d433e09a1d893f2e700dc29322e324943fd1e38a9d2f51c91d44e2b7a3410ced5b6ed247d69dd39072f0c14cdf4354dd6d56550bd9fd6ba419860a59b242c0516ee0b8a97cb3dbb22cba8b832a56d4d8e77f90afca82e984c1750e7b186cf384fd368ac3f1672e5e3311eb7fe269363e94031129612782e9af24af426da32a2949ffdf8e62f149fedcd5370007684c42eab0d2ab4204e0d721e368f4ef55bdaf3e4ffc37ed695c582ea123d0ad5a8e20a3bf4277e03f5d698631f4efa39e3d8d9696f1b33a9f412827e8d44cf02a6a5f043ec6f435c4c5149cec921ce9528218337da4a2eddf7023b48c734221fff9d701441323f4b6dad9acb9507ee4589131d88b6500e446037c9d1c9e1a5dee0271621f7f2ced9ab7e97c0b9c1e4adecc3a09f12a11186f507f5c596fae44a5ea12f905c10d1c584755463ec36fcc05e966cd3f8a5de65e64346f2d4139f0550fef0a7afdd527c8d089704e6c3a15b909c55b388f475ed831e08a7fb90b79f255b4adc8e578031478123b0e540be5b994304946591e0d3e53ed4ac9c8b67d74b464ad4a16b9952d77f98037114d5962d87f88e4f99a473f86ab878829903513266a2ec5e44c4cd4c3c1b895a6096bae83f6372d90bb74683313ded3f17aa23cee1631f6e6506637a220f84a07630a60379c41c69fbd191f057077f300e87eebb1005fe37708c82f776a1567afb35f32bdeb69b1d2fa1cbca7683f78cec7603617dbcef05e8685de367ef908f9c789fe10699313132ccb3639a6bb3d46db3718b8f20e1658d825cfba9da6a710fc140542c4478b71567810839ad5164130ec4c8aae7a8b1e0178758726cc8eec9462d656776cf7896396b1bbb9db011368bf43f1f52d48a8d35a1037414b642943713b15cb69c1011b032f55474562a577494285e4ee3ad98f2216cd426396b3468bcd49fb30172496d5aecea53b149bb911a6d4d05c7c5cb8cbdb9f1a32142ec2ee748c3e16a400b6736b07e763fdd32387989b9b222e56004482b1412187827d1da45e00c5c6b92d1b1176c41eec4c28c13eb05e5b29bf22ebe2e0be1f64b02b52e6b106577248f9ad15f64a349b5175c74ae17b36da19b46d2abc1ec9000f2aa5cc991060948b8c105bda4d0d31265505dc0595f95fa5a175f94824edef0f9ec5f62fe49bee98b66a04cdb6dc43e3b27bea87fec3dc260b98cd92594c4cf38196e45dc4956694c47fe8130216e80b0e38468e8efb048c8ab27f718ab2dfdf825518786dc17c04f5da71d2fdc1ec0dd1489bf92c021c75594fc2c42261206d81a17616b442f440ac9bd16fc488e347012e29ba7fc088c7be1b0f7b74ff07358d041b2bc2ceb1d62a52aebf610054f5e82f003007c407af87479a16d39e7c308ac8f768b10f1e96070447997a4b4f4a44b5fbb3c4f19abd5e4969a75a5e93698c5b7dcd0a72e247bd1ffeb36b9a5bc364321315b30742b99a85b6f30efb413b625831c7610abde85ce44fcb95164651f1acbbf8fc7f0bc862950ce0b3bc49a0d61ebf18e8c21f8c69180602b0bf5e9e20d72b5c0dc4462cc812ed6abaac4699f219691c7f8cf4046e708eaba8600d1c5106cbf0bde972d3c8681638acd952e60831780218644b73ab1c988b10b9f18423acf1dc77392f9526f00c4e3c647c27501574f97f3daaf47075f5e6021221ea8893bcad98db2096333d85a2dcb7a3eb4eda6d2bf105b4e91a2ea4373bd047705796b5a1a3932d16da7db243983162edb529c0b1f08f0711d6cc6a1b76ef2947436cfee3d3f8cf13c34b359dadf04878277fedf20c383f8483c7128c68668b5c6892cab2fb83c395ba90d54fd455978314ad1231f7addea0545c457395c4c95d5a923a5a73bc8f656d9a9aa8e7160f697a1315b57150bcc20cda97bf45f80c581933a7bb0399e7abe41c4a2ace30046279c5f33b652af6a1377b437e5f80bfeadab22653f22a5cfcb6a978d22babc5dfbb020f81b2e08c0ba25e9c24759920cbd33b41ab622c9f4acfc7cd84eaa0b71e9fbef5dc5720ef55aae22b1c8591b0ce2007057a9c076d98050be6a13f22686e5f9595fa8813e1c5993d407b652463970595bdd5ca8e9d4acebd6e6a85f31986e8e436f47e1313200bd962e928eca918448d5e2c40315bf89fa80718f5e6bfb558829212a6ba3a5b887a99b68d8b017791613d6318750768ba8f2ce53b588042fe226c29440c37aa2311bd82d3e48689b134041173782d9463848cc43171377211f92447198056378b4cb7abeae54930b28592691c1e8e35bdc2f4e4e7b6b9267f4ce2d68186e55ad1b55c131ca47207ea0bd0c0e71ee5333879fdacde1a7b5aa5bb811aa289a43fc4b5b8b301d5f2a61091d6c4a16f59b4a2244f9459e761bb893cf6e4219065fab0b7bb9bef8d46e22763abb5cff24c3f935e49c96aa4abbb487677f7a9fe394ae0cda9563f9cc206a8cf904939350f9d99fde77f0181a5f99d0037a74fc329795e2ade16f0e8cda202206b7a6528d9f6e7e34ad7239f32be202e50408923b1ee2484d8a1b08521c677fc023f265b57ce677381469b972be008ea4ba7c5f38d9e0c398e19c083481dce9914818c820c17fa51b5575e93a2f983adb0a47556a09bbc4a52c11c0aea005266af4e230fa5baaf622bb330ffacc39c8a2cac2cef348e5f7832711dc872a3c4cd5aeb876c20d81474b1c45c7331909cfc0da63ccea7fb7d3d55e777dcc37df1cfc7e7f641786817854d0839f7db164a3ace75b23af2430bb3538aeb2390c63523101f287de64b7b602731c1625dfbfd90b52f430920e00ce1b89e33a834295d5e6c2aaca0dbb3475bb080f3ea638510d0dc00056edbd2a92dc8192d5904a43249b2b9f0a12ab8a0dd36e41d22896be5516841ca6875a5ad2cb55bbc9e8c45f7ce1fa27fd601ec2a990ebe63adc7269d39ead890c31f69063a242497a271f0ed5aaccbb4c6f3704a2a6987a9e22aa3b5d24e2b58483bb003fa61c2c1440e30780cb9f809d52deacdad0a259b5dde8cdaa45131f0146b2c45fd2899064d9da0453ca9f3f8b23db1c4e8518f05a6b8bb84c506794cbd533a08dc8f351e0be11af3d2f3d1323e9bd2b2aa973e9c82c576ef075caa26cba603b70a8aa080bd1ab7a8ee2d6860f2c4ba60d62e7871db9b9a86f973bf0a589ce9b8beef309b117b9eea5cf45860fc01dc5a56749970bc27bc99535eb3af3724406ff725a96d3da8e8d6028f7c2763882704fee72035790e3bc712fbb7d3217f733dbb235489a247f91611aff70930b639ae89299c8cfebcf5b597f032f34a64b313830b133ef992c004cba53cdfe1d98ce050a3b9b20e74884ed30f28da594d4b20dca810d1b668594031f606c7533c3cd5152feaadba38ab7c40bddbe55732d7fe23f4705163c7fbfb2ba69f1d7b37575191ef2398e94f2b7bc12cc66f973be2a3ac7f0cf9fed400008871b8b5dd21e4bb20ed1139f500bc71db0f1bcfc945b15d350898f56b03b5eebebedc1f68ac93ee3f183bad4f730812d2054bc98a94b4e340b79fcd1872d346139ae516d88938376270abb46da9344529665fb3962af36905caada84eaa471a2c99f949b9595af60b1c88c4c36248e923f9ac1965418a7d4b0b317a60a09af88e3fa65067dd419f1180cd38de35532a3decbfd1c8a0d68e5e79f5d1eb09643f9c2c3a0a0a2b651b5956338b01e8a499e06d7a9ff7a26ac8d65b0cef6824cf517688a1d196fa7dc1159ae3aca774ca8d760af23454aede77dde0745921f9b7a56ced9946ef291fc7c51d6f2c49de30566f9e342992ca6031b712c879d705ef30e495d6e18d499604fe060daca704200161908936c1326869a31496b1362197d198081e6cd6a4ab9bb0d28e7f5f611fc0e0c0edea0e2cda6bcb8ae714784525b70a210a7f36e75088fc3bd5561d38dfd79abde18e34388c26c278ff45a666e2da8d7fede2ef90d2b66697b23215924c5dcfdbe631abcd42d48dd687aa6e99d995e0eeef61329f41a1f420d67f182f6e7db0503ce3bfa5b7f1fbec8ec6db5af28871638ffbc376d3fa734abf773bebf4d3781edf3599def64f8edd513967bb7ae4f6b0fb0980850ec00ef47df1375ec51039c2493635de2346b5f2561e5fd564096dad7ad48fd2f2e1102d2a8de00d4086f48278483bac34a28a671cddd79364b7559899fcea3e0a0f28e56aa4e38460331b252753995f34b7383e79e777ac45dd753fce52b63b196f86f77780f967439a38b14ff1f41392a8ad3faca94536d0120a5d2fddaff165de957878cb3bfd1880447bc93db83f0ef2f403d6df5d2436ef5723fd9027227ccf5daed1f90bfdbee8403912c02c2b1bf7b94c32ae0825c5d385223af6c91f353575e963b2e8f59ccddf29f39330537c1a512e3f0c3e114867c16006eb88b17e5cf4b058f203bf3ed200ba9704f65561894adfd18c63f4c29c626e3128bb18cba623c53553cb1d66ca1838933f488356569210567759e11cf0567d2d2ae235b8cc8161489d41c0ba922045c1b71bcc2f12d000237cdca799604738ee5b2b39b9c92e16dfef6cdd9398de49d17da9161a2424978748b8ef8da3d4ae4651149e524ce5040bb77674e9c55002de26eaac04e29d8764afd049cbe97f6b8351555de4e0b34daae4c6d7537545081a649fcdd176e0c3e278021ebf57202b761260361135fdbb42b420c73e3ebd554dd5d19e47912de41782895b0ccce09afba7705dcbac2d1911c0f574a743def87083aa0db1102b382cfeb66177ce6c1b8bba83f854b8d890ec668df5df23da66f0e0ed2cedd3f3a1e3d3f1ed0dd5634c00de0683648779452d080817d183c197cdc1a8c4f020f762aa7f41e2a0f8cec16e3cb233f1d7cdcf6f8e592845637bee2ad5364c1c0ec6b06b66794372d85b1c424449974f69d0b8d7fefe046f6a41213485ee0606de2627fd8fc90af1e6888aeca035c13ced1b9c21fc4ab8b32f29da43538497f5af3b56c29e5a7af615a225d831a2aaad74bdd9453289ea42d973eba0afbe6e5e99ffcf19053f777f71d99903fe0b767fca908094fcd4d525a5c24075dc40961c1777c48bf0129bc4a713376dae3dcb4aa1afb0524d7501abcc0c9b52707d9812cf1b3b2aaca7a7e8c0838eeca8fdb9ed6a8ff097ed8cdddcde0fdd91138e058d5aa5083bb3e903388e1f1d680a78cfe987ee3e67197267789683e8bcbbc5a55bd33d7b6a7d41c5bf8a9399d7690d253ec92412a6b28eaecdc9f83e96cd8600ffb23377e2595e55a43041c71eb27420b12f491bac22edd295ce79927ffcefdf57d8e53aedcd1d48113dcbafa261717df99d5f7e12eb820322837ab3c0db576650d4775ceeeeadc39a815ffa8e44779c9ff2d714627e7030f9c721603bb13d37959e7522fb85caa2e149e12f3a034841df5ee8763fae69b6ffe3da3f752b514b35e27db02bf2785d1b800207cf647ca98711d9e2f07e1b3deb8789406bbafcbf6abfdc78963930b0ef9379b8b8bb23e7c7f76943a6efa0df3c2c5c5bcd5874f0e7ee31bb36ebfe01b160fb10106ddb23b1fc3cb10a98a954b1015b2eb60139b4b6e3c68f32dae4829bff1d59266958afebbcbb3b0534bcb8a9d4c9df8906bba7d22c5970053fef2cd5f4edeed3988fb84bd4eee1103def7419c331738638998b30acd3bb6f4ecb75b40a6d4de677aafd31e200a2a2b80e352255daec41b0bccf6fdef40c19987aba1ed54cee09633c9ee4992651852d18cfde1d6eaa6435ecf3c4beef15ac44271b1aa7bf3696d5ab92a2a3e4394523e608003de587e1d78e78513441fb8c547b44896165508789de794f38b9c72bc228ed8bb5d141b3e1e0bb0bb98364c206085aa879acfc4e3ba22848b19592b95f300058a89b2b9176fe332b6ee0c1db3624f93bd01099f6df65c61336ec0813954e826f0cb9ff406c7dd576a5ed6dde18dbee2f4311a0fd549cee9bab1a6c1e5db738d6fb608642f4753dcef58b7fbde2b22c52d31055f5b2ccc30d9249e533db739d89e5df9d00a3aa762987739432ec75f85ca63fc259176e5819456f200def456f0d81a9d4c2bbeae40e42990096b15c5286107ce6656b772365eaf64a16cab3634c3f8c0ba2b064780532325521827e216e5acc5104bc631e9d0322bf569cda9e0c890ede121f4281b6f3e7b06c2b0debe45237f4c7f6d63070322c9d3b6e3a6009d3b497cd95715f99d838307a5153b058f98662434ab11b466a4916464cb423004959250a2e4472aeb208c62491f71ec49d33c53c55b482adb192942ab31aea34930d24df255a7e5c117c7c2857df75518b6afea3dc33e61b37828702ec4f0ad4b97d8ccd866796d6abdcf57e2f49ce8701f0e3c5fdc7923b7b9ed79a45c9f0eb1e8269d49a3d5257792591d46ed42228b370d679cf21e32123b668183b7c32762b432ba279fd5b495267e7785e289389ecaea7c8d524f6cd7248260db72a9c20d4c8db90e2bdfc91b2d8da2d3fafe2008f429ae3299da588ea64e28b2380d9233110cd0704bdd653b0d2fecd2cb6d39d5050f7247cf7cdb080399e4f6fa94f23fba5687bff1f2eaf07707e12068e2caf0f0ca9f64e465ceb142171ebe6cbe46db75d55ab7a1fc82ed181b5f16984aeda3a79c6c4368d3e4be366513237753cdd36e62c0c80fc45647a797bd2b6c496d61cb0d45ca4bdc633be287e5cfb7637ed2368f32258b3284d5cf7e7a196ad0905cf3178385251c5359e07b01b35237ac7571302ee1b20ac80bfe2454b78a226e9c54e9e22f9c88404899832ad7ae950b32775119f867d7fca65ffd8d3a3ca80e55273110b0033edf90721d05c3af6dc907c6739853c2f91d8fe363074cf440ec282c6366bb22315992f3a1214f436487642b448fdca5f2fe0143cfcf7e7ae902d5e171c8bf7477377faa3e6fa966770b09e2124e4dc4e9a3243fe5e99ffdf4b24b58c90f97a7921f67ac1ef1c3e1f24e4f4adce0e6a7ede36366169f9272bfca264abfa64ea0c8147e304d647820e67e54d3baf6a9589881fc1656b0a53ee1be0d19db283ed78b13cb51ad16c7901afe0a88943275e56320c626e8920833fb613035d3cbc6a8e962f05142f35b0b7513051a5c029089c3190ef04ed33e1c3ef73bd9f5eb9b4d5e6b23345795d3961363290a80e4131bfc1e91048ec9f87de7ba75df59856adf320a3c44afdf9d24f339cb5d58d89438e87756fe534748780dc2832dd7555b26d80bdeae41c03274cb3b506eed5d75c2b3ee7d895ba8b0f96069704ea2bcd222e8b75126b9e9ae2b100e5ac8b7c0c6c61d689fd650653811aaca5a3ea2109bddee64158c64be95a976e67412f610ef1c1b0b9690720d22704c39be7bf9feedcbd3637ce6125fce745fbcec0c7e29cb90308fddb912ec653397ab7ae391e7d270c03f7ef3fa8797a7c7e79465193d7f138fb0c31fe13ff35727ef5e081236781d1cf2c5dc3054923b5b2d7b531f0b3ad8817d49e9890ab2f361685f73e695eb464c86fe7cb0a77c2a1c87fbddacbbad000de6d7cd33d3f66c9c39d0f1b3ac8b635a3636b1bf794a122440214ab701fc64396b13e20921f11049657f0f9ab99653bb92d6cafda739203ef1016009a1445807e1f19f4edb7c752fb52284db9d5eb129fdeee0603ff96a4786a5cb0c5b1fb2779feef97eb4f6150a4c1eb82c2bf908486eeafbc6499c27df8aeedc5beb9499776b3c48c19da3b15cb88079756fd48c9e192c36a268727051358dda441e61ea1347a6d6bd4c716492c5abd3a584918b2ea72e6f190cdf2df92bda6d4b1ee63eb32ea6d25b44d0552579099b88dedc02f2f6fd394aa1562ebe172e0b13e3acc744fe24d5069966c840f8db4aa24748471e403bf3299e1c621fc943493f35fe63130fa9190e00385dc355281e4bc750df45f2d07e0f4fbd9d2e20e7797b51cebfd9d750f41f676f5e274bddcce1442377bff70161bdfff5cdbfffbefbfe0f7ff12b2b753be36f5fe32b79a3663645a3fd7ddc546a8aee54711e5d0e8cd5cedea9eb57eeac246e93d9b0e7cf7c9040d0f7ee4a89a9e8d0811892c44ec7777910959d2d22aacf5d1b0d26e785719af25b09753a99a524ac023190c385cdbb00a6af878e358aa0d5454cbdfe35333f6e57bc8b850e6e703fbaf91fd66c47add83ce66b115ae2459074413f95156711836472e4e97fe5618bdc3712e21ad3d68bbdc1c1603fa5412f28baab196632d80f11f66d91c6bcd777b6ffeda9e7b7fbc24b455bf4edd1f9f18f9d60e9b36c948c6cd5a5975831972a4b13536c64ef9e99592bd93d14c0e1eb5143f7f350727e17aacd0914742acb3d0dac2685a00b97798ed0144661ce16c1e06f1d11212a8c9448f48a4fe8709cdccc823d91bd36d77bd87f08bd8be352949d56ed02f0515c95eec7556534318f1adec88bec1516210fd7f9fb28eae38b68ea69c253d2c53d1fa8da64d427993bbb71889a637b28d7865ca83655eba26c2f2a33c7b76dae74d3846b9205af2e480965ee42767eab49961ab1bfb05421281b453da579b87db2db4b51020c2c2fc751716f047fabffbc5c6a943f3e46bb8c7f41a8f286959f7bb9ff986cbeef03ee5bdbb1bb422764ef8e288f40b22c08f599d0a71c044345a99b8cff2cdeb669c4f627000763cd0b73d4b339b7acd335c1b69209f7b3e73dcb5a55706e37604b2e8d7ba13bc597fac87d0739aebdeb9ee2487117ff716a221a9e43a9adbffe0c557fa72c66b5c3bbf0973df91c152fa8c49ea45ecfb6a6d15f5253fb394d2bad9a78dea9883decca03879bbbe527be41c14b48485e61791e73b7fe8eb565ee43b5b07e7b13f65e9897145e12dbf41e0827df19eb70f6f7818deeec906de3048759b6175bd5dd6de76ee420f7b712b6381fe3b3b5fd4571277eef703b73f333a95106f2f37951c871a430ec3655249efbe2a43315ff28df6ff05288936d6e117ac5275e01e0e21ed3e86d45900445d0718284bfc0501e83c822bcc7f11265f3976ce45b88f93d2d418fc45b94ea1ed7e6d4aca6193d5421ddfdee22683e20209024d4c4159f04c1ea09997842ba02a2b2f122017e56554efd653047313b7d290e015bf33eff45692476f4fca8d1a9a39e18ee0e7fed4af531bc9f53460feb9d4e4988ee0b3aa2db0b7ccdac7c482d3eb7cdd333740b81135d3cebffc141dbe190e73e9402a1210c80db70f9fa85fb794f6bdf4ab4837a30c41cf330e4e7cfc2004b8918d989d264d343c9fd044a6ada9aa683b31397c9a687cb839d7b4830cbc4a5242181d47be803800e947fda2f2fe9eec9809ead4d9bd1db68eb46e7e46cb2e3caa3ddca36426fe93fb876cf8a6a9b753df55f39029aab46ae9f107b2ebad7c15f3b19dd3fb5e40f8c46cf7d00905179727040b5aa7b0dba0f914af8cad125b2cafd170cf223bcc943a0d61b98d1de4c7750160461e5e76b4f45bd97884f24beba945dc56db7b06f574078a19804fba36ce393421e133e50996f7d11c98b4889667096872ddafcafc3a35539747d165a719eb76fd8ccdfbd3d9671fd78f9679c6a1a42f1b097adb01e1e01148a06650615c424a3c32fc454f6fcd75bf8032d08ae00744ae6126a6cda7ecefe0c01b20734ffa437fbd9de63fce97272bf329771028f151b08c91939790870997cb706455112023e0f02d4bb80eccfe10c0b6266651d083731054abe297fdbc06ad77b3d6b66f945ab612935d23bed955dc642afeed1b3d0434603b486d35f7262db31b0bb4cc71919ac75c031d145beeccff6be69841dd4bf303f40d5fc595b7fea12d8f187b8fd99379c802febf9e1cdcde0e626bbbb1bdcdde5c97977f78d1f06908ac87ec4ef8cfeecbf5f14721ff1477685813bbc0d2d4de1bfc90908fe2457f78d285f02ae9a397f7459ceaa6afe4416fb3dc8812120f60fbf8d43f4716b4efe48150e79e111a7510de1dc155d83848205e8e23f0092253b0e697de95625f9da427c92a5eda88751b9f642cb113b3e1b0096eaa58c1229c4c1f30e9ce8f976d15dfaf1e53b01327aad96bdaf32f8eb2510fbb93265418ac2652169c2bea892db7fdd69eed7aa73a41a2d8b6eba77aa6a9f2027d15d5dc4079665783eb82793b4e956560cf87e5e566eae918c5ae2a83bdf988173795ba0c3552938f76dfc6a798e0aece66e41905c53b41170ad0b9b29fe6be57cf5fc39d68969855fee63567d3b25b90de7de896e3179cb3df39b9bf062c08ff125355c839fdcbae3eef8beea7697eecd761faed5bdd7de3dbdd7d6bc34d7fa3e42fef9fdf6ee139bf7dbbbe70fc03f564bcd376b3c3450d7e08111bf0461abc17d0867b870e381ceddbbad7e49b24396fa4f38daa45befb16430c11eb80093cf74823ff83b69fe528e1ff832050cebefee2e3c68304dc72ac96dce7273707797dddc0c9cf404e2747333d831d2803f21769bdce60edc039dc3cb5f0aa2bba5e16160519b5f0cf6fe8a3f0cfe7edb7f61986db6f8d230db6dff85612206fad20851b3ff2152b14ebdf81f22d8bf3cd8bf4eb6ff8721f67ce49d32fabb00fafb80fe3ef8fb601fb25620ec1eb8ebfc0514fcbb010d182423040142af71176132a4579b33e4905f211bba7527ee99cbf74addb1f7653a43daddcddb880e86f1e4326ed0e2385b17797cf78b64faa90d340d6e1c6ed80d31590e5372de4a8eae0c7b17b30a72b5a987b65575a19aa20778eff59bd7fe1a3b02fa052c25f7f9fafdc8c6f3b69ff7009946cf9fd12bdd2abe33975ecae5c6b7f4fc19c848e79b95c67f5f7bbce936789e7fd21bbce26c77b8a31c4f8e9da1f352c77104f61a6ec594a6a2a8fa1a7ad73fc9ad64e3896e378cce66fb5fb7b4b9a54d721bb2de3fd3b44e6e5db1dccf835c5afa050d6b26e29f7583fbdfe8c712bede06dff97ca72badac9627fce5c8478f086e56534ed6ad696c32a40faad29ffff1f4c9d32efd372fdbc57a924dcd72e45feed39048d1a484bdafea4bf621fc4b66227c247eea41c3bac2c564b849a6d1dd73d3d8317d887fee1cd52e5555a1ec6a34d7f568dea8d5c28ee25efbc9ff1d00a12fab515e9c0000",
+		"4e8a4856b79d3f5cb4de8bf9aa02b5a4": "1f8b08000000000000ffb4576f73d338137f1d7f8ac5c37492675c37f4e1c54d207707851e30b494a47037c3301dd55ebb02593292dc36087ff79b959c3449d33f77c3f1066777b5fbfbed6a7755e7722cb8448859cd4f4ab44c88b454a9ad6a11b76db4b3037fa07d26847369d1c86cda1405bf6c5be0061890c47225c12a28d10203237886a00ad098299df7cd000aad2a702e3d66a7020f59856d0b96be814bb06748ba17ccb25366e6eabcfb49e17f9f3655c5f48c7080e0c69277e7d2a9d54d66c3016f77cc4ab351f1024da679ed812ec82c5b0532674ce602f57fcae55996616d01be1825bde048abbcc97059c234ab00a0662502fdfbd6a09ed1079796fe838209135400107b3b8ddf1a341673e8e758b04658433c8683f89a4fc3bfe35d3e65539da2bea26e881eeb20ad44d85d0fa1748e7a05b6b19acb723d44a6aa8a81c19a6946b8893f4856a1a1b0944aa3b4854c89a69226811c4d8632274fbc805a63c12f3187d3196c2780699992e9a3643b5362771551d668a3f4dd88245eda93ceb883506b3ce7aa319e790258d5760685d25e59706d6ca7312830b306bee2cca01772c9fc856332075e4aa5317859c3a61a69ef91ad0b6ecf80755412c04b96592a70384f68e685aa98cdcec803090b2e2cea04d0585e318b74e4ea7be954c7d7b7651c39b70dbc8070cb5fcb42a55355d81728d0ce7b6a4e80cb4c34399ee45e99773c4e95120b067167b312d0a8c2c2fcd0e90c0c5a4ba89ddb1835ddf3f7e0002d4b43db059428f3b6f55f9ac912e161c151e4301a2f83df5339ee93dcac81772ed8a76fa6ef0ebd45d7b381c5423d7dfff680d53597653abd606589fa7856536f078a714833287975e41adee4c66827274f55fd2bf812ab1a94f433c7b9a9bf09e68de2b2e395eefb40ef6a037102f16a12a82ad326cbd018d81d0ec1a9d32f98d9964622abf911cbbeb2b21b4ae9112b319fa0a141e168428d3f7d762ead548e62d5726d548638fb8c8b46233cbe2bceabe3e3a3975a2bbd76ecf13f3936518d450d3bcea5feb32bd2a712ed67f27b666d0db1730f5383fa1cf5343b43b218edec5c095f2963dbd6395e8044984b8f68c6fc326cdbd19525c9c8d2a7752de86fd4c2e3e1d67c908e778731fcb5fdace6db1f0cea5163503fdafd7f448bb16336c1ecbc6d372ed1fe85c79e4ed0d44a1afc5373dfae1afed7c9fd544fa036de50137b9dfade330370512fb39774d9b9e49633c1bfe39e92162f6d5f0f22e8067e02a835596964f96b69fb3a095b234e6038887abcf0060fc620b9801f3ffc9882a730a4003d8db6d1d217a39fd9cb042e12d07499d39ca9d5aabdd4fa39cb3b748ba351af8da2de3c61378221659cc0ee8d88c8009e8e7f2eacb0ad084ebaaf74f5918906fbb197c68328ea759d3d1ac3d626d76bcd11dad3b50b061dcf2027aac1dfe0c932bf9f46e78eb13d6793be0ef33828f2509100f4b9528260c66b633d4ec234bf569b9f8add375cb49cbc8f4cf09c59ec1a21549af0ad3ec1e204360faf095acdf11c0f989cad24fd16dca8f52a340fe82401f59520e9f4c3e46dfa9e16447ff0290e1b39fefc84d4e4356ce4d17823ff3d521ea81cfb2bf7cd9f8907149617dd4e7f708b8743da115b5bf7b07ce91f0af7339dbf0b88c54dc9b94f5117a9f38ddfeb367e02f4bedaeb1e3056592626eac22ce641f03cc15af961490b6a7d5afa22ad26cebb8b07c9624224e09b77de694920de6576ede2de7103aef1a06d4957606b657bd28f29ff8ea3250cf4e7cc68fed649e0d8b30dbf46cbdc0f1729192da5a78d7abd0b5a05f450b802e6e3ad5d4d9af18b30d793ba9ed50d2bc8bb27e437e67053d387b8b7656f09e4ada91b753beadfa7b18d6ec9561b3987326fdbe8ef01007835f611ea0e0000",
+		"4ebad8bb895a442e67e9787435affade": "1f8b08000000000000ff548e3b8b84301485fbfc8a839a46d628960bdbec5a6f6527161133838c66c417c8e5fef7213e409b3c38f77ee7137128883068fb34085e66fd42b0e87636f8fe8102b30040847e68ecf48027fd284d166f1b05f33d2c645e42fa2ede19c7c54c04636b871393ae5a032295e949577a34ffba33cc8a48e52edabfa08b567393ca7effdeeddcd9d1e12eed0050c82849eb1290519a8cfbe921688e7de5e0e7fbeccfd77e73b869b20863f11900e141b80b1d010000",
+		"526ba265c6b78316fb838fcc1236c2ec": "1f8b08000000000000ffc4595f73dc36927f263f457b2a7691364de5aaaeee418e1e224bcee9ce91124949b6f6250512cd112c0e4001a086b3e3f9ee5b0d801c0e4792ffc4bb9b877848367edde8fef51f400d2b6fd91c61bdce3953bff8a773b6c0cd268ec5a251da421247b352498b9d9dc5d18c33cb0a66f0c0dcd5f48c5a2b6de857b570021aab1a4b3b8be3683617f6a62df2522d0e3e2c94d04ad2b26e16a7717c7000c7ada8f999ac140803ad410e5601c74a48047b83c09aa61625b3424928481684ac54064c7210f2039616ee59dda20121ad827bc1dc322fda6855a231796c570d8e5419abdbd2c23a8e01007a2b4e9845e0f43f63b5907350152c6fb0d7bb64061ad495d20be45089ba4632018a15bcfe1b946ad1881aa1aad93c8eb67800012d8ea3830378cf2c1afb562d16c27e235d3b90635dce88f37651a0fe96db0a887baaec853cfbe51b2af278f0a0a20bf36d155d98a9a2cb565ab1c0dfbf99ef468083a64dec99f95ecdafee6aa85a5926a5ed20e45afed6ff9b81b9abc39a348eef9986a467eea9d6e7cabe53ade4e0f2d0535663a93407a92c54f42d8ec6827004d5c2e6a7245f25b3207cae2cb8efb3d4fbe054ebdf242b6abc563f336d6e58fd7f5717e763351f8c92d0b055ad18875269dd36d659f6c8d289e28796cfd2212b09a4a138bf63a2c69dfdb5ee3d544cd4268ea6821335bce8e51dc2687b67d2a0b6fbf0c2bd1fc1ef08eec307f929fc09d6f890f5dcbd1fc1ef08eec307f929fc31e3bf30cd16668c5d300e8d7fdb30430555c838da919e2818ad183484089c1c83c60a35ca125d610e953f8e4e8e61fbdf4baae8f9c9b1f7eb8f4db32db53bcbb7053c8e76a45e0e3f439954f3396a9710aef2db1b666129ea1a0a0421efd52d7228b0521a013b2c5b4bf5dadcd5711496fa940a0695568dc034da564b5a409da235a881950e4048607df265b0d4c25a94b46f122c35324b6a57aef97846f9c7960b0ba5aadb85349953e916f817c034425923d3148b0a84a55627450d4a075b0c54ac3618475b4b1faa032924cc09f8529081ba8542a93aa55eeabae95bd5ac8081d165dfe35c5764c0d158217d1bf55f62f2875b9070e3e45057acc4f52673eb472fd2c0af751c71637f87c323081d3e3f935c682c6dd2bff89d9af1454598691a4746979f276f749912b34505cf4849fe96c91f39d7490aeb388abc9fbc1d263fc765322b69afd4f24923b9b4958c738dc650c99aa571b4f17864437ebd6a3049e1d91138f4f0f80834179563ad052acf064a265d292d28a88d40dea3574a83a0fd7dff0604fce0b1cfdbc53b81354fd237205ebd72f65724e40cf19f441a4764db3361fe8e5a5d54bf498eba5e093977a655f959efff24f52e70be1f96e75768938a5036de94b00d29ea7813c73eba8f8077e368a78e42b01e103a383a72f4fcf87108db0962737ad7b23ae9b2e125610f7124e08b2ae9d274c772b2e5e0007e427ba9966f552b6da0bc9bd2a41f2554055a2d0d35570696a207ade9f3b39f4f4f8efd9e46500ff74a0740036cdf312111d2663ec0e968a363980c4e8e474b3398cd7adbbfccf005b3e54d6ffbf2065deeb3d66006acaefd3e8722808bc6aeb2d166fbc99a72f986495e23f0c2effbe94df3027e6d51af50ef3b200b76f44f4ccf0de479be4382a98fb8601457a2ed89fff94ee98417f98916f7a8c9bf14de882693c323985d9dbe3f7d7b0da533f1650aef2e2f7e8619bc820094ffda2a8bc9605bea52d31bf6ec08663352ead08ee0120b21791256bae9e7d50cfef8dfd3cb5398bd726b5cfe114228f7cf3c6509c3bf2137b99521534be9f6f27d1c69b5a45fbcc89dc79c5fdd98352cf12ecaf33c8d23d49aa4b55ae6572593c98b525a6f3bea1db5811dafffcb79712727cbe0da40a85363c58259fc32626158c5a1d26ae1d85232cb6a3507639915c68ad2a5d0984719c91199b063a59da28b5d61b86106a4da81d34ec25b40072089f7d42e25ab57ff40ee9939ddcf9733f4ebe977479014a0b0209f1a73f5ebfbc4c7cb8b1e0d5c0b5e1f5b4d568eacf355c045322cdea3a67b9f7e06137b4102a3019ef868eeeafcbcadeb3369ffe7bf07aa3d464c8730b22efd624292d0b352baa62b387cfc08f4e0b4c30ff0fd977b25080b92eb91d2acef4107077dc4a93b8b4553e302659899fa91d1cd52fee1bacb021fd530ac9930deb9cb00a19d13041a580a7b43af060dde14e444f662056f9594fe6c3598d0573bdae6984581837114624b2a560331fb8fa71d96a370ecf37bbcecb11a4b01bf44d3d603d7e3c8d9f79791c983f9a55a9a09f02e89be0abb878ea32ba4447c128fe6ccf174f1591a9cc571f413fecbb0fb83b6edfe1f5761005efb6a6cbba0c0d1c96a260d9d0694a45aca0b3056b9c1ddd7e760144dfd97ad3c93d75d0fdca30c774b112ffaf35120f8c9711cd96ef2f2ba8b23c3eeb151425a778b157287283c6e0d4f9946b12856f087b037d71d9d2b827194baa1cc6ba42c944a623ff94bf9889f8bc1de74489fb5ab71d69d390e8fa0b41d55911613e7d2f526cd93978313d23724f6e205d89c17344ef2625c5c6c6e3b2a20fd332fc296afbbcb564acae6564245c77060e37d67508b5b1cb629a703a20bc500f2f8754a259f38638526e4fe715605758fd8d4b7dcad191918c4811ea42858fc178d198fae1e7c3bb956721857fda7c75c48e6f22287ebbd425b3277a33954d6604b7f8b6015c1b506a764cc48ebf246943744b0d25d6d529177f734554f617fe2a562af95d353b0f2764f8685731851b861529426871f41a221403290b665c81021492d832175b20781dd154b064a0e538e01d33674ab4d43fa28f1c8b40f4a48e3f6a75a8b7abc4bd2a6288d96c260ee02aa5a0b6c3c3dd1bc9e01e6f3dcf7a78add22686c941156698186e2e477b01cd68f74e461d2df06779f2da3e4fc3cea24341684df3e897d4a4ec684ca1583d0d7bf61a60f1e2678b238a8a087edc0738c7321afbb406829eaa7269a61bce674b7e43de0ce75b4a4219b3596ea1e351dc09b314064bbfc52d5355184a6c22872244b9afe1c9d842b08527ce47c12bc4af1f65e7016065764f062f0c49a17877e7cec0ec1769b347d33b57faa7d773ffda3ed727f999ff4293df87027a9b76f43051a31c997a03039e757bd2425136504fa2c2437f4b75b5b346148673f4f6d27ad90981554327b3847c6f724ee4f394cae164a63e0756261449cadc6af2f8b136e8fd8665ebd8a2349278c437fd979d568216d95cc4cf3e7733ecbc6d43414757fbd970d8ea17b8e1a29b177cf20369f9e42b6fe4de470b2f570fbc78d699e3d7d6af028c361f74f9f3347ae83e693917490dee3dd2ecf2604b7dd3e511fb46bc7b0de4b21718271fddb539fd80f5b392c7d33fc3cdd553ff86a7449fd7c99c1737308cfef67ce095b9cedaf53ad7b7b1ed8721fceed6dc3676cd3afe9513fe1ffa0211d673315ab4dbc5e8b0a88db9598e7977d47586d42cb1eb5089759e39e3164379dc14d3f87aed7f949683c748ad96c863ee4e7d11dc861245daf5f8366728ef0dde838f75da938ba6bf8c323c8dd077a329b4d1cadd7c3d7fccac104758f7d1814af9c36949c364957e29f1ea126adb47f3df6461c5d77c38417cae3392e77b61b52ef138edcbf749bdeb84d709351eb4de1e58ecaed64f662fcfe6b3cfea8cb0fe11c979f767bc28b341bf93e8aaebbc3474be9178e9fd1830328753dd7d8a34d46dc1fc2f28edde2d81fa142118917b8507af5448032a0cbf5560a0bf4f76d930502b9e9efc1f16988da546df21f0b1759f21921fb77074c8a7a1ab1f5fa35a0e49b4dfccf01000a8e73e011220000",
+		"57d6928f886eeaa00484e1656a66fbba": "1f8b08000000000000ff9492c16e13311086cfd9a7187a4ad0c6050971007268d356aa84106a8b38205479d7b3592b5ebb1acf362996df1dcda69b0685031ca2ecd89ef9fff9665232d8588f706274b85f05eaeeabdeadb5316a1514770fee24e7e2f414ce7bb73e332625d5f4bebeed9bc66e73061b41839cb00d1e3880360608eb40264a9892bad395c32fbac39c81e51bac076e115252179a75a5e3786d9e43d8586ea1eb1d5ba0b001eb231247080d549aebf6d6fec25145cc3d68d25d84f9c1ed1c7cdf55489233fad9954103d513a0ae5b88ac193bf45cc20536ba777cbe2f601bf081e12144cbf61145e6ae4558a147d28c06d6f814411342440669bedd7b120161a9e05b44f86eb9bddb8e70b47310087cf028de0eb2946820512098c325d1f560f74a5b87a610c642cce87083f563ce7f1fc8b4e62dd4c1336e592d77ffe5ded68f9faf53525d30e8beea7aad57cfe0554aea96a9af7917970720ade7194c0963eff87f2adc844d3c6b1aac8595f5fcfe5d094824bf403348c5c41e4ef3d302dec8e1e4e56871349462928b22a539d806767b75ed9ba0ce7a63595d597426e65c141353c187c540abcab9983481e07ea42037a4fdea6558a29a1287cf6183b4d41dbaa5ece01ffd0c1202b70453a90bab1dd61c4849b7d3d958bb04a61e6762533c2ce4ed9250335efba1098cd367d503c4b3a12574118fcdff7bba37d2aa6d04f04ef952487f1ce2570bf0d60d7c09b9272f6109f3b7e5d1a209e2f1d15ecc54ea709ea5a417b94809bdc9b9f83d00950ad72746040000",
+		"5eff825b68f6e8c40e1c9348ba1b0643": "1f8b08000000000000ffdc5bdb57db489a7fb6fe8aaf75861d795ae324ddbbfbc02c4b13703a9e3676629bc9cec9e140592a410db2e45495202c87ff7dcf5717a9244bc674e8dddee9873496aabefbe55717ad497443ae283c3c0c62927fd0bf2664451f1f3d8fadd6399710783d3f5949dfebf94272965d09fc53b215f5bdbee7bd7a05278ca4349270cd622a405e53987f1c43cc9284729a4554c092ca3b4a338889244b22a818c0e29a424c72488a2c922ccf042c0b96c6389d71f85250cea8803b26aff111c486479ee04fe41a73764bb979c03844799651452a0441a995ea5dce079ebc5f970f806592f28444141ebcdeab5780fa02a7b2e01992820c7f6bb2a5c05e0f47057dd026f0d4c48f452e297c51ff1290649952c85190b458658a8cd753630216d34cb284516e08d4097d484944aff334a6dc1564ed3c36f2647f96d7b0269caca8d44f89b2d57d0842122e59760544c21bafe7d00c32d4b9c1925cb18ca0e0eb35cd629039900c721e534e634d52318c5252080a82a22d917aca564c02cfef049044cb9008aa9f783d4b36d01434c7d08c09cd6496c97fffd7ba3833a535d277f4d7bc05acc80dbe21a886a05cda31dad02284bc90eb42c255ae422dc93985bf1d8dcf867320596c461bcb60f02043d4d9d8544822e98a663284652eaf81700a74b596f7c0ea410011c9b2bc648fafb4403446f5bd5ea945604483cfe7d6df81163274c431afb405ced6ae6a95ae7952e959ac63a25cacfea0c78689d183e77755be080ce21b7a6fc7b0049804fa950929bc9e6616e890b54e2ad954d3c206a74a1dd7796322e448493b3ab10a5ce7774a2852c81c5816716561b8a1f72a68ad7ada72c004704a62afe7920afa35ca73c989a457f79ae768cc6e6a591b11a1dd910926d92d85f1e89721168598614d803c036254f47a6ab67112b4a9341492ad88a4b3fcee382f328905cd61a6a39bea41e81054352b564b950fa892c0ffdba290f07c65849424cdaf30572513924522542e533c71801e8fa50388a8123dec08c86b2220cb1d725eaf45f246d99ae47735c3159ca36bb0a29b0c8135a71113ca6c86234d48919a41aa9ae6755142f5eb96a445f98a1431938aa39a65c2cbeb4df2bba0af280d166c45b53fe7e496ae7396d5b2abcc4c0111a7cad221f03c4dd1e44b12dde8b285299e5222ac234449cb967202191592c68a95e42413c4740a3d936204b69bd80c101555a72b39a454a921695a2f1fc600d64f2509af572a1c28216d10064a51aad544152b11cd10ef5135ddb6d4f89559a7fcd94a8f65d2f3a23c130a0634b8cea8c09040128a2972519671065545f64b3a3013cc132d8ad76b21d92acb01b05c92cd8ae3b60e479232e77819ee3486e5bdc3bb861306251dafd74a1ec1ce2de1c610669670c18940c69a49193e06a5a08fd193574c60cf24259e2112b397cb628db15c080a24cbe535e506df78bd92d301acc8fab38e8273f3f4c1ebf5fcd5bdf892fafb80ff9ddecf3f8eedcbc7105faf7321af381538e283f9bb31e2eaab99de35427c4999a47ad0fce39849daf6fe477fbfe3fd4a5422ce3f8ee794df52be4142a8c7fefee69023e9e019b10f921714a73dea7838d1e5e92d91d1f59cfd376dd6631ae53c1655f42fef8192e8ba2a30364cdf16e9cd511c3ba8f4ee9a6606612e2d79c593614a4b58e7badf78bd0d210ee0cdebd7758cfc2eaf213c1b05989ccd98096bbe8424e798c045668388c6364450588741a01f4fdcb262a93c783d96401c427e03fb077692f85c4d39ff0bbec3b83272c66865fba31e5ea614d5e4643a25eccf3cd16aa8f2784a3823276f351cafcd1292178aa2d700e42ab6b586813ba30f35400e0f7682c906d0a2b9101d7350976eacab924537a295b09a53afca96496907ffd287efcd6331985105d5d59c10fc4b1fffc17ffffca60fdfe30363292788adbc87ad3274a27747d143ab6413cb8f47a7a3451d73b733793658772c90ace460bee62c9349e0ef09c3742f0e612ff643bb28a9d1ea1b2b9425d5aaa23baf81fb26ee9f02db6d0afd3afced98141d67adba01c84793f970b680c16000d3099c9c7d188f8e8f1643f865f87738fb7072b4181aa1e25a6cf7e1a5d0365aff9670c0e5967de7f5b0305c587a98d49c6457b4410af319a71d9818095468347d7860964dc19ee8fb21c4039d0a9a747fe341bfaf4a034b20a51952ecc3c101bcde99d99e70985466f8fcfabcdff5dcb0344ed13d1a116e1c6aa8edd83563a94abe2e67819bc17fcd99d220040c011ba82e0eb08150c70608575a437187854c15772d10480b505be58ca79f86336b7bbdbcd18f0efb1d81b76599e3d6324d05cdd170309aafc1c956b29d1648d854d12b176a41c4b09025395f1184de1722baa62bd26abc6d6b1857f0f9703c3c5eb83cdecda6a72d5c066a88804fef87b3a119aff9c3019c1c2d8ede1ecd87411f8e2627e62d9672388043abefb64593e44516112c4c32576f05c57527620a0cb4c5e874a8da1ffe315f1c9d7e6855bab12caaa2433d526f070bc32950cfe68a4ddfd4ab8d15d4fce86fc30fd3d16411c26c3a1ebf3d3afe0516d3eab19269361c0f8fe6c3ea69ab6cbf7ab152f356c91843cdf4ca76c9dc014d01cb97c6330dc0da0240cc88f9c7b1461ecd199de0c322676393c6bc6e085222eead2824ce0b6c080a9c74b2d8058c5cfa979d60e4d2bf0ce1d2c77f3518b9f42fbbc1c81fb22e39760024b502ff078502321b9c166a988e00d377efe6438b510c767081ca26ff97c42a86fb9e854acf472c0466c3c5d96c329afc6c708b113c6e46d7af4725a5f83ef6a48a1fc6bf0a1ad31783d8e97a7ed9bc9ec02fc7d3c9bbf1e878012753d310bb35784900f38cd69d67c77996a42c9241bc858d55d8eda2ed9dd598d828baa1e637f46cebbc96b66ddaef48b5d1c36e23efd6ac5b3ab425fddcd6cc692a8b754a715d0cebab8b2825a2b30e3db32597b4f7f797ec0a9b92eacc968b69c49ca6dfd467572ce279d56cb1290a4956eb2e259edf624f2b0ebf4d9fdd90f1f7dc6a6b3b3b2d8d56bfd74db63eb6b3c59a8d23638ddaa4eefe6a779b9edd5e1bf4ff779beb61bb103b74d6a796faaa57d57b6a07afdf62c55fb5d3aa8b2a4a96b02dd05599ececa221905bc252dd685816d938821f073ffe9bd129aec7d6ffc306db90ff9fa5bd3a8be18686dfd059bf6535dc90e2ff66394cecfe96490d13d0e648084f27226ca8ee215eab0db77760cbaddcbedad2484b1984cc39ded8c0be094480a45fa5de21cd4866da5e87343bb4d2dfa45f36c4f89d77cbda21464bc3d4f0224f247a04f4e8b27dd627eb0e6aaeacd48f43d4b1d14feb3721fcb4fe2154f5c7b93a82877c5f0ac6abd3aff2a4c51c20e0c6aac453bc3c8143afd720beccf3d4db6cdcea44a7724a4dd8eecead666defdb4b4ea21b2a4527ed5dbab6ff79cbfefc3996fbf3f36a7ffedca6b1a3b815f9a7355e8b299b77bce199ad1d5c1fb70c1a26754e576a4df5a7b55935bb9b9c7e898d9bcdbfd6f06136fd348777c3c5f17b78379acd2d2a508fa793f1df3bedf9a2a0a0c4029bf2d86728ccb3d7db194ccf161fce16cdd5f6a63adf8e060ca7ed304037fbe1c9c0efe3a1413b2680d3e1ece7e116615fb2f5df120e798687da32d43731c4d3c704150b24d1cbb36ad71e69d5dc2b09bfa272a0f6ee455ef0880e6a5bf8a64d769d13bcd441c50b88a18f488c9d154f63af92adfe1d827fe82be9bd9e6adf78be52134639184693c514f6047c1a2dde43f07e3a3e194f8f7fe9c3d11cb4b47036476419986b6978c0a2de6af9d56fc48c684eafd7b3822be0d60feba714a5647850116e05aa8d99e8505fed71fbfd7efddce63fcdb18d56f2c06c12e0e6c2a7f7c3099c1e2d8edf0f4f60813fccf10942fe6d47284e09b3d46a96c33d80094ca68b3a7503a295451c6bfd451966bbb2dd6f4d98f8fdddc1aca90f26773733f71b20ed96cda21d516d4396dfc1390fcfef2e348e6519887b31885717f1f2624db854f7ef2e10de76f7f4ede076637b697e761a941cfb7a6fa993a9d96cca97ffa091bc60311cc0f4ed5f87c78b8bd14970d85709c1b2987ec577ff013ff8de93087a732beacdebd72e68863cc1fb1c1401f20f9d5a3f6b3f0a39fcc9bc21d92e9b52b0981d4de647c78bd174a241758969ab17a1033d77beecd6a9d18be1f19aecadb0bcfdbd75de8c2e99bafbab909f72e0a17ba15a794847af41c5cd97cefd1cadae2619c416c01b08d392700af3b9a8f08d3a19c76d1c07faa9d9bab72c8b043bcbbfd85afa16afc253fef0e8f554a77c5d75cfaa71aaf9981c88312364f0c7c33faadfbdecfbeff17fcb22197ce24cd2b9a21bd485cab009f47a519e499615d4eb61c5aee6cc8a8c0651ad8ee33b43ca16d2b9ba18dea80afab6381a112f449a325c9e068770774db9ba7249e298c65064291502ef27db5b98dae0256dd7e635129ba62fbbb42d157fd2d5c1ad7a8a846e805a92ef0ef0da4767ff9b0d5584a9b11b6dcdd8a1dc7071edb071851b77da157733cade58ad7e998188e2daae262abb389b3b0dbb184338adafa2dc06198dac78ebcd215a1ab9a4f2c621643d5f9bd4aeb53af6b797a19f6b029ca32ee9f1fc4e99c3b946d7b448438127ac529a02eb91ba98209f32948187fb7807f3860675027d9d9dac4a4d33bc02969fd9391c801fb88069c1d96a5e2409fb1ad84733baa64406fea1025178abc688dcef1b58a55ab5bf0d911a00652169e32e8f8594db9054850d4ba338377b1a98ceb79f5a6c60c11a50d5126f2cbf8ed56a0e0d17573e75d82a49cce71ddbb21499eb61c8a865c08e42b26443b6a7d836c677158833b5cc69a48a5ed4b9d9611a0f6e0bda7a597560bc615d3aca3e30b196e4696a6e7f9baf1c5ebdb2ef30e5f05638bf3208ac1466870ae2ac10db3203b71e36d3a216ba263d2ccd2a4da2faa24f27490b36d507848e616b016fd6237b422d49f6ece1e29e6809fb8ec54a08bfd0fb4f58dfeb5be1e57ae184a674c37bb17ab8b3cbcc3d5d4ba9dbf44f58bccd0627c3f17031d4ad6ebb059e50749e27b2555913aa82caf28317b571698c605cfbacf0c5b450d3f1f30904b6eabb136a3f22ac82dbd46992e20dff7b976fac3eb7c07ba25abed82007578b0d439781f83c833b495312b2c31f34c5c79a4d55e1c1d545c7920b4673989c8dc71566551bf2ed868f524af88b585ed9525e13893cadf99eb66925dd4b19747b161f28db94a1ac96697b42d96cba50ef5aa2bb61e5ce70df1869e3df8e773da080dfe69790d6dc6d56d626ab9857067bc234e5076b6d6003abaaa384c51da5f9cbc2ea30c1da5a11ddbdc4d62a6545a0da4132067381846bb4285fad08088adfb0e1950d352eb6f609f5f7176b4e13f6559f4ce8bfb5e1eae8a4325ed4b05c6866c1a6210dbf97684d9a94369de1b791cf9da6d3932dc0d036ab4e5e5d8bb967c626d870059541a13689f10bc6ea26239e4998eb16ca62ee616e5bb0edb2816c405f6de026f0ab656d4d68849a78da3d992ede8f263ffb9b28d3954703cd476f1b84a88bd2edadd63d64edb09ab86aeb7af85fc7e3b393e1c98ebbc6ae679fd6dd94318d449eb6402722b1a11219afb666580d32fe43e4faa37385f7ec3cd568a1c8d89702833a139213bcbbe44696ceb906a7c0fe7692cd7274be43b351e4388e729e73b5a15329dbe15fcbc3712d6ae0a6a111027daad9db6f978c309f71c2b9de04f9ce7cb964dd95b1541f610c51a424f0b5befb506437597e57decd539e423a7db3095209ae83c87e375c45423542dda730dea25f23badee62b6cb52c33e3b4dd6b734cd489d03c76f2f4f379fda887ebaf29ede3cef315cbda6c147d87fb3d846542730c341f3bad6feda76897a7219a5739485ba932867e5d45ac43bf34022f287e106ba5b1a3cc8332045dd1ec58aba2e56f72a6af8ea6e1a1d2bc5369b53b6626bb01829f11369549482aa8f7e8fdcf00684c2ad11c430000",
+		"61c21d3ec67e70145e038ffd408a0b46": "1f8b08000000000000ffb4546d6ff23614fd4c7ec55d34ed81294f605d3f4c4c95d6b7a99da616017b91a6a932f60db80b7676ed009debff3ed9043a58e9e8b4f185e4fa9c7b8eef4b9c1358488590b24a3e3021f2a9ceedbc2a53ef936e17ce85702e2f6ac5477551c895f7c08400abe31f0323d5b44420e49a62d8b97ccc2625deb1397a0f363c8354606708cee557ccb209339b63d1bc06a96f46f57ccee829680253ff903332aed0709295955afd7fb6c66c6a026664a9e6768d88eae79c6365011e8d563130202d6a8e7f8d3062f37d324cb4780ac1b916580e18ff8d4d1be57c1f6aa946484345f64ed2a6649ca33170d2eb81d39347e4d61f9739d2bf65b2ac09e1748fce2ab94bbe198f07d7449af668a7efa10d756d91a0eb5c1e1f9b2bfe5269637f0d8991cf347c706eacbf1bdddfc17a906e55a1f35b652c531ca1e7fd07788699b5150cee4763489dfb3437480ba4119f61c8d8ef765f8237da58ef9d930528844d74a0c9c2573deffb2fc8100b4854c2fb3d9329fcfcf1bc921f7f3048fdda207d71f26512b6a2b9f610f9c2fbbf2f4b7b19ade643349556067f2269913220f8bc89ff5ea3b11954260229d485f23836a6032e6971bb82fe194825ad64a5fc032fb5b2b8b26dea24ad9d9ec23358fdbd5e22791f289f1d3507ce27494b1680448144c844287e9b323898bdf375847f72064a96c1648bd0d6a462abdbdcae325866b0ce2098ded5bf26ba60a2b9e1969ab47c922400f062e5a07c7e8185261cb105b6f7ad40fcfd6b3b00e0a38dc3e203c28a11b63b47dbfd919552308bedd71b7249c82cfef717d9d2c3cb6e97378e9af15bb78c3248773f936906475b7e630a90a8b33d8d8d0ee6168c221dc3d0bc31ca193c641178d65c7b88957e75d7a2e4c13c9d6d01b6357e87e3d6326c6edc8c0dee0d259f38874a789ffc39007a4cc22060070000",
+		"6624297964f7eca85a5788bcd5bbbdb8": "1f8b08000000000000ffac546d6fdb3610fe6cfd8a9b30ac76a1ca5ed70f43b6024b9a0ecd3eb446ecbd004110d0e429662393eaf1943786ff7d202dcb899b620dba6fd4bd3dcfdd3d27ef1556da20e4a2d1678bb6be104a95e7b6e45553e72164e3311cb4f5c5be52de97556be4acad2a7d1d0208a580505a520ed882f7e55c2c6a7c2f561802707c8336c04b8cbe43c16221dcc6adbacf18610d0293304e48d6d644c8df66ed6a25e806f6ff1b24c51fa293a49b989f8845d44d9ead40c047173d44e2e61bc85e695ec2aaad5903d92bd0c621b14b04e6e2dcc53e674cade475dde4d897121b86442019a664552bf1be45905801c042b05c9e397d8bf0a945ba016d182a513b84dcb4ab0512d8aa6f6b8d8e0a163780422ec1b1605ca161182aac445b735a8c12b63c5c7f1f448099bec5517e0f7887352cacba819353efcb9555584f85bc10e7dd2ccadd60a616218f6bdaf1e4dd1ea544e7e0e564023e8d3fc057154ed9bf0b5db784f02a66dbc547949cd245a31f26bf9bcfa76f892ceda4bd7a4adab16d1909c6de97e9b926328e4701278d757c1aaba35c5a7876e2fddcfe31fbf01ed6423a32952d8f8c636124c22484d36770074be606a61f6673c8bdffbe7448974833b9c458786f3cde1adf59c72178af2b30081bebd412c3cf9310f6b691d11623d1a8101ee39ac33f2ff61bfde24f87b4d73aa41f5ffe94c5cbed46708cf23284c78f7a78953897c7e81a6b1cfe4d9a910a2078ded93fb5e8b880c6a5408a73a232e9c88dc06703c9d7b0f71ab4d1ac45ad6ff18d358cd73ca45136b814d4cbf7e4f4f9d709211b2c36b22d0089627942a18e0c0fa9807c7b357901935136d0550afbee35185dc3dd1df4f9f02b4c22c90121b764d2ea8792af0bb82a808a382025ec433a6f890e84ea3aec53b341c87aa48e50944364f443d7e2e897fb3cfe47d8ca129c15dd24d3388439dffeefd648f15d4e091b41381c65d9e001dbe4fd4bd45a09c6e1e38b784328183fefe25bdae8fb1884dd116ed8741a5b578e0b7ef8b3ce0b781add2fb045a2ddb9c6e9bceeda985f87b0a610ef23be40ae955c768a1e45204b494f51d8313b59faf1bb22aee97ed5636cec176f2fa1f599daf0b017ee684b35a26683f099ce9fd6ea553cec24d83eae136d1632efd1a810b27f0700470451271d080000",
+		"678d55f70ffe016f0d3a311904d553ac": "1f8b08000000000000ffdc56516fdb36107e0e7fc5411886a470e997610f05fa10db48500c0dbca4792a8a80164f32178ad4c8e31c8fd07f1f2829891dc48a1a0458b787d8d1ddf1eefbee3eca578bfc569408317229ecb27bba1015360d63aaaaad2338660000596e0de11d65dd139adc4a65cae91fde9ade46aac28cb1a32c465e5989faecf7e545d364ac75c7a80ae0d71ecf83c332340d64a5a27558f1dc56d3b2354e4dd03a8318d1c8a6698fed05595b6a9c86a064b693736e4da14a7e89b5f58aacdbb6b9adabb8b2d3d2baea69c61dd734d72278ccd809637f09d793bd818f90d8f02bccad9107f0a7a80498cfacd5b1d92b927c0927bfbefeb4884d4acfd841b86c3a4d23b8221772eadaffe806e581d608eed1628bd61223ff2256ba1f1850fa1f94b9f72d048995f0f76ed93f32dad63854ce10ba42e408911d9d239d6a7d9cd31df402e0f3ee7b02b528b1fbf4ea6f04656802d64974e0c929534ea0509ad0c1bb27c5ce5af3091c3bf4419387afdfdedd6b664f83fcc9c1099025a12fedc6a772bffe3201742efd5977d2825d8a129f479b07e7ed23b457c39e406e832198a7cfcf56e2ab7818bca3f93ea297a83d4b2b46274c89f053a1504bf8f0113a497c3285e5732bf12cd97d93d409aae8e3f8d2a94ab8ed6fb83d7565d207b41187bcbbce73dba6fcb2ad13915ef3ed17bc6f9ab619b97512c6766297e5a994cf0fefbb723e0c64348624a8d3a2c09c503ed7fdebdaa3a3378096c4630aad7202ce7937f9b7873b0bfaf685567e8f565782f2f5557f575ea5f697fb2b051db8b73f9ac2438bf5dfd3e2324de3bfd1aa3a41854ad45f3ba57f4bcb02bf149bcfe8bd28f1ed9bb3408d0784f4a33527911f6613e3fb046407e6952da8a3d834ece8123d59f77f62db9e610d4bfbd06236b0a27810031b0cde611e4899b2dd84fe0ce8147ad8285a83220f76631e9621580b2375bf130d95f4ad39ad448b19bc4b5b255fcc7aa817b8397c3077280807f1067f8ff5092c902b5604930f563896ab0740270355223b7248c119f87980685ccc3e805c35ac61fd0c598c8455ad138b4c0a7b936add9448426b5e5a4e55ad33e0038169d71a1739224a4839222ab4bfd823025741df8e4d995efc2302dbd7de8838d9dee41181aebbe8fb91ec9f0100b46585ccbc0d0000",
+		"6a70dcccaba25320eede0918d785d598": "1f8b08000000000000ff8c935d6bdb301486eff52b0eed4d0bb1773fb64149d8186c744b531894529f58c7b2a8ac632439a10bfeef43fe48f335925be5d1f3be3e4779cab9aac886e78ff0e90bdc2c4aed417b405064c9612009853604b521f404247500cf8dcb09b485f443a0aa3618c8df8a03d59d3150b1d485ce3168b6b0d6c6c092c0b00f1378e3064a5c112c892cacd15992478e5b21aeaf613a7f9cc1ecee1ebe36368f2a2f324536eb8d635190c8508c04e80242499025c9082412398b1f57a3f7242130749a14162541ce9220471b1be68d0f5ce9bf2461ad43398a24f2e7ce511854f1baa7d0fd66b122e03e30b6a8317f4545a910d31debd843c6c98535436170c58df31378f8fde30f2cdf4052818d098056c2b7fbf9cff7f82e327e0cbb2a13426c2b0f56cd7672308ff75914ec44024f730a4ed34a5b058e7276d2f7fe1a95b6eaf9e67a0028f9858a6432efa1db9dbb0408bea63c6e7590ecdeeb4fba0b53471822bea5fa935de6b196074c7fb2cbccc8d03ed39f6c99f842b6edbae2301417599629169bcdf848e14a22bf2876d58ba280c6a48ad350d5e60ad2b6fd2f58c75dee915996ede7f665ce049e760c833a6f4029f70d27a9a6f6e4c205e0b231af47cab1d4b099f3a59a0ebc20aec69097a7c386159f0f931d782cd96c92f87f4f17b834f4dd169c3e70117a6ddb0e9bf281dd2521ae274fa790956d2bfe0d00242e097c35050000",
+		"6cd7c14b05782fbdda0d3d2997dc7404": "1f8b08000000000000ffdc56514f2337107ec6bf62149d2a4061f352f501e91e082bd0b507a2703c9d4ec859cf2e3ebcf69e3d5b482dfff7cade848434d9dbbb22f55a2402ebf966e6fb3c339b6978f1c02b04ef33c1cd55f774c96b0c81315937c612ec330080516134e1138dba27d48511525793cfcee8c559592fad246b1c31b637f23eab8d4075f6fbd565082396ccdecb12b25b87e7adc5aa0d014695a4fb769615a69e54e970a25ba546e03d6a1102db7b8130a65238695b29466caf8b766a7429abec1a1be324193bdf88fab936d21a3d715fd4d32aec01637f70bb5078076f2112cf6eb0305aeca01a51915b363546f9f01c6a1921b2ca6e6fdfe53e85679343e63dbc1142c1f15bc83ef099c277ba34593ebd40e2599ebf87105852915021b07c0a0907391297cab1a3213fccfb1820053b824e20635756d6dcce7fc3792cab7b2f1d45aac7b1e45b6c21ac79b85f8dd42800b6782c6c21b04ba3b704eaf0db6d9b4eab3c7f775ae561ec70c218db596f369944ef1bb26d419147082b334807748f605727a64c27de7745e93c80e2ff20f5d29673e233ee9666b17864346fb02f9d26b4252f103cdb3b473a516abfa027584c51e41fa7690c0dafb0fb74f24f04a9e9979fc760ac400b8eacd4d5184aa9082d1c6ea43b4bc707b06fd1b58a1c7cfc74b81cb817a39c6d388e810c71756d1e5d4c3806b436fe1a7b90c85ef10ab7b32d5aebcc8ad83fa03d86c2b49ae0347e5e1881dfa543e3139dbee4d42f6dab2cef2dd715c29b52a2121b737a6a049ec57317e2b4439cd3845b1b86135bc5fe8084d8655d379e9b14f2c3bc893216ef90f4078e42485751182b60e83dacab3c11627bf1be29e67339067388ed74529658108a6543acf3ba6d1c5a7a056ab17574a9644190655957f7d7a73b6dd5c357aef25b3a75c6a9b8bf59ccca77f5fad7ef5770da31b73f5a87b789ebbfd78b57b11aff8dab6a2255a879f3b1ebf44f71e3caaef9e3053ac72b7cfdcbc951e18e46fad12e278aef57e3fd5124b246f3c694d4498c8be5353a32f6ffa436f9b0c0e23e944f7b561407bc6783c1272c5a92ba4a9bd09716ad44078f92ee419203f3a89f9721b8e75aa8c54ed497d2a5e3b812e553388cbb78964f17542ff171b763619113f6f26ddd92eb062d103356b6bae8cdb02f66cf840e7ab278b667915aabe1a71ea13e9f1e8398051677f1ae1ede13d68d8a2a46829bbb98ebae42e24a6595c9a86ed408b21e60dcb5862107a0b81003506dfac61e009cb5ea6168c8f8e21f004cafbd013891267900d07683fe12c9d85f03003a33fa18020f0000",
+		"6d6b5e9918a30792eecf74cdcb1e6cfc": "1f8b08000000000000ffe454514bdc40107ecfaf988652129a5b29943e588e22ea15a91ed653fa289bececb934d991cda47a2cfbdfcb2651c3a958a17d2adc9164667666beef9b59ef156a63115225e9724daeb9545823a35893e0e6ba4e4348bc9f81d120ce6559e391d52456a4f9a08f0b21d9d981e1dd7ba13b5bad3aadcd6d08605a90102d6cc80213b4a41986fc20a135765d2338acc829d08e1af07e28b2940d86001cdfc158e02b8cbe03c9b294ed9d5b8d9fb18372032d321bbbbe4fb2d5a9d8a7ba6bec09b2144382025a4438c396c96d37df83c6ba7d1dc07f80adefc3aaa10d748e1ccce0d0b925f1823aab0a50252c8c5583732b6ae87b214d8d43e46000dd5bc6231177644d493ac3ea57084fa2cd2abe858a2ce32d8bfde15978efa45d23bcd5066b05bbf3e994ec93c245b4b72180f77186863871ea4c23dde61b6ef6dc3a12027dc473dea9f32bf529cf37d71842e17dcf4dff80590839648e6eda3dadb16254602c7ffa58449cf14f2e079f240070a7ccee1cde792f1a52589fcaeaa75c8f0208efc58a5d57f1f0ed5f5c8398559591824865f96099832ac58f2b74987def88f148a165a30dba4c95e2c0c81a2b26d70f65961790fef100a7f9fb148e56b0bc383e4e73b130aee56c4056c0df9426bda7ff510f30872f69e1fd73c7b7440a21ef79313ab2721835813773b0a606df3be2cf2177cec2ec43319df4de1d92075a479ec549546fc49d8b8b6b2519b357b058c028c2829e9424174bbac9f27c7a273c52fbbf633f12d5cfe51df393ab6aac11d76e7e5fe9735cc197ca4d2fac49c9314895e26cb2de459c9b2424dea3552124bf070071959078ce060000",
+		"6f31df1bf99e541c2f3bba3142371a32": "1f8b08000000000000ff8c514d6b1b31103d5bbf629a935dd60a85d2438b0fc64920504a49720fb3d2c811d54a65349b1884fe7bd1ae17fa11680ecb4a4f4ff33e548a25e723c185c5f4784c3c3ca2b5fa98b40c3fc345adeaf212f6d696a2dd18cdfde89c3fd50a3e034243c4a7089200ad0584ece331103099c4b6c1a5e807ec037dc3816a05696bf011e489a0147d85823de6e5d89eb7aa942d7807f3dddbe892de8fd68bbef1146c9e5d3d3c116043c1a4300e3143728b32324126e92053d391f435bd101f70a070c04ca0ef854723b3ec3c7ad2a468e7e1c49c18b670cd7c1b33b1dca00f643bb03d647c26301802b80954ad8796c662ba23f35cebbf85ad8d9cc0a42874127d98ffdde2f67d297a4896c277343ff0786e4397f287cd0dac99f218e48dfc0eeed24bde3b4746c8828ff2e96307c4dcbec41b28ffaf19005ae2cfbb295e7f46de54684bdcead2571e031949acdbe97ab3c4ee4078a4cda2b16bdc03130aad67c266f24721d36b4e5ee54ecfb7f2ae459c275eb7ac5fa6fdbb1d441fa0a8559364929163433ad87ee8fe7e69b5aa4aadcea4c5b0edf5ef9d76edb6aaaa148ab656f56b009c39544d4d030000",
+		"7b5d2a46a3677e3e05f2941aa1acb452": "1f8b08000000000000ff8c935b6ea3301486df59058f331ae11b606039c69c386e8dedf89236aabaf79169464d93269d272cf4ffdfb9af6ec906eab737f4f17a7faf2ae56a8a685755010e5907a87f55755dd7d2b8bc20e59c3280a45bb172f591a076405d8d71adeda203c8b469954efb3c6f2a6140a6bd5b45c409566f4482622388348cd0890c742494f1be6b7633ede7719ac4d0d36bca025647b0cf5bd8668df16096f993c308e9d848186b5bd2102e3899b9d8cdb3bce6286d1be5ac9658695b1f29e288dd685ce33c58e1358e1e64894227343c2af2d2f222d4d932fd608907d32c411f21e0f5140fa6e4d3a19bda95530efbe0929bf3ae68daef344658f545d521f2307ef0b201e9e22926288066fbb3ea6531f0220294480c911b5f0ea032b6d998fad8a20e913fda4ab77a91f46ce05afeb43a1d9cc5f1605eef109fb2d160a3dcaf7a49789f920f2e270845dedeca9f03f601523a952eb3c7451a3d637fb8035a8536216310f1f4149d2db801d147b855a464cf93d309dafac81041ec61033e0e06e7ac973b79441db28f60b1712ae45854dded56c617a194c33b6d207e3d20d291b1a78437bc257c18e4d24bdaddb197fddf9e10ee4ce32c2c9fa2e0a8ff406d0b865c50f815cb70f2c95d664119e9e94878df3730c8aee5e30ea665b96de625c642fa646c274c594b59373540462ec844e4b8fb81114f17ddd81884f2b6a7b4a1533fcc730f7ca1bbc78ce49cb9a674c5ce58d3f6634f386929a3fc1b4a992dba8009efc12a6de1dcbbffb094b32b6a3afd1b86f3cf0a698b4f6235e8c8ca96313456bfabaaaafe0e001e9bf198b1050000",
+		"7bb45f293bb8bd6c5829d4b8a2a85ab2": "1f8b08000000000000ffe49b4d4fe33c10c7effd1451cfa88734aa104704487d0e3cd2c29e10424eeb7abd72ece017760be2bbaf5c68ab9975dcaa05b6b5c50565c6f39fdfb8994453f7a55714fd86b42d97ccf4cf8abb5e511485bfeafffae6513cd8794bfb6745bfe6b67fb23430b5beae94581b7e1a2557a673a50425726d6db5b2aa76b38ec5cc69cadcca289d1083b184b2fe22a9055d79994731b876429c8350e617618cea9557edad0be3eb498411a69326a4e572ce91e0d2c6a51d961da0636929a33a028a56ef453a86b130aa07d8489a07a569881000d5f067bfbc4c9fbda153ee9a3cf6994b5b01b58e448f1fb3cc0313669c2c69cd59e4061d55fb808eaa8f031d5551d051b5d5a69e023db83e1dd0c90fa283a0c66a2e5907e92dfd6d2398786d88f306fb4450b12f667dd7db084ba56b80220a9014ec13d1596daecc0d5828c92c483d71e0b777c4ac90f382e5729e15f07bc72ab301e692da6c603d035044013e03764a2cf1ff9bc17f37ff5f7f3d6f0d245184d4806556af1b536229505c1a2c6fe8e0963774375ea6141374b0bcbe88642c69da7809a060a80081bc30fbda652b7cef1e6ed679d5006846a4122c41e6f81e1fa7f56135705b8c47fe19b07d06b211b563475e7c0d91f9adee6ff5299df086886005664211b07180ffca5b230598417b081685883de0af702e987999ec6662e56a016b8c63a405dc2849e7401487488b57d3ac3ecf0bc7aa1b78581e11f0b05c016f2006aa38c65102c7794fd3fb4877ef70cd25d1e19e75775fcf2ded00def04cf62b4d272f8e1c62c53e18f1ddbe714b6ba1c2538434f0fcd43e71c4b7397de2904f44277f2b4ad750cd27400c77ac30e281b7d74ee2bacd6aa667cd139d58950ff04356df2ff927120192284252b4df6f2ebf3d5c5c5e8daf2f2f80320ab41374bc29fb33607a4626f4e5f56b999de353a08802ecc47aa81b0cd4d0e29d400f75536307bbdd7e670af1f24f3e82b605ecdfa79e53a68d9f734e0e371bd0c8d9583ce3df8175547d19eb366fc92ebfd1b8cb6e36ee70620878581e11f036b3c4c583c850cd8988fc0267e28c558df76f95b14c53132cd17efdedd0da5b4755aabcab5273d65198d30c0ad32b8afbde6befcf0085486a5a793a0000",
+		"807a4c794e36ee618349cddd08269966": "1f8b08000000000000ffbc56cd6ee336103e9b4f313016856d24d2a52703392431ba70bb4d0d24eda145d1a5c5914cac447a49ca5d83d0bb1724f547cb6937405b5f4ccd7c9cf9e687431e69f6891608d626956458eec2f713adb06908e1d5512a030b02003067d4d03dd598eacfe53c880caf704ec2bae0e650ef934c5669216551625ad79c05a0b53c87e4678def6b8545dd3431dc0b535197e51cac45c19a86cce6855455c265eafc9af311f57c2474ca3999a5291428d67b2cb880acd64656b781b5ee952818591272a20a1664f607dc416f31f9fef9a727db3875ba229b0778a1fb12618386f25293dbaff9116be11d6325acef20f1fbb72297c9e6e1473434d96c3e40d3109f008ff21f6ee517b710a2258e083cd3ea58e257bb7d917ed7c8e95668434586f0ad2b9f773ad23e4983dab179945585c2bc21c04b2b9e7c4b7d951202de979006924729725e24f78ced9434725fe7f74248430d97c2392769eafaedd9a83a33a1d340fb0fe01a2828f92728cca46220733007df9dde7d0b366e0d5c74ba4ddb97adba6b53e22afc9a27dbb6a5a2a2c071d9bee35832dd34d6264dd382429c3ee252e3ff1942bafaf7a370e5eabefca188b9f546e00ebe193e2c9939fd1ae6317c7e43668fb2ac2ba1d7f0dbefabb0768d1252fc0ac147c9b02339e0fad56c2b187e59bbea0583fe2c7961d3dc0cb081d208d613eb616dbfafe1638c6ce54df3716cd3f5f704da767d04accbd2057449b393474cbb0abf9c8f7895f525208e60acdd2934e6fcb706022436b1d53bc52baace3fe07992d9912ea2bdd5f7b5915b9129741363ba2f525f6e558a5e71e5c51134a85d6c93a806551c4d907f405198c3a58bb12ef2f35efa9e1bf23f12c4e65bc540692488916e045f588d4431ba1b89173b26e2ebbb063a63c975ec4eea358c903ba9c7b9182dbb61306b6e4843889f6efd09078d46fb49cb854665dad1259c2a970acc81eb6e1cb95145f25a64b07003eb209589a6d6ea628c2d07378ba533c2450196cc149a5a89c9a471e4d2141e30970a9fe909818b93fc840cf65e049a9eb8286ea0dd4f05a0525201cf2177f976c3d95d514779ac4b6a90256f633b785e982fb0724f9064f3b06cbdd8ebaf91c0ed56d313462f922e4ac1cb36b09dc22355af469551017b845a230323fb2042681ad06409b1f616e23bffbe66dcf4f7812fed01813a69b7d3f954481948519edda5a4f0738ddac05e328efac655ffec5159895421032a98eb0bd89f9d0e1895e012e96e789d8c5e356fca6e1bfe6209d69b0877c63b4ff2e26d7511d4ccda77531789b561737ccae10e7a85b7f32b2a19bdc5ae96f118d845250c65fb85969c5133a9db11552e55e57a9afad4fc778dd95158044770effffea1314feda628243710268dd927be53851950a1a1fe29fd36b2bdb5c5125683e9e1dcc7c7be47b4642671649261a740c1c85f0300156d5ff2d40c0000",
+		"86fec1d27c469ab2f234b192403cbe21": "1f8b08000000000000ffac585f73db36127f163fc59693a66446a6d23edd78ce377562c7f59c623b969376a6e9b410b9a25083000d80fe530ebffbcd02a044c972925ef3628b8bc5ee02f8ed6f17a8597ecd4a848a711945bcaa95b69044a33857d2e2bd8da351bca8dc3fa14afa27d14e96d6d6f45b19ff7762782999a00ff3607226441c450000bf435c72bb6ce659aeaac99f5cfeb56c26a5d2d5a4e04c606ecda47a303722fe526d7323b8c52f56af95b1a546f3c5132ae3a2894643cd92cbbd52499e4f4a2ee368f48495ad1173c7ca524d165ca089c93d004c26e0c4a801ab3916e04677ce23a74137a679836fa8785108bc631a37a7165a35b27898944ad5368eda962f202b986546353a47d37551dbee8166b244785618d83fd81e1fc56dfbac30d9e1c5e99b7717675d17af44474c6d8bdeaa02452f24d3288bae8b46bf43dcb659a58a4660d74d0a951b1a476130f8580fb66dc66a7ee18178c62a245bb45bdb5a05533bb476bada35bda258b70cac434ea32857d258786f50ff171fe000e2c684bdef65d7f8008da163531a8c559acbd24d006375935be01242e244d12dd39044e1d45f355c1447cc2214f4c75837552de06e8912e6340a77cc408d7aa174452eb8105890c5f903ecfd02b9aa6a2e10168295d1686d0f20588ba2d164025366d1d8d7aaaab8fd4abe364c0e7db920ce9a6a8efa6b2e2b587ce4ca9ecbd38bafe8c8db839d8ececdd775746eb61d5d36d2f20a3f7cb5bd1b18dcf4746e668e9ec1b3b447b05560968d2dd49d8c462b0d0a325f3209ca645e14a55134996c809ca65aa511b85ca821e4ed438d43cd361a513ef7c17451b468640e49032f482b85991b48d2a0016d34d2681b2da1c96866d439e7275cce50dfa206c11a992fa1e4128c93788b2b85248504b506d45ae9b48d468d164472259733cfa5d9fbcb69421c977903b37c894439fb13e2082ffb4919db758e3d2542af7941a5f15f2fbb6e7f3d9b64a4e9386412e8993828fbd32819a794fa574b040aa3565c5a5aa5557078710a052eb8e4962b194523ad1a8b3a849a1de18235c226693f909d1c5f25f1cac10b261fe2f170553f6b56ffc46421502741eb0d95962c08c714429afe7f2561551136d8337bade48297275c5ebae0131faaf3f02c0c6697582bc3add20f5d3786551dd9347486772b3d8e267942ede8551a763a1dd41af72bd4951da5e453513e1564567c3ec2acf8b2f0fa03bc6c24c12ee066ca8d45d975711a8df882e00adf1c80e4825260245499bd61968945121f1392c158a61d76fcf431d825d22ca5811bf8eedbdbefe2317da7d1a88bfa24a2ec71f9f3a3e55620b46d16e0722a172abb2261d739855bd4862bb9adf2c18b83528126d7bcb63b148fd64341d9a2aeccf982f296e78f7d9fcf3a9fdb3f1279b0dc6692558fd45efb317ffe1bda9450bb95df5f4eb774b162fc29ed631aeb43113c4769d0877258b37c89f043f672638c1c53fbbb3f99dcdddd65cc69654a9793a06126d3d3d7c767b3e3bd1fb297d9d256c21b5f2a63e19f528cb3f48a19bc6076b9bda45ede759e15a9a74f52685d0fb2a2f803a8d835269b2c3f86ef535f2c8e8895107a4010c5eb8ad1c14623d756f6a080035854369bd59a4bbb48a23f0eeb5af0dca986ea359c0cbe5bf0b58e02827df8d6acc432d4fc95f8845baa6fd4c50cb543915bc517c464db8292703e5b6bff315eb75de1a76f2dc61b5dd278508ac7ebfa9ff6ebbd60da6022b9f069fdf7f9d3edabe7348f64588f47a327c90e0e40d5288f9865736630092d77685dc710be67efa6570ff5b6e8b5927266b56798275d64878d556f79a999c5241a8dda36acc3b2b970bc368667b92a90128c2a03d9b9a2311298ae83e7837bc0a6f5b65dcdcc66ae3b267b5dd77663e7c9e1199e8e6eaa28b3e100a6aa9cbd9b3ea977985ba5e1c0f51d24fa54b9a21bed29d55c26f85fcef8fa2b0cefae2cd1a8983b82a54da0bb5e765e6320f41b31dcffccdc888dcdff34bd9f281b78dc757db9921273d7b8bb43ff1cd117f36caa4adafdc4ea06dd6eeeaa4c7000c5dc5fc9db169e0daf42b4a2477723a0b25fcc37f111eeb0f0599464760b229b1e3f038eb59b1e22bb57b50208915d92dbfbbe0fed4f7a0ce64684d63275859508ebc2f3553c7b3725f2f82863a7e72ae74e3fbb00b6b3cdf87be8227a1936aeee70a64ad56f9446d7cafafabd9b73a8100cd9c15185034b8010b1b05a001b720d6dd426a750a573cb27088f69038935567bf6c241fde815b44f24c0f6cc7f8a77f8d6ec86bc0ff453c00f1787621e2e0d9e3640a8d2192428e03de68dc582ae4f242b9882f000667cc9f493be084c9fc452200fc2af0be6111020d74895309c1879a0ab1f038d370d1a3b5e3d2f9006410f165a556e29bfec1dd67ccfc996c88afe12f4c847a2e105b52ad9a5b799c2ae85b9c590fc00742f4b28e76ea92d50d7c40f3afbc939fa355efb8e7f7367adaee1f9731028935b2652f80fbc247bc160efea676e971f9868900218f74f2c63784ebf5a4aaa7db865e2d797bf753e0fc361e6f63e9c2629bed1aa0a018246ab39de22b0c1e6f4837c01ec9671412ce4f7666bfaee7d48dcad740c73a584db95a65f7f6eef331f7f083dcdbcf21a774e77102c2d6a1d251d1b4198ce78f34077c5ec538039de091308aaac295c53249a4a06bcf69e9e589087ebae15edd8109fbbdfa86b5a7abfac381ec3820983c363f197f33150c9094b1e1017b561e1a5a1d62a4763e8f21fd26b406fdb49742c2d52b8f4ac40176304a154fd51c604466f303b53962f1e92be991d43786ece66a727a767571bdf57c7976f3704ef6797dfa7f4367900ffdeeb4d445b41dc73fb2806d09823bfc562f594f251c6691475d1ff0600dbbec7463f170000",
+		"897e7d545473fa3f37af09d464b01c6f": "1f8b08000000000000ffec5b5b73db36f67f263fc589e6ffcf48294dc5339d3eb855675ca7c964dba6d9b8d917af270393a08d98043400e844cbf0bbef1c5c28509225ca75b24e771f5a8bb81c1c1cfcce15c89c64d7e49242d3a43911afedd72b52d1b68d6356cd85d4308e01004699e09a7ed423fb45792672c62fa7ef95e0aeada87caf12b2fba96526f88dff5af0ccfdd4aca2a3388e464d935622a7e5f3bfbf7ed5b6a3d874370d2b207dabe88b5ad2cbba6d6174c9f4557d9166a29a5e9ac629afcb72044d4379deb671d41b21c46549a775cdf2513c89e31b22dd46dec10c70edf4946682e7b7ac86a3907cfa931065d3768b780a48387dfbf6e5b3c6b7354d7aaa659d692bbe37742e14d3422e60068f9f936b7aeb80a6450ea753d83a0a9802c28171a86885dfb78f2c84849a330d9a2aad527843332173054452b8a6738d441857546a26783c9d8290399509bca0fab82c81f01c7fbe4660d01b52d64453d057140a566a2a4d3f9e305c2ceccc8029ecc3a1592d95900a44d191c2d5455128aa551a37cd01e009ff412e4afa9217223d15857e464baa117bd329e037e4a6210719eca0123734072de099ebacb9662532b830fd922a2d24cd936593e0e50224d5b5e434c77583dd309e95754e158e4659ac2c6959b508d38b39dd7148ca9c08347154d5880b00b5e059fa5bade9c738f2077176fec4a3bea773e90ae15d628abc08f6226837d31ac8bda21fb66f2893148f9fecd8f79528d11c78a9c545cdb39dc4c76e34a4693a8cff093cd9ce471347f69c77e99c3b8a23cf310ac41a9d13c10b76991ee7f90b21ab63ce8526a82816964e47dc2204e6a825a240f4785250119d5da1341cc4505968dea94b622629509a480d44c32194ec9aa2163dfbe9568ead48c7c50e094c1c87e34c7f0467b3d313fbd72eec9667ffc21575e2545869c9f865e2597eb2b2c073d33c81b1a4aa2ef5700827a08526e51bf141e172df7d9b009512ff1372827ae284669b8f6650a4ae65ec8465399ac4112bcc984733e0acc4a9fea8392b1338383424e2a88de3c8da19389ac153330f770c3fc25333cb75ce606c9a0fe070024f3aa1208188f21c27bb91df049dacf0ad3f4249b987f0a44f39ec310491779e6f9a834bad4f40b95841cf80cce794e75ef28987d9995ded88f2fc3c4dd34987fc6ea091f738243d4950764ef7bd6176d310c177403390025badc1df07a4e81436a3d4d2ea207967b82690899a6b38c1ffff26727a27fc72fa519ff439da01e9e9d49803e71b15dc9092e504edb4d17302d774810872005ffa4be7e3edc11ae0be33a0861970fa6185b35f0c91f168e46492c06834f97e9b8a8c463d35b937d55ba1db61d7caee77b39b60113c4f8f487fd8cb53de7f355604ebc00feb2a169ce00c51850169fa520b325ece9b20ad6879b033383834a42d841ecd2c8a5e094e0d47e1c80d7ae6e4dbd7c7251f0184bc46360d2d15bd67278380427839c3e448144c2aed0879d783beedcb7b9fefbedd4ba1ffbcfff9c2de87716d9ccc64dda5f8ae25e6bf1abf1212fdda1dca77df3e4097f23f87720f0ea5a7605fa1570917bc933b31699ef526a14a3abbff81e92b8394b96415910bc450023f4bf94ae8e7a2e63e4f961411c705a7fbe8e2463d6c1a49f82585ff2b182d8d350c12db1391d3e7d8ae5a2cb7e0ea765cfada32f80b5d1ccb4b842a9811b7f5869d2f8421f9c7628e7ae76462fec041db1add35c218aab87db52cd2aa4e7f15d9f5781247392da804d3f49697b6318e18eeb2480bc6f3f197dafefa3e0d9a19fce01220070683fbe0c0439015a94b90cfd87968e28ff3dcf90d8723039997a698f49cb0d29657c81ac814a97a4803fa9129ad0643ea38cf379bf6bd0eb033d603c727802a755c14344363ebdcc5fe20283e0704ecced355acdf8601f871b6e1f89fae9d1fa2208ebaf35fc6095d93177a1819e07702872154dece111420e9bc2419dd687a0c2a32c18b92651a3251d61557c9aa554241d06aae17e8a9fbe81b8c1fcbcc3d402859f29ba6a9f5de9f0356d7747162e58128691a2d7e151fa83c21152d4f88a2d0a3b8048832ba8ebec3f339e90a1fac8025591f07f861aeb99b96ec5cd4cd580f11561166dc381a97361ea42f58bf6689351118271ecdc0aacd12956e3b45ead955633fdc1f68126c1643e25db5d4c8852f785a9bc6f4150c661d7fc32604a5d7285aead7193b8799e33810dd8a4639e9edd882091409a8f5ca39baf07a6e43462cd12b4d16ca8fd92a705f61be83c03fbb448d1c1d837bcbd18402f763e97eaacbeb35c7a8be9067748b6f336dfba42617989c9dbae4e84ec9cdbdb84c874827ac0e8f7e3f88461cf26eb795d88dda3d8cab83f5aa815b73a1066751bb8d4bb795b32376fe00f81ceaf3d54a45c075eea834bf35a6676b2830380bb197349bac43db627cc0341abbd006ba50688f5801b9ddac500f2d85f1467d98727e8e40e5af90043d5dcb83e2e83e1975a7744bac0eb3ad3b699ab9645cc3e89f7cd4b6e1b6da9d1141b45c79e39035463a853f63e7fbc735e16c98f96d77e6c27daf78cfd7e871d0f5960b6309fe76fafb2ba8a8bcc4eb28ecd2e2a15b0cb385afc360589156647e667397737cc093be211f7ea34a61a5fa3f63225c85d3728745cbf9bc64f69907814ccc17092881a54cc64df1d341a3a4e486aa101e35cfaed0c0e4716486506363066da5691f98a9ea3276bf93193c0955ccdf00bc4b9665dd9d4102921a1b82093c7684b757797d06d7ee694157ac815fac3307be21b4073bec195e52191535af80769985e091d0760b616d58a9fa4b483a64912194f7322696e38dd6e4a119138c27feea01c23ab2b7f99378999186517487c25027261b5de786e01b7384ded46f0e8ffa57739d02edd01fcc56dfd8f771c334a890a2f23a041724bb469d727c24f01424de5891eefc0be74cb9e87ce960dc3bbefeab807f4b9abbc4902d5b0ddcfccebdc726cbec8a32fdad3a093cda1e8de2c6a2086f5819af691c85d51427a538daaa041ecdfe3340f3a0147412472bb00fee2d9e868ae04a3cd3a99ba9c08ddae3367a307c1dbdf1e77eced0c798a6b25adeb79ab5ffc0267fe1ba2b1c70e5bafd8a0bbbee52bb3bd4011ae08fe128c83d766635582ab182751c3c7eec1ad297f639af0397636d0552eecf5099376d607c11a8017af1b35f51f4159f559d962b956bcbae411f55ae6ce2ca361e141dc3ae21d40057b341b4a6a725cbe8a9466179402580b81db304dea3719ac08510bd832aa952764b63872133c1dec138f84e702a9505c968e314dfcdde85967f90b2ee7831578896b0e1fc732ef5bebf143e9209cb554e36cbe473bef25ec59f927bb262bac3f728dd0377f724c78dc36709e6adcb607311be59d843fb1d17debc842f59ee644d82270e1bde9dac3ea375ab3f9ac168e4812c82d718cb7710c75ab0b11dde4f2e3e7d7233ba386b358f282a9dfe8c2c14e391a570d4657d6eeaffab911745a00b777c421588e0b617bfc1900da47b04fec483ab259d7e70d78d5f0e0821dc958c3b1ccb9aa2f92406ac1770455ca067ae5bf11f74507c6514dcd6a0ce547322addfd3579449c825bba112dfaed674f86dc4b27e4d12b8185c4358b20267e7de227893e50caab3179d410de6382866d53c01718d7875fb31c6418d87d910d2998eddeed0ceb8e8664cbe8747e21a3e7d82ac9a23d6fbd82e48a9a847aa6f44a82c3761ae69dd9162f921b44a8ce7f463ff19dd2db9e8c1e17a0a3af4ecbe68fa05abe1e7ad9136d63e9b2da1b273cc5f6ba8ec8e9985c838388cdbf8df0300fb02caa49c370000",
+		"8f87caa6465fd125638d1cbe5bd544c9": "1f8b08000000000000ff8c935f6bdb3014c5dff5290eed4b0bb5f73eb64169d9186c744b5b189452df58d78ea8aceb4972b32ef8bb0fff4b93362379557efa9de37b95bb5caa8a5dbc7f8f0f9f7072b33001268050b2634f91350a6319b5650a0cd6262248e373867148df45ae6a4b91c3a97aa53ab716956853989ca21187a5b11673869510cff02c0d16f4c498333b2cc93bd66f1ca74a1d1fe362767b89cbf32b7c6e5cdea982ca4a76d9609c8a4293a09808980271c1c8926402124d92751f575308ac1105bd26c5cd82918b66e4e4ba867913a254e62f6b2c4d5c4c224df2b1771496caee7ae0d8ffe6a862c810d8b5a8297fa49253a52e36ac530fdd4d2e2e0585a527697c38c3f5cf6fbf307f86e6821a1b414ee3cbd5ecfb4b7c1fd97d8cf82a534aad2b8f5623eeecd53c5e6651885709ee661cbde127e34a78cec5eb30f86b2a8d2bef4f8e4780931f54b24e660374ba7197410835e7dd5647c9e6bde1a4bf70e1996287afa9e16493b9adf52b6638d9642ed9f236339cac99ee85acdbf5c5311657599695a256abe991e248933c84dff6cf43c991ac4d4b496355db23a46dfb5fb0ee76b9456659b69d3b94d913b8db310e6abf81b4de36eca49a3ab08f0780f3c63ebe514ea5c6cdec2fd5f4e0017135c57cb13b6c5cf1fe30dd836f25ab55d2fddfd31b9a5bfeea0a49afa58883b66dc74d8528fe90103f90bb53d8e9b655ff06003ccbbada35050000",
+		"99c1af5d6348918cca760407fb39dd93": "1f8b08000000000000ffb457cd6e1bb7133ffff514cc023676f3df50b9e4100539388a9d04b11dd74ad002ae11d0dcd935612eb925676da98280be436fbdf4dae7ea13f4110a7eacb492251771510481a5e16fbe7f33a41ac66f5805643ea763ad4a51d177a6e167417aca6a582c06035137da20490784109270ad10a698c46f66d6a01ea2b4eb82e98be72fa3a4ac3bb0d043a15b14327e97ba8a9f147418dd1932ad4251c3b080abb64a0641389fd382e9a3efce4e178b889bcf69ad0b909d30482b81d7ed15e5ba1e56a6e1cf806b3bb308f5b0d2cfbca4164521e18e1948be5d65c85abc7e8c9ed45525543594ba32ad7d8c05035cdf82993d461759b5c5a715a66d2ca88da0b4ae24d04a4ba62aaa4de5637bf070c875010feb0fb98102140a26ff016891a1ab5036180cca567162c0d1f0d0186dd21aac75bcb56884aa7202c6b8ffda6464eedd0f87c4824233a363d6606be070caa141a1555ad648bd95324df6ec88ecdd26398906bda52cf326a4aee899110acb34f9ebf7dffe207b96fcf9cbaf5bf083450c31b5e4e904cc2d988c1cb4787dd42afee9168c1105a41ca7240e8f1b36374439295b294f00af75e1a62de69391f41e70333b26a5be83e22ba8a2d142a125a3d7a466cd45307179a5b55c82ff970caf18bf0155d037e1ef706c80217cb160921121044d0b79075f0cba4fa20c8e72a26f9c837b5e2fd613b87c15f0647fdf69ac023080ad5184e334274ac84ebef01f360f5d3d63451ba6043f0f944f1b221482291987f92273a5d726fae893c33578d2c4ce9d3903e49a350d28284664cf26396932efe87e9f035ab79c9b1e781d96261364fc060de3404aa3eb10e488fca812f2ffd8c2d4af2dea91699665fd3c03b33b0efa99a11f5c628ac99c24ddc7905fb262d779ab02b9d28e07dcef6cd79863cd8ab0c1d3e0cb7aa43b3a85bba07664741d314131200b28c1443c1d4b60aa6dd2ac4f8180a6133f506f27a7e4c96b92243106d7dd386a1f94c0b41b3b2940e1273f7276fed6aad13d338b6c83706e414c9671bb6f74197c5ab31b0887d1689704a53418ea6e82b5eb8b9e43252c8289c48fd656aef2987acc580aeb97808b4001d263a7acd2047993e45d0a41785014267816a55779f2dab12ad6a5e3cc114326cb342999905010d4447aedb878dcbef1f8c5609d66659ac45a586406a1205a05f63e1c44ac5cd0a5fe4f2a85cd5e7d4b88be24f723ec98b8ab17240803c9327271e9ca4cfbc8e8190d53d64dec78751d2ccb5e017ede72def5fb1115af0049efe2d95dfa2a506f3fdc84f4d84b56d63fb5388a1fb5a5132c748bf9f2f4489b9a21821911057769b4f119a6b83cc956e8f75adfd860cd95b3831fc32d487fd6c37ae1a84bd0c1debaf5e2c5794c617578e8e6cba511b1a770e745ce45b5247a7c47c4063a7868d7d7ee8141c3d12afbf5d3ef055ec7c53c7bcf5421dd82ee6deb187e08ac55cccc020ffc7a7377b1363daff4cb56c486738e53f784d9014ed7302ebe2301b2389cba557def7cac0b7807ea1c7e6ac1e23a32ebd5de2bc54aeef0db2bfba6a27b27ee0ad7dd40f98eeaee50596f9adb7c5d95fb17cc96b95b2f243d61d373e0b727b69a889f214e96939e84779093be9921d88d001d6402aaf8764537c8367dba6df037a05f570f56dfc45e29c6d74ca874079956d5d86569820658dd37b5842f553a7af4b1fd0efc271cdbe4d92ee7db89b6d4f664dba5bbceb6fb8cdba5b78b72ee5f966f5c0c3b5777b97937a44f7b0b996e53da78f33600660c0697d7044a4bddb3e78717cf5f7e84d9191326e56515691fa0abef1f61f6f0cd11674709e93df4c78ab335c7e1372c3d07561c0909dee9f8c041fea58733ada5cbccfd78762f9f4e96661b207ae09eb3853bb7ee557776789286200312b933d32ff029dc7d3e9ea4fbae68a105abb89c9e2805670876442e2e3d66259b77955fac1a1f5e77e3033b5a0f2cf26179c5f884f791e7440939580cfe1e00e07f4bfb70100000",
+		"a9dfe68e857a66531d16b0826b724fe5": "1f8b08000000000000ff8c54df6fe3360c7eb6ff0aaee8433c38ea060c7bc89001457a0704fb75b8ee9e86a1502c3aa7c5963c495e1308fadf074a729cb42b760f412c91df47f22345ef05b65221dc08ae9ff6daf44fe360d138b6d7ccf543771342e9fd126e15ef11566b70fa67fd8c66c37bec36dc22b04767c6c6fdca7b0ca1bcbb834f91c07bd68eaa791cdb561e4300698103dd38a915380d5c08e060a5da7708061b6d045d7bcf7ee7bb0e131f38fa06a9c07d46f09e3d70c777dc4e66918f356803e320b8c3e899f99ea5fb1ccf96b26fb46a3bd938687437f6ca52b20337bcb7b09c8d4bf8cb6a0554af05dd467806d091c3a8e4df6364b3ce70a95c1d7d06237b6e4e70c013c816b01fdc8922a031dac012467550fa59bdcca28677c66c1549f69ecb0e45492251a982eb8fd8fc13c27f0aba68dc91b81c1e1ddba4ff7ad2f16bef59af05761f7873e0fbac16f3feaa59f59c0b63cc3a23d5be8285413b76ee8b393eea677bdfb6d838142095fbfebb1ad018fa6953812f8b039e3659c0d51abc8fb314c28724d84f78b265215be8502da6842af811be212c19667c625e9ff3ced767583db36753f543847cb50625bb48581874a35174ae61f96da42c8b229445284bef97d43c6d204de156b59add8f423ab631c8a9c40bc3a36edd0376e868f2cbe2ee0eb8023c4aeba4da4fbd38200e16a4b3e0bd6cdfe40da1c90138859b66d47b54228408e54abc097f2bad084ac167a2377cad6e1d8878c8e133a82cd2d3ca9ad21af8e3cf342f1e4832c3d51ee1b695d809b25e04d86881efe9de521524aed20e16da647796487f41c7d9d6ce33018bdb0b9624d5d64ed5266c75edb4b5733593470521dc78ff3a567a1237f52c0c2a01cb10209485569b3c51544dd3f1d122fbed7ce91ff4a728885d4dc67b6be55ef5a85c166981c70687f3e94ac0fa62a4ab2a2d58ec6c9aa2ff8b9d22df77dd0a9c1931a3a9b765d16a034f756e1ec15363e668f105cc11b22016d6c087019558bcb6d5530ec9d79370ab1c225497afe655bfa6be97652176940e6db51d257a7ea6b1b1b4cb6a103bf62079878dd386519445352db43a965a459a35396e624af622dd2abf84454254d7a25e85ff627412959679dc3a62c7ded1527bb5525e6e94971b9d349ad6ce5490d8b1cbcd5913ba0ca5f7a84408e5bf0300684da23099070000",
+		"aada6cb25c03f95af1045a0b40b8d157": "1f8b08000000000000ff9454df6fdb36107e16ff8a9b1f0a6995d56628fa10c003d6a4d9c3b22e73860d435114947852b852a44352b13341fffb70245dd9f9b16140028be4f1beefbefb78e328b0951a6121b8f9ec6ed5ee73877ec33bac3a53f97ea316d3c45ebd821fd15ff10ec7b16a07dd5c0f6d2b77d304d20107daf1d268f0063af4c081128069c16263acc85d01ad353d8c63f51baf157ee03d4e1378fa06a9c1df209d9d73cf6beef6c7222d612bfd0d7cc17b879e324bcd098d586db8e5bd8325348375c602c01234eefc7e6dd10f56a380fa3e806c2cde4933384a832560bff1f7d01a1b0e5b695d00c0a3d4b4e1e4df48a987be463b17e6883c7f7cc5588144868899bee7e090ce3c0af8cb190d9af7e8280dc13a633d34460dbd762508740d6a217507b2858dc556ee22ff6509fde03cd418d8460cd33eaeeb884a2b950f5c888a1692947340bdbac119554b1564e04aed4b3bcad29841fb58d0197d7e301acbf8f97ec71b0fc6a695f3b2e73e2888d61a3bf726fc11e7b05f82a8e1426a11978c3c441e10dcacb1b99ba6a70d97377e478578dcf9ea2cfe967b04e7add45d39774c6afff64d9960f7a749916fc7b1baf676687cb4db45d82e53a9a1989f8dc002728b6e50dec1c74f74a73702d5156fbef02e19b57a90a90c163c3be6e48de76a6db664195f52d1f46f6c0123cb440da7ab507d3d4d2c13922b6c3ced9dc7cf0b63735157e756dea125d4bc2818cbe29b88d94e57a071fb80ca4f21e0e1d5a4c85eb78265b20d39be5905278c2ccbe2cba165098b4509cb9300c3b289b12c4af8c70d5adcebf983ed1c118eab6a4b678f296761bf049ea2630129fa99ac05cbdcada2dcd7485a5cff7a9927854a581c8f9445092157bab38235d6528b393e7d5457718c60ee6ed5cb05fcb23e7fbf86777fc2e265a214147a774f62bd9e1d1554972d5c9aaec323bde20ed9b30477ab8a281489ba025157917a326c8c7a917c15e2a3245555fdff66c8f62b3df81e5ec38b1764b1b76f72857aefdda280d56a0ea3062bee82c352c4c7c3e8e5c9279665b38b43ebe16bbba26d727a97791c21c9e60521a36d7983e304631abf308ede5c9a2dda33dea33aa3917ee4d2dfb91a302742651a49054c05cb9e50e27929b229ca313fb4152c4f58e6b6d23737e9598f2c6b087e9e5ca72c9bafeceb5c9b6d88888d12f5533e7bc6adb185072069203e89b33ffc6fbce0a7e79d911a7738794a587e77e0927f0b3ce0a5a5621363e3885a4c13fb6700cb168aec1f080000",
+		"ac415658ebc1011b86081fd0c63736bf": "1f8b08000000000000ffb455416fdc36133d4bbf623ee3432065652687a2073b2ae0ac93c2801b34768a1e0cc3a0c4d12e612d699394bd2ec1ff5e0c25ed6a6d37b503f4b0586948bd79f3e60de9bdc0462a843dc1f595bd6dd75755d75e7321d84233b7ba69f74248dfbd838f5d7b7d2484f7ace9547dde358d5c8700d202078a38a915380d5c0830586b232cbd7acfbef1aac52f7c852180a367900adc12c17b76cc1dafb81d97c5f00af7d22d61d5b54e82d1f7209545e32ce8062aeeeae5b9fc0bc72c44ee861bbeb2b03f59dd07d5ad2a34f4cdc8a7874101d50320af97601d77b842e50a38c68677adfbb801900d28ede0465be9e41d529a6f4b84052a34dca1806b7cb0c00d82450754fc72c3895ec75a2c18749d51522de2960d09a3ef2d833f2cc29fd22dbfad47f578db8236a0b442223f816544028dd106f64154433d7d24a52690a482eb33acef4278be6359edd6506be570edd8bcff2f36bc2f2edf7acf565a60fb3bafaff962e80cf39e9d3bd3d5ae7f2f264a4be572c80cdaae75af4138d3f7f6a869b02631a5723fff545029f4d326079f26a2828332d65485902642f2166b47b1e3fef1b33699a8d8b19177680837cbf3344de4d4261f4a784f60c936543ee9769a84344d6add762b6529c1c5a57546aa8507eff7c170b540f87f23b115b4da7bfa44359acdb5c0cf14b721783fbaa6dfcae611f037749c9dd8a3cee913559b68380861cffba7dba88810f60af01e9520485402f6438090268d3664591325787f383c7f8016553674301fa3b372a201954f3807e5b8ba5d4c13d28b567fd9018a9a25142f77e2699284514de231c42f22f0012a7199a649c2cd22eab8e2d7985d5c4ae5d034bc461f0a785f44c0c8207f4b8f83f0799e26b1c8abd19104d18b1f77834fa91db29976e0a813d2b1b10744da7ba74ff53d9a395f613ba70365c77bf10b1a84021eb967cc5b80331de6315bec03c1c69a4ae03737a844466fc57fe78e8108db98e4571d4b1ca6e7b13d48b990be9acd4b98503b6503c3f4b1536edd493c784e8eb31cca12a691b3cd5917ed636f5b52e40c2ba944364014f132e9bf38ff7aba0dd3444caf8bbd0206674c1d536c87f37b2314a29bc8dba77ab14003ff2b41c9b6b775d2c77a0fd8db366ea5232049e858ee0fa28392ecf1b543f3301c949bfd0550fb1963630e348f1298280405764fba08bdcd466e97c3385366f685d2e4f0e60d48f830a9fa10e46c36600ff94aba1b2d3bafb9cadec45d17f2f21f0c931f3ea5184b65f3565bccf2347911eb38fb49325d9bcdd231be03983e61fac9982c3ffc21ad9224a14b4baa0e374edf4e406fd2579bed255e53b28d467ade47cfd8285211d559bc0fa746fab4c6fabb3e92cd1369fe4d9998ec9163fbcc6cba3dfb61f8dd56c3acf75c7f5d0e5f0f17c0630425db34a4dea31221a47f0f00bd64325d680a0000",
+		"b72788ee7c008da58642883b8a609eaa": "1f8b08000000000000ffbc55c16edc3610bdf32b06b10fb1b0917a2c8cb68063bb6e80a409765de41004582e399226a648951c5a7154fd7b414af2c26d0a0428dad34ae4ec9b376fde8c3e28d77568f9e339fcf0133cbf6d29000590d0a0452f1935d464107a833220a02686e0a2570864a1ac18bbde48c67026fe0275610c744e534d4a32390b0319030704e3026fe0c14568e53dc201d1c220bd45fd378c33717272023bd9f506e172fbdb155cbc7b05b5f3c02dc23896e17773fbd0e33481962c0f89e27c7ae9acddb19f26214e4ee0fa738610b72d42efdd27543c57b9bddeddd6d180ec29c34aa53004b2cdb727287386770beacf6430e43c4701d78c5900e52c4bb219bf76c6b82165534e2344ab71ae6c5f563853de83268f8a9d7f2845016fe41da67e08800262c0c47d3dcb05900d2c8d4998ec9c09708864747a5d5920ab125e851011f69dbcc33db0034da137f2015a34bd28a06c88a9b1cecf891a62985f738ec6ad60a280c6959dd373984b0d8f062120c77e03bd0c01f62f5ecca77ba88d6c324240e655e495d7f2578db58c8661bf08502ad73d8a210ad85e5f5cbdb92ebb39e52abb47a93b1405c8beaf02fa7bf45527c9968dcb718b856ec8c22edf6e60206e210cb269d00359629056c3e2bf90a1a82a56806414503ea608cbde19833e0569e98e4157176fa18e5625bb87a4d13d65e9b373676b65b117278922e985e608b0631f1507f0d87b0c68b34812bc1bb26c28557bf421cb834191bd96d9250fcfa5436432f4054336532abaf6b2c3c1f9bb0ddcbcddbe017d48e5e58a77c3d29423df5515ed544cd398e75714f0e186ecc7e72d731fceabaa216ee321f7a721fba2719654d5903d4b9109b5715f0dcee82effe4d01be7bbaf067e22fba58d55e37c779687ece5e265b1dfefcb830cad480686c51de954bccf33e6513282840359e91f605f5607b2471725ac6db4f609d493988c9597611e5a23a3556d5673c0c3aab2b3308ea7e5fcf68b0b3c4de348355884f5f49df30cdf7f374de7c7c8749622d1ea653d65b1d0e756aedaa73c91d6753177f2493f1ed760526ea1b481646313dc623e0c202d9065f45231dda7a5cde86ba93019113fa3571430c3ac7eb8271cc063888643298eb477aac50ea7e9bcaafe75d5d552654556e3e7b2e5ce6421b28fa33721175723ab36f931595e889ca54ccff3072824382f6d8370aa039cfff84f9779507e951d6ee0342dda57b67629fc5487f236dda583304da280ffa8dc717c4c5c6e5de4cc265d035a0d4f1ed004fc06ee25ffefc4e7e594b8c01fc0eeb51bd07fad8627dfc39bc7afe015b22413c43876d2df6937d84ba7f1a571ea0e9ee5697e06cfcb4b676b6acacb4ebf268befbdec7bd46709741cab4214d5340921841042883f0700c6601faebc080000",
+		"b73c8e334efa711338911cf5a56d47cf": "1f8b08000000000000ffbc55d16fdbb6137e96fe8afb193f0cd2cab07d18f6d0c103026729baa5596ab72f2b8a82124f2a178a74482a8941f07f1f48caa9ed79ab1fb63d1816ef4e77df7d77fce43dc74e28841967fa93bd938f9fd6ccb59f69afa91bd6721642f9fc39dc449bf7b41b55bb1abb4e3c8600c202836871422b701ac635670ec17d4668b51c07654177c0e0e7d5afd730a0e911527202b7b8410ecd067eb75a816203921c6a85ea2582c1561b0e9dd103784fdfb146e2351b300470f119844a65bca717ccb186d9ad9b4fc788fa37341aee991cd10233080f4638870a98e2a04629c1a28b3d64b0b183ebf75757b4f4fe0c4407b9ec6bd569bad29dbb40890e331ff10c3c19f804369750da4d347002161196689d3678c05dae818ae774688c367006a3ba55fa411130c838682537a04dca19e146349940e8044a4e80375bce538664b8148ae763196713e9e34c2fb1bd0fe1d818abd63d42ab95c3474717f99f80f786a91ee1ffa912bc9cefb2b1d01c2fa3dd8600de47ae721cbd31626066f30b6ece4d1f270229e2afbcbbce573aa57cb7596308c4fb444efa83b31072db035b7fb0ce08d57f8c8b4397ece10d5acb7aaca1326847e9e05befe9a039ca1bd6deb27eda0bea3d5d3933b62e9f092cf5833def3a6c1d7210ca7dff1d89b4c59f3635f8b29876980033bdcdce9773f0dee92bfd8066c106940b6611f632278eab69cfbf39098c0f7559882e55f8df1c9490b17c61d08d46c5238117a97e598432454a54d504af861fe1458ae74d9c539c7713423c0b26b175d178911f2fb5a978432f8cb8471389a8eafac8b69f8f5c38ba9d705916058b96c5131ff1746e7a7b0a1f29595c320207a54fa78740c7a4c5ba2cf687027360eb352a5eb57bd826a494d29a6c2362fc0ef4e8dbb9846551dce2667a2fb6f5e1635e340f31e8c4cbf0d5bb307b5af75cea0d3a46a32f841981c3a587f035292a0a7b2723a0253642f16a1a3981f74916566fafbe9866fb423a235b9126f0a5f5fad90cceaf2f60f66c7a8fbe1db5c3eae9e5030c47fa88295eaf9294ce268aa5fd77c01e4c507470a5fb1ef76e51914d7903ed9d8c4b94a2f717289ec83f3aebbfd5bd3f095c84c59b65d2b027ade10dfde911db49959f5ac8cb9f36b838a21b4785a3d832b4ab7bc90773d816a6bbceaafee1d4cc29b9d9c13e9f3e3b0b266508afd01df9e890ffea1b738ceb53d5766bde36b7cf9e12b20ca5f7a87808e51f030047a900994c090000",
+		"b957c1754c2848f071605e4d6c214140": "1f8b08000000000000ffd4565f6b2337107fdf4f315d4ab1af1b8542e9438a29c1b994d05cb8c639fa18e4d5ac23224b46d2267185be7b1969d7ddb89bc429a5700f66ad99d1fcf9fd46238520b0911aa114dcdcae8c5ddfb61bc13db295617ebd51658cc5f1317c49c21058d3ea7ad1368d7c8a11a4030e24f1d268f006f25ee0e0a45e29048bb5b1021a6bd61002bbe14b85577c8d3182a7ff2035f83b24dd19f77cc95daf16dd9282a3b5c6c2117cb4f6caf873d36a518158eebc1b0b5280361e1ad215211c816c2087bbd08d61a7ad909e9d4b54c2e5826eee103849a136aa5d6b07dc2238f4554aa8b6c83d8a3d937bc40da9a58507ae5a742cc5422d621c89ba308d3f43851e734c5a834802d1259fc352ea193a015c8b1d547b5ed83ce5f1093d671d4c949103e95d4ea802870839e63e59e4f81a9d37765f35ace239dc99f6732e1566c8d7e879e2066ab3d9429334602c88255bf007849a2bd5890b8a41b5086eaeb17e8871b48b26b57f82da688f4f9ecdf3b782102cd72b846f1b220d4e664360e746604f268440b0673bf6d9ca35b7dbdf707b6a578411248b97b443e5af26b9bcd96e30c62a848447fac0518c3d3d1f42606b23507de6f53d5f75edca42600b6fdbdae7f51426165dabfc81f6155c9b4777da34585310a9fd4f3f564404fd8c9d42280a00e87ccee0bb83bc86379b1280483d992596963bc18ce8fce30e2d4e7e6f8dc70b81dacb46a29d88253b935c61ed8d4d4d389956501edcb0e5f4fb122e1670f5e5f2b29cb273699defa0aafe4bcacb1dadff3c3333f8a5ac427869fb08f9d384232a3786d9575604154634cb867a2b53fd919aece7b4fe66065a2a0864923aceb75693a882a31faae108268b5824645e29394fde791ea7397208de5c9a47b473be4635e70ee1f911ec4a3c99750dcf421833387cf68e071db57e258703ec07293dc3786e36dbbe43fa493f3d14f0e11026abf82f307f1548981dcac9bb207f0f70e329bc0ff137affd17da603832d30eba912a189d743d870d570e8727ea2d3c8884e3635820399c941fca293c5ae9d1d17b02fe446bba270528798f407769b57b23b8e1c3c1495d63daa58cb96f37fb2f88dd0cefe7d327ba28baee9bfe2f637d5025cbddebfaf8c349bacbb47b3c3cb7396c50bdefe0147f1bf64c8a251bdebd159dc6221621a01631167f0d00b41a84f4210b0000",
+		"bfaffea277618f8ba2d318933f3b4c2a": "1f8b08000000000000ffbc534d6fd43c103ec7bf62dee815da85d4bd83f680da6e5551aac21671ac9c789c5a249eca99a05d8dfcdf919314ad68119c38acbcf3e9e72316b1e87c4028ada1fb96627fdf22eb9634f78f5d99923a3d854b6411edc6d0ec46e7fc3e25f00318c819f61480095a643030f8d07608111b8a165ca41ef8014144df99bac31bd3634ac0f93ff8f0543b376c6a333c95ed122a9113f00ee6d9abe048efc8f13976c838231bc831d82961975b073011211043441e63403bedc160e7118c91229cc0458c37c45b1a83adc0d6b0f5c1ce459579655cd6d0676cbea7f45c8055c37b682830ee599fcd6725124d6811fe771e3b0b6f37c7d0cfc8e236e787944024139bfbf46df4bd89870f78781fdbac014c1dbfab1e172f695a797778c4942a9189e574c0494a6b582d4ebc16d13d59ec6e4df3cdb48bd25a44ef388e0dcf7195f9e71fc535882a96e10dbcfaab71497f72acb07556254b5b2fe1066cadbf3e60c4d5a79118af2c06f6ce635cd95a9f7bd361c3147546b85a57508abcb85d9f5137f6e123b2997a532ad76f4ab8dac1cd97ebeb72ad0aef32b5f9c2ad8f032fe254136aec8609e1cfae05e44bad5964f857663f77555f6487de4d38ffdb40f05d360b0016e4475fb62a8af9152c8f6372581549a95ff3c1772a29110c3625f56300402ae8e717040000",
+		"c246b1d07f818c6d4803eec9bc8d086c": "1f8b08000000000000ff9452c16edb38103d8b5f31abc342dac8ca6eb0d8c3023e6c627b2f4692dae9a1288a8012470a5b8ab4492a712ae8df8ba1e438765aa03560889c19be37f3de749dc04a6a84587073efb66a775fa3e74ae5b5c97db35171dfb3f373f81ffd7f4a755d5eb5ba5cb75525777d0fd201078a7869347803357ae0e0942c114c05164b6345e252a8ac69a0ebf23b5e28bce60df63d783a83d4e01f907233ee79c1dd3e2dc62bd16fb8e58d83096c788d40bff16871dba2f328201158f15679477dfc99be79e5e4578409e8b629d01e9a73d4001f718f302e4e408c156807ead2340d07879423eecfce68d0bc4147c0348e33d6436954db6897814057a21652d7202bd858ace40e0514cf3039a2a8a4f2818328b49024ab0392f6010f685a2aa88c05aed47e0842416b8d8509ccadbd367e615a2d3210052ca41643929155a4b4e06685e563df7fd7d6a4f43be2f7b8f3f9d5f0cd8242d94149a9fd3f7f67a328ce5ba9eb6cdfff1f5d97afbd6d4b3f58b908e114128b2e38f4f113953446a0bae5e5175e8f9ee7270f33f0c673b5324f6493cf680cfa1b9b42c72251c0bfd3304fd1f72c12922b2c3dc566c371616c228a7c66e5235aa248d294b1e8e9012d66c06deda878e83a0fd1b7e591db2aaa5a2321aedf2d93912783f878a1e30c020651045d2e9f878e87265f0f7633a44fd9463d5316c92abcfc6d1adcee581459f4add574cd60f257006651cf42e9c806d329c43129b3e78729c4c97abe9c5fddc1f5fbe5328de94d18690a2b2ca4168771c6437ecb6ba9b9c7c46dd5590c37abd97c05971f203e1b514f9721882a2b589abac6a3a687082d54066eab526267114d360551e483a6e38a0d55bf8f3b12ea078ff23cff35454aede145f99579ba32ad1ee145f143db7e8aeca5bbc9c52bc2d36439ee2aeb19eb3ad4a2efd9b701008e1de7206a050000",
+		"c631c7727f22a2e0af93ddf632062d11": "1f8b08000000000000ff8c534d6fdb38103d9bbf62e243200532932c167bd8c00bece6632fbb719114e8a128025a1c296c29d225478d5381ffbd18498e1db7496bc090c49979efcd9b61d769ac8c43986ae5ef6a1f9abb1a49592b6b2fa959d9694ae2f818fe45fadbdaae9355ebcadbb6aacc3a25301114f00919ef803cd448a0205a5322f80a02963ee82ce65005df40d7c9b76a69f15a35981210bf837140f7c8b10b456aa9e226acc74fa65fa9a09a083358a91a817fe36bc0cf2d46420d99c64ab59622eb38c9bfab8ae62bc20c5cdb2c316cc54516a046dc6718bfed81f8a0310cd4a56f1a051139c6dc1fa377e054839181b99de80341e96ddbb85880c658a2d3c6d5602a5805accc1a352c1f61f68ca232967a0ea670dab0ad11d8da7bdca23963a1f20194b59b26180543f001667019c2b5a72bdf3a5d805ec295717a080a1e153bad95bfc1f24b4a3f1c6b56d29af909d724cf8767d13b546c9d348e8ad19248c1b8bad8a83fea3a794ba12d6918e4557f9c431630f6f379ff81531aafd1be51e527558f13977b85059027656ffc030f89fef8bde036f8ef430e9d10930171111af873def7b54c49fecfc0d9e12f517429171353c1c33d062c40853a32d4d089ec4f47d98bd0c80ba32c96e48364c1599e9f0d85703087e9143a31d95134876de13bceca7648a494b99824c1ebb44d3bf7ada3ecf0a9eb5cf4e2d873f80b4e7a025f5511894566fdf90c4e73387a9acb8b12167d5d3694e7f23fd318ca3655ac05d0467ca587fd0a969e8498f43bf0cfe3309b610cbb0e2f86f02b2e8e6b340c82410ee6fd8a0f52a80d8e3f0b989df61c6c1b678ebc3ff5be17908dd9dca87822da4de35b921d8e2b9acb4b5eb2b33d39dc329fcc77efd8cb2ac52630a2eeec737f8945125d874ea724be0d009994f62d87050000",
+		"c8065f16f9da35b025d004a2b023532b": "1f8b08000000000000ffc456516fdb36107eb67ec54de883b52a6c1f863d04f043603745302f68edf4691b0a4a3cb94468d221a9c406cbff3e50a46dd971da6ce8b20743e2f1c8bbfbbeef4e768e61c32542cea8fa6ceec4fa73bb62d422592862972b917b9fbd79039f3aa373a469653d6f9b86afbd076e8042b058ae245805f12c50305c2e0482c65a69068d564b708edcd04ae0355da2f760c33b7009f60b86bd09b5b4a266bbcdd2320447ad95863378a7f5b5b297aa95ac0456ed6e571a3803a92c34612f73ee0c780331dc956c14b96819b7e492a360261674f30581062bd44ab44b694035297d06542318b465975bad311a0fbc834b88b83b22e3318d944145eb5bd2a58192797f22a1b96aec04055a8ce98435b0cec0525da7633877f21632eef2fa1d2d2509416e3a481e34b7166509061162c8631ac3bd333456e9e3ad7e11874444415c522e3092b1444b3bd6a056ab0d34dd0e280dac22737a8f50532192390b3102e98caa19d6f7de9fd4d7b0b66ba895b4b8b6641c9f2538a7a95c20bc6a029d703eeae33a560cb734837301f5e8473e68bea47af31b6e2ef42240049dc753bbfdcdf7aabbf266b342ef4be73a3cba079c79bf65e767e7c85231141f687d4b1749c8c43932b7baad6d5c1730d4685a619fe95fc24c3d988ba6c13a04e1d2fefa4b1988083fa50b70d9805501850067e57d36609c0aac6db04de2eba5d243569189e6f7a8431ec3a2f87e970c9cb36aaa1e508fe912c5981a8483dcbaae0a249570747bb9d56c090d15068b9e8eb2c1b687ce47f0c75fc66a2e170e82c333898dbc06710f957e9adfe1ab47b55d9971eae678aa3874ba32fb8e4af71605789fefa4f0a8cdf2128e05013e1bdce266fc43aa7cb2ba7f91d577c6d0c0dc8980fa0c2b2ed930c9a84cb37ffe71ba37e587c33c2fb773b1847de5c5eb1c2eae2790bf4ee7c8c756591ce6cf9e6179b8e26a0ed79fa6d33c694898ff24d74385f206a66ab1400d3f8d407211ba6c102d51efe64e14d920b8b26ad6f5736ccaf3516885776bacd3bcdab9fff3c1f58202dfb62b399e7969083d12d34b0de147d3b6e8c8417dc08c46db6a199625bced8888dc68f5607abc44a2487fa00e8b0c007ee837e5db50c2e89bf53ab7d25c5ac8ff94b9f7fde24ffe8d88a427cabd3fc6e6eb570810c068046ffb40a5144bd80194f0ea297994becf632a84f7efd19ef83897ffa70c522ddb9cf7a5f4c7c4d305efdbdd67cea164de677f0f0000817c8b0e0b0000",
+		"c848c69d321a09c77c07e3de8dc3bb7f": "1f8b08000000000000ffb4556d6fdb3610fe6cfd8a9b51acf6a0c859d70f8387004bd306edd6765eec6c0386a160a893cc5626b923152763f9df0752f22b22cf1dda7c897dbcbbe7b997e7ec5c8e8590087da6c5bb5ae7cc6256aacc2e74d5f73e198de03a1a9dcb8a5af2695d14e2cefbd60a0c8c90658540c815e550905a8073d98cdd54f8962dd07bb0e1330809768ee1ed39b3ec8699d573de7e0d583f4eebc582d1fd3abd5c250ee131cf6ef218f41c0d27a1ad50f24bf19ab1d2049fa9a59adb2dec73ce515b80f746c9689890ca6b8eadc53962b244785408ac72189f41c3fe952c5476a172bc0c76e3bd73208ad62d9b90085df819efcfa96cc0626a466c01e05ca71f780f9ad9f996cff4d7d76f98d64296d974c9ca126976afa3a3a51aa1bff1bc5055bd906fd0b2accdd5770e651ec8c57fc90e899d5ec08dcaef4383162ac76ac2f80756b68dccf65d1bdc7650fb8fcdb8fbed32708ec6c093d35370eae63d72eb8fc388e1974c5435213cdd0b675aec06bf9ccd262f8814ed853dfd94b02b555b24183997c58f0d91cf377f07ce9d743ac089f7e077c7057feadafe156a423e57f0d8b999fa69facbdb6d0aafa4b14c728453ef1fc347985bab61723d0b7bf1283348b748533ec750cb7834da185f2a630392284022acac134516be3ff57ebcf10cb635a72fd79ef51a5fb20f18a40c7eaf1f7d803f4eceb538b93648e3da207dfbe4bb245cb576bc57c86fbd7ff0de0d96b135d9151aada4c1df4958a41408be69ed7fd7686c0ada44470a2b4059148b19824b7adcde85f10b29ac6095f8072f94b4786707344c8e6e4372b80fe07d92f49ceb7af73e05240a000f38c55e4f181924ef07daa4d03f94aa3f4c7aa288f9be3a0329aa50658fd0d624a32c06dcdea5b04c8122ea70fd9af47cb23b9ac87a4bc1f011ac7aad96814960fbf551aa777ecd687c06842c0feb3ea0143a930f7f38b200e7b29ca95df81744cf58dece78a7ba0400364c3ad1b3675828c229bbc5c13e13887fff9b0d003434bac127849a110e8647d3fd8d55228863d071851be97cfe4ad6e1e14b58f10dd915a55680cdce515cdded1ffb7e7a3ce54f59e3037b9bc2bbb8f770d61679855a759d978091f48ebe03f09f77000e4937dd535f77110f69fcc034a3cc0160cba7e9d232dccba8c695f701489f388732f73e49fe1d008c8323dea00a0000",
+		"ca0c83aeeb6b99eb363a06d34dee4cd6": "1f8b08000000000000ffa455518f9c36107ec6bf62b20f2ba858d2abaa3e5c44a57673d787a697e852b50f5114193cb06ec05ed926ec15f1dfab31e66037b93652a5930e3366e6fbbef9667618045652216c04d71f6a6dda0f35ba23af31ab75e6da63b31947f6fc39fc82ee0daf7118b2aa53e5dbaeaae4691c415ae0406f9cd40a9c861a1d70a004a02b30586a23629b4065740bc390fdce8b06ef788be3088e9e412a7007a4d84bee78c1ed1c16e108bd7407f8880f161d65968a53354275e486b716765076c66a03003b507872f3d9a0eb8c4201c5832f7234f849eace521a4c01dba37b804a1b1faca4b1be009ea5a61756fe8d94ba6b0b340b314be0f9e79f682390c01030ddb61c2c52cca180bfac56a0788b96d25059ab8d8352375dab6c0a026d894a485583ace068b092a709ff2e85b6b30e0af468a71abafa9cd719944a36ce6321284a4852ce02f5ea804b55251b2f036f9a99da59965277ca4d84f6f478a715a6d3e3cd89970eb40927eb64cb9d57108dd166e98dff23ccfe7d0a37c6dc6977ab3b251839881c20b8bec7f2d3387ed96e71e94e44c3e1c965fbe97f3ae7b7ce4855a74bbfa472692839c7821adf0c43f6d699ae7493d56efdeb34d0f4447ed30213880ddaae7116debda76f5a2db079c3cb8fbc0e26cd2e32a5de7efb73444e3bdedceb9eece27ef83e053441850406168902ae73cfbe1847164d469f2e5de7a0b0bfa8f1abbf108b227b297983a5d32623347112e8ce92242c9295cff32cf72d1e58144d2341c714369b147657be148b46c6a2499f3f0f687016eb27535bc2379db29e625faa9d30162d4473d85db1c8f6d2958720ebc0a292a67971cd358b221f7b6d5a2a218a8c646fe2ed57693d8c098b88e10a353ccb61b3215557a973981f337f297e826696659471645144a2ad3ef398e3ed23bf24bb21139f310ace27528ff7bcb490c31cbcd7fd944a14296cced7e126a11e7c4dc7be5b756c32e8ff105056d0932829f0d0e9c98059ff6f52252fa0bfd07b4192c3e373107c55611279644fdc7f4df31a0708decd3f3fc44992bd92ad74f13cdb097b146afdf1ad5422de86990d3d7a712967e8c86afbfcc750c86ad9293fc2b7b0dd42836a5e0d09e4f912a7020db78eec1c2ebc5b5fde5dbd6751b4ec88d92081f034b731edbc785ace618924b43ad054bcc4618421fcb0c13038fd4af768f6bcc5664fe375d6e13f78d3614c80d2b0ec1398a7e64296a7352057d27208170295f5a65bad38ff5bc246360ca8c438b27f06005ff54d3c61080000",
+		"e17ee6d3a56b7b8e2070cd55fbc2d882": "1f8b08000000000000ffb4595f6fdc36127f163fc554685a2990a5b4f77230ce873ab1e318e7da8ed7490b24414b4bd42e6b8a94496aedbd85befb61286a57fb2f59dcf91e125bc31f6786c3991f87744df37b3a6650512e09e155adb485880461aea4654f3624415856ee875063fc2199cd26d6d6f8bb32ddff99e16349057e9899c9a91021214138e676d2dca5b9aab2bf2ac5b5929979104f2109c65c8e1ee978cc340c5106652a1b7379e07e653a24c1d70199fff9960b66d6d0081b2bc973d4b8365668d5c862968d95aa6d480800c01f2bce144c72c3e4bdaab2b13aa88c7910c55db805385607e6411c149a4f99ceaa997910db6082df65f5c3b6918a5a2bd1887910dcb2bf858490e00f08e7f3b452452358db6685ca4d48e6f303e025a405b5d4a846e7ccb4ad936a2ac70cbe2f0c1c1ead8f07e17cfe7d61d2e3ebf3b7efaf2fdb365c884ea85a17fdaa0a267a21aa66b2f0469830cceb4b69cd8733d36255535a6d5743624272258d850f86e97fb1191c41d818a643c8b285ec9ecda031ac8052693056692ec76e108cd54d6e814bf0194ac8946a885c58b30c5e375c1427d43228f03f63dd5455c2e38449b8c35178a4066aa64ba52b34c18560056abc9bc1c1ef90abaae6824129e898044b7d005e1b214196c105b5ccd837aaaab87d265b2b2a87b69c13974d75c7f4732ecb6bdc3065afe4f9f5331aeaf4c1564357e6790d5d997543378db4bc621f9f2d760385ab96aeccc8f1207474d865b05560268d2dd4a324c102814ee6132a4199b413616164d94a92e354ab34032e4b354c793babd9103927c125adfaa5919690b29139440dbc44540c2317f828f60898934033db68094d8a33714a96c1199723a6a74c83a08dcc2730e6128c93741a1780288688690d4c6ba5e339091a2d907996c49e7eb8b9889078d24ec1289fb08ab5ed6196cde75ef64e19dbb6f3392f4132e891d77806fdfd55db1e2e67a30c918e447ace47524cff324a863192c7ed8401ba512b2e2daed22a38be3e8782955c72cb952424d0aab14c7b57d31356d246d828ee07d2b3d3db285c187849e52c4c86abfa4dd3fa1d9585603af22877f4a45e98a00b714ce6f3ff82a717347ddd1dcbb8356d9bbe51b2e4e3332e6f9cf351e7aab3f0bd1f4c6f58ad0cb74acfda368105b9af2aba648f0b1c6726da013b791dfb48c73b0e808efff7f672979369b16e7ad3c3b4d8cfbd00d3f108fc36de341293cf67cf053796c9b60d6312f0129316be3b02c905164220d4387d4b2d1565149e623e83b154bb0ceaa62760270c67290ddcc08f2fa63f86097ec72468495f4a5843ae8a7eb1dc0a06f379ea93e65c962abd4561db3ac09469c3955c877cecc41e5430936b5edb2dc093e590075ba62b735562f5f27cd3f6d5a8ed2afc17a4109adb54d26a03f6a61bebb260058d65b51dfce1e6620dcb2aca77a14f71ac7745f09c49c33a578e6b9a4f18fc9cbe5a1943c3d86d1e66d9e3e3634a1d2a557a9c7984c92ecedf9c5e8e4e0f7e4e5fa5135b894ef944190bff2bd1384dafa961d7d44ed697d4cbdbb6e3466ca1a318e6ae135910fd1154f49e45ab5c9fc04f7177649c203731e81302895e5714379604ae35ed93028ea0ac6c3aaa3597b68cc89fc7752d78eea0fe0c1b4e86ae67e84e3c74080ee1855988a53ff917e2336ef194c35e6688f647ddc23f2f46dd169484abd112fd67b26cbefcaf5d8391acf44ac9e0404e965d40dcaff79a6ac322c945bca3db25c194ba8307ff29fd4d567571ee98aecb6c588e93602705ba028723c01b4b7a5533c727881dbdbfb89dd5ac6dc30496a2374aca91d5fb70cc99b29e4c5c03922b2959ee7a487a470df3e4dd39f22dead9e1fe85c23a8523b850e3d1fb8b9db8e3dc2a644dec1250f4b52308af83e7788e52c1ffed942fbffcf0cee937eca161c67ea48217b433893513e5f6a96f6a7a130968788925df4f4ac0d23bd1373709d0dc53e2e2b2b26aebd88dc73e68187cdfea78ebcceb45e35e77afd4f1f9d6138f04c59d8b3b1caea5446a1ec4301ff0fbf992e1dbfbbfed7c842328eeb60f6eecf876d82281766f9379107e4f62b72abcc425a0ee3142a8fdad569507a3829804180a75efc00192d975c765e1a717d32f307a7f81ecf259860936ceda19c049addb83cd59c3093d7411946dddc96602bbc5ada55b0cdb968b4b24413045eeee96a8d3778c164c7f0a7f3f38aef9012e39fcb2ba485e8260329a5211c33fe155270c1a9cfe03e2e7e8dc214ca9f8f4ea4beb06d1362af776a37820ed7dfa8ddbc9472a1a869e26fda53981a6036b07580b3dc6c60507fd734696f9b8d5684b969593dba7dd91fdbfd476f77cf0adc2de2fe5beebb7c3af0693c8f579651436f25eaa47e9322e5c2c7b98663872082fa640f39c19835de10be31d45f92061d7b9a43728b95825163ceb867729d7325c2855bf559ab9db956b26779c7ff8f8e0681d841a3b7ec052644f2c6f2c2bf0ca8ab2822af0af7b2681476e270e8acbc127243aa55ca0bf5df3d29d137b15fab783beac80bdab7c50e45faff1d65f5537ce1ec835c3660717e99780777c0aba4fb5fe1d0911e838945a552e28cb0a86892bea2e281b36f6e78a2d2545f6618f2e743ffcb0c91bfb51c0165ac1e82eaefb58c95d00d74e432421acdf2e805dae63fcf0cb47d06d185805d4572d8657ce86ed940bdb9ae6ed49b5b5ee71ebbba70aa60775be57c6f555be479163013e53897b6b58e12b059e65eb9e8266567336654007d9d70f6e56e49685ae473186c8bdef2470a794e88ab38f536e9fd22e417c6ec469075e3aedb03e1bfa7660e9256ebcbb99a972ad62b6f99cf8b4c1e6c24f4002a24de12e16a2a9a459ae0b2ded58509f119b2bda77e7c33081920ac38679df3d73256075c3fc92077c8b5719ff66576be573a027c6012bc37c35714ea565e82e3ed0e1131303a154fd598678bbeb14a697caf27216f517c204fc5f48d2d1f9d9f9e5edcaf7ede9cdaf2b820fa39b9f62fc5bc011fce3a057b196bda74fdc6ef8009ae58c4f59b17894fc2cc398b4e43f03008d1afac5f1190000",
+		"e262965d85150a2041a5712a39a6ebd0": "1f8b08000000000000ffb4546f6ffa36107e4d3ec52dfabd802904d675d2c45469a850755a3b31607fa4699a5ce712dc267676760a9de5ef3e39a4102660adf4ebabc4e7c7cfdd3d8fcfd626980a8910b252fc95608e06e34cc5a628f3d0b9603080491db4364e2bc917559a8a8d734d14186821b31c81902b4a20255580b5f1923de4f8132bd03930fe1f8404b342bf3761863d30fdba9d344b9febfb4555148c5ef6f4a7796bfc043527511aa1e44795b46499f69885a18a9b56ee31e7581a8047ad641d98914a2a8e4dc45a623243f8940acc13185dc1b6fa1f64aae26b95e08d8f6be7ac059136b07846c20bf023be8c29db26aba919b102c0da9338700e4a66562dcce2e7bb7b56964266f162cdb20c69f952d640431542b8475eabbc2ae43d1a16375ca1b528135f5cfd69cce11cb5868be12558f5f088dc38af4ca112cc678c3fb1ac51303ea6d70d1379450897c3e1c171568ac3c3b7cbe56c4aa4e8e0d837ef3b365795418281b571fdbb2decf39962c1dafe4900f49d0377a821fcb19daf3f7d5b2b634a984cefa6cba937e253ac919e91167c85beced160b00fde2a6d3c8b484122bc46678a0c7c3b746eb447fad82edfc7b5bebb3737ec09fdec80fb4faf21fcde1f97a2ff8b461a551ae9ab8baf03ff8034cecd913f3b77f469e9ae6b71e239ea52498dbf91304811107cd9c4ffae509b084a5d03c9bb4b713d21ba0736e870b3f1ce0a298c60b9f807af9534b8315dea056f5621382f03381774ac3db5ed5c0448e4f98f806aa5678c349273dd5247109ea30a7b4147a435df17572045ee9bec109a8a647de1bbdc6c2258474075d6de6e37e8b8e0d098604735ba825f592e1266b091744b437535ede7328c4e4cf9d6bfde77add2de5359d021b5d6e334456e30690916274ccdb154a76e88277db391f0bf46c239f1a3b67e7eac8f99010070b6eb3d60dbf8da5fea79abfbae77afb5865ee0026b5126ce05ff0e0088407fc9a6070000",
+		"e8d26e97f44abd42262f9f0206f49b22": "1f8b08000000000000ffb4554d6fe336103d8bbf621aec412a64660f450f5ba440e07481a0db6291b4bd04c18216470e6b8954486a6d83e07f2ff821d94ebd1f05ba07c32239337cefcd70c6398ead9008179ca90fe6b9db7d180783dad2b5a2b61fba0bef89730b7825598ff0e60aac7aa7b6a897acc76ec90c02bdb77a6cecefac47efc9e525fc19033847db5136f763db8a9df7200c30083b5628095601e31c181821d71d82c646691eb69da37fb05587291ed8f00d42827d42708ede30cb56cc4cc73c2f6b501ac681338bd132c7db0afb14d726a06f946c3bd158685437f6d204b003d3ac37b0381c2ee06fa32404be06541bddb343583218a5781e6334633513d2d6d166d0a2677a0f1bdc836801fbc1eec30da8b5d2b080516ea4daca97286ae02b489a275312440a54395377d87cf4feaca06563772196c59da5cbf45f4f3a7eef1ced15c7ee3d6b366c9dd5a2ce9d24ab3e60a1941aab855c57506a346367bf3ac69dda9aebb6c5c6220721ed8f3fd48148f8295d8123055f85c2098c56de93820bd66163c3de4dfa7cab74c957f4468b8fa803d6b2aa082936b85f66e1a37fac41efdf27a17fc5bd218568a143594e442af8195e873bc3c1c13f21ba9af9e6edd9ad3e44cf47d54fd1e5bb2b90a28b010b8d76d432ac6b781d2392a2f0a4f084149797c046ab40c846638f72ae31601a41c96e0f5b2dac4509db278cd5bc07c1515ad1ee8f2a961493df9b2b78784c3971e0dc0234936b8457adc08e07edd233b995ada24bc5f16dd837de3b17aa4f2a9b4d6922f41b5a466fcdf568d5ed8cd1fb0be7fe6d1632e0fd451005250f21517258780f9efc67245f8322a631d43213d224bce571f23e873256d8acda15b06140c9cb59bbcff9fa7a4a13a5b40a990cf40e9cbd2791b0688f395e8f5c583ab124a4986b279e848759c38b729e5e660d568f581dee21a4607a1dd3ddb30d960f8f425ad42d6bd0f93ad776845855a468950631810e3e2915d9222a11a23d8847387a307fb16ec47202908c23db33e4ee556b6fb0431b9a79aa6b09b813c60ab9ce246083381810d68073e7b4596a6416b9f74dfa001684996132c9b37394daa8d6028f77661352a4569e0b2010c55d8383cd1be5e92ac7ad8f1e7c551f5ecf857367099e2b88ea93f99e39bd04f712dbc9e971634901f2c1710914e6b90b1cef7025242f737fac217fd0d4ffcb9947063ba5f284789da7e0744dbea733f805565fcef5d964fecfe04fa53c5d9d71a83eadef11f3c8ee1bc23c076cba3e14786c6fefd47a8d270325eda476619ebbf4220bbeba8bf3370e9850157c457fd9619347fc6c5e4378e9a96f89f6e5b03a37ab820a5a6df3308c91d355f47884971599bca78e313b114f9c43c9bd27ff0c003737a2c3390a0000",
+		"f04388538a7588e007317323f1703476": "1f8b08000000000000ffd43bfb6fdb38d23f4b7fc59cb0db930a454eda7cc1c2572fae6dfac887dd3697a4771f10045946a26d6e65524b5189b35afdef1f86a41e96e5c4e90bbdfc129b1ace8b33a399e13823f14732a3509611c9d8b1f9f68e2c6855b92e5b64422af05dc78b055774a93cd7f1288f45c2f86cf47b2ef8cac29c2ef1fb74a1e1981831512896e2174ed568ae54869f732563c1afed47c667397e546c41f17fc17332c54f97e0cd989a1757512c16a39910b3948e8a82259eeb3a5e59460911afff75fcaeaa705b59460b91d0b45e719d95dd8cefcc0467f168c6b8b7faecf7226594e7f17cc112c3a41485a2d27303d7bd2612625924af789209c6550e0b929d1bb62f1ebf9445f2fcf8c8754723b09f21a1792cd915cd41d23f0a9aab1cc8356129b94a294c8504859f72601cd49c424214b9223975d56d461b24b99245aca0741d3c0be8fc19d2f53700284b885e0a3e65b3e87f4fdfbf3b2333f03859500faaca755e4a4a14fd70f2cb43b6c77ad365215383e4842ac9e8357dcf0da6ad9048bbe952f00154bf127e8bb81e866a41f86d8beb43963c5cb6426f6a911cd2943e1849a237b5485e5315cf0f0f7fa9d16c85648a9b2e93246df19ca1691cf1a9b0db1ed756bde298510b368457dbd725e353a19156ae36cf6332a3c909cd8b54a1659aff688e1999d11cde50f53c4deb0791b1c6953dad49e2b26510807175b07f8794885ef3a1b79db23fe9d6db2e73f6a7dd7b4814b1d09a24955312d3b21adc8b2ea5b721f49950243da1b19009fa9c02b883aa42e04b69a0350ae71d5daa9785cc85ec9debfafef70ba65e2d32750b1ea74b7519eb6df5218c46f0f6ececf8959442025d9245965aa76f975b1dbf1409b5b2e2bfdf30d68ebd1ecdd78ca6890e105e2c12ad2aaf463df6f67777bddf5ce7579ae7786096f77b312d0cbcb7822b574415395c91a48e6ade6fb56519864e74cc84587f2924050ca4d15bc293944a3011d59d163c5e81f7cb924d1b4e4e682672a684bcad2a597f6634d77e9010b1ea050d34a37959529e5455b04ab5741d4318c61368037bf48edef881eb94a5247c46e107ed31a8c7107e40456a0f1c4f2052b5a3e55565242bcb06223ad5a785fbaaca8a63c869a17e18922a8477f466130ecbb6df153dda041cc20ad8d932a875e0a2c1d82fb502a237afce7caf2c6f989a7738436e8f259db265558dca32aa2abb6f9424e9684ce4ece8d00b31381c2669f039c81a2ccd8b3470cb72073aa7ff82a878de61f9f8fde9c3c85c21022f048dc8d7df5e173cceb733328b2508612bf0e86c599634cd69550d19e77f989a6b0854406064ad4f84aa42f2da25569ce80de36b7e34637cc07d1a48df5af88cf1e8486fce37f21fc2277a55f969cef286f1ff067f71eca72fe32c2f05bfa6f26d136dcec41bc67deb429feb4377216f3debebb9d630fdefd0e1063caee36aeb32c08d2459f71d618c0b94d09e654d0dc349e385038a98ae6308fafba16c420062f263788c102f4d8115e06307ab8e8c48b2c8bb088ff58aeb389729e5f84a4b29f763bb1cb88ec3a6a01f4d26b0abf13816c904384b5dc7a90015d87be4fb8fcff7e0d933d8dbbde8530b7c538e45c7685954fa8f6a7ae7bb1741109c8f91e005a2765dc799fa71f41fc9149521c4d189c914422b4ae03a953e03a341c6996224657f522bba2fe131d2aff705e0c76a09b6f25c51109b82fd7ad46091f0372da6960e374e06607c19b85d2d18385923c7aca08dd0b15a6a7e35bbff2629c3cac13237c45a083d094253ebd9d42b04122b263894e50f4359fd73fd3400aa334423a54564898b15192d977d10e42c046969d744b5608d64680d956bb2cffe7e6da328f2d716d114d77df283fc5856d7cfb365763be33134d7f10c2c216ab7b156494972c4559f8cb5ed46fc6b4cd80ff603f0f5ffd048aa6d36438f95d16b2117ff2669417dbd33d0c79cc164029ed73dd6eb100f7ae5d46cdf041d30a7c84c16c2de6e0807fb815b75f87c21447a3fa35742a401f8f8ef2bb2a979c95afe6e303a60c5316c5d376099ce33c1735ac792eb6ecda7b589355e0897a8532c68a25f89cce724f5af03d7b989de529250e907d12955bea7cf96ab9db3db8c7a217824cb521613740bddc3fa07c47354a99a146abaf393378482c473ba8388a4c0f7b0c7c54e8c6b0658f3e9234f3d494fc44dfe7c3aa5b1a289bf4138d981a9cde70e09bbe0df83b0a3114c59aaa83ca50a2b409603c3027441390a7475abdb5c06240731d55f756cca8d57afeeaecf190f19058a455a2c7808226b6d17ed3287288acc4a1d4f0c33e8a9af354ac8a9d2d462c113861a68e8ff5150796b7c971abed49c4a5073c2013b16e82e338afd8710844cd0064d351f422c0aae80f00489311ea745422f4d432801c1ad38113c6fd1c33323c5cff6618e70f48f82a44cdd86cdd3cbcb6722fb199f21d322a352db28882cd44c1bb991ac9862fb90480ab1582c08e41469299a446d14303a588f038605fbcf68dd2a10558e1d21ec1e365ac668a0ab64197d38f925fa172aced7068a11014175ec427579f0d75fab2ba8c0d555adccd5a5ba4db2b2864a5e5deaa9da442107033ce305ad33908eb98c273094289e66295346393a93f191639b3d89cc92f2e0d1239d5e192d04b8bc67285abd4cac39e606a1053cdfbd08c10bd1ab9ccae0a452a20f5bb35835e95acb511405ffc043e8bedfeb784aa534d8aafefbbb396b1d50d74eba17359b33be2aa661cd96e9d047279424cfd3d497d10b91dc9a68df63a7cb4d27daebd8f4812f6c74d2b8af83366f3260babdf5a090bf260db2d3bea66c436a3cd9759dfc86a9788e9ce19398e474f0e05f49f94ea8d7a2e0c9d8756a0c1313944ff5b71724b1f4eec6f38163fc3a133626a3fa3f1ba5ee4bbf262ca59fcdde11cfa9545f0697e98f7f195c2f48a25d2edf065142a7a448d536a095eb981e5fd34945ffc116ea187b61667be83a752f748cb6820c09e90721eec7770d4ff01cfd1b9d3c53ac09128a7e12d485e33b7ab3dabdd5065eaf6aebee9672a1258c5ed837dfcf64d789d55277807d0bdcb069bd2ec37ce603e3ea273f1ba82143f8486f6d000bc02f10b09b04b2e454690eb33c7a718b07e87fa43a2c244de458cb46f5269391fe745704d1d47c9604611d4c363ca97ab2ec1d6c2bccdec1979466efe03e71f60e36cab377b059a0a74fb615e8e9932f29d0d327f709f4f4c946819e3ed92cd0c1feb6021dec7f49810ef6ef13e8607fa34007fbc302e90aeb7e696aeffe26a2ececad89c0b8dac8ff56deff4d9d7f58800d4e7fb4adcf7f63971f166293a71f6debe8dfd8cf87a5d8e4de47db7af71777eeff79b04724ebec9f6af6b6e1bfae32b710a0a1a7793549794bf2c387a3c36f40b043f1c5ada2f9d72139a7cbe890e2cd8ad5a4de50e71c58939a4b08603910dd91d3a52bb6cfb108c774197024414fc0906641d7b565191dda2918245c55ed50cc6804ff3c2d160b226fe1cd672082ab5bd01734c8e93fcfc82c876688422f1d1d769e1fea119e4c4bf0d5c47a1ec73453a00b28bd702c4552c4b4bb8267078631c8889aa383829205050fc7a070d76911c734cfe1c9ee2e94e2ea771aab6a689a2bb2a3451a31a6f37847bf7fdfa62643ed6ddbdf721b789daa2b84e40acc7085d6174b800b0553acc860078c37e55083eb71094dab85d2066b04b717a50fbb421b955a99159ccfa8ba403ce82ae061bf3aa7f29acad3784ed174c6a351bbf856e40aaf9db0e341a15e3dc6e1b89f76ab6adc42e25a43f181bc2d6ffff4e0ff769e676ce7434ee5b8c8a9dc7bf2d438b8b1437fdb6a7928086847c72a05ebfea6d16dab15bc19711dad9dd530e0e9352f709b203c9e0cdd86e8b2c933d794c3634bf594d47aaba3d72408a12ec264d03cadbb0e683e21888fc8e5ca64deb966f442b3f937f1f12ec4d38532f5d4d4f70a5dcba34b4f194f8c0b8fe1c7dc0b8de305d0e761a5876d506aa3b6d1b02c076e5e47233396f0bee9ea113063762198913410124c6f0b9b94a47614baa471611ba91a83699af690b58343a311bccfdae661b8918aebbccf0687d57aa3417a304a64385ca4b1ebb869da72dd66ae1d5edb0e9d56728311839b643ac663626a1b6c16b95583126b028475a0d53dc59a9942a63a54baced1219287f38b558e86f86149c38c1915abb5af84d51f9e8e61006718350886e9e884dcd82a7d10b141d31901d3e766bda63da5fcce63aec1db436e0e3e87f38bc73d5b18e2a3a5b4ce8b8924cd4ca0d5e35dbc9976b4514cd26aa6d11a0eb9ce29f06271650ea66e8fe3ddc58a6096762b593d6d787ed1e95d6e50ad86eccb83d77635c740400f25b48aae9beb86d11c79b36fed0e5b0fbd011519f4cec0545535fb759ea5996ca724b48e5a82620af41a6f25343b98afd9db93c6dfcc8be0a163160f18f3e98c36b77cb5e30a438f4b3d67f190d120d771bcee90ce59bda9aabc316c1e05fa7a83409156693d5c62bf0c6fb6aa0abbd3252be667b3182030b74388c61631c2124859aed0e67a3199f0c4ba483724300e82535092f0dc5eaba36f2e431c9a231c49b6763d252ccd81a46917035e104991a6684824feb8924dbfb22ef289acb479b4167b2d6dd6abb51fe60f155df0de94fc1d99fd06deb64faf6d283231f64a24b78329ed0a9449c15b861f988a5b5c26f87d4e42fef01c585b379c672237e92f8de702fe5e766519c3397ef7c6f59c3bde776b0fc6a567fad3cfb8c6126f0ce7de3396fcec5d5417d5dfe12f9d74024e6e7e0739b596757346ad4fc1c7989a0f86b610d472f026e36c7952708ed7a69d14db4c7bf587bb3e3b5dbf275f77ec3436823cea9a68b97afbd85e1286f500f7c60bc7b56cf9bedb9ca0dd5b5fc3ca3aabe8f065563463962bb53449f9a6776de7ced27174c5585fecda2b69236bd4bc7773d4bfe338cd3bb22e1490427e2e32f3d3099c5773ba6542c3ff4a65d0cf1d7e4cc650f08f5cdcd8f064aa04cd9441ac35613480183141695a5c0d4f4db124b2a066a47f125bf3f3e3b5e1c0964aa87e4b5b6b3baaf3a909902ca33cf1fb4f429bf86956aaf624eb89c1c01d64f0ee8aad670d430593e1c2ce045a8fedf4919aaa6eb8f3d2be4f922405da00dff54babeddb4cf9d7c03fd0745aef337d7bb1b7784f7e528be993da33df635be6be964c73645fbb37f31d745fd61d79a503d30ebfe1ebca4c09ad00c00416e423f53bafdbfa37942821ce66aa4506f59afd25cb5079d15414bdfa02ab4107714ce091c582f10a7530d6294983a4aa3cbc636f7e2039066f543f4754c6543b90abbf82dc1adcfed2f15ef8e6d78cf742363f59bc17b23e7303fb30bb6f30b7f83098ab857dd721419cc09c6c1af67d435503e8f7551ff42ce3bc0f700113508bcc6d7f9d51b9aefbff0300d5af101daf3c0000",
+		"f6340f69aef77c41d1dbfd542f775d5f": "1f8b08000000000000ffcc58ff6fe23816ff19ff154f215d60779d741969a443e26e684ba7dc52402ddddbbde91e328913bc4dec8ce3b4d3a5fcef279b8498b6e83af7c3cd05099c8f3fef8bfd9e9f6de8974c4805b3abe9df17b3c1fca2bf5e7ba9088b846e360895bd6783f9107a7d70dbf98a2609844451f8e1e837ef28f58e427c747174d9a9b8e3c17c783d5f9c4e2f2f47735b28660a121103c699a44a3df6232153a27aada3550b30879f762a4eae0693d38be7b24b4978b082a758d20cf03d385c9498f30406bcfdfe292814e0105ad0021c756b8d37a3f17c319d2c46335bed2770dd76c1494a3bd08731e3c517f81dbefb0e5622571a06cce0e9a97ead158e268bb3d155dff3978c57e06c70faf3557731fc75787a331f9c8cf7e62c10694a78a83dcf487027bb00ddbf821fd27b9f1749b2d37cfd8fc1c7ffac227f20f10105573793f9e872b8f86578650bc702eea9cc99e01df4625aa6d7fd8a67a60330e92016d1cfd076dbdb70747eeca03a3029c9159588f290453b75db982f4e272fe22ee93d4e58aee0623838832778080027f004390da195fbe0fb71cb9adc9bd1f86c31b9b93c2987b0de1ade60775ddbd8586667a3f170313e3b1f0f3e5ef7710ef8577052c2b87752b0243c238af6ddb5cee28d03b7080e3e3bb931513457a7224d99eabbebbda47eab0e637b52a44b2afbeeda1ed4d76850533e9a95f2650a7fa5f834b7c5a7d746bc611e8b7c5570c552fa8b71d6caa18d83d0528f64c178247a10ac6870b7c82495f433349ba08d84a0561402c115619c4ad4f840839580566bd7c2ffed53abb026a01ca8dbb6b0ce2bcce9f54be6f4da62ea942829e5c76d6bcca2ecd733cd71db7b98c52dd7c74e19c06ef5d4246b95ec4835666bb39741a5cdc22ceab32550e9b4318badeb7c69bafcb86d8ded519e9732b7fd02b3f8cfab9676e01966b1ed125539606116b34a83af7e6a152d8450f31b3ca8d944cd26ac68924124245012ac4091fc0e30ac94caf29eefa744a634214b2f10a9bf4c44ec778f7f7aef1f77fdee5f7c52288143111429e58a86382577346209f5562a4db6dafff70ff26617d3c96f3d332e84f4774f9781f98ae506f350e30379b883d6c9f0e36802ebf36be883d3f3beff5bb309ce06fc7f7d22f8cf01fee702fffe4309fbb0ce24e32a02e7f6f8ddbb4fefdea747f8dd716e5e8e5338ca6fb9f323b8ee4ffaabbb6981dbbe1cfc3c3c37f93dba9e7710f2ce86e7839bf17cf1713a18eb5d433b83be6decb7b55144b0649c4846f36f163592243da05f489a2514f4d6a66366cafa0ed587135970d39b2354b95c8bed24aa2e605c8f0c4226110a24258a2e42267ba8f121bd0b99049ce992b53d2a7510b2b78e1ed40288453ca4d1cbf3136ac40262aa0017fa18b12ab60b2516cb228a48227c7392f2efbbdb46b73c8f34dcf603919cf1189c48143c2c4f5c4e0755a69ed5a60386f4292b16e6c70fd2d0340edad09dda42b55d922cdb1b64a3519efb4c376061cd8dbf6d4e0697c30e60023809a384c439b45ea9e3e0b607b39939ad6b7365787a50efd22f836b42f66807ac2c8fce3e2fa7f29e4a0735f4688071a600631d4a0f00e398722a4932d2263c9f6499bfe5fbe604110bd4d0350aaaa1f42bad95bb7db7bdbb68742cf9d275926556d5ae5bdf76119f2694f022fbbf58c628d0ce2c2a3f7ab07dafe659c7dd204092e4f5656a6b78ecd502af24884c0147079214a13dcb96a21239940ab679bd5674b531d275e9d16b51b7f43dc74e1884a2b4e646a902c12193e20f1a28d468c6c2607b29e6799e5eda1ad7b78210f083be787808852230aa1252e85b652c4211187dfa46f1fef8fdb196d318d67b75bf672014d22c376221cbb3843c8206cceebef363bdf634b8d9943755c777a0bc9f3e1b4f893ab79e66e4daf053c1d9678412c6eb81ea17ed993e62efacc4c2c09e1921ca15512c30e5752766612fa4ed3ecc622e2405a7baaf05448167fab63d1d073c84ee69ed91b94abee693813d849410c9769e18cf95cec590669487942b307d66ca021152209c248f39cb0f96fa58f899144a97fcb770b6e43731ffc805cf966f571ae8fa87b5476f92d1c417ceac04e734f202e1c7c237537150970c05bf4b4891fb8cd3282279ce627e801dfdc9b2cc8f45f01824fbde8984f0d81332f6bff83a65fc6de67cf5ce8a109234a6dc44d5b4a8d4ff02558dd0841335d66bef4af79ea6e19871f327d27acd225891fc9cd124040f9c999e95a064389b0d32d3143c571d49918267faf4b1d7e8de13dd6cd07a4d79b8d9a07f0f008e23a27dc7120000",
+		"f6869bdc7ec1c640ce1b9cf421052e1e": "1f8b08000000000000ffec585b6fdb3a127ed7af181805e202aad526ddeea2861fda745b148b2c82b6fbb4280c5a1c29dc48a4428ed2b882fefb821475f1e5c84a73709e0ab4b048cee59b0b6786315b49ec0156302bb42275315b0641c1e25b962254d5e20323b66106ffcd72aceb6520f242698259aa549a61e47836651291c8d110cb8b85db9a0d2805dd949b45acf22855a9ea59ecca2ddc7ec71704aa20a124cc3b8245a18a3263846b9665cf6105a44b5cb674a95ab7805730abaac5a592894817578a6376dd9c34f067cb23b2f1ae64d951c103a25419d242a6bb74418ec658bd5fd0941941150000a02c73d06e674ddb02fdb6fdf74fad958615bc5c765b5fcb3846636005af9acd3a703f4301b1e2d6b657f0df01a4b834a472c972b480ce2e15c7b3706858ae34124b8d3bfe9f51f2edcc0a9ac1439eb59f7ce3bfcebe37da1b33a1356c05e7634aaf1ab2937abd38afba5bf14dbfb000ea2008a2083e8a8c505f2ac9858b43dc7d29090c629595b9049500834f48efb20c34de9568286ccf8401ba41b04683f59025b61bcd7108aa0025dd2ede85206468d5a614424a184246f6bffd10b71882c8c42d02931c6499655dccf7515643ff791c5d50fdb62a6005e74b1fe00219216f59ee5956a2cd838bc611ad1e9bdbb8935bcd8e4b8d358d671754156826538467c43699bbc6a1fffe2c13056f57b0e85606eaba638da2eb5baaaa01f1e22be932262b03ea1a56505583c3cf92e3435d2fabea05a0e4753dcce65dc882f76ed86c090d7046ac35dda0be1731c27bcb233954c1af19a18bd8e7c78815f393145f9adc7a0e1aa9d4d2c01416532869f0b98f74036484613e7e7c14c2e3f4bfe37c84613e7e7ca8ff14fd81feff1406f5a80b4e521ca298c07200e47d99dd8ea39f9f2639843285e7885338231ce1999fa438e694932c07403e6086a33cf39314874026b074406cd110c90ea54aa89130b8ce5fd090d26332e7a7490e914ee1d981dad4b7feab0e7ea14645113873df7675d432d57557f947f0f8aa7f6c5071bfeb526a8c552ac54fe4b611272c33b83cc9748bdb47d01bf1136316dfe08063e00811c2b34460c6adf1034beca8f2d1ee77a53a8ae0d2754ce70bc7b36836ae90980d8590a9a7b55e6928aefd24f96d5b605d1f39704aacc77cb7827d826b65ea7a6cc0e9657e52036923034f55019a9815d8337e52574ae337969aba3efb3e6890c3363fb50ff9c80b496f5e436139bb21a3df7391e97b6c1481d21c35c42acf19182c9876a34737209976423276ba6fa61713024713a3e4763c1109141a13f1801c365b78b133d638e1ae7def4c36fb2352e2d676c679dd01bbc5ad41028319c6d4ae0a960ac96c9a868d8dc28048a5d2c8dd206691ba7d969035abd4466910c65f69e44efa46a9ac95b882bf752a3db9c4075afb6f6f7da1f15ea8d238a521605ed01612a5dd6122b4217732b4dd0b58c19b5ebe2a25c10f4137c7ecc107161390f26456b2bdaa9a1bc819c53756a8ddf4ce0a010d899c115a9efebb676bd57a1b5cfa0cb14baf6a07b5d3bd82bf4f2abd510442c659c971cd5d49e6ed7a88038c4a085a82cd160c125965557554f8f086fbcad7456d5fdd0afe31acbcf5a3ee4d53bafdc5f14fb5e679d5df9d2e67abeaa8a0ba6e67d4f3fdcbd627fee105f479de1c902296ad5b6f7519e943324c47974d7bd5615269e8ead5b532b6eadae47b0975fdc8a25c552e250e0af16773ad45cef4f65fb86dc876b40919fb2056d57ea99d5ea43b91a3a579f607a579d6155807f078c63c395da66449dfc8270d93504d93fc6a393466daccf97463fa2cd6ea877997241837f7f26207ced4211eaa47de3a0fd5763279a46df98e65bb1883528abbd23ef6a521cd84a4d0177797bab626dbec7605721785bf894ab6017c8c5d533cfd8b5566d4e5935f2b4ff0f9c636a6a6a6c932dfa0b68e6e0b999036e6cd58802cbe01438c3047eb77ced4e20326accce8bd95f1d58a1009484550282348dc636be8c5f950cf7e04a63fb0fefa104c7da57964531cff38e9530cff13ed9dfa18fcdd902635a4c90fe5f100f7815bb33e72ee169d98f13a24931fcfbf233b29b2d3ffb0f0a4d0367a83aa0294bcae83ff0f00f6c680ced4190000",
+		"f955cce0a3fb41553c9427fb1e0cceca": "1f8b08000000000000ff2c8fb16edc301044fbfd8a01d4dc09175e9f32b9200810c08d7f8022f7a405282e412eef2c17fe7643b29bc514b3ef6106fcaeec8d23a60d4ee6ac95d17a295a0d25f559324e4b7b77ab4e72a66118f05761bc96e48d69c02fc9be0a37dcb5a2549dab5f1b7c8edfdf8d46c76ffc753f687431251a5dd33d6e49269ad5b5be120d78e5669876e076c1d425199e62cb4fccbbb2197e041add9ef6f64bb7d20d7a872dbc57823eb8fa9961aae9825638c85d824f69c373e18cde381e44fc17e37fb73f343aed07ecc68573e41c3644a91c4c8f51a7caab3ef830045d57ce8689933e610ac921f5c8103bd38007e7a8f54a3449be9293c8fe4a44f43900cd9078ef62010000",
 	})
 	if err != nil {
 		panic(err)
@@ -61,45 +79,63 @@ var _ = func() error {
 
 	func() {
 		b := packr.New("gen", "../template")
-		b.SetResolver("GEN_README.md.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "5a6731c012006171905bc006b699e6a0"})
-		b.SetResolver("Makefile.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "b799be4656319aafe2b18e1fce84b51b"})
-		b.SetResolver("README.md.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "34e1957655af505ea742ca42555313b7"})
-		b.SetResolver("api.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "fd7390ee6c4256229f5f3d526262546f"})
-		b.SetResolver("api_add.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "36cca6f17a7dad3fd8ffb948c01678bf"})
-		b.SetResolver("api_delete.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "26e9ac997e362937a263db5cd36883cc"})
-		b.SetResolver("api_get.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "7e1bb7a6fa257d6a01306f3b3fbeb3a6"})
-		b.SetResolver("api_getall.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "16f6909b4741ddb9a56b42c8f91e9fd9"})
-		b.SetResolver("api_update.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "1ef7a6022c151f089c8f937777998127"})
-		b.SetResolver("code_dao_gorm.md.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "b0787b42c5582ac977ebdf8da2845709"})
-		b.SetResolver("code_dao_sqlx.md.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "29cd6e2f5881d9f19ca732c24b7ed7b2"})
-		b.SetResolver("code_http.md.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "db7217d16e2b0c79d27600b28e1ce9c6"})
-		b.SetResolver("dao_gorm.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "ca8905e3fae93dc0ee5a57d9aeed3259"})
-		b.SetResolver("dao_gorm_add.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "cdfebd95d7762d8a6701d2476c4cd066"})
-		b.SetResolver("dao_gorm_delete.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "0df26a2f94d76462650022cc445a4a11"})
-		b.SetResolver("dao_gorm_get.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "bd6cbab1e1b42444feb752d9cb2f8163"})
-		b.SetResolver("dao_gorm_getall.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "b33731a3f7f61e6e31ce19e294a05aa4"})
-		b.SetResolver("dao_gorm_init.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "a3c72a98c9645c632a78c4b287b49ab2"})
-		b.SetResolver("dao_gorm_update.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "3b1c75b8e4d28f4b29acb1e48a50e464"})
-		b.SetResolver("dao_sqlx.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "7d8a0ff5810f52aa5335bb5f06c75056"})
-		b.SetResolver("dao_sqlx_add.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "5ae4f8ef0e9b1de7530664f3fba1d023"})
-		b.SetResolver("dao_sqlx_delete.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "ff54a046f5cf5bab67d837a8a7b21fd2"})
-		b.SetResolver("dao_sqlx_get.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "f960b840e03e232aace40533d640c30a"})
-		b.SetResolver("dao_sqlx_getall.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "5d0f233f1bccaa0723de47b5a2ceb285"})
-		b.SetResolver("dao_sqlx_init.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "2a100c4d01d80db47aa8c6c74f636d22"})
-		b.SetResolver("dao_sqlx_update.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "813f7763788b1b28819a407ac9207289"})
-		b.SetResolver("debug.txt", packr.Pointer{ForwardBox: gk, ForwardPath: "2a4ec5d806dceaf4df95a14e32bc8fe2"})
-		b.SetResolver("gitignore.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "2c89626a28ddc92ac95f345d6ae2a468"})
-		b.SetResolver("gomod.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "4dc5d0bcc754ea1d422df93cf8b3abc0"})
-		b.SetResolver("http_utils.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "6363cd73a54815cbc46f48f9b0c72cf4"})
-		b.SetResolver("main_gorm.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "89aa623ced15e1535b6ae80b0f4baabc"})
-		b.SetResolver("main_sqlx.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "d5d1125410fabba3c14b9f97a7018f2f"})
-		b.SetResolver("mapping.json", packr.Pointer{ForwardBox: gk, ForwardPath: "77f02d048f6eed1a3e480f2220639f05"})
-		b.SetResolver("model.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "28851f11a1e66316d1f1150914d2be3b"})
-		b.SetResolver("model_base.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "e4bd0d6c65071597f7e774df0618541d"})
-		b.SetResolver("protobuf.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "7b7749291dddd94b5eb4d0ee2c75d8bc"})
-		b.SetResolver("protomain.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "346fbc41181bdf0f31a8d024d6ffbf18"})
-		b.SetResolver("protoserver.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "3adf58574e9c76acb6a937d9d849f6a7"})
-		b.SetResolver("router.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "bf7f87a723eaffa58cf271518912327b"})
+		b.SetResolver("GEN_README.md.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "4b0f3d2bdba0d625b051a8845d627f59"})
+		b.SetResolver("Makefile.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "f6340f69aef77c41d1dbfd542f775d5f"})
+		b.SetResolver("README.md.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "b72788ee7c008da58642883b8a609eaa"})
+		b.SetResolver("api.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "4aa5e97f288ebb6ade4b557804160d53"})
+		b.SetResolver("api_add.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "61c21d3ec67e70145e038ffd408a0b46"})
+		b.SetResolver("api_batch.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "17da0259671026bbcd5800914e3fe3c1"})
+		b.SetResolver("api_bulkadd.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "6624297964f7eca85a5788bcd5bbbdb8"})
+		b.SetResolver("api_delete.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "e262965d85150a2041a5712a39a6ebd0"})
+		b.SetResolver("api_get.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "48e427ead6a7a4c1f4c20cfcfce353b3"})
+		b.SetResolver("api_getall.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "4e8a4856b79d3f5cb4de8bf9aa02b5a4"})
+		b.SetResolver("api_patch.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "0d7aa2fadb0a277d30bf439ed5a151fc"})
+		b.SetResolver("api_restore.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "2f8dd756d3400dbd1c54d1156dfabdc0"})
+		b.SetResolver("api_update.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "c848c69d321a09c77c07e3de8dc3bb7f"})
+		b.SetResolver("api_upsert.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "4a4527aba86ea6c4cad7d61f978f884c"})
+		b.SetResolver("code_dao_gorm.md.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "6a70dcccaba25320eede0918d785d598"})
+		b.SetResolver("code_dao_sqlx.md.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "8f87caa6465fd125638d1cbe5bd544c9"})
+		b.SetResolver("code_http.md.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "1ba04236fba67eae85d6b04d6693b76a"})
+		b.SetResolver("dao_dialect.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "5eff825b68f6e8c40e1c9348ba1b0643"})
+		b.SetResolver("dao_fake.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "897e7d545473fa3f37af09d464b01c6f"})
+		b.SetResolver("dao_filter.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "23c996435a95e1fd132776e3b21ec020"})
+		b.SetResolver("dao_gorm.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "678d55f70ffe016f0d3a311904d553ac"})
+		b.SetResolver("dao_gorm_add.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "6f31df1bf99e541c2f3bba3142371a32"})
+		b.SetResolver("dao_gorm_bulkadd.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "57d6928f886eeaa00484e1656a66fbba"})
+		b.SetResolver("dao_gorm_delete.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "6d6b5e9918a30792eecf74cdcb1e6cfc"})
+		b.SetResolver("dao_gorm_get.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "bfaffea277618f8ba2d318933f3b4c2a"})
+		b.SetResolver("dao_gorm_getall.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "c631c7727f22a2e0af93ddf632062d11"})
+		b.SetResolver("dao_gorm_getpage.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "ca0c83aeeb6b99eb363a06d34dee4cd6"})
+		b.SetResolver("dao_gorm_init.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "385f58a2c0f21f2941409b7e1ff4c361"})
+		b.SetResolver("dao_gorm_patch.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "1821410450bb2a624bac209e4f13c39b"})
+		b.SetResolver("dao_gorm_restore.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "1955a1c25f24dd61fc8a4aa10db6b9de"})
+		b.SetResolver("dao_gorm_update.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "b957c1754c2848f071605e4d6c214140"})
+		b.SetResolver("dao_gorm_upsert.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "a9dfe68e857a66531d16b0826b724fe5"})
+		b.SetResolver("dao_sqlx.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "6cd7c14b05782fbdda0d3d2997dc7404"})
+		b.SetResolver("dao_sqlx_add.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "3bb4d245f7af16bd3b593ed2a35bb098"})
+		b.SetResolver("dao_sqlx_bulkadd.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "ac415658ebc1011b86081fd0c63736bf"})
+		b.SetResolver("dao_sqlx_delete.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "18492817ebd8d94fbe3e73201b0bb5d1"})
+		b.SetResolver("dao_sqlx_get.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "48dd2150bbb76163de11ee370747203b"})
+		b.SetResolver("dao_sqlx_getall.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "c246b1d07f818c6d4803eec9bc8d086c"})
+		b.SetResolver("dao_sqlx_getpage.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "aada6cb25c03f95af1045a0b40b8d157"})
+		b.SetResolver("dao_sqlx_init.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "526ba265c6b78316fb838fcc1236c2ec"})
+		b.SetResolver("dao_sqlx_patch.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "b73c8e334efa711338911cf5a56d47cf"})
+		b.SetResolver("dao_sqlx_restore.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "2ff3a0cb930dc87373247454c196e588"})
+		b.SetResolver("dao_sqlx_update.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "c8065f16f9da35b025d004a2b023532b"})
+		b.SetResolver("dao_sqlx_upsert.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "e8d26e97f44abd42262f9f0206f49b22"})
+		b.SetResolver("debug.txt", packr.Pointer{ForwardBox: gk, ForwardPath: "4ebad8bb895a442e67e9787435affade"})
+		b.SetResolver("gitignore.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "f955cce0a3fb41553c9427fb1e0cceca"})
+		b.SetResolver("gomod.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "7b5d2a46a3677e3e05f2941aa1acb452"})
+		b.SetResolver("http_utils.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "3ddd46698e7b6260725e8b77ccc102e8"})
+		b.SetResolver("main_gorm.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "86fec1d27c469ab2f234b192403cbe21"})
+		b.SetResolver("main_sqlx.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "e17ee6d3a56b7b8e2070cd55fbc2d882"})
+		b.SetResolver("mapping.json", packr.Pointer{ForwardBox: gk, ForwardPath: "7bb45f293bb8bd6c5829d4b8a2a85ab2"})
+		b.SetResolver("model.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "807a4c794e36ee618349cddd08269966"})
+		b.SetResolver("model_base.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "14a29ee9bf4f478b5b5acb1be338d9b9"})
+		b.SetResolver("protobuf.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "f6869bdc7ec1c640ce1b9cf421052e1e"})
+		b.SetResolver("protomain.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "99c1af5d6348918cca760407fb39dd93"})
+		b.SetResolver("protoserver.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "2412da4bd4dc5faec21cc8fca40df65a"})
+		b.SetResolver("router.go.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "f04388538a7588e007317323f1703476"})
 	}()
 	return nil
 }()